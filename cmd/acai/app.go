@@ -0,0 +1,458 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
+	auditapp "github.com/felixgeelhaar/acai/internal/application/audit"
+	authapp "github.com/felixgeelhaar/acai/internal/application/auth"
+	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
+	exportapp "github.com/felixgeelhaar/acai/internal/application/export"
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	workspaceapp "github.com/felixgeelhaar/acai/internal/application/workspace"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	infraauth "github.com/felixgeelhaar/acai/internal/infrastructure/auth"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/cache"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/config"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/events"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/granola"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/offline"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/outbox"
+	infraPolicy "github.com/felixgeelhaar/acai/internal/infrastructure/policy"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/resilience"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/webhook"
+	"github.com/felixgeelhaar/acai/internal/interfaces/cli"
+	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
+)
+
+// app is the wired application: the CLI dependencies, plus the resources
+// released when it closes.
+type app struct {
+	deps    *cli.Dependencies
+	closers []func()
+}
+
+// onClose registers fn to run on Close; like defer, the last registered
+// runs first.
+func (a *app) onClose(fn func()) {
+	a.closers = append(a.closers, fn)
+}
+
+// Close releases the app's resources.
+func (a *app) Close() {
+	for i := len(a.closers) - 1; i >= 0; i-- {
+		a.closers[i]()
+	}
+	a.closers = nil
+}
+
+// newApp wires every layer from cfg. On error, resources opened so far
+// are already released.
+func newApp(cfg *config.Config) (_ *app, err error) {
+	a := &app{}
+	defer func() {
+		if err != nil {
+			a.Close()
+		}
+	}()
+
+	// --- Infrastructure Layer ---
+
+	// Metrics registry (nil when disabled; instrumentation is then a no-op)
+	var metricsRegistry *metrics.Registry
+	if cfg.Metrics.Enabled {
+		metricsRegistry = metrics.NewRegistry()
+	}
+
+	// HTTP client for Granola API. Per-operation deadlines are enforced by the
+	// resilience decorator, so the client only caps at the longest of them.
+	httpClient, err := granola.NewHTTPClient(granola.HTTPClientOptions{
+		Timeout:             cfg.Resilience.MaxTimeout(),
+		MaxIdleConns:        cfg.Granola.MaxIdleConns,
+		MaxIdleConnsPerHost: cfg.Granola.MaxIdleConnsPerHost,
+		IdleConnTimeout:     cfg.Granola.IdleConnTimeout,
+		MinTLSVersion:       cfg.Granola.TLSMinVersion,
+		CAFile:              cfg.Granola.CAFile,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("granola HTTP client: %w", err)
+	}
+	if metricsRegistry != nil {
+		httpClient.Transport = metricsRegistry.Transport(httpClient.Transport)
+	}
+
+	// Granola API client (anti-corruption layer)
+	granolaClient := granola.NewClient(cfg.Granola.APIURL, httpClient, cfg.Granola.APIToken)
+	granolaClient.SetAPIVersion(cfg.Granola.APIVersion)
+	granolaClient.SetUserAgent(granola.DefaultUserAgent + "/" + version)
+	granolaClient.SetSlowRequestThreshold(cfg.Granola.SlowRequestThreshold)
+
+	// Repository: Granola API → domain.Repository
+	granolaRepo := granola.NewRepository(granolaClient)
+	granolaRepo.SetListPageSize(cfg.Granola.ListPageSize)
+
+	// Resilience decorator (circuit breaker, timeout, retry, rate limit)
+	resilientRepo := resilience.NewResilientRepository(granolaRepo, resilience.Config{
+		Timeout:           cfg.Resilience.Timeout,
+		ListTimeout:       cfg.Resilience.ListTimeout,
+		TranscriptTimeout: cfg.Resilience.TranscriptTimeout,
+		MaxRetries:        cfg.Resilience.Retry.MaxAttempts,
+		RetryDelay:        cfg.Resilience.Retry.InitialDelay,
+		RetryMaxDelay:     cfg.Resilience.Retry.MaxDelay,
+		FailureThreshold:  cfg.Resilience.CircuitBreaker.FailureThreshold,
+		SuccessThreshold:  cfg.Resilience.CircuitBreaker.SuccessThreshold,
+		HalfOpenTimeout:   cfg.Resilience.CircuitBreaker.HalfOpenTimeout,
+		RateLimit:         cfg.Resilience.RateLimit.Rate,
+		RateBurst:         cfg.Resilience.RateLimit.Rate * 2,
+		RateInterval:      cfg.Resilience.RateLimit.Interval,
+	})
+	a.onClose(func() { _ = resilientRepo.Close() })
+	resilientRepo.SetMetrics(metricsRegistry)
+
+	// Offline switch: beneath the cache, so cached data is still served
+	// when API calls are refused. --offline may turn it on later.
+	offlineSwitch := &offline.Switch{}
+	offlineSwitch.SetOffline(cfg.Granola.Offline)
+	offlineRepo := offline.NewRepository(resilientRepo, offlineSwitch)
+
+	sqlitePragmas := localstore.Pragmas{
+		JournalMode: cfg.SQLite.JournalMode,
+		BusyTimeout: cfg.SQLite.BusyTimeout,
+		Synchronous: cfg.SQLite.Synchronous,
+	}
+
+	// Cache decorator (SQLite local cache, or in memory)
+	var repo domain.Repository = offlineRepo
+	var cacheInvalidator meetingapp.CacheInvalidator
+	var cachedRepo *cache.CachedRepository
+	if cfg.Cache.Enabled {
+		if cfg.Cache.Backend == "memory" {
+			cachedRepo = cache.NewCachedRepositoryWithBackend(offlineRepo, cache.NewMemoryBackend(), cfg.Cache.TTL)
+		} else if err := os.MkdirAll(cfg.Cache.Dir, 0o700); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Warning: cannot create cache dir: %v\n", err)
+		} else if db, err := localstore.Open(filepath.Join(cfg.Cache.Dir, "cache.db"), sqlitePragmas); err == nil {
+			if cachedRepo, err = cache.NewCachedRepository(offlineRepo, db, cfg.Cache.TTL); err == nil {
+				a.onClose(func() { _ = db.Close() })
+			}
+		}
+		if cachedRepo != nil {
+			cachedRepo.SetMetrics(metricsRegistry)
+			cachedRepo.SetServeStale(offlineSwitch.Offline)
+			cachedRepo.SetSearchTTL(cfg.Cache.SearchTTL)
+			repo = cachedRepo
+			if cfg.Cache.InvalidateOnWrite {
+				cacheInvalidator = cachedRepo
+			}
+
+			// Purge expired entries periodically; stopped before the DB closes.
+			evictCtx, stopEvictor := context.WithCancel(context.Background())
+			evictorDone := cachedRepo.StartEvictor(evictCtx, cfg.Cache.EvictInterval)
+			a.onClose(func() {
+				stopEvictor()
+				<-evictorDone
+			})
+		}
+	}
+
+	// Auth infrastructure
+	homeDir, _ := os.UserHomeDir()
+	tokenStore := infraauth.NewFileTokenStore(homeDir + "/.acai")
+	tokenStore.SetStrictPermissions(cfg.Privacy.StrictTokenPermissions)
+	authService := infraauth.NewService(tokenStore, granola.NewTokenRefresher(granolaClient))
+	authService.SetIdentityFetcher(granola.NewIdentityFetcher(granolaClient))
+
+	// If we have a stored token, set it on the Granola client
+	if cred, err := authService.Status(context.Background()); err == nil && cred.IsValid() {
+		granolaClient.SetToken(cred.Token().AccessToken())
+	}
+
+	// Workspace repository
+	granolaWsRepo := granola.NewWorkspaceRepository(granolaClient)
+	granolaWsRepo.SetCacheTTL(cfg.Cache.WorkspaceTTL)
+	wsRepo := offline.NewWorkspaceRepository(granolaWsRepo, offlineSwitch)
+
+	// Local store (SQLite for write-side: notes, action item overrides, outbox)
+	localDir := cfg.Cache.Dir // Reuse cache dir for local store
+	if err := os.MkdirAll(localDir, 0o700); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Warning: cannot create local store dir: %v\n", err)
+	}
+	localDBPath := filepath.Join(localDir, "local.db")
+	localDB, err := localstore.Open(localDBPath, sqlitePragmas)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Warning: cannot open local store: %v\n", err)
+	} else {
+		a.onClose(func() { _ = localDB.Close() })
+		if err := localstore.InitSchema(localDB); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Warning: cannot init local store schema: %v\n", err)
+		}
+	}
+
+	// Local store repositories
+	noteRepo := localstore.NewNoteRepository(localDB)
+	writeRepo := localstore.NewWriteRepository(localDB)
+	auditLog := localstore.NewAuditLog(localDB)
+	speakerAliases := localstore.NewSpeakerAliasStore(localDB)
+	syncState := localstore.NewSyncStateStore(localDB)
+	meetingTags := localstore.NewMeetingTagStore(localDB)
+	granolaRepo.SetSpeakerAliases(speakerAliases)
+
+	// Local action item overrides on top of the upstream reads
+	if localDB != nil {
+		repo = localstore.NewOverlayRepository(repo, writeRepo)
+	}
+
+	// Event infrastructure: inner dispatcher → recent events recorder → live
+	// stream broadcaster → outbox decorator (→ outbound webhook, when enabled)
+	innerDispatcher := events.NewDispatcher(nil) // notifier wired after MCP server creation
+	recentEvents := events.NewRecentEvents(cfg.Events.RecentBufferSize)
+	recordingDispatcher := events.NewRecordingDispatcher(innerDispatcher, recentEvents)
+	outboxStore := outbox.NewSQLiteStore(localDB)
+	broadcaster := events.NewBroadcaster()
+	broadcastingDispatcher := events.NewBroadcastingDispatcher(recordingDispatcher, broadcaster)
+	outboxDispatcher := outbox.NewDispatcher(broadcastingDispatcher, outboxStore)
+	outboxDispatcher.SetRetryPolicy(outbox.RetryPolicy{
+		MaxAttempts: cfg.Events.OutboxMaxAttempts,
+		Backoff:     cfg.Events.OutboxBackoff,
+	})
+	var dispatcher domain.EventDispatcher = outboxDispatcher
+	if cfg.Webhook.OutboundEnabled {
+		outbound := webhook.NewOutboundDispatcher(dispatcher, cfg.Webhook.OutboundURL, cfg.Webhook.OutboundSecret)
+		a.onClose(outbound.Close)
+		dispatcher = outbound
+	}
+
+	// --- Application Layer (Use Cases) ---
+
+	listMeetings := meetingapp.NewListMeetings(repo)
+	listMeetings.SetTags(meetingTags)
+	getMeeting := meetingapp.NewGetMeeting(repo)
+	getMeeting.SetTags(meetingTags)
+	getTranscript := meetingapp.NewGetTranscript(repo)
+	getTranscript.SetMaxUtterances(cfg.MCP.MaxTranscriptUtterances)
+	searchTranscripts := meetingapp.NewSearchTranscripts(repo)
+	searchMeetings := meetingapp.NewSearchMeetings(repo)
+	getActionItems := meetingapp.NewGetActionItems(repo)
+	getMeetingStats := meetingapp.NewGetMeetingStats(repo)
+	getMeetingStats.SetMaxMeetings(cfg.MCP.MaxScanMeetings)
+	extractKeywords := meetingapp.NewExtractKeywords(repo)
+	if cfg.MCP.KeywordStopwordsFile != "" {
+		f, err := os.Open(cfg.MCP.KeywordStopwordsFile)
+		if err == nil {
+			var words []string
+			words, err = meetingapp.ParseStopwords(f)
+			_ = f.Close()
+			extractKeywords.SetExtraStopwords(words)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Warning: cannot load keyword stopwords: %v\n", err)
+		}
+	}
+	compareMeetings := meetingapp.NewCompareMeetings(getMeeting)
+	overdueActionItems := meetingapp.NewOverdueActionItems(repo, getActionItems)
+	actionItemDigest := meetingapp.NewActionItemDigest(repo, getActionItems)
+	findConflicts := meetingapp.NewFindConflicts(repo)
+	meetingTimeline := meetingapp.NewMeetingTimeline(repo)
+	overdueActionItems.SetMaxMeetings(cfg.MCP.MaxScanMeetings)
+	actionItemDigest.SetMaxMeetings(cfg.MCP.MaxScanMeetings)
+	findConflicts.SetMaxMeetings(cfg.MCP.MaxScanMeetings)
+	meetingTimeline.SetMaxMeetings(cfg.MCP.MaxScanMeetings)
+	getActionItem := meetingapp.NewGetActionItem(repo)
+	syncMeetings := meetingapp.NewSyncMeetings(repo)
+	syncMeetings.SetSyncState(syncState)
+	syncMeetings.SetWorkspaceInvalidator(granolaWsRepo)
+	exportMeeting := exportapp.NewExportMeeting(repo)
+	exportMeeting.SetTags(meetingTags)
+	exportTranscript := exportapp.NewExportTranscript(repo)
+	exportNotes := exportapp.NewExportNotes(noteRepo)
+	exportCalendar := exportapp.NewExportCalendar(repo)
+	login := authapp.NewLogin(authService)
+	checkStatus := authapp.NewCheckStatus(authService)
+	refreshToken := authapp.NewRefreshToken(authService, granolaClient)
+	listProfiles := authapp.NewListProfiles(tokenStore)
+	listWorkspaces := workspaceapp.NewListWorkspaces(wsRepo)
+	getWorkspace := workspaceapp.NewGetWorkspace(wsRepo)
+
+	// Write use cases (Phase 3)
+	addNote := annotationapp.NewAddNote(noteRepo, repo, dispatcher)
+	listNotes := annotationapp.NewListNotes(noteRepo)
+	searchNotes := annotationapp.NewSearchNotes(noteRepo)
+	deleteNote := annotationapp.NewDeleteNote(noteRepo, dispatcher)
+	deleteMeetingNotes := annotationapp.NewDeleteNotesByMeeting(noteRepo, dispatcher)
+	completeActionItem := meetingapp.NewCompleteActionItem(repo, writeRepo, dispatcher)
+	completeActionItems := meetingapp.NewCompleteActionItems(repo, completeActionItem)
+	updateActionItem := meetingapp.NewUpdateActionItem(repo, writeRepo, dispatcher)
+	addNote.SetAuditLogger(auditLog)
+	addNote.SetContentPolicy(annotationapp.ContentPolicy{
+		MaxLength:    cfg.Notes.MaxLength,
+		SanitizeHTML: cfg.Notes.SanitizeHTML,
+	})
+	deleteNote.SetAuditLogger(auditLog)
+	deleteMeetingNotes.SetAuditLogger(auditLog)
+	completeActionItem.SetAuditLogger(auditLog)
+	updateActionItem.SetAuditLogger(auditLog)
+	if cacheInvalidator != nil {
+		completeActionItem.SetCacheInvalidator(cacheInvalidator)
+		updateActionItem.SetCacheInvalidator(cacheInvalidator)
+	}
+	listAuditEntries := auditapp.NewListEntries(auditLog)
+	setSpeakerAlias := meetingapp.NewSetSpeakerAlias(speakerAliases)
+	removeSpeakerAlias := meetingapp.NewRemoveSpeakerAlias(speakerAliases)
+	listSpeakerAliases := meetingapp.NewListSpeakerAliases(speakerAliases)
+	tagMeeting := meetingapp.NewTagMeeting(meetingTags)
+	untagMeeting := meetingapp.NewUntagMeeting(meetingTags)
+	exportEmbeddings := embeddingapp.NewExportEmbeddings(repo, noteRepo)
+
+	// Refresh an expired OAuth access token once on 401 before surfacing it
+	granolaClient.SetTokenRefresher(func(ctx context.Context) (string, error) {
+		out, err := refreshToken.Execute(ctx)
+		if err != nil {
+			return "", err
+		}
+		return out.Credential.Token().AccessToken(), nil
+	})
+
+	// --- Interfaces Layer ---
+
+	// Inbound webhook receiver, mounted by serve on the HTTP transport
+	webhookHandler := webhook.NewHandler(syncMeetings, dispatcher, cfg.Webhook.Secret)
+	webhookHandler.SetTimestampTolerance(cfg.Webhook.TimestampTolerance)
+	if cachedRepo != nil {
+		webhookHandler.SetInvalidator(cachedRepo)
+	}
+
+	// MCP server
+	toolOverrides := make(map[string]mcpiface.ToolOverride, len(cfg.MCP.ToolOverrides))
+	for name, o := range cfg.MCP.ToolOverrides {
+		toolOverrides[name] = mcpiface.ToolOverride(o)
+	}
+	mcpServer := mcpiface.NewServer(cfg.MCP.ServerName, version, mcpiface.ServerOptions{
+		ListMeetings:        listMeetings,
+		GetMeeting:          getMeeting,
+		GetTranscript:       getTranscript,
+		SearchTranscripts:   searchTranscripts,
+		SearchMeetings:      searchMeetings,
+		GetActionItems:      getActionItems,
+		GetMeetingStats:     getMeetingStats,
+		ExtractKeywords:     extractKeywords,
+		CompareMeetings:     compareMeetings,
+		OverdueActionItems:  overdueActionItems,
+		ActionItemDigest:    actionItemDigest,
+		FindConflicts:       findConflicts,
+		MeetingTimeline:     meetingTimeline,
+		GetActionItem:       getActionItem,
+		ListWorkspaces:      listWorkspaces,
+		GetWorkspace:        getWorkspace,
+		AddNote:             addNote,
+		ListNotes:           listNotes,
+		SearchNotes:         searchNotes,
+		DeleteNote:          deleteNote,
+		DeleteMeetingNotes:  deleteMeetingNotes,
+		CompleteActionItem:  completeActionItem,
+		CompleteActionItems: completeActionItems,
+		UpdateActionItem:    updateActionItem,
+		TagMeeting:          tagMeeting,
+		UntagMeeting:        untagMeeting,
+		ExportEmbeddings:    exportEmbeddings,
+		RecentEvents:        recentEvents,
+		DisabledTools:       cfg.MCP.DisabledTools,
+		ToolOverrides:       toolOverrides,
+		HTTP: mcpiface.HTTPLimits{
+			ReadHeaderTimeout: cfg.MCP.HTTPReadHeaderTimeout,
+			ReadTimeout:       cfg.MCP.HTTPReadTimeout,
+			WriteTimeout:      cfg.MCP.HTTPWriteTimeout,
+			IdleTimeout:       cfg.MCP.HTTPIdleTimeout,
+			MaxHeaderBytes:    cfg.MCP.HTTPMaxHeaderBytes,
+			MaxInFlight:       cfg.MCP.HTTPMaxInFlight,
+			MaxStreams:        cfg.MCP.HTTPMaxStreams,
+		},
+		Limits: mcpiface.ListLimits{
+			Default: cfg.MCP.DefaultListLimit,
+			Max:     cfg.MCP.MaxListLimit,
+		},
+		RedactExportEmails: cfg.Privacy.RedactExportEmails,
+	})
+
+	// Tool middleware chain: policy (if a policy file is configured) → metrics
+	var toolHandler mcpiface.ToolHandler = mcpServer
+	if cfg.Policy.Enabled && cfg.Policy.FilePath != "" {
+		loadResult, policyErr := infraPolicy.LoadFromFile(cfg.Policy.FilePath)
+		if policyErr != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Warning: cannot load policy file: %v\n", policyErr)
+		} else {
+			for _, problem := range loadResult.Validate(mcpiface.ToolNames()) {
+				_, _ = fmt.Fprintf(os.Stderr, "Warning: policy: %s\n", problem)
+			}
+			policyEngine := infraPolicy.NewEngine(loadResult)
+			toolHandler = mcpiface.NewPolicyMiddleware(mcpServer, policyEngine)
+		}
+	}
+	if metricsRegistry != nil {
+		toolHandler = mcpiface.NewMetricsMiddleware(toolHandler, metricsRegistry)
+	}
+	mcpServer.SetToolHandler(toolHandler)
+
+	// CLI dependencies
+	deps := &cli.Dependencies{
+		ListMeetings:        listMeetings,
+		GetMeeting:          getMeeting,
+		GetTranscript:       getTranscript,
+		SearchTranscripts:   searchTranscripts,
+		GetActionItems:      getActionItems,
+		SyncMeetings:        syncMeetings,
+		ExportMeeting:       exportMeeting,
+		ExportTranscript:    exportTranscript,
+		ExportNotes:         exportNotes,
+		ExportCalendar:      exportCalendar,
+		Login:               login,
+		CheckStatus:         checkStatus,
+		RefreshToken:        refreshToken,
+		ListProfiles:        listProfiles,
+		TokenSetter:         granolaClient,
+		ListWorkspaces:      listWorkspaces,
+		GetWorkspace:        getWorkspace,
+		EventDispatcher:     dispatcher,
+		MCPServer:           mcpServer,
+		Breaker:             resilientRepo,
+		RateLimiter:         resilientRepo,
+		APIProbe:            meetingapp.NewListMeetings(resilientRepo),
+		RawFetcher:          granolaClient,
+		Offline:             offlineSwitch,
+		Timezone:            cfg.Display.Timezone,
+		RedactEmails:        cfg.Privacy.RedactExportEmails,
+		PolicyFile:          cfg.Policy.FilePath,
+		AddNote:             addNote,
+		ListNotes:           listNotes,
+		SearchNotes:         searchNotes,
+		DeleteNote:          deleteNote,
+		DeleteMeetingNotes:  deleteMeetingNotes,
+		CompleteActionItem:  completeActionItem,
+		CompleteActionItems: completeActionItems,
+		UpdateActionItem:    updateActionItem,
+		ListAuditEntries:    listAuditEntries,
+		Outbox:              outboxStore,
+		OutboxRedeliver:     outboxDispatcher,
+		LocalArchive:        localstore.NewArchiver(localDB),
+		SetSpeakerAlias:     setSpeakerAlias,
+		RemoveSpeakerAlias:  removeSpeakerAlias,
+		ListSpeakerAliases:  listSpeakerAliases,
+		TagMeeting:          tagMeeting,
+		UntagMeeting:        untagMeeting,
+		ExportEmbeddings:    exportEmbeddings,
+		EventStream:         events.NewStreamHandler(broadcaster),
+		OutboxHealth:        outbox.NewHealthHandler(outboxStore, cfg.Events.OutboxMaxPending),
+		WebhookHandler:      webhookHandler,
+		WebhookPath:         cfg.Webhook.Path,
+		Out:                 os.Stdout,
+		In:                  os.Stdin,
+	}
+	if metricsRegistry != nil {
+		deps.MetricsHandler = metricsRegistry.Handler()
+	}
+	a.deps = deps
+	return a, nil
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/config"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/granola"
+	"github.com/felixgeelhaar/acai/internal/interfaces/cli"
+	"github.com/felixgeelhaar/mcp-go/protocol"
+)
+
+// fakeGranola serves get-document for any id and counts the calls.
+func fakeGranola(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/get-document") {
+			http.NotFound(w, r)
+			return
+		}
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(granola.DocumentDTO{
+			ID:        r.URL.Query().Get("id"),
+			Title:     "Planning",
+			CreatedAt: time.Now().UTC(),
+			Source:    "zoom",
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+// testApp wires the real app against a fake Granola API, with every
+// local file under a temporary home directory.
+func testApp(t *testing.T, api *httptest.Server) *app {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("ACAI_GRANOLA_API_URL", api.URL)
+	t.Setenv("ACAI_GRANOLA_API_TOKEN", "token")
+
+	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	a, err := newApp(cfg)
+	if err != nil {
+		t.Fatalf("new app: %v", err)
+	}
+	t.Cleanup(a.Close)
+	a.deps.Out = io.Discard
+	return a
+}
+
+// serveHTTP runs `serve --transport http` until the test ends and returns
+// its base URL.
+func serveHTTP(t *testing.T, a *app) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	root := cli.NewRootCmd(a.deps)
+	root.SetArgs([]string{"serve", "--transport", "http", "--port", strconv.Itoa(port)})
+	done := make(chan error, 1)
+	go func() { done <- root.ExecuteContext(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Error("serve did not stop in time")
+		}
+	})
+
+	base := fmt.Sprintf("http://127.0.0.1:%d", port)
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		if resp, err := http.Get(base + "/health"); err == nil {
+			_ = resp.Body.Close()
+			return base
+		}
+	}
+	t.Fatal("server did not start")
+	return ""
+}
+
+// postWebhook sends body signed with secret and the given timestamp.
+func postWebhook(t *testing.T, url, secret string, ts time.Time, body string) int {
+	t.Helper()
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp + "."))
+	_, _ = mac.Write([]byte(body))
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Granola-Timestamp", timestamp)
+	req.Header.Set("X-Granola-Signature", hex.EncodeToString(mac.Sum(nil)))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestApp_WebhookDeleteInvalidatesCache(t *testing.T) {
+	api, calls := fakeGranola(t)
+	t.Setenv("ACAI_WEBHOOK_SECRET", "s3cret")
+	a := testApp(t, api)
+	base := serveHTTP(t, a)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := a.deps.GetMeeting.Execute(ctx, meetingapp.GetMeetingInput{ID: "m-1"}); err != nil {
+			t.Fatalf("get meeting: %v", err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("got %d API calls, want the second read served from cache", got)
+	}
+
+	body := `{"event":"meeting.deleted","meeting_id":"m-1"}`
+	if code := postWebhook(t, base+cli.DefaultWebhookPath, "wrong", time.Now(), body); code != http.StatusUnauthorized {
+		t.Errorf("got status %d for a bad signature, want 401", code)
+	}
+	if code := postWebhook(t, base+cli.DefaultWebhookPath, "s3cret", time.Now(), body); code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", code)
+	}
+
+	if _, err := a.deps.GetMeeting.Execute(ctx, meetingapp.GetMeetingInput{ID: "m-1"}); err != nil {
+		t.Fatalf("get meeting: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("got %d API calls, want a re-fetch after meeting.deleted", got)
+	}
+}
+
+func TestApp_WebhookMountedAtConfiguredPath(t *testing.T) {
+	api, _ := fakeGranola(t)
+	t.Setenv("ACAI_WEBHOOK_PATH", "/ingress/acai/webhook")
+	a := testApp(t, api)
+	base := serveHTTP(t, a)
+
+	body := `{"event":"meeting.deleted","meeting_id":"m-1"}`
+	if code := postWebhook(t, base+"/ingress/acai/webhook", "", time.Now(), body); code != http.StatusOK {
+		t.Errorf("got status %d on the configured path, want 200", code)
+	}
+	if code := postWebhook(t, base+cli.DefaultWebhookPath, "", time.Now(), body); code != http.StatusNotFound {
+		t.Errorf("got status %d on the default path, want 404", code)
+	}
+}
+
+func TestApp_WebhookUsesConfiguredTimestampTolerance(t *testing.T) {
+	api, _ := fakeGranola(t)
+	t.Setenv("ACAI_WEBHOOK_SECRET", "s3cret")
+	t.Setenv("ACAI_WEBHOOK_TIMESTAMP_TOLERANCE", "1m")
+	a := testApp(t, api)
+	base := serveHTTP(t, a)
+
+	// Two minutes old: inside the default tolerance, outside the configured one.
+	body := `{"event":"meeting.deleted","meeting_id":"m-1"}`
+	if code := postWebhook(t, base+cli.DefaultWebhookPath, "s3cret", time.Now().Add(-2*time.Minute), body); code != http.StatusUnauthorized {
+		t.Errorf("got status %d for a stale timestamp, want 401", code)
+	}
+	if code := postWebhook(t, base+cli.DefaultWebhookPath, "s3cret", time.Now(), body); code != http.StatusOK {
+		t.Errorf("got status %d for a fresh timestamp, want 200", code)
+	}
+}
+
+func TestApp_ServedToolCallsAreCounted(t *testing.T) {
+	api, _ := fakeGranola(t)
+	t.Setenv("ACAI_METRICS_ENABLED", "true")
+	a := testApp(t, api)
+
+	handle := a.deps.MCPServer.ToolCallMiddleware()(func(context.Context, *protocol.Request) (*protocol.Response, error) {
+		t.Fatal("a registered tool should not fall through to the default handler")
+		return nil, nil
+	})
+	params, _ := json.Marshal(map[string]any{"name": "get_meeting", "arguments": map[string]string{"id": "m-1"}})
+	if _, err := handle(context.Background(), &protocol.Request{
+		JSONRPC: "2.0",
+		ID:      json.RawMessage(`1`),
+		Method:  protocol.MethodToolsCall,
+		Params:  params,
+	}); err != nil {
+		t.Fatalf("tool call: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	a.deps.MetricsHandler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if want := `acai_mcp_tool_calls_total{tool="get_meeting",status="ok"} 1`; !strings.Contains(rec.Body.String(), want) {
+		t.Errorf("metrics output missing %q:\n%s", want, rec.Body.String())
+	}
+}
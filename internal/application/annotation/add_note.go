@@ -3,10 +3,14 @@ package annotation
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -14,43 +18,113 @@ type AddNoteInput struct {
 	MeetingID string
 	Author    string
 	Content   string
+	Tags      []string
+	// IdempotencyKey makes retries safe: when a note was already created
+	// with this key, that note is returned and nothing new is stored.
+	IdempotencyKey string
 }
 
 type AddNoteOutput struct {
 	Note *annotation.AgentNote
+	// Replayed reports that Note already existed under the idempotency key.
+	Replayed bool
+}
+
+// ContentPolicy bounds and cleans note content before it is stored.
+type ContentPolicy struct {
+	// MaxLength is the most characters a note may hold; zero allows any length.
+	MaxLength int
+	// SanitizeHTML escapes '<' so no HTML tag survives if the note is later
+	// rendered as HTML. Markdown, including '>' quotes, is unaffected.
+	SanitizeHTML bool
+}
+
+// apply checks content against the policy and returns it cleaned.
+func (p ContentPolicy) apply(content string) (string, error) {
+	if n := utf8.RuneCountInString(content); p.MaxLength > 0 && n > p.MaxLength {
+		return "", fmt.Errorf("%w: %d characters, limit is %d", annotation.ErrNoteTooLong, n, p.MaxLength)
+	}
+	if p.SanitizeHTML {
+		content = strings.ReplaceAll(content, "<", "&lt;")
+	}
+	return content, nil
 }
 
 type AddNote struct {
 	noteRepo   annotation.NoteRepository
 	meetingRepo domain.Repository
 	dispatcher domain.EventDispatcher
+	audit      audit.Logger
+	policy     ContentPolicy
 }
 
 func NewAddNote(noteRepo annotation.NoteRepository, meetingRepo domain.Repository, dispatcher domain.EventDispatcher) *AddNote {
 	return &AddNote{noteRepo: noteRepo, meetingRepo: meetingRepo, dispatcher: dispatcher}
 }
 
+// SetAuditLogger records each added note to the audit log, attributed to
+// the note's author.
+func (uc *AddNote) SetAuditLogger(l audit.Logger) {
+	uc.audit = l
+}
+
+// SetContentPolicy validates and sanitizes the content of each new note.
+func (uc *AddNote) SetContentPolicy(p ContentPolicy) {
+	uc.policy = p
+}
+
 func (uc *AddNote) Execute(ctx context.Context, input AddNoteInput) (*AddNoteOutput, error) {
 	// Verify meeting exists
 	if input.MeetingID == "" {
 		return nil, annotation.ErrInvalidMeetingID
 	}
+	content, err := uc.policy.apply(input.Content)
+	if err != nil {
+		return nil, err
+	}
 	if _, err := uc.meetingRepo.FindByID(ctx, domain.MeetingID(input.MeetingID)); err != nil {
 		return nil, err
 	}
 
+	key := strings.TrimSpace(input.IdempotencyKey)
+	if key != "" {
+		existing, err := uc.noteRepo.FindByIdempotencyKey(ctx, key)
+		if err == nil {
+			return &AddNoteOutput{Note: existing, Replayed: true}, nil
+		}
+		if !errors.Is(err, annotation.ErrNoteNotFound) {
+			return nil, err
+		}
+	}
+
 	// Generate note ID
 	noteID := annotation.NoteID(fmt.Sprintf("note-%d", time.Now().UnixNano()))
 
-	note, err := annotation.NewAgentNote(noteID, input.MeetingID, input.Author, input.Content)
+	note, err := annotation.NewAgentNote(noteID, input.MeetingID, input.Author, content)
 	if err != nil {
 		return nil, err
 	}
+	note.SetTags(input.Tags)
+	note.SetIdempotencyKey(key)
 
 	if err := uc.noteRepo.Save(ctx, note); err != nil {
+		// A concurrent retry may have stored the key since the lookup.
+		if key != "" {
+			if existing, findErr := uc.noteRepo.FindByIdempotencyKey(ctx, key); findErr == nil {
+				return &AddNoteOutput{Note: existing, Replayed: true}, nil
+			}
+		}
 		return nil, err
 	}
 
+	recordAudit(ctx, uc.audit, audit.Entry{
+		Operation: audit.OpAddNote,
+		TargetID:  string(note.ID()),
+		Actor:     note.Author(),
+		Timestamp: note.CreatedAt(),
+		Details:   "meeting " + note.MeetingID(),
+	})
+
 	// Dispatch event (annotation events satisfy meeting.DomainEvent via structural typing)
 	event := annotation.NewNoteAddedEvent(string(note.ID()), note.MeetingID(), note.Author())
 	if uc.dispatcher != nil {
@@ -2,11 +2,14 @@ package annotation_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	app "github.com/felixgeelhaar/acai/internal/application/annotation"
 	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -48,6 +51,31 @@ func TestAddNote_Success(t *testing.T) {
 	}
 }
 
+func TestAddNote_WithTags(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	meetingRepo := newMockMeetingRepository()
+
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now(), domain.SourceZoom, nil)
+	meetingRepo.addMeeting(mtg)
+
+	uc := app.NewAddNote(noteRepo, meetingRepo, nil)
+	out, err := uc.Execute(context.Background(), app.AddNoteInput{
+		MeetingID: "m-1",
+		Author:    "claude",
+		Content:   "Ship date agreed",
+		Tags:      []string{"Decision", "follow-up", "decision"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved := noteRepo.notes[out.Note.ID()]
+	tags := saved.Tags()
+	if len(tags) != 2 || tags[0] != "decision" || tags[1] != "follow-up" {
+		t.Errorf("got tags %v, want [decision follow-up]", tags)
+	}
+}
+
 func TestAddNote_MeetingNotFound(t *testing.T) {
 	noteRepo := newMockNoteRepository()
 	meetingRepo := newMockMeetingRepository()
@@ -110,3 +138,130 @@ func TestAddNote_EmptyAuthor(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, annotatn.ErrInvalidAuthor)
 	}
 }
+
+func TestAddNote_RecordsAudit(t *testing.T) {
+	meetingRepo := newMockMeetingRepository()
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now(), domain.SourceZoom, nil)
+	meetingRepo.addMeeting(mtg)
+	logger := &mockAuditLogger{}
+
+	uc := app.NewAddNote(newMockNoteRepository(), meetingRepo, nil)
+	uc.SetAuditLogger(logger)
+	out, err := uc.Execute(context.Background(), app.AddNoteInput{
+		MeetingID: "m-1",
+		Author:    "claude",
+		Content:   "observation",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(logger.entries))
+	}
+	e := logger.entries[0]
+	if e.Operation != audit.OpAddNote || e.TargetID != string(out.Note.ID()) || e.Actor != "claude" {
+		t.Errorf("got entry %+v", e)
+	}
+}
+
+func TestAddNote_AuditFailureKeepsNote(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	meetingRepo := newMockMeetingRepository()
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now(), domain.SourceZoom, nil)
+	meetingRepo.addMeeting(mtg)
+
+	uc := app.NewAddNote(noteRepo, meetingRepo, nil)
+	uc.SetAuditLogger(&mockAuditLogger{err: errors.New("disk full")})
+	out, err := uc.Execute(context.Background(), app.AddNoteInput{
+		MeetingID: "m-1",
+		Author:    "claude",
+		Content:   "observation",
+	})
+	if err != nil {
+		t.Fatalf("got %v, want the saved note despite the audit failure", err)
+	}
+	if noteRepo.notes[out.Note.ID()] == nil {
+		t.Error("note should be saved")
+	}
+}
+
+func TestAddNote_ContentPolicy_TooLong(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	meetingRepo := newMockMeetingRepository()
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now(), domain.SourceZoom, nil)
+	meetingRepo.addMeeting(mtg)
+
+	uc := app.NewAddNote(noteRepo, meetingRepo, nil)
+	uc.SetContentPolicy(app.ContentPolicy{MaxLength: 5})
+
+	_, err := uc.Execute(context.Background(), app.AddNoteInput{MeetingID: "m-1", Author: "claude", Content: "héllo!"})
+	if !errors.Is(err, annotatn.ErrNoteTooLong) {
+		t.Fatalf("got %v, want %v", err, annotatn.ErrNoteTooLong)
+	}
+	if !strings.Contains(err.Error(), "limit is 5") {
+		t.Errorf("error %q should name the limit", err)
+	}
+	if len(noteRepo.notes) != 0 {
+		t.Error("an over-length note must not be saved")
+	}
+
+	// Length counts characters, not bytes.
+	if _, err := uc.Execute(context.Background(), app.AddNoteInput{MeetingID: "m-1", Author: "claude", Content: "héllo"}); err != nil {
+		t.Errorf("unexpected error at the limit: %v", err)
+	}
+}
+
+func TestAddNote_ContentPolicy_SanitizeHTML(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	meetingRepo := newMockMeetingRepository()
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now(), domain.SourceZoom, nil)
+	meetingRepo.addMeeting(mtg)
+
+	uc := app.NewAddNote(noteRepo, meetingRepo, nil)
+	uc.SetContentPolicy(app.ContentPolicy{SanitizeHTML: true})
+
+	out, err := uc.Execute(context.Background(), app.AddNoteInput{
+		MeetingID: "m-1",
+		Author:    "claude",
+		Content:   "> **Decision**: ship\n<script>alert(1)</script>",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "> **Decision**: ship\n&lt;script>alert(1)&lt;/script>"
+	if out.Note.Content() != want {
+		t.Errorf("got content %q, want %q", out.Note.Content(), want)
+	}
+}
+
+func TestAddNote_IdempotencyKey(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	meetingRepo := newMockMeetingRepository()
+	dispatcher := &mockDispatcher{}
+
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now(), domain.SourceZoom, nil)
+	meetingRepo.addMeeting(mtg)
+
+	uc := app.NewAddNote(noteRepo, meetingRepo, dispatcher)
+	input := app.AddNoteInput{MeetingID: "m-1", Author: "claude", Content: "Decision made", IdempotencyKey: "retry-1"}
+
+	first, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+
+	if !second.Replayed || second.Note.ID() != first.Note.ID() {
+		t.Errorf("retry got note %s (replayed %v), want %s", second.Note.ID(), second.Replayed, first.Note.ID())
+	}
+	if len(noteRepo.notes) != 1 {
+		t.Errorf("got %d notes, want 1", len(noteRepo.notes))
+	}
+	if len(dispatcher.events) != 1 {
+		t.Errorf("got %d events, want 1", len(dispatcher.events))
+	}
+}
@@ -0,0 +1,20 @@
+package annotation
+
+import (
+	"context"
+	"log"
+
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+)
+
+// recordAudit records entry when an audit logger is set. The note write
+// it describes is already committed, so a failure is logged rather than
+// reported as a failed write.
+func recordAudit(ctx context.Context, l audit.Logger, entry audit.Entry) {
+	if l == nil {
+		return
+	}
+	if err := l.Record(ctx, entry); err != nil {
+		log.Printf("audit: recording %s for %s failed: %v", entry.Operation, entry.TargetID, err)
+	}
+}
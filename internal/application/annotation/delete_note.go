@@ -2,8 +2,10 @@ package annotation
 
 import (
 	"context"
+	"time"
 
 	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -16,12 +18,18 @@ type DeleteNoteOutput struct{}
 type DeleteNote struct {
 	noteRepo   annotatn.NoteRepository
 	dispatcher domain.EventDispatcher
+	audit      audit.Logger
 }
 
 func NewDeleteNote(noteRepo annotatn.NoteRepository, dispatcher domain.EventDispatcher) *DeleteNote {
 	return &DeleteNote{noteRepo: noteRepo, dispatcher: dispatcher}
 }
 
+// SetAuditLogger records each deleted note to the audit log.
+func (uc *DeleteNote) SetAuditLogger(l audit.Logger) {
+	uc.audit = l
+}
+
 func (uc *DeleteNote) Execute(ctx context.Context, input DeleteNoteInput) (*DeleteNoteOutput, error) {
 	if input.NoteID == "" {
 		return nil, annotatn.ErrInvalidNoteID
@@ -39,6 +47,14 @@ func (uc *DeleteNote) Execute(ctx context.Context, input DeleteNoteInput) (*Dele
 		return nil, err
 	}
 
+	recordAudit(ctx, uc.audit, audit.Entry{
+		Operation: audit.OpDeleteNote,
+		TargetID:  string(note.ID()),
+		Actor:     audit.ActorFromContext(ctx),
+		Timestamp: time.Now().UTC(),
+		Details:   "meeting " + note.MeetingID(),
+	})
+
 	// Dispatch event
 	event := annotatn.NewNoteDeletedEvent(string(note.ID()), note.MeetingID())
 	if uc.dispatcher != nil {
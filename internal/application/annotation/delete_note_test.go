@@ -6,6 +6,7 @@ import (
 
 	app "github.com/felixgeelhaar/acai/internal/application/annotation"
 	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 )
 
 func TestDeleteNote_Success(t *testing.T) {
@@ -51,3 +52,25 @@ func TestDeleteNote_EmptyNoteID(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, annotatn.ErrInvalidNoteID)
 	}
 }
+
+func TestDeleteNote_RecordsAuditWithContextActor(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	note, _ := annotatn.NewAgentNote("n-1", "m-1", "claude", "observation")
+	noteRepo.notes[note.ID()] = note
+	logger := &mockAuditLogger{}
+
+	uc := app.NewDeleteNote(noteRepo, nil)
+	uc.SetAuditLogger(logger)
+	ctx := audit.WithActor(context.Background(), "cli")
+	if _, err := uc.Execute(ctx, app.DeleteNoteInput{NoteID: "n-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(logger.entries))
+	}
+	e := logger.entries[0]
+	if e.Operation != audit.OpDeleteNote || e.TargetID != "n-1" || e.Actor != "cli" {
+		t.Errorf("got entry %+v", e)
+	}
+}
@@ -0,0 +1,66 @@
+package annotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+type DeleteNotesByMeetingInput struct {
+	MeetingID string
+}
+
+type DeleteNotesByMeetingOutput struct {
+	Deleted int
+}
+
+type DeleteNotesByMeeting struct {
+	noteRepo   annotatn.NoteRepository
+	dispatcher domain.EventDispatcher
+	audit      audit.Logger
+}
+
+func NewDeleteNotesByMeeting(noteRepo annotatn.NoteRepository, dispatcher domain.EventDispatcher) *DeleteNotesByMeeting {
+	return &DeleteNotesByMeeting{noteRepo: noteRepo, dispatcher: dispatcher}
+}
+
+// SetAuditLogger records each non-empty clear to the audit log, as one
+// entry for the meeting.
+func (uc *DeleteNotesByMeeting) SetAuditLogger(l audit.Logger) {
+	uc.audit = l
+}
+
+func (uc *DeleteNotesByMeeting) Execute(ctx context.Context, input DeleteNotesByMeetingInput) (*DeleteNotesByMeetingOutput, error) {
+	if input.MeetingID == "" {
+		return nil, annotatn.ErrInvalidMeetingID
+	}
+
+	deleted, err := uc.noteRepo.DeleteByMeeting(ctx, input.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted > 0 {
+		recordAudit(ctx, uc.audit, audit.Entry{
+			Operation: audit.OpClearNotes,
+			TargetID:  input.MeetingID,
+			Actor:     audit.ActorFromContext(ctx),
+			Timestamp: time.Now().UTC(),
+			Details:   fmt.Sprintf("%d notes", deleted),
+		})
+	}
+
+	// One event for the whole batch rather than one per note
+	if deleted > 0 && uc.dispatcher != nil {
+		event := annotatn.NewNotesClearedEvent(input.MeetingID, deleted)
+		if err := uc.dispatcher.Dispatch(ctx, []domain.DomainEvent{event}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DeleteNotesByMeetingOutput{Deleted: deleted}, nil
+}
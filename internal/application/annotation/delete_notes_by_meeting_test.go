@@ -0,0 +1,90 @@
+package annotation_test
+
+import (
+	"context"
+	"testing"
+
+	app "github.com/felixgeelhaar/acai/internal/application/annotation"
+	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+)
+
+func TestDeleteNotesByMeeting_Success(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	dispatcher := &mockDispatcher{}
+
+	for _, id := range []annotatn.NoteID{"n-1", "n-2"} {
+		note, _ := annotatn.NewAgentNote(id, "m-1", "claude", "observation")
+		noteRepo.notes[note.ID()] = note
+	}
+	other, _ := annotatn.NewAgentNote("n-3", "m-2", "claude", "observation")
+	noteRepo.notes[other.ID()] = other
+
+	uc := app.NewDeleteNotesByMeeting(noteRepo, dispatcher)
+	out, err := uc.Execute(context.Background(), app.DeleteNotesByMeetingInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Deleted != 2 {
+		t.Errorf("got %d deleted, want 2", out.Deleted)
+	}
+	if len(noteRepo.notes) != 1 {
+		t.Errorf("got %d notes left, want 1", len(noteRepo.notes))
+	}
+
+	// A single batch event, not one per note
+	if len(dispatcher.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(dispatcher.events))
+	}
+	event, ok := dispatcher.events[0].(annotatn.NotesCleared)
+	if !ok || event.EventName() != "notes.cleared" || event.Count() != 2 {
+		t.Errorf("got event %+v", dispatcher.events[0])
+	}
+}
+
+func TestDeleteNotesByMeeting_NoNotes(t *testing.T) {
+	dispatcher := &mockDispatcher{}
+	uc := app.NewDeleteNotesByMeeting(newMockNoteRepository(), dispatcher)
+
+	out, err := uc.Execute(context.Background(), app.DeleteNotesByMeetingInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Deleted != 0 {
+		t.Errorf("got %d deleted, want 0", out.Deleted)
+	}
+	if len(dispatcher.events) != 0 {
+		t.Errorf("got %d events, want none", len(dispatcher.events))
+	}
+}
+
+func TestDeleteNotesByMeeting_EmptyMeetingID(t *testing.T) {
+	uc := app.NewDeleteNotesByMeeting(newMockNoteRepository(), nil)
+	_, err := uc.Execute(context.Background(), app.DeleteNotesByMeetingInput{})
+	if err != annotatn.ErrInvalidMeetingID {
+		t.Errorf("got error %v, want %v", err, annotatn.ErrInvalidMeetingID)
+	}
+}
+
+func TestDeleteNotesByMeeting_RecordsAudit(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	for _, id := range []annotatn.NoteID{"n-1", "n-2"} {
+		note, _ := annotatn.NewAgentNote(id, "m-1", "claude", "observation")
+		noteRepo.notes[note.ID()] = note
+	}
+	logger := &mockAuditLogger{}
+
+	uc := app.NewDeleteNotesByMeeting(noteRepo, nil)
+	uc.SetAuditLogger(logger)
+	if _, err := uc.Execute(audit.WithActor(context.Background(), "cli"), app.DeleteNotesByMeetingInput{MeetingID: "m-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1 for the batch", len(logger.entries))
+	}
+	e := logger.entries[0]
+	if e.Operation != audit.OpClearNotes || e.TargetID != "m-1" || e.Actor != "cli" || e.Details != "2 notes" {
+		t.Errorf("got entry %+v", e)
+	}
+}
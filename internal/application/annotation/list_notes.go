@@ -8,6 +8,8 @@ import (
 
 type ListNotesInput struct {
 	MeetingID string
+	// Tags restricts results to notes carrying every listed tag.
+	Tags []string
 }
 
 type ListNotesOutput struct {
@@ -32,5 +34,15 @@ func (uc *ListNotes) Execute(ctx context.Context, input ListNotesInput) (*ListNo
 		return nil, err
 	}
 
+	if len(input.Tags) > 0 {
+		filtered := make([]*annotatn.AgentNote, 0, len(notes))
+		for _, n := range notes {
+			if n.HasAllTags(input.Tags) {
+				filtered = append(filtered, n)
+			}
+		}
+		notes = filtered
+	}
+
 	return &ListNotesOutput{Notes: notes}, nil
 }
@@ -23,6 +23,41 @@ func TestListNotes_Found(t *testing.T) {
 	}
 }
 
+func TestListNotes_FilterByTags(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	both, _ := annotatn.NewAgentNote("n-1", "m-1", "claude", "ship date agreed")
+	both.SetTags([]string{"decision", "follow-up"})
+	one, _ := annotatn.NewAgentNote("n-2", "m-1", "claude", "budget approved")
+	one.SetTags([]string{"decision"})
+	none, _ := annotatn.NewAgentNote("n-3", "m-1", "claude", "general observation")
+	noteRepo.notes[both.ID()] = both
+	noteRepo.notes[one.ID()] = one
+	noteRepo.notes[none.ID()] = none
+
+	uc := app.NewListNotes(noteRepo)
+	out, err := uc.Execute(context.Background(), app.ListNotesInput{
+		MeetingID: "m-1",
+		Tags:      []string{"decision", "follow-up"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Notes) != 1 || out.Notes[0].ID() != "n-1" {
+		t.Errorf("got %d notes, want only n-1", len(out.Notes))
+	}
+
+	out, err = uc.Execute(context.Background(), app.ListNotesInput{
+		MeetingID: "m-1",
+		Tags:      []string{"decision"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Notes) != 2 {
+		t.Errorf("got %d notes, want 2", len(out.Notes))
+	}
+}
+
 func TestListNotes_Empty(t *testing.T) {
 	noteRepo := newMockNoteRepository()
 
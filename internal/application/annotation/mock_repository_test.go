@@ -2,9 +2,11 @@ package annotation_test
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -30,6 +32,15 @@ func (m *mockNoteRepository) FindByID(_ context.Context, id annotatn.NoteID) (*a
 	return note, nil
 }
 
+func (m *mockNoteRepository) FindByIdempotencyKey(_ context.Context, key string) (*annotatn.AgentNote, error) {
+	for _, note := range m.notes {
+		if note.IdempotencyKey() == key {
+			return note, nil
+		}
+	}
+	return nil, annotatn.ErrNoteNotFound
+}
+
 func (m *mockNoteRepository) ListByMeeting(_ context.Context, meetingID string) ([]*annotatn.AgentNote, error) {
 	var result []*annotatn.AgentNote
 	for _, note := range m.notes {
@@ -43,6 +54,14 @@ func (m *mockNoteRepository) ListByMeeting(_ context.Context, meetingID string)
 	return result, nil
 }
 
+func (m *mockNoteRepository) ListAll(_ context.Context) ([]*annotatn.AgentNote, error) {
+	result := make([]*annotatn.AgentNote, 0, len(m.notes))
+	for _, note := range m.notes {
+		result = append(result, note)
+	}
+	return result, nil
+}
+
 func (m *mockNoteRepository) Delete(_ context.Context, id annotatn.NoteID) error {
 	if _, ok := m.notes[id]; !ok {
 		return annotatn.ErrNoteNotFound
@@ -51,6 +70,30 @@ func (m *mockNoteRepository) Delete(_ context.Context, id annotatn.NoteID) error
 	return nil
 }
 
+func (m *mockNoteRepository) DeleteByMeeting(_ context.Context, meetingID string) (int, error) {
+	deleted := 0
+	for id, note := range m.notes {
+		if note.MeetingID() == meetingID {
+			delete(m.notes, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (m *mockNoteRepository) Search(_ context.Context, query string, limit int) ([]*annotatn.AgentNote, error) {
+	result := []*annotatn.AgentNote{}
+	for _, note := range m.notes {
+		if strings.Contains(strings.ToLower(note.Content()), strings.ToLower(query)) {
+			result = append(result, note)
+		}
+	}
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
 // mockMeetingRepository implements domain.Repository for verifying meeting existence.
 type mockMeetingRepository struct {
 	meetings map[domain.MeetingID]*domain.Meeting
@@ -88,8 +131,8 @@ func (m *mockMeetingRepository) GetActionItems(_ context.Context, _ domain.Meeti
 	return nil, nil
 }
 
-func (m *mockMeetingRepository) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
-	return nil, nil
+func (m *mockMeetingRepository) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{}, nil
 }
 
 // mockDispatcher captures dispatched events.
@@ -101,3 +144,17 @@ func (m *mockDispatcher) Dispatch(_ context.Context, events []domain.DomainEvent
 	m.events = append(m.events, events...)
 	return nil
 }
+
+// mockAuditLogger captures recorded audit entries.
+type mockAuditLogger struct {
+	entries []audit.Entry
+	err     error
+}
+
+func (m *mockAuditLogger) Record(_ context.Context, entry audit.Entry) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
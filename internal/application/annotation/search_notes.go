@@ -0,0 +1,50 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+)
+
+// DefaultSearchLimit caps note search results when no limit is given.
+const DefaultSearchLimit = 20
+
+var ErrEmptyQuery = errors.New("search query must not be empty")
+
+type SearchNotesInput struct {
+	Query string
+	Limit int
+}
+
+type SearchNotesOutput struct {
+	Notes []*annotatn.AgentNote
+}
+
+type SearchNotes struct {
+	noteRepo annotatn.NoteRepository
+}
+
+func NewSearchNotes(noteRepo annotatn.NoteRepository) *SearchNotes {
+	return &SearchNotes{noteRepo: noteRepo}
+}
+
+func (uc *SearchNotes) Execute(ctx context.Context, input SearchNotesInput) (*SearchNotesOutput, error) {
+	query := strings.TrimSpace(input.Query)
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	notes, err := uc.noteRepo.Search(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchNotesOutput{Notes: notes}, nil
+}
@@ -0,0 +1,34 @@
+package annotation_test
+
+import (
+	"context"
+	"testing"
+
+	app "github.com/felixgeelhaar/acai/internal/application/annotation"
+	annotatn "github.com/felixgeelhaar/acai/internal/domain/annotation"
+)
+
+func TestSearchNotes_Found(t *testing.T) {
+	noteRepo := newMockNoteRepository()
+	hit, _ := annotatn.NewAgentNote("n-1", "m-1", "claude", "budget approved")
+	miss, _ := annotatn.NewAgentNote("n-2", "m-2", "claude", "hiring plan")
+	noteRepo.notes[hit.ID()] = hit
+	noteRepo.notes[miss.ID()] = miss
+
+	uc := app.NewSearchNotes(noteRepo)
+	out, err := uc.Execute(context.Background(), app.SearchNotesInput{Query: "budget"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Notes) != 1 || out.Notes[0].MeetingID() != "m-1" {
+		t.Errorf("got %d notes, want only the m-1 note", len(out.Notes))
+	}
+}
+
+func TestSearchNotes_EmptyQuery(t *testing.T) {
+	uc := app.NewSearchNotes(newMockNoteRepository())
+	_, err := uc.Execute(context.Background(), app.SearchNotesInput{Query: "   "})
+	if err != app.ErrEmptyQuery {
+		t.Errorf("got %v, want ErrEmptyQuery", err)
+	}
+}
@@ -0,0 +1,39 @@
+// Package audit contains use cases for the audit bounded context.
+package audit
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/audit"
+)
+
+type ListEntriesInput struct {
+	Since     *time.Time
+	Operation string
+}
+
+type ListEntriesOutput struct {
+	Entries []domain.Entry
+}
+
+type ListEntries struct {
+	repo domain.Repository
+}
+
+func NewListEntries(repo domain.Repository) *ListEntries {
+	return &ListEntries{repo: repo}
+}
+
+func (uc *ListEntries) Execute(ctx context.Context, input ListEntriesInput) (*ListEntriesOutput, error) {
+	op := domain.Operation(input.Operation)
+	if op != "" && !op.IsKnown() {
+		return nil, domain.ErrInvalidOperation
+	}
+
+	entries, err := uc.repo.List(ctx, domain.Filter{Since: input.Since, Operation: op})
+	if err != nil {
+		return nil, err
+	}
+	return &ListEntriesOutput{Entries: entries}, nil
+}
@@ -0,0 +1,57 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/audit"
+	domain "github.com/felixgeelhaar/acai/internal/domain/audit"
+)
+
+// mockAuditRepository returns canned entries and captures the filter.
+type mockAuditRepository struct {
+	entries []domain.Entry
+	filter  domain.Filter
+}
+
+func (m *mockAuditRepository) Record(_ context.Context, entry domain.Entry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *mockAuditRepository) List(_ context.Context, filter domain.Filter) ([]domain.Entry, error) {
+	m.filter = filter
+	return m.entries, nil
+}
+
+func TestListEntries_PassesFilter(t *testing.T) {
+	repo := &mockAuditRepository{entries: []domain.Entry{
+		{Operation: domain.OpDeleteNote, TargetID: "n-1", Actor: "cli"},
+	}}
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	out, err := app.NewListEntries(repo).Execute(context.Background(), app.ListEntriesInput{
+		Since:     &since,
+		Operation: "delete_note",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Entries) != 1 {
+		t.Errorf("got %d entries, want 1", len(out.Entries))
+	}
+	if repo.filter.Operation != domain.OpDeleteNote || repo.filter.Since == nil || !repo.filter.Since.Equal(since) {
+		t.Errorf("got filter %+v", repo.filter)
+	}
+}
+
+func TestListEntries_InvalidOperation(t *testing.T) {
+	_, err := app.NewListEntries(&mockAuditRepository{}).Execute(context.Background(), app.ListEntriesInput{
+		Operation: "drop_table",
+	})
+	if !errors.Is(err, domain.ErrInvalidOperation) {
+		t.Errorf("got %v, want %v", err, domain.ErrInvalidOperation)
+	}
+}
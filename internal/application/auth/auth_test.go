@@ -13,6 +13,9 @@ type mockAuthService struct {
 	credential *domain.Credential
 	loginErr   error
 	statusErr  error
+	refreshErr error
+
+	refreshProfile string
 }
 
 func (m *mockAuthService) Login(_ context.Context, method domain.AuthMethod) (*domain.Credential, error) {
@@ -33,6 +36,20 @@ func (m *mockAuthService) Logout(_ context.Context) error {
 	return nil
 }
 
+func (m *mockAuthService) Refresh(ctx context.Context) (*domain.Credential, error) {
+	m.refreshProfile = domain.ProfileFromContext(ctx)
+	if m.refreshErr != nil {
+		return nil, m.refreshErr
+	}
+	return m.credential, nil
+}
+
+type mockTokenSetter struct {
+	token string
+}
+
+func (m *mockTokenSetter) SetToken(token string) { m.token = token }
+
 func TestLogin_Success(t *testing.T) {
 	token := domain.NewToken("access", "refresh", time.Now().Add(1*time.Hour).UTC())
 	cred := domain.NewCredential(domain.AuthOAuth, token, "ws")
@@ -60,7 +77,8 @@ func TestLogin_Error(t *testing.T) {
 
 func TestCheckStatus_Authenticated(t *testing.T) {
 	token := domain.NewToken("access", "refresh", time.Now().Add(1*time.Hour).UTC())
-	cred := domain.NewCredential(domain.AuthOAuth, token, "ws")
+	cred := domain.NewCredential(domain.AuthOAuth, token, "ws").
+		WithIdentity(domain.NewIdentity("u-1", "alice@example.com", "Alice"))
 	svc := &mockAuthService{credential: cred}
 
 	uc := app.NewCheckStatus(svc)
@@ -71,6 +89,9 @@ func TestCheckStatus_Authenticated(t *testing.T) {
 	if !out.Authenticated {
 		t.Error("expected authenticated")
 	}
+	if out.Identity.Email() != "alice@example.com" {
+		t.Errorf("got identity %q", out.Identity)
+	}
 }
 
 func TestCheckStatus_NotAuthenticated(t *testing.T) {
@@ -85,3 +106,72 @@ func TestCheckStatus_NotAuthenticated(t *testing.T) {
 		t.Error("expected not authenticated")
 	}
 }
+
+func TestRefreshToken_SetsNewAccessToken(t *testing.T) {
+	token := domain.NewToken("new-access", "refresh", time.Now().Add(1*time.Hour).UTC())
+	cred := domain.NewCredential(domain.AuthOAuth, token, "ws")
+	setter := &mockTokenSetter{}
+
+	uc := app.NewRefreshToken(&mockAuthService{credential: cred}, setter)
+	out, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Credential.Token().AccessToken() != "new-access" {
+		t.Errorf("got access token %q", out.Credential.Token().AccessToken())
+	}
+	if setter.token != "new-access" {
+		t.Errorf("client token not updated, got %q", setter.token)
+	}
+}
+
+func TestRefreshToken_UsesPinnedProfile(t *testing.T) {
+	token := domain.NewToken("new-access", "refresh", time.Now().Add(1*time.Hour).UTC())
+	svc := &mockAuthService{credential: domain.NewCredential(domain.AuthOAuth, token, "ws")}
+
+	uc := app.NewRefreshToken(svc, &mockTokenSetter{})
+	uc.SetProfile("work")
+	if _, err := uc.Execute(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if svc.refreshProfile != "work" {
+		t.Errorf("refreshed profile %q, want work", svc.refreshProfile)
+	}
+}
+
+func TestRefreshToken_Error(t *testing.T) {
+	setter := &mockTokenSetter{}
+	uc := app.NewRefreshToken(&mockAuthService{refreshErr: domain.ErrNoRefreshToken}, setter)
+
+	_, err := uc.Execute(context.Background())
+	if err != domain.ErrNoRefreshToken {
+		t.Errorf("got error %v, want %v", err, domain.ErrNoRefreshToken)
+	}
+	if setter.token != "" {
+		t.Errorf("token should not be set on failure, got %q", setter.token)
+	}
+}
+
+type mockProfileRepo struct {
+	profiles []domain.Profile
+}
+
+func (m *mockProfileRepo) ListProfiles(_ context.Context) ([]domain.Profile, error) {
+	return m.profiles, nil
+}
+
+func TestListProfiles(t *testing.T) {
+	token := domain.NewToken("access", "", time.Now().Add(1*time.Hour).UTC())
+	repo := &mockProfileRepo{profiles: []domain.Profile{
+		domain.NewProfile("default", domain.NewCredential(domain.AuthOAuth, token, "ws-a")),
+		domain.NewProfile("work", domain.NewCredential(domain.AuthAPIToken, token, "ws-b")),
+	}}
+
+	out, err := app.NewListProfiles(repo).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Profiles) != 2 || out.Profiles[1].Name() != "work" {
+		t.Errorf("unexpected profiles: %+v", out.Profiles)
+	}
+}
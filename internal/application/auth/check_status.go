@@ -9,6 +9,9 @@ import (
 type CheckStatusOutput struct {
 	Credential    *domain.Credential
 	Authenticated bool
+	// Identity is the account the credential belongs to; it is zero when
+	// unauthenticated or when the credential predates identity tracking.
+	Identity domain.Identity
 }
 
 type CheckStatus struct {
@@ -28,5 +31,6 @@ func (uc *CheckStatus) Execute(ctx context.Context) (*CheckStatusOutput, error)
 	return &CheckStatusOutput{
 		Credential:    cred,
 		Authenticated: cred.IsValid(),
+		Identity:      cred.Identity(),
 	}, nil
 }
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/auth"
+)
+
+type ListProfilesOutput struct {
+	Profiles []domain.Profile
+}
+
+type ListProfiles struct {
+	repo domain.ProfileRepository
+}
+
+func NewListProfiles(repo domain.ProfileRepository) *ListProfiles {
+	return &ListProfiles{repo: repo}
+}
+
+func (uc *ListProfiles) Execute(ctx context.Context) (*ListProfilesOutput, error) {
+	profiles, err := uc.repo.ListProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListProfilesOutput{Profiles: profiles}, nil
+}
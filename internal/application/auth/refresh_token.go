@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"sync"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/auth"
+)
+
+// TokenSetter receives the refreshed access token, e.g. the API client.
+type TokenSetter interface {
+	SetToken(token string)
+}
+
+type RefreshTokenOutput struct {
+	Credential *domain.Credential
+}
+
+type RefreshToken struct {
+	service domain.Service
+	setter  TokenSetter
+
+	mu      sync.RWMutex
+	profile string
+}
+
+func NewRefreshToken(service domain.Service, setter TokenSetter) *RefreshToken {
+	return &RefreshToken{service: service, setter: setter}
+}
+
+// SetProfile pins the credential profile every refresh acts on, whatever
+// profile ctx carries. The API client holds the token of the profile
+// selected at startup, and request contexts on the serve paths carry none,
+// so without a pin a 401 there would refresh the default profile.
+func (uc *RefreshToken) SetProfile(name string) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.profile = name
+}
+
+func (uc *RefreshToken) Execute(ctx context.Context) (*RefreshTokenOutput, error) {
+	uc.mu.RLock()
+	profile := uc.profile
+	uc.mu.RUnlock()
+	if profile != "" {
+		ctx = domain.WithProfile(ctx, profile)
+	}
+
+	cred, err := uc.service.Refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if uc.setter != nil {
+		uc.setter.SetToken(cred.Token().AccessToken())
+	}
+	return &RefreshTokenOutput{Credential: cred}, nil
+}
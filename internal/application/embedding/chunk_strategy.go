@@ -50,8 +50,11 @@ func (s *BySpeakerTurn) ChunkTranscript(meetingID domain.MeetingID, utterances [
 }
 
 // ByTimeWindow groups utterances within a fixed-duration window.
+// Overlap repeats the last Overlap utterances of each window at the start
+// of the next, so a thought spanning a boundary appears in both chunks.
 type ByTimeWindow struct {
-	Window time.Duration
+	Window  time.Duration
+	Overlap int
 }
 
 func (s *ByTimeWindow) ChunkTranscript(meetingID domain.MeetingID, utterances []domain.Utterance) ([]domain.Chunk, error) {
@@ -65,39 +68,34 @@ func (s *ByTimeWindow) ChunkTranscript(meetingID domain.MeetingID, utterances []
 	}
 
 	var chunks []domain.Chunk
-	idx := 0
-	i := 0
-	for i < len(utterances) {
-		windowStart := utterances[i].Timestamp()
-		windowEnd := windowStart.Add(window)
-		var texts []string
-		var speakers []string
-		endTime := windowStart
-
-		for i < len(utterances) && !utterances[i].Timestamp().After(windowEnd) {
-			texts = append(texts, utterances[i].Text())
-			if len(speakers) == 0 || speakers[len(speakers)-1] != utterances[i].Speaker() {
-				speakers = append(speakers, utterances[i].Speaker())
-			}
-			endTime = utterances[i].Timestamp()
-			i++
+	for start := 0; start < len(utterances); {
+		windowEnd := utterances[start].Timestamp().Add(window)
+		end := start
+		for end < len(utterances) && !utterances[end].Timestamp().After(windowEnd) {
+			end++
 		}
 
-		content := strings.Join(texts, " ")
-		speaker := strings.Join(speakers, ", ")
-		c, err := domain.NewChunk(meetingID, idx, content, speaker, windowStart, endTime, domain.ChunkSourceTranscript, estimateTokens(content))
+		c, err := transcriptChunk(meetingID, len(chunks), utterances[start:end])
 		if err != nil {
 			return nil, err
 		}
 		chunks = append(chunks, c)
-		idx++
+		if end == len(utterances) {
+			break
+		}
+		// Always advance by at least one utterance, however large Overlap is.
+		start = max(end-s.Overlap, start+1)
 	}
 	return chunks, nil
 }
 
 // ByTokenLimit splits content at approximate token boundaries.
+// Overlap repeats the last Overlap utterances of each chunk at the start
+// of the next; repeated utterances are dropped first when they would push
+// a chunk past MaxTokens.
 type ByTokenLimit struct {
 	MaxTokens int
+	Overlap   int
 }
 
 func (s *ByTokenLimit) ChunkTranscript(meetingID domain.MeetingID, utterances []domain.Utterance) ([]domain.Chunk, error) {
@@ -110,53 +108,67 @@ func (s *ByTokenLimit) ChunkTranscript(meetingID domain.MeetingID, utterances []
 		maxTokens = 256
 	}
 
-	var chunks []domain.Chunk
-	idx := 0
-	var texts []string
-	var speakers []string
-	tokenCount := 0
-	startTime := utterances[0].Timestamp()
-	endTime := startTime
+	var (
+		chunks  []domain.Chunk
+		current []domain.Utterance
+		carried int // leading utterances of current repeated from the previous chunk
+		tokens  int
+	)
 
 	flush := func() error {
-		if len(texts) == 0 {
-			return nil
-		}
-		content := strings.Join(texts, " ")
-		speaker := strings.Join(speakers, ", ")
-		c, err := domain.NewChunk(meetingID, idx, content, speaker, startTime, endTime, domain.ChunkSourceTranscript, estimateTokens(content))
+		c, err := transcriptChunk(meetingID, len(chunks), current)
 		if err != nil {
 			return err
 		}
 		chunks = append(chunks, c)
-		idx++
-		texts = nil
-		speakers = nil
-		tokenCount = 0
+		carried = min(s.Overlap, len(current))
+		current = append([]domain.Utterance(nil), current[len(current)-carried:]...)
+		tokens = 0
+		for _, u := range current {
+			tokens += estimateTokens(u.Text())
+		}
 		return nil
 	}
 
 	for _, u := range utterances {
 		uTokens := estimateTokens(u.Text())
-		if tokenCount+uTokens > maxTokens && len(texts) > 0 {
+		if tokens+uTokens > maxTokens && len(current) > carried {
 			if err := flush(); err != nil {
 				return nil, err
 			}
-			startTime = u.Timestamp()
 		}
-		texts = append(texts, u.Text())
-		if len(speakers) == 0 || speakers[len(speakers)-1] != u.Speaker() {
-			speakers = append(speakers, u.Speaker())
+		for carried > 0 && tokens+uTokens > maxTokens {
+			tokens -= estimateTokens(current[0].Text())
+			current = current[1:]
+			carried--
 		}
-		tokenCount += uTokens
-		endTime = u.Timestamp()
+		current = append(current, u)
+		tokens += uTokens
 	}
-	if err := flush(); err != nil {
-		return nil, err
+	if len(current) > carried {
+		if err := flush(); err != nil {
+			return nil, err
+		}
 	}
 	return chunks, nil
 }
 
+// transcriptChunk joins consecutive utterances into one chunk, listing
+// each run of speakers once.
+func transcriptChunk(meetingID domain.MeetingID, idx int, utterances []domain.Utterance) (domain.Chunk, error) {
+	texts := make([]string, len(utterances))
+	var speakers []string
+	for i, u := range utterances {
+		texts[i] = u.Text()
+		if len(speakers) == 0 || speakers[len(speakers)-1] != u.Speaker() {
+			speakers = append(speakers, u.Speaker())
+		}
+	}
+	content := strings.Join(texts, " ")
+	start, end := utterances[0].Timestamp(), utterances[len(utterances)-1].Timestamp()
+	return domain.NewChunk(meetingID, idx, content, strings.Join(speakers, ", "), start, end, domain.ChunkSourceTranscript, estimateTokens(content))
+}
+
 // estimateTokens provides a rough token count approximation (~0.75 words per token).
 func estimateTokens(text string) int {
 	words := len(strings.Fields(text))
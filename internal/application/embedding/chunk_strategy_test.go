@@ -188,6 +188,69 @@ func TestByTokenLimit_DefaultMaxTokens(t *testing.T) {
 	}
 }
 
+func TestByTokenLimit_Overlap(t *testing.T) {
+	now := time.Now().UTC()
+	utterances := []domain.Utterance{
+		domain.NewUtterance("Alice", "one two three", now, 0.9),
+		domain.NewUtterance("Bob", "four five six", now.Add(5*time.Second), 0.9),
+		domain.NewUtterance("Alice", "seven eight nine", now.Add(10*time.Second), 0.9),
+		domain.NewUtterance("Bob", "ten eleven twelve", now.Add(15*time.Second), 0.9),
+	}
+
+	// Four tokens per utterance: two fit in a chunk, and one is repeated.
+	s := &ByTokenLimit{MaxTokens: 8, Overlap: 1}
+	chunks, err := s.ChunkTranscript("m-1", utterances)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"one two three four five six",
+		"four five six seven eight nine",
+		"seven eight nine ten eleven twelve",
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d", len(chunks), len(want))
+	}
+	for i, c := range chunks {
+		if c.Content() != want[i] {
+			t.Errorf("chunk %d: got %q, want %q", i, c.Content(), want[i])
+		}
+		if c.ChunkIndex() != i {
+			t.Errorf("chunk %d: got index %d", i, c.ChunkIndex())
+		}
+	}
+
+	noOverlap, _ := (&ByTokenLimit{MaxTokens: 8}).ChunkTranscript("m-1", utterances)
+	if len(noOverlap) != 2 {
+		t.Errorf("overlap 0: got %d chunks, want 2", len(noOverlap))
+	}
+}
+
+func TestByTimeWindow_Overlap(t *testing.T) {
+	now := time.Now().UTC()
+	utterances := []domain.Utterance{
+		domain.NewUtterance("Alice", "first", now, 0.9),
+		domain.NewUtterance("Bob", "second", now.Add(20*time.Second), 0.9),
+		domain.NewUtterance("Alice", "third", now.Add(40*time.Second), 0.9),
+		domain.NewUtterance("Bob", "fourth", now.Add(60*time.Second), 0.9),
+	}
+
+	s := &ByTimeWindow{Window: 30 * time.Second, Overlap: 1}
+	chunks, err := s.ChunkTranscript("m-1", utterances)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"first second", "second third", "third fourth"}
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d", len(chunks), len(want))
+	}
+	for i, c := range chunks {
+		if c.Content() != want[i] {
+			t.Errorf("chunk %d: got %q, want %q", i, c.Content(), want[i])
+		}
+	}
+}
+
 func TestEstimateTokens(t *testing.T) {
 	tests := []struct {
 		text string
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/felixgeelhaar/acai/internal/domain/annotation"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -12,18 +13,42 @@ import (
 var (
 	ErrNoMeetings       = errors.New("at least one meeting ID is required")
 	ErrInvalidStrategy  = errors.New("unknown chunking strategy")
+	ErrInvalidOverlap   = errors.New("chunk overlap must not be negative")
 )
 
+// DefaultFetchConcurrency is how many meetings are fetched in parallel
+// when ExportEmbeddingsInput.Concurrency is not set.
+const DefaultFetchConcurrency = 4
+
 type ExportEmbeddingsInput struct {
-	MeetingIDs []domain.MeetingID
-	Strategy   string // "speaker_turn", "time_window", "token_limit"
-	MaxTokens  int
-	Format     string // "jsonl"
+	MeetingIDs  []domain.MeetingID
+	Strategy    string // "speaker_turn", "time_window", "token_limit"
+	MaxTokens   int
+	Overlap     int    // utterances repeated between consecutive time_window and token_limit chunks
+	Format      string // "jsonl"
+	Concurrency int    // max meetings fetched in parallel; <= 0 uses DefaultFetchConcurrency
+
+	// RedactEmails masks email addresses in chunk content, keeping their
+	// domain, so they do not reach a vector store.
+	RedactEmails bool
+}
+
+// MeetingExportError records a meeting that was left out of the export.
+type MeetingExportError struct {
+	MeetingID domain.MeetingID
+	Err       error
+}
+
+func (e MeetingExportError) Error() string {
+	return fmt.Sprintf("%s: %v", e.MeetingID, e.Err)
 }
 
+func (e MeetingExportError) Unwrap() error { return e.Err }
+
 type ExportEmbeddingsOutput struct {
-	Content    string
+	Content    string // empty for ExecuteStream, which writes it instead
 	ChunkCount int
+	Errors     []MeetingExportError
 }
 
 type ExportEmbeddings struct {
@@ -35,83 +60,189 @@ func NewExportEmbeddings(meetingRepo domain.Repository, noteRepo annotation.Note
 	return &ExportEmbeddings{meetingRepo: meetingRepo, noteRepo: noteRepo}
 }
 
+// meetingContent is everything fetched for one meeting, ready to be chunked.
+type meetingContent struct {
+	transcriptChunks []domain.Chunk
+	meeting          *domain.Meeting
+	notes            []*annotation.AgentNote
+	err              error
+}
+
+// Execute builds the whole export in memory and returns it as Content.
 func (uc *ExportEmbeddings) Execute(ctx context.Context, input ExportEmbeddingsInput) (*ExportEmbeddingsOutput, error) {
+	var allChunks []domain.Chunk
+	out, err := uc.export(ctx, input, func(c domain.Chunk) error {
+		allChunks = append(allChunks, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	formatter := resolveFormat(input.Format)
+	out.Content, err = formatter.FormatChunks(allChunks)
+	if err != nil {
+		return nil, fmt.Errorf("format chunks: %w", err)
+	}
+	return out, nil
+}
+
+// ExecuteStream writes each chunk to w as soon as its meeting is assembled,
+// so memory use does not grow with the size of the export. The output
+// matches Execute's Content, with every line newline-terminated.
+func (uc *ExportEmbeddings) ExecuteStream(ctx context.Context, input ExportEmbeddingsInput, w io.Writer) (*ExportEmbeddingsOutput, error) {
+	formatter := resolveFormat(input.Format)
+	return uc.export(ctx, input, func(c domain.Chunk) error {
+		if err := formatter.WriteChunk(w, c); err != nil {
+			return fmt.Errorf("write chunk: %w", err)
+		}
+		return nil
+	})
+}
+
+// export fetches the meetings and passes every chunk to emit in order.
+func (uc *ExportEmbeddings) export(ctx context.Context, input ExportEmbeddingsInput, emit func(domain.Chunk) error) (*ExportEmbeddingsOutput, error) {
 	if len(input.MeetingIDs) == 0 {
 		return nil, ErrNoMeetings
 	}
 
-	strategy, err := resolveStrategy(input.Strategy, input.MaxTokens)
+	strategy, err := resolveStrategy(input.Strategy, input.MaxTokens, input.Overlap)
 	if err != nil {
 		return nil, err
 	}
 
-	var allChunks []domain.Chunk
+	if input.RedactEmails {
+		next := emit
+		emit = func(c domain.Chunk) error {
+			return next(c.WithContent(domain.MaskEmails(c.Content())))
+		}
+	}
+
+	// Cancel the fetches still in flight when emitting fails part way.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	next := uc.fetchAll(ctx, input.MeetingIDs, strategy, input.Concurrency)
 
+	// Assemble sequentially in input order so chunk indexes and output are
+	// deterministic regardless of which fetch finished first.
+	out := &ExportEmbeddingsOutput{}
 	for _, mid := range input.MeetingIDs {
-		// Get transcript chunks
-		transcript, err := uc.meetingRepo.GetTranscript(ctx, mid)
-		if err != nil && !errors.Is(err, domain.ErrTranscriptNotReady) {
-			return nil, fmt.Errorf("get transcript %s: %w", mid, err)
+		c := next()
+		if c.err != nil {
+			out.Errors = append(out.Errors, MeetingExportError{MeetingID: mid, Err: c.err})
+			continue
 		}
-		if transcript != nil {
-			chunks, err := strategy.ChunkTranscript(mid, transcript.Utterances())
-			if err != nil {
-				return nil, fmt.Errorf("chunk transcript %s: %w", mid, err)
+
+		for _, chunk := range c.transcriptChunks {
+			if err := emit(chunk); err != nil {
+				return nil, err
 			}
-			allChunks = append(allChunks, chunks...)
+			out.ChunkCount++
 		}
 
-		// Get meeting for summary
-		meeting, err := uc.meetingRepo.FindByID(ctx, mid)
-		if err != nil {
-			return nil, fmt.Errorf("get meeting %s: %w", mid, err)
-		}
-		if summary := meeting.Summary(); summary != nil && summary.Content() != "" {
-			chunkIdx := len(allChunks)
-			c, err := domain.NewChunk(mid, chunkIdx, summary.Content(), "", meeting.Datetime(), meeting.Datetime(), domain.ChunkSourceSummary, estimateTokens(summary.Content()))
+		if summary := c.meeting.Summary(); summary != nil && summary.Content() != "" {
+			chunk, err := domain.NewChunk(mid, out.ChunkCount, summary.Content(), "", c.meeting.Datetime(), c.meeting.Datetime(), domain.ChunkSourceSummary, estimateTokens(summary.Content()))
 			if err != nil {
 				return nil, err
 			}
-			allChunks = append(allChunks, c)
+			if err := emit(chunk); err != nil {
+				return nil, err
+			}
+			out.ChunkCount++
 		}
 
-		// Get agent notes
-		if uc.noteRepo != nil {
-			notes, err := uc.noteRepo.ListByMeeting(ctx, string(mid))
+		for _, n := range c.notes {
+			chunk, err := domain.NewChunk(mid, out.ChunkCount, n.Content(), n.Author(), n.CreatedAt(), n.CreatedAt(), domain.ChunkSourceNote, estimateTokens(n.Content()))
 			if err != nil {
-				return nil, fmt.Errorf("list notes %s: %w", mid, err)
+				return nil, err
 			}
-			for _, n := range notes {
-				chunkIdx := len(allChunks)
-				c, err := domain.NewChunk(mid, chunkIdx, n.Content(), n.Author(), n.CreatedAt(), n.CreatedAt(), domain.ChunkSourceNote, estimateTokens(n.Content()))
-				if err != nil {
-					return nil, err
-				}
-				allChunks = append(allChunks, c)
+			if err := emit(chunk); err != nil {
+				return nil, err
 			}
+			out.ChunkCount++
 		}
 	}
+	return out, nil
+}
 
-	formatter := resolveFormat(input.Format)
-	content, err := formatter.FormatChunks(allChunks)
+// fetchAll fetches the meetings in parallel and returns next, which
+// yields their contents in the order of ids, one per call. At most
+// concurrency meetings are fetched ahead of the caller, in flight or
+// waiting to be read, so a slow consumer bounds memory as well as the
+// requests in flight. The next fetch starts only when a result is read.
+func (uc *ExportEmbeddings) fetchAll(ctx context.Context, ids []domain.MeetingID, strategy ChunkStrategy, concurrency int) (next func() meetingContent) {
+	if concurrency <= 0 {
+		concurrency = DefaultFetchConcurrency
+	}
+
+	pending := make([]chan meetingContent, 0, concurrency)
+	started := 0
+	start := func() {
+		if started == len(ids) {
+			return
+		}
+		ch := make(chan meetingContent, 1)
+		go func(mid domain.MeetingID) {
+			ch <- uc.fetchMeeting(ctx, mid, strategy)
+		}(ids[started])
+		pending = append(pending, ch)
+		started++
+	}
+	for i := 0; i < concurrency; i++ {
+		start()
+	}
+
+	return func() meetingContent {
+		c := <-pending[0]
+		pending = pending[1:]
+		start()
+		return c
+	}
+}
+
+func (uc *ExportEmbeddings) fetchMeeting(ctx context.Context, mid domain.MeetingID, strategy ChunkStrategy) meetingContent {
+	var c meetingContent
+
+	// Get transcript chunks
+	transcript, err := uc.meetingRepo.GetTranscript(ctx, mid)
+	if err != nil && !errors.Is(err, domain.ErrTranscriptNotReady) {
+		return meetingContent{err: fmt.Errorf("get transcript: %w", err)}
+	}
+	if transcript != nil {
+		c.transcriptChunks, err = strategy.ChunkTranscript(mid, transcript.Utterances())
+		if err != nil {
+			return meetingContent{err: fmt.Errorf("chunk transcript: %w", err)}
+		}
+	}
+
+	// Get meeting for summary
+	c.meeting, err = uc.meetingRepo.FindByID(ctx, mid)
 	if err != nil {
-		return nil, fmt.Errorf("format chunks: %w", err)
+		return meetingContent{err: fmt.Errorf("get meeting: %w", err)}
 	}
 
-	return &ExportEmbeddingsOutput{
-		Content:    content,
-		ChunkCount: len(allChunks),
-	}, nil
+	// Get agent notes
+	if uc.noteRepo != nil {
+		c.notes, err = uc.noteRepo.ListByMeeting(ctx, string(mid))
+		if err != nil {
+			return meetingContent{err: fmt.Errorf("list notes: %w", err)}
+		}
+	}
+
+	return c
 }
 
-func resolveStrategy(name string, maxTokens int) (ChunkStrategy, error) {
+func resolveStrategy(name string, maxTokens, overlap int) (ChunkStrategy, error) {
+	if overlap < 0 {
+		return nil, ErrInvalidOverlap
+	}
 	switch name {
 	case "", "speaker_turn":
 		return &BySpeakerTurn{}, nil
 	case "time_window":
-		return &ByTimeWindow{}, nil
+		return &ByTimeWindow{Overlap: overlap}, nil
 	case "token_limit":
-		return &ByTokenLimit{MaxTokens: maxTokens}, nil
+		return &ByTokenLimit{MaxTokens: maxTokens, Overlap: overlap}, nil
 	default:
 		return nil, ErrInvalidStrategy
 	}
@@ -2,7 +2,10 @@ package embedding
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -40,8 +43,8 @@ func (m *mockMeetingRepo) SearchTranscripts(_ context.Context, _ string, _ domai
 func (m *mockMeetingRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
 	return nil, nil
 }
-func (m *mockMeetingRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
-	return nil, nil
+func (m *mockMeetingRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{}, nil
 }
 
 type mockNoteRepo struct {
@@ -52,10 +55,20 @@ func (m *mockNoteRepo) Save(_ context.Context, _ *annotation.AgentNote) error {
 func (m *mockNoteRepo) FindByID(_ context.Context, _ annotation.NoteID) (*annotation.AgentNote, error) {
 	return nil, annotation.ErrNoteNotFound
 }
+func (m *mockNoteRepo) FindByIdempotencyKey(_ context.Context, _ string) (*annotation.AgentNote, error) {
+	return nil, annotation.ErrNoteNotFound
+}
 func (m *mockNoteRepo) ListByMeeting(_ context.Context, meetingID string) ([]*annotation.AgentNote, error) {
 	return m.notes[meetingID], nil
 }
-func (m *mockNoteRepo) Delete(_ context.Context, _ annotation.NoteID) error { return nil }
+func (m *mockNoteRepo) ListAll(_ context.Context) ([]*annotation.AgentNote, error) {
+	return nil, nil
+}
+func (m *mockNoteRepo) Delete(_ context.Context, _ annotation.NoteID) error      { return nil }
+func (m *mockNoteRepo) DeleteByMeeting(_ context.Context, _ string) (int, error) { return 0, nil }
+func (m *mockNoteRepo) Search(_ context.Context, _ string, _ int) ([]*annotation.AgentNote, error) {
+	return nil, nil
+}
 
 // --- Tests ---
 
@@ -78,6 +91,18 @@ func TestExportEmbeddings_InvalidStrategy(t *testing.T) {
 	}
 }
 
+func TestExportEmbeddings_NegativeOverlap(t *testing.T) {
+	uc := NewExportEmbeddings(&mockMeetingRepo{}, nil)
+	_, err := uc.Execute(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs: []domain.MeetingID{"m-1"},
+		Strategy:   "token_limit",
+		Overlap:    -1,
+	})
+	if err != ErrInvalidOverlap {
+		t.Errorf("expected ErrInvalidOverlap, got %v", err)
+	}
+}
+
 func TestExportEmbeddings_TranscriptOnly(t *testing.T) {
 	now := time.Now().UTC()
 	mtg, _ := domain.New("m-1", "Sprint Planning", now, domain.SourceZoom, nil)
@@ -110,6 +135,31 @@ func TestExportEmbeddings_TranscriptOnly(t *testing.T) {
 	}
 }
 
+func TestExportEmbeddings_RedactEmails(t *testing.T) {
+	now := time.Now().UTC()
+	mtg, _ := domain.New("m-1", "Sprint Planning", now, domain.SourceZoom, nil)
+	mtg.ClearDomainEvents()
+
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Mail me at alice@example.com", now, 0.9),
+	})
+	repo := &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{"m-1": mtg},
+		transcripts: map[domain.MeetingID]*domain.Transcript{"m-1": &transcript},
+	}
+
+	out, err := NewExportEmbeddings(repo, nil).Execute(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs:   []domain.MeetingID{"m-1"},
+		RedactEmails: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(out.Content, "alice@example.com") || !strings.Contains(out.Content, "a***@example.com") {
+		t.Errorf("got content %s, want the email masked", out.Content)
+	}
+}
+
 func TestExportEmbeddings_WithSummary(t *testing.T) {
 	now := time.Now().UTC()
 	mtg, _ := domain.New("m-1", "Sprint Planning", now, domain.SourceZoom, nil)
@@ -142,7 +192,7 @@ func TestExportEmbeddings_WithNotes(t *testing.T) {
 	mtg, _ := domain.New("m-1", "Sprint", now, domain.SourceZoom, nil)
 	mtg.ClearDomainEvents()
 
-	note := annotation.ReconstructAgentNote("n-1", "m-1", "agent", "Agent observation", now)
+	note := annotation.ReconstructAgentNote("n-1", "m-1", "agent", "Agent observation", nil, now)
 
 	repo := &mockMeetingRepo{
 		meetings:    map[domain.MeetingID]*domain.Meeting{"m-1": mtg},
@@ -203,6 +253,173 @@ func TestExportEmbeddings_MultipleMeetings(t *testing.T) {
 	}
 }
 
+func TestExportEmbeddings_ExecuteStream(t *testing.T) {
+	now := time.Now().UTC()
+	repo := &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{},
+		transcripts: map[domain.MeetingID]*domain.Transcript{},
+	}
+	ids := []domain.MeetingID{"m-1", "m-2", "m-3"}
+	for _, id := range ids {
+		mtg, _ := domain.New(id, "Meeting", now, domain.SourceZoom, nil)
+		mtg.AttachSummary(domain.NewSummary(id, "Summary of "+string(id), domain.SummaryAuto))
+		repo.meetings[id] = mtg
+		tr := domain.NewTranscript(id, []domain.Utterance{
+			domain.NewUtterance("Alice", "Hello", now, 0.9),
+			domain.NewUtterance("Bob", "Hi", now.Add(time.Second), 0.9),
+		})
+		repo.transcripts[id] = &tr
+	}
+	input := ExportEmbeddingsInput{MeetingIDs: append(ids, "m-404")}
+
+	uc := NewExportEmbeddings(repo, nil)
+	var buf strings.Builder
+	out, err := uc.ExecuteStream(context.Background(), input, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 9 || out.ChunkCount != 9 { // two speaker turns and a summary per meeting
+		t.Errorf("got %d lines, ChunkCount %d, want 9", len(lines), out.ChunkCount)
+	}
+	if out.Content != "" {
+		t.Error("a streamed export should not also buffer Content")
+	}
+	if len(out.Errors) != 1 || out.Errors[0].MeetingID != "m-404" {
+		t.Errorf("got errors %v, want m-404", out.Errors)
+	}
+
+	buffered, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != buffered.Content+"\n" {
+		t.Errorf("streamed output differs from Execute:\n%s\nvs\n%s", buf.String(), buffered.Content)
+	}
+}
+
+func TestExportEmbeddings_ExecuteStream_WriteError(t *testing.T) {
+	now := time.Now().UTC()
+	mtg, _ := domain.New("m-1", "Meeting", now, domain.SourceZoom, nil)
+	tr := domain.NewTranscript("m-1", []domain.Utterance{domain.NewUtterance("Alice", "Hello", now, 0.9)})
+	repo := &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{"m-1": mtg},
+		transcripts: map[domain.MeetingID]*domain.Transcript{"m-1": &tr},
+	}
+
+	_, err := NewExportEmbeddings(repo, nil).ExecuteStream(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs: []domain.MeetingID{"m-1"},
+	}, failingWriter{})
+	if err == nil {
+		t.Fatal("expected the write error")
+	}
+}
+
+// ctxRecordingRepo keeps the context of its last transcript fetch.
+type ctxRecordingRepo struct {
+	*mockMeetingRepo
+	mu  sync.Mutex
+	ctx context.Context
+}
+
+func (r *ctxRecordingRepo) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	r.mu.Lock()
+	r.ctx = ctx
+	r.mu.Unlock()
+	return r.mockMeetingRepo.GetTranscript(ctx, id)
+}
+
+func TestExportEmbeddings_ExecuteStream_WriteErrorCancelsFetches(t *testing.T) {
+	now := time.Now().UTC()
+	mtg, _ := domain.New("m-1", "Meeting", now, domain.SourceZoom, nil)
+	tr := domain.NewTranscript("m-1", []domain.Utterance{domain.NewUtterance("Alice", "Hello", now, 0.9)})
+	repo := &ctxRecordingRepo{mockMeetingRepo: &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{"m-1": mtg},
+		transcripts: map[domain.MeetingID]*domain.Transcript{"m-1": &tr},
+	}}
+
+	_, err := NewExportEmbeddings(repo, nil).ExecuteStream(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs: []domain.MeetingID{"m-1", "m-2", "m-3"},
+	}, failingWriter{})
+	if err == nil {
+		t.Fatal("expected the write error")
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.ctx == nil || repo.ctx.Err() == nil {
+		t.Error("expected the fetch context to be cancelled after the write error")
+	}
+}
+
+// countingRepo counts transcript fetches.
+type countingRepo struct {
+	*mockMeetingRepo
+	mu      sync.Mutex
+	fetches int
+}
+
+func (r *countingRepo) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	r.mu.Lock()
+	r.fetches++
+	r.mu.Unlock()
+	return r.mockMeetingRepo.GetTranscript(ctx, id)
+}
+
+// probeWriter records how many fetches had started at its first write.
+type probeWriter struct {
+	repo  *countingRepo
+	first int
+	wrote bool
+}
+
+func (w *probeWriter) Write(p []byte) (int, error) {
+	if !w.wrote {
+		w.wrote = true
+		w.repo.mu.Lock()
+		w.first = w.repo.fetches
+		w.repo.mu.Unlock()
+	}
+	return len(p), nil
+}
+
+func TestExportEmbeddings_ExecuteStream_BoundsFetchAhead(t *testing.T) {
+	now := time.Now().UTC()
+	inner := &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{},
+		transcripts: map[domain.MeetingID]*domain.Transcript{},
+	}
+	var ids []domain.MeetingID
+	for i := 0; i < 6; i++ {
+		id := domain.MeetingID(fmt.Sprintf("m-%d", i))
+		mtg, _ := domain.New(id, "Meeting", now, domain.SourceZoom, nil)
+		tr := domain.NewTranscript(id, []domain.Utterance{domain.NewUtterance("Alice", "Hello", now, 0.9)})
+		inner.meetings[id] = mtg
+		inner.transcripts[id] = &tr
+		ids = append(ids, id)
+	}
+	repo := &countingRepo{mockMeetingRepo: inner}
+	w := &probeWriter{repo: repo}
+
+	_, err := NewExportEmbeddings(repo, nil).ExecuteStream(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs:  ids,
+		Concurrency: 2,
+	}, w)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The first meeting is being written, so at most Concurrency more
+	// may have been fetched ahead of it.
+	if w.first > 3 {
+		t.Errorf("%d meetings fetched before the first write, want at most 3", w.first)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
 func TestExportEmbeddings_TimeWindowStrategy(t *testing.T) {
 	now := time.Now().UTC()
 	mtg, _ := domain.New("m-1", "Sprint", now, domain.SourceZoom, nil)
@@ -269,11 +486,113 @@ func TestExportEmbeddings_MeetingNotFound(t *testing.T) {
 	}
 
 	uc := NewExportEmbeddings(repo, nil)
-	_, err := uc.Execute(context.Background(), ExportEmbeddingsInput{
+	out, err := uc.Execute(context.Background(), ExportEmbeddingsInput{
 		MeetingIDs: []domain.MeetingID{"nonexistent"},
 	})
-	if err == nil {
-		t.Error("expected error for nonexistent meeting")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ChunkCount != 0 {
+		t.Errorf("ChunkCount = %d, want 0", out.ChunkCount)
+	}
+	if len(out.Errors) != 1 || out.Errors[0].MeetingID != "nonexistent" || !errors.Is(out.Errors[0], domain.ErrMeetingNotFound) {
+		t.Errorf("Errors = %v, want one ErrMeetingNotFound for nonexistent", out.Errors)
+	}
+}
+
+func TestExportEmbeddings_PartialFailure(t *testing.T) {
+	now := time.Now().UTC()
+	repo := &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{},
+		transcripts: map[domain.MeetingID]*domain.Transcript{},
+	}
+	for _, id := range []domain.MeetingID{"m-1", "m-3"} {
+		mtg, _ := domain.New(id, "Sync", now, domain.SourceZoom, nil)
+		transcript := domain.NewTranscript(id, []domain.Utterance{
+			domain.NewUtterance("Alice", "Hello", now, 0.9),
+		})
+		repo.meetings[id] = mtg
+		repo.transcripts[id] = &transcript
+	}
+
+	uc := NewExportEmbeddings(repo, nil)
+	out, err := uc.Execute(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs: []domain.MeetingID{"m-1", "m-2", "m-3"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ChunkCount != 2 {
+		t.Errorf("ChunkCount = %d, want 2 (failed meeting excluded)", out.ChunkCount)
+	}
+	if len(out.Errors) != 1 || out.Errors[0].MeetingID != "m-2" {
+		t.Errorf("Errors = %v, want only m-2", out.Errors)
+	}
+}
+
+// slowMeetingRepo delays GetTranscript and records peak concurrency.
+type slowMeetingRepo struct {
+	*mockMeetingRepo
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func (r *slowMeetingRepo) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	r.mu.Lock()
+	r.inFlight++
+	if r.inFlight > r.peak {
+		r.peak = r.inFlight
+	}
+	r.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	r.mu.Lock()
+	r.inFlight--
+	r.mu.Unlock()
+	return r.mockMeetingRepo.GetTranscript(ctx, id)
+}
+
+func TestExportEmbeddings_ConcurrentFetchKeepsOrder(t *testing.T) {
+	now := time.Now().UTC()
+	base := &mockMeetingRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{},
+		transcripts: map[domain.MeetingID]*domain.Transcript{},
+	}
+	var ids []domain.MeetingID
+	for i := 0; i < 8; i++ {
+		id := domain.MeetingID(fmt.Sprintf("m-%d", i))
+		mtg, _ := domain.New(id, "Standup", now, domain.SourceZoom, nil)
+		transcript := domain.NewTranscript(id, []domain.Utterance{
+			domain.NewUtterance("Alice", "Update", now, 0.9),
+		})
+		base.meetings[id] = mtg
+		base.transcripts[id] = &transcript
+		ids = append(ids, id)
+	}
+	repo := &slowMeetingRepo{mockMeetingRepo: base}
+
+	uc := NewExportEmbeddings(repo, nil)
+	out, err := uc.Execute(context.Background(), ExportEmbeddingsInput{
+		MeetingIDs:  ids,
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.peak > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", repo.peak)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.Content), "\n")
+	if len(lines) != len(ids) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(ids))
+	}
+	for i, line := range lines {
+		if !strings.Contains(line, `"meeting_id":"`+string(ids[i])+`"`) {
+			t.Errorf("line %d = %s, want meeting %s", i, line, ids[i])
+		}
 	}
 }
 
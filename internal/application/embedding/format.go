@@ -2,6 +2,7 @@ package embedding
 
 import (
 	"encoding/json"
+	"io"
 	"strings"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 // ExportFormat defines how chunks are serialized for output.
 type ExportFormat interface {
 	FormatChunks(chunks []domain.Chunk) (string, error)
+	// WriteChunk writes one chunk as it is produced, for streaming exports.
+	WriteChunk(w io.Writer, chunk domain.Chunk) error
 }
 
 // JSONLLine is the serialization structure for a single JSONL line.
@@ -31,21 +34,7 @@ type JSONLFormat struct{}
 func (f *JSONLFormat) FormatChunks(chunks []domain.Chunk) (string, error) {
 	var lines []string
 	for _, c := range chunks {
-		line := JSONLLine{
-			MeetingID:  string(c.MeetingID()),
-			ChunkIndex: c.ChunkIndex(),
-			Content:    c.Content(),
-			Speaker:    c.Speaker(),
-			Source:     string(c.Source()),
-			TokenCount: c.TokenCount(),
-		}
-		if !c.StartTime().IsZero() {
-			line.StartTime = c.StartTime().Format(time.RFC3339)
-		}
-		if !c.EndTime().IsZero() {
-			line.EndTime = c.EndTime().Format(time.RFC3339)
-		}
-		data, err := json.Marshal(line)
+		data, err := marshalJSONLLine(c)
 		if err != nil {
 			return "", err
 		}
@@ -53,3 +42,31 @@ func (f *JSONLFormat) FormatChunks(chunks []domain.Chunk) (string, error) {
 	}
 	return strings.Join(lines, "\n"), nil
 }
+
+// WriteChunk writes c as one newline-terminated JSON line.
+func (f *JSONLFormat) WriteChunk(w io.Writer, c domain.Chunk) error {
+	data, err := marshalJSONLLine(c)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func marshalJSONLLine(c domain.Chunk) ([]byte, error) {
+	line := JSONLLine{
+		MeetingID:  string(c.MeetingID()),
+		ChunkIndex: c.ChunkIndex(),
+		Content:    c.Content(),
+		Speaker:    c.Speaker(),
+		Source:     string(c.Source()),
+		TokenCount: c.TokenCount(),
+	}
+	if !c.StartTime().IsZero() {
+		line.StartTime = c.StartTime().Format(time.RFC3339)
+	}
+	if !c.EndTime().IsZero() {
+		line.EndTime = c.EndTime().Format(time.RFC3339)
+	}
+	return json.Marshal(line)
+}
@@ -0,0 +1,189 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultEventDuration is used when a meeting's transcript gives no better estimate.
+const DefaultEventDuration = 60 * time.Minute
+
+// icsTimeLayout is the RFC 5545 UTC date-time form.
+const icsTimeLayout = "20060102T150405Z"
+
+type ExportCalendarInput struct {
+	Since *time.Time
+	Until *time.Time
+	Limit int
+}
+
+type ExportCalendarOutput struct {
+	Content    string
+	EventCount int
+}
+
+type ExportCalendar struct {
+	repo domain.Repository
+}
+
+func NewExportCalendar(repo domain.Repository) *ExportCalendar {
+	return &ExportCalendar{repo: repo}
+}
+
+func (uc *ExportCalendar) Execute(ctx context.Context, input ExportCalendarInput) (*ExportCalendarOutput, error) {
+	meetings, err := uc.repo.List(ctx, domain.ListFilter{
+		Since: input.Since,
+		Until: input.Until,
+		Limit: input.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(meetings, func(i, j int) bool {
+		return meetings[i].Datetime().Before(meetings[j].Datetime())
+	})
+
+	content, err := formatICS(ctx, uc.repo, meetings, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportCalendarOutput{
+		Content:    content,
+		EventCount: len(meetings),
+	}, nil
+}
+
+// formatICS renders meetings as a single VCALENDAR with one VEVENT each.
+// maskEmails masks addresses before lines are folded, where a later
+// rewrite could miss an address split across two lines.
+func formatICS(ctx context.Context, repo domain.Repository, meetings []*domain.Meeting, maskEmails bool) (string, error) {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//acai//Granola meetings//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	for _, m := range meetings {
+		duration, err := estimateDuration(ctx, repo, m)
+		if err != nil {
+			return "", err
+		}
+		writeEvent(&b, m, duration, maskEmails)
+	}
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String(), nil
+}
+
+// estimateDuration uses the span of transcript utterances when one is
+// available, falling back to DefaultEventDuration.
+func estimateDuration(ctx context.Context, repo domain.Repository, m *domain.Meeting) (time.Duration, error) {
+	transcript := m.Transcript()
+	if transcript == nil {
+		t, err := repo.GetTranscript(ctx, m.ID())
+		switch {
+		case err == nil:
+			transcript = t
+		case errors.Is(err, domain.ErrTranscriptNotReady), errors.Is(err, domain.ErrMeetingNotFound):
+			// No transcript yet; use the default duration.
+		default:
+			return 0, err
+		}
+	}
+	if transcript == nil {
+		return DefaultEventDuration, nil
+	}
+
+	var first, last time.Time
+	for _, u := range transcript.Utterances() {
+		ts := u.Timestamp()
+		if ts.IsZero() {
+			continue
+		}
+		if first.IsZero() || ts.Before(first) {
+			first = ts
+		}
+		if ts.After(last) {
+			last = ts
+		}
+	}
+	if span := last.Sub(first); span > 0 {
+		return span, nil
+	}
+	return DefaultEventDuration, nil
+}
+
+func writeEvent(b *strings.Builder, m *domain.Meeting, duration time.Duration, maskEmails bool) {
+	mask := func(s string) string { return s }
+	if maskEmails {
+		mask = domain.MaskEmails
+	}
+
+	start := m.Datetime().UTC()
+	stamp := m.UpdatedAt()
+	if stamp.IsZero() {
+		stamp = start
+	}
+
+	writeICSLine(b, "BEGIN:VEVENT")
+	writeICSLine(b, "UID:"+escapeICSText(string(m.ID()))+"@acai")
+	writeICSLine(b, "DTSTAMP:"+stamp.UTC().Format(icsTimeLayout))
+	writeICSLine(b, "DTSTART:"+start.Format(icsTimeLayout))
+	writeICSLine(b, "DTEND:"+start.Add(duration).Format(icsTimeLayout))
+	writeICSLine(b, "SUMMARY:"+escapeICSText(mask(m.Title())))
+	for _, p := range m.Participants() {
+		// ATTENDEE values must be a calendar address; skip participants without one.
+		if p.Email() == "" {
+			continue
+		}
+		line := "ATTENDEE"
+		if p.Name() != "" {
+			line += ";CN=" + quoteICSParam(p.Name())
+		}
+		writeICSLine(b, line+":mailto:"+mask(p.Email()))
+	}
+	writeICSLine(b, "END:VEVENT")
+}
+
+// writeICSLine writes a CRLF-terminated content line, folding it at 75
+// octets as required by RFC 5545 section 3.1.
+func writeICSLine(b *strings.Builder, line string) {
+	const limit = 75
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+}
+
+// escapeICSText escapes a TEXT property value (RFC 5545 section 3.3.11).
+func escapeICSText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// quoteICSParam quotes a parameter value containing separators.
+// Double quotes cannot be escaped inside parameters, so they are dropped.
+func quoteICSParam(s string) string {
+	s = strings.ReplaceAll(s, `"`, "")
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + s + `"`
+	}
+	return s
+}
@@ -0,0 +1,153 @@
+package export_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/application/export"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// parseVCalendar unfolds RFC 5545 content lines and checks that they form a
+// single VCALENDAR block with balanced components. It returns the unfolded
+// lines and the number of VEVENTs.
+func parseVCalendar(t *testing.T, content string) ([]string, int) {
+	t.Helper()
+
+	if !strings.HasSuffix(content, "\r\n") {
+		t.Fatal("content must end with CRLF")
+	}
+	var lines []string
+	for _, raw := range strings.Split(strings.TrimSuffix(content, "\r\n"), "\r\n") {
+		if len(raw) > 75 {
+			t.Errorf("line exceeds 75 octets: %q", raw)
+		}
+		if strings.HasPrefix(raw, " ") {
+			if len(lines) == 0 {
+				t.Fatal("continuation line before first content line")
+			}
+			lines[len(lines)-1] += raw[1:]
+			continue
+		}
+		lines = append(lines, raw)
+	}
+
+	var stack []string
+	calendars, events := 0, 0
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "BEGIN:"):
+			name := strings.TrimPrefix(line, "BEGIN:")
+			if name == "VCALENDAR" {
+				calendars++
+				if len(stack) != 0 {
+					t.Fatal("nested VCALENDAR")
+				}
+			} else if len(stack) == 0 {
+				t.Fatalf("%s outside VCALENDAR", name)
+			}
+			if name == "VEVENT" {
+				events++
+			}
+			stack = append(stack, name)
+		case strings.HasPrefix(line, "END:"):
+			name := strings.TrimPrefix(line, "END:")
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				t.Fatalf("unbalanced END:%s", name)
+			}
+			stack = stack[:len(stack)-1]
+		case len(stack) == 0:
+			t.Fatalf("content outside VCALENDAR: %q", line)
+		}
+	}
+	if calendars != 1 || len(stack) != 0 {
+		t.Fatalf("expected a single closed VCALENDAR, got %d (open: %v)", calendars, stack)
+	}
+	return lines, events
+}
+
+func TestExportCalendar_SingleVCalendar(t *testing.T) {
+	start := time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)
+	planning, _ := domain.New("m-1", "Sprint Planning; Q2, kickoff", start, domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+		domain.NewParticipant("Bob, Jr.", "bob@example.com", domain.RoleAttendee),
+		domain.NewParticipant("No Email", "", domain.RoleAttendee),
+	})
+	retro, _ := domain.New("m-2", "Retrospective with a deliberately long title that must be folded onto a continuation line",
+		start.Add(48*time.Hour), domain.SourceMeet, nil)
+
+	transcript := domain.NewTranscript("m-2", []domain.Utterance{
+		domain.NewUtterance("Alice", "Welcome", start.Add(48*time.Hour), 0.9),
+		domain.NewUtterance("Bob", "Bye", start.Add(48*time.Hour+25*time.Minute), 0.9),
+	})
+	repo := &mockRepo{
+		meetings:    map[domain.MeetingID]*domain.Meeting{"m-1": planning, "m-2": retro},
+		transcripts: map[domain.MeetingID]*domain.Transcript{"m-2": &transcript},
+	}
+
+	out, err := export.NewExportCalendar(repo).Execute(context.Background(), export.ExportCalendarInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.EventCount != 2 {
+		t.Errorf("got %d events, want 2", out.EventCount)
+	}
+
+	lines, events := parseVCalendar(t, out.Content)
+	if events != 2 {
+		t.Fatalf("parsed %d VEVENTs, want 2", events)
+	}
+
+	want := []string{
+		"UID:m-1@acai",
+		"DTSTART:20260302T150000Z",
+		"DTEND:20260302T160000Z",
+		`SUMMARY:Sprint Planning\; Q2\, kickoff`,
+		"ATTENDEE;CN=Alice:mailto:alice@example.com",
+		`ATTENDEE;CN="Bob, Jr.":mailto:bob@example.com`,
+		"UID:m-2@acai",
+		"DTEND:20260304T152500Z",
+	}
+	joined := strings.Join(lines, "\n")
+	for _, w := range want {
+		if !strings.Contains(joined, w) {
+			t.Errorf("missing %q in:\n%s", w, joined)
+		}
+	}
+	if strings.Count(joined, "ATTENDEE") != 2 {
+		t.Error("participants without an email should not produce ATTENDEE lines")
+	}
+	if strings.Index(joined, "UID:m-1") > strings.Index(joined, "UID:m-2") {
+		t.Error("events should be ordered by start time")
+	}
+}
+
+func TestExportCalendar_Empty(t *testing.T) {
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{}}
+
+	out, err := export.NewExportCalendar(repo).Execute(context.Background(), export.ExportCalendarInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, events := parseVCalendar(t, out.Content); events != 0 {
+		t.Errorf("got %d events, want 0", events)
+	}
+}
+
+func TestExportMeeting_ICS(t *testing.T) {
+	mtg, _ := domain.New("m-1", "Meeting", time.Now().UTC(), domain.SourceZoom, nil)
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{"m-1": mtg}}
+
+	out, err := export.NewExportMeeting(repo).Execute(context.Background(), export.ExportMeetingInput{
+		MeetingID: "m-1",
+		Format:    export.FormatICS,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, events := parseVCalendar(t, out.Content); events != 1 {
+		t.Errorf("got %d events, want 1", events)
+	}
+}
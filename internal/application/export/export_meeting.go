@@ -2,6 +2,7 @@ package export
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -18,11 +19,23 @@ const (
 	FormatJSON     Format = "json"
 	FormatMarkdown Format = "md"
 	FormatText     Format = "txt"
+	FormatICS      Format = "ics"
+	// FormatObsidian is markdown with YAML frontmatter and [[wiki-links]]
+	// for people, ready to drop into an Obsidian vault.
+	FormatObsidian Format = "obsidian"
 )
 
 type ExportMeetingInput struct {
 	MeetingID domain.MeetingID
 	Format    Format
+	// Location is the zone dates are rendered in; nil means UTC.
+	// iCalendar output is always UTC.
+	Location *time.Location
+	// OpenOnly leaves completed action items out of the export.
+	OpenOnly bool
+	// RedactEmails masks every email address, keeping its domain:
+	// "alice@example.com" is exported as "a***@example.com".
+	RedactEmails bool
 }
 
 type ExportMeetingOutput struct {
@@ -32,12 +45,18 @@ type ExportMeetingOutput struct {
 
 type ExportMeeting struct {
 	repo domain.Repository
+	tags domain.TagRepository
 }
 
 func NewExportMeeting(repo domain.Repository) *ExportMeeting {
 	return &ExportMeeting{repo: repo}
 }
 
+// SetTags supplies the local meeting tags listed in Obsidian frontmatter.
+func (uc *ExportMeeting) SetTags(tags domain.TagRepository) {
+	uc.tags = tags
+}
+
 func (uc *ExportMeeting) Execute(ctx context.Context, input ExportMeetingInput) (*ExportMeetingOutput, error) {
 	if input.MeetingID == "" {
 		return nil, domain.ErrInvalidMeetingID
@@ -48,17 +67,41 @@ func (uc *ExportMeeting) Execute(ctx context.Context, input ExportMeetingInput)
 		return nil, err
 	}
 
+	items := mtg.ActionItems()
+	if input.OpenOnly {
+		items = openActionItems(items)
+	}
+
 	var content string
 	switch input.Format {
 	case FormatMarkdown:
-		content = formatMarkdown(mtg)
+		content = formatMarkdown(mtg, items, input.Location, plainLink)
+	case FormatObsidian:
+		var tags []string
+		if uc.tags != nil {
+			if tags, err = uc.tags.TagsOf(ctx, mtg.ID()); err != nil {
+				return nil, err
+			}
+		}
+		content = formatFrontmatter(mtg, tags, input.Location) + formatMarkdown(mtg, items, input.Location, wikiLink)
 	case FormatText:
-		content = formatText(mtg)
+		content = formatText(mtg, input.Location)
+	case FormatICS:
+		content, err = formatICS(ctx, uc.repo, []*domain.Meeting{mtg}, input.RedactEmails)
+		if err != nil {
+			return nil, err
+		}
 	case FormatJSON, "":
-		content = formatJSON(mtg)
+		content, err = formatJSON(mtg, items, input.Location)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, ErrUnsupportedFormat
 	}
+	if input.RedactEmails && input.Format != FormatICS {
+		content = domain.MaskEmails(content)
+	}
 
 	f := input.Format
 	if f == "" {
@@ -71,16 +114,73 @@ func (uc *ExportMeeting) Execute(ctx context.Context, input ExportMeetingInput)
 	}, nil
 }
 
-func formatMarkdown(m *domain.Meeting) string {
+func openActionItems(items []*domain.ActionItem) []*domain.ActionItem {
+	open := make([]*domain.ActionItem, 0, len(items))
+	for _, item := range items {
+		if !item.IsCompleted() {
+			open = append(open, item)
+		}
+	}
+	return open
+}
+
+// formatTime renders t as RFC3339 in loc, or in UTC when loc is nil.
+func formatTime(t time.Time, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// linkFormatter renders a person's name in markdown exports.
+type linkFormatter func(name string) string
+
+func plainLink(name string) string { return name }
+
+// wikiLink renders name as an Obsidian [[link]]; a blank name stays blank
+// rather than becoming an empty link.
+func wikiLink(name string) string {
+	if strings.TrimSpace(name) == "" {
+		return name
+	}
+	return "[[" + name + "]]"
+}
+
+// formatFrontmatter renders the YAML frontmatter of an Obsidian export.
+// Strings are JSON-quoted, which YAML reads as double-quoted scalars.
+func formatFrontmatter(m *domain.Meeting, tags []string, loc *time.Location) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	_, _ = fmt.Fprintf(&b, "title: %s\n", yamlString(m.Title()))
+	_, _ = fmt.Fprintf(&b, "date: %s\n", formatTime(m.Datetime(), loc))
+	_, _ = fmt.Fprintf(&b, "source: %s\n", yamlString(string(m.Source())))
+	if len(tags) == 0 {
+		b.WriteString("tags: []\n")
+	} else {
+		b.WriteString("tags:\n")
+		for _, t := range tags {
+			_, _ = fmt.Fprintf(&b, "  - %s\n", yamlString(t))
+		}
+	}
+	b.WriteString("---\n\n")
+	return b.String()
+}
+
+func yamlString(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+func formatMarkdown(m *domain.Meeting, items []*domain.ActionItem, loc *time.Location, link linkFormatter) string {
 	var b strings.Builder
 	_, _ = fmt.Fprintf(&b, "# %s\n\n", m.Title())
-	_, _ = fmt.Fprintf(&b, "**Date:** %s\n", m.Datetime().Format(time.RFC3339))
+	_, _ = fmt.Fprintf(&b, "**Date:** %s\n", formatTime(m.Datetime(), loc))
 	_, _ = fmt.Fprintf(&b, "**Source:** %s\n\n", m.Source())
 
 	if len(m.Participants()) > 0 {
 		b.WriteString("## Participants\n\n")
 		for _, p := range m.Participants() {
-			_, _ = fmt.Fprintf(&b, "- %s (%s)\n", p.Name(), p.Email())
+			_, _ = fmt.Fprintf(&b, "- %s (%s)\n", link(p.Name()), p.Email())
 		}
 		b.WriteString("\n")
 	}
@@ -91,14 +191,14 @@ func formatMarkdown(m *domain.Meeting) string {
 		b.WriteString("\n\n")
 	}
 
-	if len(m.ActionItems()) > 0 {
+	if len(items) > 0 {
 		b.WriteString("## Action Items\n\n")
-		for _, item := range m.ActionItems() {
+		for _, item := range items {
 			status := "[ ]"
 			if item.IsCompleted() {
 				status = "[x]"
 			}
-			_, _ = fmt.Fprintf(&b, "- %s %s (Owner: %s)\n", status, item.Text(), item.Owner())
+			_, _ = fmt.Fprintf(&b, "- %s %s (Owner: %s)\n", status, item.Text(), link(item.Owner()))
 		}
 		b.WriteString("\n")
 	}
@@ -106,10 +206,10 @@ func formatMarkdown(m *domain.Meeting) string {
 	return b.String()
 }
 
-func formatText(m *domain.Meeting) string {
+func formatText(m *domain.Meeting, loc *time.Location) string {
 	var b strings.Builder
 	_, _ = fmt.Fprintf(&b, "%s\n", m.Title())
-	_, _ = fmt.Fprintf(&b, "Date: %s\n", m.Datetime().Format(time.RFC3339))
+	_, _ = fmt.Fprintf(&b, "Date: %s\n", formatTime(m.Datetime(), loc))
 	_, _ = fmt.Fprintf(&b, "Source: %s\n", m.Source())
 
 	if m.Summary() != nil {
@@ -119,11 +219,73 @@ func formatText(m *domain.Meeting) string {
 	return b.String()
 }
 
-func formatJSON(m *domain.Meeting) string {
-	// Minimal JSON without encoding/json to avoid domain layer import concerns.
-	// The interfaces layer handles proper JSON serialization.
-	var b strings.Builder
-	_, _ = fmt.Fprintf(&b, `{"id":"%s","title":"%s","datetime":"%s","source":"%s"}`,
-		m.ID(), m.Title(), m.Datetime().Format(time.RFC3339), m.Source())
-	return b.String()
+// meetingJSON is the JSON export shape, covering the same fields as the
+// markdown export.
+type meetingJSON struct {
+	ID           string            `json:"id"`
+	Title        string            `json:"title"`
+	Datetime     string            `json:"datetime"`
+	Source       string            `json:"source"`
+	Participants []participantJSON `json:"participants"`
+	Summary      *summaryJSON      `json:"summary,omitempty"`
+	ActionItems  []actionItemJSON  `json:"action_items"`
+}
+
+type participantJSON struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Role  string `json:"role"`
+}
+
+type summaryJSON struct {
+	Content string `json:"content"`
+	Kind    string `json:"kind"`
+}
+
+type actionItemJSON struct {
+	ID        string  `json:"id"`
+	Owner     string  `json:"owner"`
+	Text      string  `json:"text"`
+	DueDate   *string `json:"due_date,omitempty"`
+	Completed bool    `json:"completed"`
+}
+
+func formatJSON(m *domain.Meeting, items []*domain.ActionItem, loc *time.Location) (string, error) {
+	doc := meetingJSON{
+		ID:           string(m.ID()),
+		Title:        m.Title(),
+		Datetime:     formatTime(m.Datetime(), loc),
+		Source:       string(m.Source()),
+		Participants: make([]participantJSON, 0, len(m.Participants())),
+		ActionItems:  make([]actionItemJSON, 0, len(items)),
+	}
+	for _, p := range m.Participants() {
+		doc.Participants = append(doc.Participants, participantJSON{
+			Name:  p.Name(),
+			Email: p.Email(),
+			Role:  string(p.Role()),
+		})
+	}
+	if s := m.Summary(); s != nil {
+		doc.Summary = &summaryJSON{Content: s.Content(), Kind: string(s.Kind())}
+	}
+	for _, item := range items {
+		ai := actionItemJSON{
+			ID:        string(item.ID()),
+			Owner:     item.Owner(),
+			Text:      item.Text(),
+			Completed: item.IsCompleted(),
+		}
+		if due := item.DueDate(); due != nil {
+			d := formatTime(*due, loc)
+			ai.DueDate = &d
+		}
+		doc.ActionItems = append(doc.ActionItems, ai)
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encode meeting: %w", err)
+	}
+	return string(data), nil
 }
@@ -2,6 +2,7 @@ package export_test
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
@@ -11,7 +12,8 @@ import (
 )
 
 type mockRepo struct {
-	meetings map[domain.MeetingID]*domain.Meeting
+	meetings    map[domain.MeetingID]*domain.Meeting
+	transcripts map[domain.MeetingID]*domain.Transcript
 }
 
 func (m *mockRepo) FindByID(_ context.Context, id domain.MeetingID) (*domain.Meeting, error) {
@@ -23,10 +25,18 @@ func (m *mockRepo) FindByID(_ context.Context, id domain.MeetingID) (*domain.Mee
 }
 
 func (m *mockRepo) List(_ context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
-	return nil, nil
+	result := make([]*domain.Meeting, 0, len(m.meetings))
+	for _, mtg := range m.meetings {
+		result = append(result, mtg)
+	}
+	return result, nil
 }
-func (m *mockRepo) GetTranscript(_ context.Context, _ domain.MeetingID) (*domain.Transcript, error) {
-	return nil, nil
+func (m *mockRepo) GetTranscript(_ context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	t, ok := m.transcripts[id]
+	if !ok {
+		return nil, domain.ErrTranscriptNotReady
+	}
+	return t, nil
 }
 func (m *mockRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListFilter) ([]*domain.Meeting, error) {
 	return nil, nil
@@ -34,8 +44,8 @@ func (m *mockRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListF
 func (m *mockRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
 	return nil, nil
 }
-func (m *mockRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
-	return nil, nil
+func (m *mockRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{}, nil
 }
 
 func TestExportMeeting_Markdown(t *testing.T) {
@@ -85,6 +95,206 @@ func TestExportMeeting_JSON(t *testing.T) {
 	}
 }
 
+func TestExportMeeting_JSONRoundTrip(t *testing.T) {
+	at := time.Date(2025, 4, 2, 15, 0, 0, 0, time.UTC)
+	title := `Q2 "Roadmap" <review> & \ wrap-up`
+	mtg, _ := domain.New("m-1", title, at, domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+		domain.NewParticipant("Bob", "", domain.RoleAttendee),
+	})
+	mtg.AttachSummary(domain.NewSummary("m-1", "Agreed on scope.\nShip in May.", domain.SummaryAuto))
+	due := at.AddDate(0, 0, 7)
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Bob", "Draft the \"plan\"", &due)
+	item.Complete()
+	mtg.AddActionItem(item)
+	mtg.ClearDomainEvents()
+
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{"m-1": mtg}}
+	out, err := export.NewExportMeeting(repo).Execute(context.Background(), export.ExportMeetingInput{
+		MeetingID: "m-1",
+		Format:    export.FormatJSON,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got struct {
+		ID           string `json:"id"`
+		Title        string `json:"title"`
+		Datetime     string `json:"datetime"`
+		Source       string `json:"source"`
+		Participants []struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+			Role  string `json:"role"`
+		} `json:"participants"`
+		Summary *struct {
+			Content string `json:"content"`
+			Kind    string `json:"kind"`
+		} `json:"summary"`
+		ActionItems []struct {
+			ID        string  `json:"id"`
+			Owner     string  `json:"owner"`
+			Text      string  `json:"text"`
+			DueDate   *string `json:"due_date"`
+			Completed bool    `json:"completed"`
+		} `json:"action_items"`
+	}
+	if err := json.Unmarshal([]byte(out.Content), &got); err != nil {
+		t.Fatalf("export is not valid JSON: %v\n%s", err, out.Content)
+	}
+
+	if got.ID != "m-1" || got.Title != title || got.Source != string(domain.SourceZoom) {
+		t.Errorf("got id/title/source %q/%q/%q", got.ID, got.Title, got.Source)
+	}
+	if got.Datetime != "2025-04-02T15:00:00Z" {
+		t.Errorf("got datetime %q", got.Datetime)
+	}
+	if len(got.Participants) != 2 || got.Participants[0].Email != "alice@example.com" || got.Participants[1].Role != string(domain.RoleAttendee) {
+		t.Errorf("got participants %+v", got.Participants)
+	}
+	if got.Summary == nil || got.Summary.Content != "Agreed on scope.\nShip in May." {
+		t.Errorf("got summary %+v", got.Summary)
+	}
+	if len(got.ActionItems) != 1 {
+		t.Fatalf("got %d action items, want 1", len(got.ActionItems))
+	}
+	ai := got.ActionItems[0]
+	if ai.Owner != "Bob" || ai.Text != `Draft the "plan"` || !ai.Completed || ai.DueDate == nil || *ai.DueDate != "2025-04-09T15:00:00Z" {
+		t.Errorf("got action item %+v", ai)
+	}
+}
+
+func TestExportMeeting_OpenOnly(t *testing.T) {
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Now().UTC(), domain.SourceZoom, nil)
+	done, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Book the room", nil)
+	done.Complete()
+	open, _ := domain.NewActionItem("ai-2", "m-1", "Bob", "Write the plan", nil)
+	mtg.AddActionItem(done)
+	mtg.AddActionItem(open)
+	mtg.ClearDomainEvents()
+
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{"m-1": mtg}}
+	uc := export.NewExportMeeting(repo)
+
+	for _, format := range []export.Format{export.FormatMarkdown, export.FormatJSON} {
+		out, err := uc.Execute(context.Background(), export.ExportMeetingInput{
+			MeetingID: "m-1",
+			Format:    format,
+			OpenOnly:  true,
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", format, err)
+		}
+		if strings.Contains(out.Content, "Book the room") {
+			t.Errorf("%s: completed item should be excluded: %q", format, out.Content)
+		}
+		if !strings.Contains(out.Content, "Write the plan") {
+			t.Errorf("%s: open item should be included: %q", format, out.Content)
+		}
+	}
+
+	out, err := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatMarkdown})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.Content, "Book the room") {
+		t.Error("completed item should be included without OpenOnly")
+	}
+}
+
+// mockTags implements domain.TagRepository for tests.
+type mockTags map[domain.MeetingID][]string
+
+func (m mockTags) AddTag(_ context.Context, id domain.MeetingID, tag string) error {
+	m[id] = append(m[id], tag)
+	return nil
+}
+func (m mockTags) RemoveTag(_ context.Context, _ domain.MeetingID, _ string) error { return nil }
+func (m mockTags) TagsOf(_ context.Context, id domain.MeetingID) ([]string, error) {
+	return m[id], nil
+}
+func (m mockTags) ListTags(_ context.Context) (map[domain.MeetingID][]string, error) {
+	return m, nil
+}
+
+func TestExportMeeting_Obsidian(t *testing.T) {
+	mtg, _ := domain.New("m-1", "Sprint: Planning", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+	})
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Bob", "Write the plan", nil)
+	mtg.AddActionItem(item)
+	mtg.ClearDomainEvents()
+
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{"m-1": mtg}}
+	uc := export.NewExportMeeting(repo)
+	uc.SetTags(mockTags{"m-1": {"planning"}})
+
+	out, err := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatObsidian})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantFrontmatter := "---\n" +
+		"title: \"Sprint: Planning\"\n" +
+		"date: 2026-03-02T09:00:00Z\n" +
+		"source: \"zoom\"\n" +
+		"tags:\n  - \"planning\"\n" +
+		"---\n\n"
+	if !strings.HasPrefix(out.Content, wantFrontmatter) {
+		t.Errorf("frontmatter: got %q", out.Content)
+	}
+	for _, want := range []string{"- [[Alice]] (alice@example.com)", "(Owner: [[Bob]])"} {
+		if !strings.Contains(out.Content, want) {
+			t.Errorf("missing %q in %q", want, out.Content)
+		}
+	}
+	if out.Format != export.FormatObsidian {
+		t.Errorf("got format %q", out.Format)
+	}
+
+	plain, _ := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatMarkdown})
+	if strings.Contains(plain.Content, "[[") || strings.HasPrefix(plain.Content, "---") {
+		t.Errorf("plain markdown should have no links or frontmatter: %q", plain.Content)
+	}
+}
+
+func TestExportMeeting_RedactEmails(t *testing.T) {
+	mtg, _ := domain.New("m-1", "Sync with alice@example.com", time.Now().UTC(), domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+		domain.NewParticipant("Bob", "bob@corp.example.org", domain.RoleAttendee),
+	})
+	mtg.ClearDomainEvents()
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{"m-1": mtg}}
+	uc := export.NewExportMeeting(repo)
+
+	for _, f := range []export.Format{export.FormatMarkdown, export.FormatText, export.FormatJSON, export.FormatObsidian, export.FormatICS} {
+		t.Run(string(f), func(t *testing.T) {
+			out, err := uc.Execute(context.Background(), export.ExportMeetingInput{
+				MeetingID:    "m-1",
+				Format:       f,
+				RedactEmails: true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Contains(out.Content, "alice@") || strings.Contains(out.Content, "bob@") {
+				t.Errorf("export still contains an email:\n%s", out.Content)
+			}
+			if !strings.Contains(out.Content, "a***@example.com") {
+				t.Errorf("export should keep the masked email:\n%s", out.Content)
+			}
+		})
+	}
+
+	out, err := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatText})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.Content, "alice@example.com") {
+		t.Error("emails should be kept unless RedactEmails is set")
+	}
+}
+
 func TestExportMeeting_NotFound(t *testing.T) {
 	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{}}
 	uc := export.NewExportMeeting(repo)
@@ -123,3 +333,45 @@ func TestExportMeeting_EmptyID(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
 	}
 }
+
+func TestExportMeeting_Location(t *testing.T) {
+	at := time.Date(2025, 6, 1, 14, 30, 0, 0, time.UTC)
+	mtg, _ := domain.New("m-1", "Sync", at, domain.SourceZoom, nil)
+	repo := &mockRepo{meetings: map[domain.MeetingID]*domain.Meeting{"m-1": mtg}}
+	uc := export.NewExportMeeting(repo)
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("zone database unavailable: %v", err)
+	}
+
+	md, err := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatMarkdown, Location: ny})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(md.Content, "**Date:** 2025-06-01T10:30:00-04:00") {
+		t.Errorf("markdown date not in New York time:\n%s", md.Content)
+	}
+
+	js, err := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatJSON, Location: ny})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var doc struct {
+		Datetime string `json:"datetime"`
+	}
+	if err := json.Unmarshal([]byte(js.Content), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if parsed, err := time.Parse(time.RFC3339, doc.Datetime); err != nil || !parsed.Equal(at) || doc.Datetime != "2025-06-01T10:30:00-04:00" {
+		t.Errorf("datetime = %q, want the same instant with a -04:00 offset", doc.Datetime)
+	}
+
+	utc, err := uc.Execute(context.Background(), export.ExportMeetingInput{MeetingID: "m-1", Format: export.FormatText})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(utc.Content, "Date: 2025-06-01T14:30:00Z") {
+		t.Errorf("default should stay UTC:\n%s", utc.Content)
+	}
+}
@@ -0,0 +1,180 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+)
+
+type ExportNotesInput struct {
+	// MeetingID selects a single meeting's notes; ignored when All is set.
+	MeetingID string
+	All       bool
+	Format    Format // FormatMarkdown or FormatJSON
+	// Location is the zone timestamps are rendered in; nil means UTC.
+	Location *time.Location
+}
+
+type ExportNotesOutput struct {
+	Content   string
+	Format    Format
+	NoteCount int
+}
+
+// ExportNotes renders agent notes for sharing or backup, grouped by
+// meeting and ordered by creation time.
+type ExportNotes struct {
+	noteRepo annotation.NoteRepository
+}
+
+func NewExportNotes(noteRepo annotation.NoteRepository) *ExportNotes {
+	return &ExportNotes{noteRepo: noteRepo}
+}
+
+// noteGroup is one meeting's notes, oldest first.
+type noteGroup struct {
+	meetingID string
+	notes     []*annotation.AgentNote
+}
+
+func (uc *ExportNotes) Execute(ctx context.Context, input ExportNotesInput) (*ExportNotesOutput, error) {
+	if !input.All && input.MeetingID == "" {
+		return nil, annotation.ErrInvalidMeetingID
+	}
+
+	f := input.Format
+	if f == "" {
+		f = FormatJSON
+	}
+	if f != FormatJSON && f != FormatMarkdown {
+		return nil, ErrUnsupportedFormat
+	}
+
+	var groups []noteGroup
+	if input.All {
+		notes, err := uc.noteRepo.ListAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = groupNotes(notes)
+	} else {
+		notes, err := uc.noteRepo.ListByMeeting(ctx, input.MeetingID)
+		if err != nil {
+			return nil, err
+		}
+		sortNotes(notes)
+		groups = []noteGroup{{meetingID: input.MeetingID, notes: notes}}
+	}
+
+	count := 0
+	for _, g := range groups {
+		count += len(g.notes)
+	}
+
+	var content string
+	if f == FormatMarkdown {
+		content = formatNotesMarkdown(groups, input.Location)
+	} else {
+		var err error
+		content, err = formatNotesJSON(groups, input.Location)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ExportNotesOutput{
+		Content:   content,
+		Format:    f,
+		NoteCount: count,
+	}, nil
+}
+
+// groupNotes buckets notes by meeting, with meetings in ID order.
+func groupNotes(notes []*annotation.AgentNote) []noteGroup {
+	byMeeting := make(map[string][]*annotation.AgentNote)
+	for _, n := range notes {
+		byMeeting[n.MeetingID()] = append(byMeeting[n.MeetingID()], n)
+	}
+
+	groups := make([]noteGroup, 0, len(byMeeting))
+	for id, ns := range byMeeting {
+		sortNotes(ns)
+		groups = append(groups, noteGroup{meetingID: id, notes: ns})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].meetingID < groups[j].meetingID })
+	return groups
+}
+
+// sortNotes orders notes by creation time, breaking ties by ID so output
+// is stable across runs.
+func sortNotes(notes []*annotation.AgentNote) {
+	sort.Slice(notes, func(i, j int) bool {
+		a, b := notes[i], notes[j]
+		if !a.CreatedAt().Equal(b.CreatedAt()) {
+			return a.CreatedAt().Before(b.CreatedAt())
+		}
+		return a.ID() < b.ID()
+	})
+}
+
+func formatNotesMarkdown(groups []noteGroup, loc *time.Location) string {
+	var b strings.Builder
+	b.WriteString("# Agent Notes\n")
+	for _, g := range groups {
+		_, _ = fmt.Fprintf(&b, "\n## Meeting %s\n", g.meetingID)
+		if len(g.notes) == 0 {
+			b.WriteString("\n_No notes._\n")
+		}
+		for _, n := range g.notes {
+			_, _ = fmt.Fprintf(&b, "\n### %s — %s\n\n", formatTime(n.CreatedAt(), loc), n.Author())
+			b.WriteString(n.Content())
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+type notesExportJSON struct {
+	Meetings []meetingNotesJSON `json:"meetings"`
+}
+
+type meetingNotesJSON struct {
+	MeetingID string     `json:"meeting_id"`
+	Notes     []noteJSON `json:"notes"`
+}
+
+type noteJSON struct {
+	ID        string   `json:"id"`
+	Author    string   `json:"author"`
+	Content   string   `json:"content"`
+	Tags      []string `json:"tags,omitempty"`
+	CreatedAt string   `json:"created_at"`
+}
+
+func formatNotesJSON(groups []noteGroup, loc *time.Location) (string, error) {
+	doc := notesExportJSON{Meetings: make([]meetingNotesJSON, len(groups))}
+	for i, g := range groups {
+		notes := make([]noteJSON, len(g.notes))
+		for j, n := range g.notes {
+			notes[j] = noteJSON{
+				ID:        string(n.ID()),
+				Author:    n.Author(),
+				Content:   n.Content(),
+				Tags:      n.Tags(),
+				CreatedAt: formatTime(n.CreatedAt(), loc),
+			}
+		}
+		doc.Meetings[i] = meetingNotesJSON{MeetingID: g.meetingID, Notes: notes}
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encode notes: %w", err)
+	}
+	return string(data), nil
+}
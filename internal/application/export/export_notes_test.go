@@ -0,0 +1,121 @@
+package export_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/application/export"
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+)
+
+// mockNoteRepo returns notes in insertion order, which is deliberately
+// not chronological.
+type mockNoteRepo struct {
+	notes []*annotation.AgentNote
+}
+
+func (m *mockNoteRepo) Save(_ context.Context, n *annotation.AgentNote) error {
+	m.notes = append(m.notes, n)
+	return nil
+}
+func (m *mockNoteRepo) FindByID(_ context.Context, _ annotation.NoteID) (*annotation.AgentNote, error) {
+	return nil, annotation.ErrNoteNotFound
+}
+func (m *mockNoteRepo) FindByIdempotencyKey(_ context.Context, _ string) (*annotation.AgentNote, error) {
+	return nil, annotation.ErrNoteNotFound
+}
+func (m *mockNoteRepo) ListByMeeting(_ context.Context, meetingID string) ([]*annotation.AgentNote, error) {
+	var result []*annotation.AgentNote
+	for _, n := range m.notes {
+		if n.MeetingID() == meetingID {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+func (m *mockNoteRepo) ListAll(_ context.Context) ([]*annotation.AgentNote, error) {
+	return append([]*annotation.AgentNote(nil), m.notes...), nil
+}
+func (m *mockNoteRepo) Delete(_ context.Context, _ annotation.NoteID) error { return nil }
+func (m *mockNoteRepo) DeleteByMeeting(_ context.Context, _ string) (int, error) {
+	return 0, nil
+}
+func (m *mockNoteRepo) Search(_ context.Context, _ string, _ int) ([]*annotation.AgentNote, error) {
+	return nil, nil
+}
+
+func notesFixture() *mockNoteRepo {
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	return &mockNoteRepo{notes: []*annotation.AgentNote{
+		annotation.ReconstructAgentNote("n-2", "m-1", "agent", "Second", nil, base.Add(time.Hour)),
+		annotation.ReconstructAgentNote("n-3", "m-2", "bot", "Other meeting", nil, base),
+		annotation.ReconstructAgentNote("n-1", "m-1", "agent", "First", []string{"decision"}, base),
+	}}
+}
+
+func TestExportNotes_MarkdownOrderedByCreatedAt(t *testing.T) {
+	uc := export.NewExportNotes(notesFixture())
+
+	out, err := uc.Execute(context.Background(), export.ExportNotesInput{MeetingID: "m-1", Format: export.FormatMarkdown})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.NoteCount != 2 {
+		t.Errorf("NoteCount = %d, want 2", out.NoteCount)
+	}
+	first := strings.Index(out.Content, "First")
+	second := strings.Index(out.Content, "Second")
+	if first < 0 || second < 0 || first > second {
+		t.Errorf("notes not in creation order:\n%s", out.Content)
+	}
+	if !strings.Contains(out.Content, "### 2025-03-01T10:00:00Z — agent") {
+		t.Errorf("missing timestamp/author heading:\n%s", out.Content)
+	}
+	if strings.Contains(out.Content, "Other meeting") {
+		t.Error("export leaked a note from another meeting")
+	}
+}
+
+func TestExportNotes_AllGroupedByMeeting(t *testing.T) {
+	uc := export.NewExportNotes(notesFixture())
+
+	out, err := uc.Execute(context.Background(), export.ExportNotesInput{All: true, Format: export.FormatJSON})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc struct {
+		Meetings []struct {
+			MeetingID string `json:"meeting_id"`
+			Notes     []struct {
+				ID string `json:"id"`
+			} `json:"notes"`
+		} `json:"meetings"`
+	}
+	if err := json.Unmarshal([]byte(out.Content), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(doc.Meetings) != 2 || doc.Meetings[0].MeetingID != "m-1" || doc.Meetings[1].MeetingID != "m-2" {
+		t.Fatalf("got meetings %+v, want m-1 then m-2", doc.Meetings)
+	}
+	if ids := doc.Meetings[0].Notes; len(ids) != 2 || ids[0].ID != "n-1" || ids[1].ID != "n-2" {
+		t.Errorf("got m-1 notes %+v, want n-1 then n-2", ids)
+	}
+	if out.NoteCount != 3 {
+		t.Errorf("NoteCount = %d, want 3", out.NoteCount)
+	}
+}
+
+func TestExportNotes_Validation(t *testing.T) {
+	uc := export.NewExportNotes(notesFixture())
+
+	if _, err := uc.Execute(context.Background(), export.ExportNotesInput{}); err != annotation.ErrInvalidMeetingID {
+		t.Errorf("got error %v, want %v", err, annotation.ErrInvalidMeetingID)
+	}
+	if _, err := uc.Execute(context.Background(), export.ExportNotesInput{MeetingID: "m-1", Format: export.FormatICS}); err != export.ErrUnsupportedFormat {
+		t.Errorf("got error %v, want %v", err, export.ErrUnsupportedFormat)
+	}
+}
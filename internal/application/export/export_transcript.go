@@ -0,0 +1,196 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+type ExportTranscriptInput struct {
+	MeetingID domain.MeetingID
+	// Format is json, md or txt; empty means json.
+	Format Format
+	// Location is the zone timestamps are rendered in; nil means UTC.
+	Location *time.Location
+	// MergeSpeakerTurns combines adjacent utterances by the same speaker
+	// into one segment spanning their timestamps.
+	MergeSpeakerTurns bool
+}
+
+type ExportTranscriptOutput struct {
+	Content string
+	Format  Format
+}
+
+type ExportTranscript struct {
+	repo domain.Repository
+}
+
+func NewExportTranscript(repo domain.Repository) *ExportTranscript {
+	return &ExportTranscript{repo: repo}
+}
+
+func (uc *ExportTranscript) Execute(ctx context.Context, input ExportTranscriptInput) (*ExportTranscriptOutput, error) {
+	if input.MeetingID == "" {
+		return nil, domain.ErrInvalidMeetingID
+	}
+	f := input.Format
+	if f == "" {
+		f = FormatJSON
+	}
+	if f != FormatJSON && f != FormatMarkdown && f != FormatText {
+		return nil, ErrUnsupportedFormat
+	}
+
+	t, err := uc.repo.GetTranscript(ctx, input.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := transcriptSegments(t, input.MergeSpeakerTurns)
+	var content string
+	switch f {
+	case FormatMarkdown:
+		content = formatTranscriptMarkdown(segments, input.Location, input.MergeSpeakerTurns)
+	case FormatText:
+		content = formatTranscriptText(segments, input.Location, input.MergeSpeakerTurns)
+	default:
+		content, err = formatTranscriptJSON(t.MeetingID(), segments, input.Location, input.MergeSpeakerTurns)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ExportTranscriptOutput{Content: content, Format: f}, nil
+}
+
+// transcriptSegment is one exported line: a single utterance, or a merged
+// speaker turn when merging is on.
+type transcriptSegment struct {
+	speaker    string
+	text       string
+	start, end time.Time
+	utterances int
+}
+
+func transcriptSegments(t *domain.Transcript, merge bool) []transcriptSegment {
+	if merge {
+		merged := t.SpeakerSegments()
+		segments := make([]transcriptSegment, len(merged))
+		for i, s := range merged {
+			segments[i] = transcriptSegment{s.Speaker(), s.Text(), s.Start(), s.End(), s.Utterances()}
+		}
+		return segments
+	}
+	utterances := t.Utterances()
+	segments := make([]transcriptSegment, len(utterances))
+	for i, u := range utterances {
+		segments[i] = transcriptSegment{u.Speaker(), u.Text(), u.Timestamp(), u.Timestamp(), 1}
+	}
+	return segments
+}
+
+// clockTime renders t as a wall-clock time in loc for text and markdown
+// transcripts; an unknown timestamp renders empty.
+func clockTime(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format("15:04:05")
+}
+
+// segmentSpan is the start time, or start-end for a merged turn.
+func segmentSpan(s transcriptSegment, loc *time.Location, merged bool) string {
+	start := clockTime(s.start, loc)
+	if !merged || s.end.Equal(s.start) {
+		return start
+	}
+	return start + "-" + clockTime(s.end, loc)
+}
+
+func formatTranscriptText(segments []transcriptSegment, loc *time.Location, merged bool) string {
+	var b strings.Builder
+	for _, s := range segments {
+		if span := segmentSpan(s, loc, merged); span != "" {
+			_, _ = fmt.Fprintf(&b, "[%s] ", span)
+		}
+		_, _ = fmt.Fprintf(&b, "%s: %s\n", s.speaker, s.text)
+	}
+	return b.String()
+}
+
+func formatTranscriptMarkdown(segments []transcriptSegment, loc *time.Location, merged bool) string {
+	var b strings.Builder
+	b.WriteString("# Transcript\n\n")
+	for _, s := range segments {
+		_, _ = fmt.Fprintf(&b, "**%s**", s.speaker)
+		if span := segmentSpan(s, loc, merged); span != "" {
+			_, _ = fmt.Fprintf(&b, " (%s)", span)
+		}
+		_, _ = fmt.Fprintf(&b, ": %s\n\n", s.text)
+	}
+	return b.String()
+}
+
+// transcriptJSON is the JSON export shape. Merged exports list segments,
+// others list utterances.
+type transcriptJSON struct {
+	MeetingID  string          `json:"meeting_id"`
+	Utterances []utteranceJSON `json:"utterances,omitempty"`
+	Segments   []segmentJSON   `json:"segments,omitempty"`
+}
+
+type utteranceJSON struct {
+	Speaker   string `json:"speaker"`
+	Text      string `json:"text"`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+type segmentJSON struct {
+	Speaker    string `json:"speaker"`
+	Text       string `json:"text"`
+	Start      string `json:"start,omitempty"`
+	End        string `json:"end,omitempty"`
+	Utterances int    `json:"utterances"`
+}
+
+func formatTranscriptJSON(id domain.MeetingID, segments []transcriptSegment, loc *time.Location, merged bool) (string, error) {
+	doc := transcriptJSON{MeetingID: string(id)}
+	for _, s := range segments {
+		if merged {
+			doc.Segments = append(doc.Segments, segmentJSON{
+				Speaker:    s.speaker,
+				Text:       s.text,
+				Start:      optionalTime(s.start, loc),
+				End:        optionalTime(s.end, loc),
+				Utterances: s.utterances,
+			})
+			continue
+		}
+		doc.Utterances = append(doc.Utterances, utteranceJSON{
+			Speaker:   s.speaker,
+			Text:      s.text,
+			Timestamp: optionalTime(s.start, loc),
+		})
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encode transcript: %w", err)
+	}
+	return string(data), nil
+}
+
+func optionalTime(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	return formatTime(t, loc)
+}
@@ -0,0 +1,101 @@
+package export_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/application/export"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func transcriptRepo() *mockRepo {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time { return start.Add(time.Duration(sec) * time.Second) }
+	t := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "So", at(0), 0.9),
+		domain.NewUtterance("Alice", "about the launch", at(2), 0.9),
+		domain.NewUtterance("Bob", "Yes?", at(5), 0.9),
+		domain.NewUtterance("Alice", "it slips.", at(7), 0.9),
+		domain.NewUtterance("Bob", "Okay", at(9), 0.9),
+		domain.NewUtterance("Bob", "noted.", at(10), 0.9),
+	})
+	return &mockRepo{transcripts: map[domain.MeetingID]*domain.Transcript{"m-1": &t}}
+}
+
+func TestExportTranscript_MergeSpeakerTurns(t *testing.T) {
+	uc := export.NewExportTranscript(transcriptRepo())
+
+	out, err := uc.Execute(context.Background(), export.ExportTranscriptInput{
+		MeetingID: "m-1", Format: export.FormatText, MergeSpeakerTurns: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "[10:00:00-10:00:02] Alice: So about the launch\n" +
+		"[10:00:05] Bob: Yes?\n" +
+		"[10:00:07] Alice: it slips.\n" +
+		"[10:00:09-10:00:10] Bob: Okay noted.\n"
+	if out.Content != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.Content, want)
+	}
+}
+
+func TestExportTranscript_MergedJSON(t *testing.T) {
+	uc := export.NewExportTranscript(transcriptRepo())
+
+	out, err := uc.Execute(context.Background(), export.ExportTranscriptInput{MeetingID: "m-1", MergeSpeakerTurns: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Format != export.FormatJSON {
+		t.Errorf("got format %q, want json", out.Format)
+	}
+
+	var doc struct {
+		Utterances []json.RawMessage `json:"utterances"`
+		Segments   []struct {
+			Speaker    string `json:"speaker"`
+			Text       string `json:"text"`
+			Start      string `json:"start"`
+			End        string `json:"end"`
+			Utterances int    `json:"utterances"`
+		} `json:"segments"`
+	}
+	if err := json.Unmarshal([]byte(out.Content), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(doc.Utterances) != 0 || len(doc.Segments) != 4 {
+		t.Fatalf("got %d utterances and %d segments, want 0 and 4", len(doc.Utterances), len(doc.Segments))
+	}
+	first := doc.Segments[0]
+	if first.Speaker != "Alice" || first.Utterances != 2 || first.Start != "2026-03-02T10:00:00Z" || first.End != "2026-03-02T10:00:02Z" {
+		t.Errorf("got first segment %+v", first)
+	}
+}
+
+func TestExportTranscript_WithoutMerging(t *testing.T) {
+	uc := export.NewExportTranscript(transcriptRepo())
+
+	out, err := uc.Execute(context.Background(), export.ExportTranscriptInput{MeetingID: "m-1", Format: export.FormatMarkdown})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := strings.Count(out.Content, "**Alice**"); n != 3 {
+		t.Errorf("got %d Alice lines, want one per utterance (3):\n%s", n, out.Content)
+	}
+	if !strings.Contains(out.Content, "**Bob** (10:00:10): noted.") {
+		t.Errorf("got:\n%s", out.Content)
+	}
+}
+
+func TestExportTranscript_UnsupportedFormat(t *testing.T) {
+	uc := export.NewExportTranscript(transcriptRepo())
+
+	_, err := uc.Execute(context.Background(), export.ExportTranscriptInput{MeetingID: "m-1", Format: export.FormatICS})
+	if err != export.ErrUnsupportedFormat {
+		t.Errorf("got error %v, want %v", err, export.ErrUnsupportedFormat)
+	}
+}
@@ -0,0 +1,146 @@
+package meeting
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultDigestUpcomingWindow is how far ahead ActionItemDigest looks for
+// upcoming due dates when the input sets no window.
+const DefaultDigestUpcomingWindow = 7 * 24 * time.Hour
+
+type ActionItemDigestInput struct {
+	// Since and Until bound the meeting dates scanned.
+	Since *time.Time
+	Until *time.Time
+	Owner string // case-insensitive substring of the owner; empty matches all
+	// UpcomingWithin is how far from now a due date counts as upcoming;
+	// zero uses DefaultDigestUpcomingWindow.
+	UpcomingWithin time.Duration
+}
+
+// DigestItem is an action item with the meeting it came from.
+type DigestItem struct {
+	Item    *domain.ActionItem
+	Meeting *domain.Meeting
+}
+
+// OwnerDigest rolls up one owner's action items. Open includes the
+// overdue items. Upcoming lists open items due within the window, soonest
+// first.
+type OwnerDigest struct {
+	Owner     string
+	Open      int
+	Overdue   int
+	Completed int
+	Upcoming  []DigestItem
+}
+
+type ActionItemDigestOutput struct {
+	// Owners are sorted by name, case-insensitively, with unassigned
+	// items (an empty Owner) last.
+	Owners []OwnerDigest
+	// ScanLimited reports that more meetings matched than the scan cap, so
+	// the result covers only the first ScanLimit of them.
+	ScanLimited bool
+	ScanLimit   int
+	// Errors holds the failure for each meeting whose action items could
+	// not be read; those meetings are left out.
+	Errors map[domain.MeetingID]error
+}
+
+// ActionItemDigest groups the action items of the meetings in a range by
+// owner: a standup-style rollup of what is open, overdue, done, and due
+// soon. Each meeting's items come from the range scan shared with
+// OverdueActionItems.
+type ActionItemDigest struct {
+	repo        domain.Repository
+	items       *GetActionItems
+	maxMeetings int
+}
+
+func NewActionItemDigest(repo domain.Repository, items *GetActionItems) *ActionItemDigest {
+	return &ActionItemDigest{repo: repo, items: items, maxMeetings: DefaultMaxScanMeetings}
+}
+
+// SetMaxMeetings replaces the per-call meeting scan cap,
+// DefaultMaxScanMeetings by default. Zero or negative disables it.
+func (uc *ActionItemDigest) SetMaxMeetings(n int) {
+	uc.maxMeetings = n
+}
+
+func (uc *ActionItemDigest) Execute(ctx context.Context, input ActionItemDigestInput) (*ActionItemDigestOutput, error) {
+	window := input.UpcomingWithin
+	if window < 0 {
+		return nil, domain.ErrInvalidFilter
+	}
+	if window == 0 {
+		window = DefaultDigestUpcomingWindow
+	}
+
+	meetings, limited, err := listCapped(ctx, uc.repo, domain.ListFilter{
+		Since: input.Since,
+		Until: input.Until,
+	}, uc.maxMeetings)
+	if err != nil {
+		return nil, err
+	}
+
+	scanned, failed, err := scanActionItems(ctx, uc.items, meetings, GetActionItemsInput{Owner: input.Owner})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	horizon := now.Add(window)
+	// Owners differing only in case or surrounding space are one person;
+	// the first spelling seen names the group.
+	byOwner := make(map[string]*OwnerDigest)
+	for _, s := range scanned {
+		for _, item := range s.items {
+			key := strings.ToLower(strings.TrimSpace(item.Owner()))
+			d, ok := byOwner[key]
+			if !ok {
+				d = &OwnerDigest{Owner: strings.TrimSpace(item.Owner()), Upcoming: make([]DigestItem, 0)}
+				byOwner[key] = d
+			}
+			if item.IsCompleted() {
+				d.Completed++
+				continue
+			}
+			d.Open++
+			due := item.DueDate()
+			switch {
+			case due == nil:
+			case due.Before(now):
+				d.Overdue++
+			case !due.After(horizon):
+				d.Upcoming = append(d.Upcoming, DigestItem{Item: item, Meeting: s.meeting})
+			}
+		}
+	}
+
+	out := &ActionItemDigestOutput{Owners: make([]OwnerDigest, 0, len(byOwner)), Errors: failed}
+	if limited {
+		out.ScanLimited = true
+		out.ScanLimit = uc.maxMeetings
+	}
+	for _, d := range byOwner {
+		sort.SliceStable(d.Upcoming, func(i, j int) bool {
+			return d.Upcoming[i].Item.DueDate().Before(*d.Upcoming[j].Item.DueDate())
+		})
+		out.Owners = append(out.Owners, *d)
+	}
+	sort.Slice(out.Owners, func(i, j int) bool {
+		a, b := out.Owners[i].Owner, out.Owners[j].Owner
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return out, nil
+}
@@ -0,0 +1,101 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestActionItemDigest_GroupsByOwner(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+	in := func(d time.Duration) *time.Time {
+		due := now.Add(d)
+		return &due
+	}
+	day := 24 * time.Hour
+
+	for _, id := range []domain.MeetingID{"m-1", "m-2", "m-3"} {
+		m, _ := domain.New(id, "Meeting "+string(id), now.Add(-3*day), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	a1, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", in(-2*day))
+	a2, _ := domain.NewActionItem("ai-2", "m-1", "Bob", "Book room", in(3*day))
+	a3, _ := domain.NewActionItem("ai-3", "m-2", "alice", "Draft plan", in(2*day))
+	a4, _ := domain.NewActionItem("ai-4", "m-2", "Alice", "Ship it", in(5*day))
+	a5, _ := domain.NewActionItem("ai-5", "m-2", "Alice", "Done already", in(-day))
+	a5.Complete()
+	a6, _ := domain.NewActionItem("ai-6", "m-3", "Alice", "Someday", in(30*day))
+	a7, _ := domain.NewActionItem("ai-7", "m-3", "", "Unassigned", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{a1, a2})
+	repo.addActionItems("m-2", []*domain.ActionItem{a4, a3, a5})
+	repo.addActionItems("m-3", []*domain.ActionItem{a6, a7})
+
+	uc := app.NewActionItemDigest(repo, app.NewGetActionItems(repo))
+	out, err := uc.Execute(context.Background(), app.ActionItemDigestInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(out.Owners) != 3 {
+		t.Fatalf("got %d owners, want Alice, Bob, and unassigned: %+v", len(out.Owners), out.Owners)
+	}
+	alice, bob, unassigned := out.Owners[0], out.Owners[1], out.Owners[2]
+	if alice.Owner != "Alice" || alice.Open != 4 || alice.Overdue != 1 || alice.Completed != 1 {
+		t.Errorf("got Alice %+v, want 4 open, 1 overdue, 1 completed", alice)
+	}
+	if len(alice.Upcoming) != 2 || alice.Upcoming[0].Item.ID() != "ai-3" || alice.Upcoming[1].Item.ID() != "ai-4" {
+		t.Errorf("got Alice upcoming %+v, want ai-3 then ai-4", alice.Upcoming)
+	}
+	if alice.Upcoming[0].Meeting.ID() != "m-2" {
+		t.Errorf("got meeting %s for ai-3, want m-2", alice.Upcoming[0].Meeting.ID())
+	}
+	if bob.Owner != "Bob" || bob.Open != 1 || len(bob.Upcoming) != 1 || bob.Upcoming[0].Item.ID() != "ai-2" {
+		t.Errorf("got Bob %+v, want one upcoming open item", bob)
+	}
+	if unassigned.Owner != "" || unassigned.Open != 1 || len(unassigned.Upcoming) != 0 {
+		t.Errorf("got unassigned %+v, want one open item without a due date", unassigned)
+	}
+
+	out, err = uc.Execute(context.Background(), app.ActionItemDigestInput{Owner: "bob", UpcomingWithin: day})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Owners) != 1 || out.Owners[0].Owner != "Bob" || len(out.Owners[0].Upcoming) != 0 {
+		t.Errorf("got %+v, want only Bob with nothing due within a day", out.Owners)
+	}
+
+	if _, err := uc.Execute(context.Background(), app.ActionItemDigestInput{UpcomingWithin: -day}); !errors.Is(err, domain.ErrInvalidFilter) {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidFilter)
+	}
+}
+
+func TestActionItemDigest_ReportsFailedMeetings(t *testing.T) {
+	repo := newMockRepository()
+	for _, id := range []domain.MeetingID{"m-1", "m-2"} {
+		m, _ := domain.New(id, "Meeting "+string(id), time.Now().UTC(), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	unavailable := errors.New("upstream unavailable")
+	repo.actionItemsErr = map[domain.MeetingID]error{"m-2": unavailable}
+
+	out, err := app.NewActionItemDigest(repo, app.NewGetActionItems(repo)).Execute(context.Background(), app.ActionItemDigestInput{})
+	if err != nil {
+		t.Fatalf("got %v, want the digest of the readable meetings", err)
+	}
+	if len(out.Owners) != 1 || out.Owners[0].Open != 1 {
+		t.Errorf("got %+v, want Alice's item from m-1", out.Owners)
+	}
+	if len(out.Errors) != 1 || !errors.Is(out.Errors["m-2"], unavailable) {
+		t.Errorf("got errors %v, want m-2's failure", out.Errors)
+	}
+}
@@ -0,0 +1,140 @@
+package meeting
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+type CompareMeetingsInput struct {
+	IDA domain.MeetingID
+	IDB domain.MeetingID
+}
+
+// MeetingSnapshot is the side of a comparison describing one meeting.
+type MeetingSnapshot struct {
+	Meeting         *domain.Meeting
+	ActionItems     int
+	OpenActionItems int
+	HasSummary      bool
+	// Duration spans the first to the last transcript utterance; zero
+	// when there is no transcript.
+	Duration time.Duration
+}
+
+type CompareMeetingsOutput struct {
+	A MeetingSnapshot
+	B MeetingSnapshot
+	// ParticipantsAdded attended B but not A; ParticipantsRemoved
+	// attended A but not B. Both keep meeting order.
+	ParticipantsAdded   []domain.Participant
+	ParticipantsRemoved []domain.Participant
+}
+
+// CompareMeetings contrasts two meetings, typically instances of the same
+// recurring meeting. Both are fetched concurrently through GetMeeting.
+type CompareMeetings struct {
+	get *GetMeeting
+}
+
+func NewCompareMeetings(get *GetMeeting) *CompareMeetings {
+	return &CompareMeetings{get: get}
+}
+
+func (uc *CompareMeetings) Execute(ctx context.Context, input CompareMeetingsInput) (*CompareMeetingsOutput, error) {
+	if input.IDA == "" || input.IDB == "" {
+		return nil, domain.ErrInvalidMeetingID
+	}
+
+	var (
+		wg         sync.WaitGroup
+		outA, outB *GetMeetingOutput
+		errA, errB error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		outA, errA = uc.get.Execute(ctx, GetMeetingInput{ID: input.IDA, IncludeTranscript: true})
+	}()
+	go func() {
+		defer wg.Done()
+		outB, errB = uc.get.Execute(ctx, GetMeetingInput{ID: input.IDB, IncludeTranscript: true})
+	}()
+	wg.Wait()
+
+	if errA != nil {
+		return nil, fmt.Errorf("meeting %s: %w", input.IDA, errA)
+	}
+	if errB != nil {
+		return nil, fmt.Errorf("meeting %s: %w", input.IDB, errB)
+	}
+
+	a, b := outA.Meeting.Participants(), outB.Meeting.Participants()
+	return &CompareMeetingsOutput{
+		A:                   snapshot(outA),
+		B:                   snapshot(outB),
+		ParticipantsAdded:   participantsMissing(b, a),
+		ParticipantsRemoved: participantsMissing(a, b),
+	}, nil
+}
+
+func snapshot(out *GetMeetingOutput) MeetingSnapshot {
+	s := MeetingSnapshot{
+		Meeting:    out.Meeting,
+		HasSummary: out.Meeting.Summary() != nil,
+	}
+	for _, item := range out.Meeting.ActionItems() {
+		s.ActionItems++
+		if !item.IsCompleted() {
+			s.OpenActionItems++
+		}
+	}
+	if out.Transcript != nil {
+		s.Duration = transcriptSpan(out.Transcript.Utterances())
+	}
+	return s
+}
+
+func transcriptSpan(utterances []domain.Utterance) time.Duration {
+	var first, last time.Time
+	for _, u := range utterances {
+		ts := u.Timestamp()
+		if ts.IsZero() {
+			continue
+		}
+		if first.IsZero() || ts.Before(first) {
+			first = ts
+		}
+		if ts.After(last) {
+			last = ts
+		}
+	}
+	return last.Sub(first)
+}
+
+// participantsMissing returns the participants of from that are not in
+// other. People match by email when they have one, otherwise by name.
+func participantsMissing(from, other []domain.Participant) []domain.Participant {
+	seen := make(map[string]bool, len(other))
+	for _, p := range other {
+		seen[participantKey(p)] = true
+	}
+	missing := []domain.Participant{}
+	for _, p := range from {
+		if !seen[participantKey(p)] {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+func participantKey(p domain.Participant) string {
+	if p.Email() != "" {
+		return "email:" + strings.ToLower(p.Email())
+	}
+	return "name:" + strings.ToLower(strings.TrimSpace(p.Name()))
+}
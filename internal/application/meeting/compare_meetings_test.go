@@ -0,0 +1,82 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestCompareMeetings(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+
+	a, _ := domain.New("m-1", "Standup", now.Add(-7*24*time.Hour), domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+		domain.NewParticipant("Bob", "bob@example.com", domain.RoleAttendee),
+	})
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Ship it", nil)
+	item.Complete()
+	a.AddActionItem(item)
+	repo.addMeeting(a)
+	tr := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Morning", now, 0.9),
+		domain.NewUtterance("Bob", "Done", now.Add(15*time.Minute), 0.9),
+	})
+	repo.addTranscript("m-1", &tr)
+
+	b, _ := domain.New("m-2", "Standup", now, domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "ALICE@example.com", domain.RoleHost),
+		domain.NewParticipant("Carol", "", domain.RoleAttendee),
+	})
+	b.AttachSummary(domain.NewSummary("m-2", "All good", domain.SummaryAuto))
+	for _, id := range []domain.ActionItemID{"ai-2", "ai-3"} {
+		item, _ := domain.NewActionItem(id, "m-2", "Carol", "Follow up", nil)
+		b.AddActionItem(item)
+	}
+	repo.addMeeting(b)
+
+	uc := app.NewCompareMeetings(app.NewGetMeeting(repo))
+	out, err := uc.Execute(context.Background(), app.CompareMeetingsInput{IDA: "m-1", IDB: "m-2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(out.ParticipantsAdded) != 1 || out.ParticipantsAdded[0].Name() != "Carol" {
+		t.Errorf("added: got %v, want [Carol]", out.ParticipantsAdded)
+	}
+	if len(out.ParticipantsRemoved) != 1 || out.ParticipantsRemoved[0].Name() != "Bob" {
+		t.Errorf("removed: got %v, want [Bob]", out.ParticipantsRemoved)
+	}
+	if out.A.ActionItems != 1 || out.A.OpenActionItems != 0 || out.B.ActionItems != 2 || out.B.OpenActionItems != 2 {
+		t.Errorf("action items: got A %d/%d, B %d/%d", out.A.ActionItems, out.A.OpenActionItems, out.B.ActionItems, out.B.OpenActionItems)
+	}
+	if out.A.HasSummary || !out.B.HasSummary {
+		t.Errorf("summary: got A %v, B %v", out.A.HasSummary, out.B.HasSummary)
+	}
+	if out.A.Duration != 15*time.Minute || out.B.Duration != 0 {
+		t.Errorf("duration: got A %v, B %v", out.A.Duration, out.B.Duration)
+	}
+}
+
+func TestCompareMeetings_Missing(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "Standup"))
+	uc := app.NewCompareMeetings(app.NewGetMeeting(repo))
+
+	_, err := uc.Execute(context.Background(), app.CompareMeetingsInput{IDA: "m-1", IDB: "m-404"})
+	if !errors.Is(err, domain.ErrMeetingNotFound) {
+		t.Fatalf("got %v, want %v", err, domain.ErrMeetingNotFound)
+	}
+	if got := err.Error(); got == domain.ErrMeetingNotFound.Error() {
+		t.Errorf("error %q should name the missing meeting", got)
+	}
+
+	_, err = uc.Execute(context.Background(), app.CompareMeetingsInput{IDA: "m-1"})
+	if !errors.Is(err, domain.ErrInvalidMeetingID) {
+		t.Errorf("got %v, want %v", err, domain.ErrInvalidMeetingID)
+	}
+}
@@ -2,7 +2,9 @@ package meeting
 
 import (
 	"context"
+	"time"
 
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -15,16 +17,35 @@ type CompleteActionItemOutput struct {
 	Item *domain.ActionItem
 }
 
+// CacheInvalidator drops cached data for a meeting, so reads after a
+// write see the change. Implemented by cache.CachedRepository.
+type CacheInvalidator interface {
+	Invalidate(ctx context.Context, id domain.MeetingID) error
+}
+
 type CompleteActionItem struct {
-	repo       domain.Repository
-	writeRepo  domain.WriteRepository
-	dispatcher domain.EventDispatcher
+	repo        domain.Repository
+	writeRepo   domain.WriteRepository
+	dispatcher  domain.EventDispatcher
+	audit       audit.Logger
+	invalidator CacheInvalidator
 }
 
 func NewCompleteActionItem(repo domain.Repository, writeRepo domain.WriteRepository, dispatcher domain.EventDispatcher) *CompleteActionItem {
 	return &CompleteActionItem{repo: repo, writeRepo: writeRepo, dispatcher: dispatcher}
 }
 
+// SetAuditLogger records each completion to the audit log.
+func (uc *CompleteActionItem) SetAuditLogger(l audit.Logger) {
+	uc.audit = l
+}
+
+// SetCacheInvalidator drops the meeting's cached entry after each
+// completion.
+func (uc *CompleteActionItem) SetCacheInvalidator(inv CacheInvalidator) {
+	uc.invalidator = inv
+}
+
 func (uc *CompleteActionItem) Execute(ctx context.Context, input CompleteActionItemInput) (*CompleteActionItemOutput, error) {
 	if input.MeetingID == "" {
 		return nil, domain.ErrInvalidMeetingID
@@ -50,20 +71,51 @@ func (uc *CompleteActionItem) Execute(ctx context.Context, input CompleteActionI
 		return nil, domain.ErrMeetingNotFound
 	}
 
-	// Apply local override
-	item.Complete()
-
-	if err := uc.writeRepo.SaveActionItemState(ctx, item); err != nil {
+	if err := uc.apply(ctx, input.MeetingID, item); err != nil {
 		return nil, err
 	}
+	uc.invalidate(ctx, input.MeetingID)
 
 	// Dispatch event
 	event := domain.NewActionItemCompletedEvent(input.MeetingID, input.ActionItemID)
-	if uc.dispatcher != nil {
-		if err := uc.dispatcher.Dispatch(ctx, []domain.DomainEvent{event}); err != nil {
-			return nil, err
-		}
+	if err := uc.dispatch(ctx, []domain.DomainEvent{event}); err != nil {
+		return nil, err
 	}
 
 	return &CompleteActionItemOutput{Item: item}, nil
 }
+
+// apply completes item as a local override, persists it, and audits the
+// completion.
+func (uc *CompleteActionItem) apply(ctx context.Context, meetingID domain.MeetingID, item *domain.ActionItem) error {
+	item.Complete()
+	item.MarkModified(time.Now().UTC())
+
+	if err := uc.writeRepo.SaveActionItemState(ctx, item); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, uc.audit, audit.Entry{
+		Operation: audit.OpCompleteActionItem,
+		TargetID:  string(item.ID()),
+		Actor:     audit.ActorFromContext(ctx),
+		Timestamp: time.Now().UTC(),
+		Details:   "meeting " + string(meetingID),
+	})
+	return nil
+}
+
+// invalidate drops the meeting's cached entry. The overrides are saved by
+// then; a failed invalidation only leaves the cached entry to expire.
+func (uc *CompleteActionItem) invalidate(ctx context.Context, meetingID domain.MeetingID) {
+	if uc.invalidator != nil {
+		_ = uc.invalidator.Invalidate(ctx, meetingID)
+	}
+}
+
+func (uc *CompleteActionItem) dispatch(ctx context.Context, events []domain.DomainEvent) error {
+	if uc.dispatcher == nil || len(events) == 0 {
+		return nil
+	}
+	return uc.dispatcher.Dispatch(ctx, events)
+}
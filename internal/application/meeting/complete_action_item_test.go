@@ -3,8 +3,10 @@ package meeting_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -28,6 +30,9 @@ func TestCompleteActionItem_Success(t *testing.T) {
 	if !out.Item.IsCompleted() {
 		t.Error("action item should be completed")
 	}
+	if at := out.Item.ModifiedAt(); at == nil || time.Since(*at) > time.Minute {
+		t.Errorf("got modified at %v, want the completion time", at)
+	}
 
 	// Verify persisted
 	saved := writeRepo.items["ai-1"]
@@ -81,3 +86,57 @@ func TestCompleteActionItem_EmptyActionItemID(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, domain.ErrInvalidActionItemID)
 	}
 }
+
+func TestCompleteActionItem_RecordsAudit(t *testing.T) {
+	repo := newMockRepository()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	logger := &mockAuditLogger{}
+
+	uc := app.NewCompleteActionItem(repo, newMockWriteRepository(), nil)
+	uc.SetAuditLogger(logger)
+	if _, err := uc.Execute(context.Background(), app.CompleteActionItemInput{MeetingID: "m-1", ActionItemID: "ai-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(logger.entries))
+	}
+	e := logger.entries[0]
+	if e.Operation != audit.OpCompleteActionItem || e.TargetID != "ai-1" || e.Actor != audit.DefaultActor {
+		t.Errorf("got entry %+v", e)
+	}
+}
+
+// mockCacheInvalidator records the meetings invalidated.
+type mockCacheInvalidator struct {
+	ids []domain.MeetingID
+}
+
+func (m *mockCacheInvalidator) Invalidate(_ context.Context, id domain.MeetingID) error {
+	m.ids = append(m.ids, id)
+	return nil
+}
+
+func TestCompleteActionItem_InvalidatesCache(t *testing.T) {
+	repo := newMockRepository()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	inv := &mockCacheInvalidator{}
+
+	uc := app.NewCompleteActionItem(repo, newMockWriteRepository(), nil)
+	uc.SetCacheInvalidator(inv)
+	if _, err := uc.Execute(context.Background(), app.CompleteActionItemInput{MeetingID: "m-1", ActionItemID: "ai-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.ids) != 1 || inv.ids[0] != "m-1" {
+		t.Errorf("got invalidated %v, want [m-1]", inv.ids)
+	}
+
+	if _, err := uc.Execute(context.Background(), app.CompleteActionItemInput{MeetingID: "m-1", ActionItemID: "nonexistent"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(inv.ids) != 1 {
+		t.Errorf("got invalidated %v after a failed completion, want only [m-1]", inv.ids)
+	}
+}
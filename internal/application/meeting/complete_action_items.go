@@ -0,0 +1,102 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+var ErrNoActionItemIDs = errors.New("no action item ids given")
+
+type CompleteActionItemsInput struct {
+	MeetingID     domain.MeetingID
+	ActionItemIDs []domain.ActionItemID
+	// AllOpen completes every open item in the meeting; ActionItemIDs is ignored.
+	AllOpen bool
+}
+
+type CompleteActionItemsOutput struct {
+	// Items are the completed items, in request order.
+	Items []*domain.ActionItem
+	// Errors holds the failure for each ID that could not be completed.
+	Errors map[domain.ActionItemID]error
+}
+
+// CompleteActionItems completes several action items of one meeting. It
+// reads the meeting's items once and persists and audits each completion
+// as CompleteActionItem does, then invalidates the cache and dispatches
+// the completion events once for the whole batch. A failed item does not
+// stop the rest.
+type CompleteActionItems struct {
+	repo     domain.Repository
+	complete *CompleteActionItem
+}
+
+func NewCompleteActionItems(repo domain.Repository, complete *CompleteActionItem) *CompleteActionItems {
+	return &CompleteActionItems{repo: repo, complete: complete}
+}
+
+func (uc *CompleteActionItems) Execute(ctx context.Context, input CompleteActionItemsInput) (*CompleteActionItemsOutput, error) {
+	if input.MeetingID == "" {
+		return nil, domain.ErrInvalidMeetingID
+	}
+	ids := input.ActionItemIDs
+	if !input.AllOpen && len(ids) == 0 {
+		return nil, ErrNoActionItemIDs
+	}
+
+	items, err := uc.repo.GetActionItems(ctx, input.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[domain.ActionItemID]*domain.ActionItem, len(items))
+	for _, item := range items {
+		byID[item.ID()] = item
+	}
+	if input.AllOpen {
+		ids = nil
+		for _, item := range items {
+			if !item.IsCompleted() {
+				ids = append(ids, item.ID())
+			}
+		}
+	}
+
+	out := &CompleteActionItemsOutput{
+		Items:  []*domain.ActionItem{},
+		Errors: make(map[domain.ActionItemID]error),
+	}
+	var events []domain.DomainEvent
+	seen := make(map[domain.ActionItemID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if id == "" {
+			out.Errors[id] = domain.ErrInvalidActionItemID
+			continue
+		}
+		item, ok := byID[id]
+		if !ok {
+			out.Errors[id] = domain.ErrMeetingNotFound
+			continue
+		}
+		if err := uc.complete.apply(ctx, input.MeetingID, item); err != nil {
+			out.Errors[id] = err
+			continue
+		}
+		out.Items = append(out.Items, item)
+		events = append(events, domain.NewActionItemCompletedEvent(input.MeetingID, id))
+	}
+
+	if len(out.Items) > 0 {
+		uc.complete.invalidate(ctx, input.MeetingID)
+	}
+	if err := uc.complete.dispatch(ctx, events); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
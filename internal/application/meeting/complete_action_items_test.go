@@ -0,0 +1,101 @@
+package meeting_test
+
+import (
+	"context"
+	"testing"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func newBulkFixture() (*mockRepository, *mockWriteRepository, *mockDispatcher) {
+	repo := newMockRepository()
+	a, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	b, _ := domain.NewActionItem("ai-2", "m-1", "Bob", "Book room", nil)
+	c, _ := domain.NewActionItem("ai-3", "m-1", "Carol", "Send invites", nil)
+	c.Complete()
+	repo.addActionItems("m-1", []*domain.ActionItem{a, b, c})
+	return repo, newMockWriteRepository(), &mockDispatcher{}
+}
+
+func TestCompleteActionItems_PartialFailure(t *testing.T) {
+	repo, writeRepo, dispatcher := newBulkFixture()
+	uc := app.NewCompleteActionItems(repo, app.NewCompleteActionItem(repo, writeRepo, dispatcher))
+
+	out, err := uc.Execute(context.Background(), app.CompleteActionItemsInput{
+		MeetingID:     "m-1",
+		ActionItemIDs: []domain.ActionItemID{"ai-2", "missing", "ai-1", "ai-2"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(out.Items) != 2 || out.Items[0].ID() != "ai-2" || out.Items[1].ID() != "ai-1" {
+		t.Fatalf("got items %v, want ai-2 then ai-1", out.Items)
+	}
+	if len(out.Errors) != 1 || out.Errors["missing"] != domain.ErrMeetingNotFound {
+		t.Errorf("got errors %v, want only missing", out.Errors)
+	}
+	if len(dispatcher.events) != 2 {
+		t.Errorf("got %d events, want 2", len(dispatcher.events))
+	}
+	if writeRepo.items["ai-1"] == nil || writeRepo.items["ai-2"] == nil {
+		t.Error("completed items should be persisted")
+	}
+}
+
+func TestCompleteActionItems_AllOpen(t *testing.T) {
+	repo, writeRepo, dispatcher := newBulkFixture()
+	uc := app.NewCompleteActionItems(repo, app.NewCompleteActionItem(repo, writeRepo, dispatcher))
+
+	out, err := uc.Execute(context.Background(), app.CompleteActionItemsInput{MeetingID: "m-1", AllOpen: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Items) != 2 {
+		t.Errorf("got %d items, want the 2 open ones", len(out.Items))
+	}
+	if writeRepo.items["ai-3"] != nil {
+		t.Error("already completed item should not be re-saved")
+	}
+}
+
+func TestCompleteActionItems_Validation(t *testing.T) {
+	repo := newMockRepository()
+	uc := app.NewCompleteActionItems(repo, app.NewCompleteActionItem(repo, newMockWriteRepository(), nil))
+
+	if _, err := uc.Execute(context.Background(), app.CompleteActionItemsInput{ActionItemIDs: []domain.ActionItemID{"ai-1"}}); err != domain.ErrInvalidMeetingID {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
+	}
+	if _, err := uc.Execute(context.Background(), app.CompleteActionItemsInput{MeetingID: "m-1"}); err != app.ErrNoActionItemIDs {
+		t.Errorf("got error %v, want %v", err, app.ErrNoActionItemIDs)
+	}
+}
+
+func TestCompleteActionItems_ReadsAndDispatchesOnce(t *testing.T) {
+	repo, writeRepo, dispatcher := newBulkFixture()
+	single := app.NewCompleteActionItem(repo, writeRepo, dispatcher)
+	inv := &mockCacheInvalidator{}
+	single.SetCacheInvalidator(inv)
+	uc := app.NewCompleteActionItems(repo, single)
+
+	out, err := uc.Execute(context.Background(), app.CompleteActionItemsInput{
+		MeetingID:     "m-1",
+		ActionItemIDs: []domain.ActionItemID{"ai-1", "ai-2"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Items) != 2 {
+		t.Fatalf("got %d items, want 2", len(out.Items))
+	}
+	if repo.getActionItemsCalls != 1 {
+		t.Errorf("got %d action item reads, want 1 for the batch", repo.getActionItemsCalls)
+	}
+	if len(inv.ids) != 1 {
+		t.Errorf("got %d invalidations, want 1", len(inv.ids))
+	}
+	if dispatcher.calls != 1 || len(dispatcher.events) != 2 {
+		t.Errorf("got %d dispatches of %d events, want one dispatch of 2", dispatcher.calls, len(dispatcher.events))
+	}
+}
@@ -0,0 +1,54 @@
+package meeting
+
+import domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+
+// ConfidenceBucket counts utterances whose confidence falls in Range.
+// Ranges include their lower bound; the last one also includes 1.0.
+type ConfidenceBucket struct {
+	Range string `json:"range"`
+	Count int    `json:"count"`
+}
+
+// ConfidenceHistogram summarizes transcription quality. Granola sends a
+// zero confidence when it has none, so zero scores are counted as
+// Unscored rather than bucketed; HasScores is false when every utterance
+// is unscored.
+type ConfidenceHistogram struct {
+	Buckets   []ConfidenceBucket `json:"buckets"`
+	Scored    int                `json:"scored"`
+	Unscored  int                `json:"unscored"`
+	HasScores bool               `json:"has_scores"`
+}
+
+// confidenceBuckets are the lower bounds and labels of the histogram.
+var confidenceBuckets = []struct {
+	min   float64
+	label string
+}{
+	{0, "0-0.5"},
+	{0.5, "0.5-0.7"},
+	{0.7, "0.7-0.9"},
+	{0.9, "0.9-1.0"},
+}
+
+func computeConfidenceHistogram(utterances []domain.Utterance) *ConfidenceHistogram {
+	h := &ConfidenceHistogram{Buckets: make([]ConfidenceBucket, len(confidenceBuckets))}
+	for i, b := range confidenceBuckets {
+		h.Buckets[i].Range = b.label
+	}
+	for _, u := range utterances {
+		c := u.Confidence()
+		if c <= 0 {
+			h.Unscored++
+			continue
+		}
+		h.Scored++
+		i := len(confidenceBuckets) - 1
+		for i > 0 && c < confidenceBuckets[i].min {
+			i--
+		}
+		h.Buckets[i].Count++
+	}
+	h.HasScores = h.Scored > 0
+	return h
+}
@@ -0,0 +1,147 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+const (
+	// DefaultKeywordLimit is how many terms ExtractKeywords returns by default.
+	DefaultKeywordLimit = 20
+	// DefaultKeywordMinLength drops very short tokens ("ok", "go") by default.
+	DefaultKeywordMinLength = 3
+)
+
+type ExtractKeywordsInput struct {
+	MeetingID domain.MeetingID
+	Limit     int // defaults to DefaultKeywordLimit
+	MinLength int // minimum term length in runes; defaults to DefaultKeywordMinLength
+	// Language selects the built-in stopword pack: "en", "de", or "fr".
+	// Defaults to DefaultKeywordLanguage.
+	Language string
+}
+
+// KeywordCount is a term and how often it occurs in the transcript.
+type KeywordCount struct {
+	Term  string
+	Count int
+}
+
+type ExtractKeywordsOutput struct {
+	// Keywords are ordered by count, most frequent first, with ties
+	// broken alphabetically.
+	Keywords []KeywordCount
+	// Warning is set when Language has no stopword pack, so only the
+	// extra stopwords were filtered.
+	Warning string
+}
+
+// ExtractKeywords gives a quick topic overview of a meeting by counting
+// transcript terms. It is plain term frequency with stopwords removed,
+// so results are deterministic.
+type ExtractKeywords struct {
+	repo  domain.Repository
+	extra map[string]bool
+}
+
+func NewExtractKeywords(repo domain.Repository) *ExtractKeywords {
+	return &ExtractKeywords{repo: repo}
+}
+
+// SetExtraStopwords adds words filtered out in every language, e.g.
+// company or product names that dominate every transcript.
+func (uc *ExtractKeywords) SetExtraStopwords(words []string) {
+	uc.extra = toSet(words...)
+}
+
+func (uc *ExtractKeywords) Execute(ctx context.Context, input ExtractKeywordsInput) (*ExtractKeywordsOutput, error) {
+	if input.MeetingID == "" {
+		return nil, domain.ErrInvalidMeetingID
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultKeywordLimit
+	}
+	minLength := input.MinLength
+	if minLength <= 0 {
+		minLength = DefaultKeywordMinLength
+	}
+
+	language := strings.ToLower(strings.TrimSpace(input.Language))
+	if language == "" {
+		language = DefaultKeywordLanguage
+	}
+	pack, ok := stopwordPacks[language]
+	var warning string
+	if !ok {
+		pack = &stopwordPack{}
+		warning = fmt.Sprintf("unknown language %q: language stopwords were not filtered", input.Language)
+	}
+
+	t, err := uc.repo.GetTranscript(ctx, input.MeetingID)
+	if errors.Is(err, domain.ErrTranscriptNotReady) {
+		return &ExtractKeywordsOutput{Keywords: []KeywordCount{}, Warning: warning}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, u := range t.Utterances() {
+		for _, term := range tokenize(u.Text()) {
+			term, stop := pack.filter(term)
+			if stop || uc.extra[term] || len([]rune(term)) < minLength || isNumeric(term) {
+				continue
+			}
+			counts[term]++
+		}
+	}
+
+	keywords := make([]KeywordCount, 0, len(counts))
+	for term, n := range counts {
+		keywords = append(keywords, KeywordCount{Term: term, Count: n})
+	}
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Count != keywords[j].Count {
+			return keywords[i].Count > keywords[j].Count
+		}
+		return keywords[i].Term < keywords[j].Term
+	})
+	if len(keywords) > limit {
+		keywords = keywords[:limit]
+	}
+
+	return &ExtractKeywordsOutput{Keywords: keywords, Warning: warning}, nil
+}
+
+// tokenize lowercases text and splits it into words. Apostrophes inside
+// a word are kept so contractions ("don't") match the stopword list.
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\'' && r != '’'
+	})
+	terms := fields[:0]
+	for _, f := range fields {
+		f = strings.ReplaceAll(f, "’", "'")
+		if f = strings.Trim(f, "'"); f != "" {
+			terms = append(terms, f)
+		}
+	}
+	return terms
+}
+
+func isNumeric(term string) bool {
+	for _, r := range term {
+		if !unicode.IsNumber(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+package meeting_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestExtractKeywords_TopTermsByFrequency(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "The budget for the launch is tight.", now, 0.9),
+		domain.NewUtterance("Bob", "Yeah, I think the launch budget needs review. Don't cut marketing!", now, 0.9),
+		domain.NewUtterance("Alice", "Launch in 2025, ok?", now, 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	uc := app.NewExtractKeywords(repo)
+	out, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{MeetingID: "m-1", Limit: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []app.KeywordCount{
+		{Term: "launch", Count: 3},
+		{Term: "budget", Count: 2},
+		{Term: "cut", Count: 1},
+	}
+	if len(out.Keywords) != len(want) {
+		t.Fatalf("got %+v, want %+v", out.Keywords, want)
+	}
+	for i := range want {
+		if out.Keywords[i] != want[i] {
+			t.Errorf("keyword %d = %+v, want %+v", i, out.Keywords[i], want[i])
+		}
+	}
+}
+
+func TestExtractKeywords_MinLength(t *testing.T) {
+	repo := newMockRepository()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "API API roadmap", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	uc := app.NewExtractKeywords(repo)
+	out, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{MeetingID: "m-1", MinLength: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Keywords) != 1 || out.Keywords[0].Term != "roadmap" {
+		t.Errorf("got %+v, want only roadmap", out.Keywords)
+	}
+}
+
+func TestExtractKeywords_NoTranscript(t *testing.T) {
+	uc := app.NewExtractKeywords(newMockRepository())
+
+	out, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Keywords == nil || len(out.Keywords) != 0 {
+		t.Errorf("got %+v, want empty list", out.Keywords)
+	}
+}
+
+func TestExtractKeywords_EmptyMeetingID(t *testing.T) {
+	uc := app.NewExtractKeywords(newMockRepository())
+
+	_, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{})
+	if err != domain.ErrInvalidMeetingID {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
+	}
+}
+
+func TestExtractKeywords_Language(t *testing.T) {
+	repo := newMockRepository()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Wir haben das Budget und the roadmap", time.Now().UTC(), 0.9),
+		domain.NewUtterance("Bob", "Le budget de l'équipe", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	uc := app.NewExtractKeywords(repo)
+
+	terms := func(t *testing.T, language string) map[string]bool {
+		t.Helper()
+		out, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{MeetingID: "m-1", Language: language})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Warning != "" {
+			t.Errorf("unexpected warning %q", out.Warning)
+		}
+		got := make(map[string]bool)
+		for _, k := range out.Keywords {
+			got[k.Term] = true
+		}
+		return got
+	}
+
+	en := terms(t, "")
+	if en["the"] || !en["haben"] || !en["wir"] {
+		t.Errorf("English should drop only English stopwords, got %v", en)
+	}
+	de := terms(t, "de")
+	if de["haben"] || de["das"] || de["und"] || !de["the"] {
+		t.Errorf("German should drop only German stopwords, got %v", de)
+	}
+	fr := terms(t, "FR")
+	if fr["les"] || fr["l'équipe"] || !fr["équipe"] || !fr["the"] {
+		t.Errorf("French should drop French stopwords and elisions, got %v", fr)
+	}
+	for name, got := range map[string]map[string]bool{"en": en, "de": de, "fr": fr} {
+		if !got["budget"] || !got["roadmap"] {
+			t.Errorf("%s: content words should survive, got %v", name, got)
+		}
+	}
+}
+
+func TestExtractKeywords_UnknownLanguage(t *testing.T) {
+	repo := newMockRepository()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "the roadmap", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	uc := app.NewExtractKeywords(repo)
+
+	out, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{MeetingID: "m-1", Language: "es"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.Warning, `unknown language "es"`) {
+		t.Errorf("got warning %q", out.Warning)
+	}
+	if len(out.Keywords) != 2 {
+		t.Errorf("got %+v, want the and roadmap unfiltered", out.Keywords)
+	}
+}
+
+func TestExtractKeywords_ExtraStopwords(t *testing.T) {
+	repo := newMockRepository()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Acme roadmap for Acme", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	uc := app.NewExtractKeywords(repo)
+
+	words, err := app.ParseStopwords(strings.NewReader("# company names\nAcme\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc.SetExtraStopwords(words)
+
+	out, err := uc.Execute(context.Background(), app.ExtractKeywordsInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Keywords) != 1 || out.Keywords[0].Term != "roadmap" {
+		t.Errorf("got %+v, want only roadmap", out.Keywords)
+	}
+}
@@ -0,0 +1,136 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultAssumedMeetingDuration is the length assumed for a meeting
+// whose transcript cannot tell how long it ran.
+const DefaultAssumedMeetingDuration = 30 * time.Minute
+
+type FindConflictsInput struct {
+	// Since and Until bound the meeting start times scanned.
+	Since *time.Time
+	Until *time.Time
+	// AssumedDuration applies to meetings without a usable transcript;
+	// zero uses DefaultAssumedMeetingDuration.
+	AssumedDuration time.Duration
+}
+
+// MeetingConflict is a pair of meetings whose estimated time ranges
+// overlap. A starts no later than B.
+type MeetingConflict struct {
+	A       *domain.Meeting
+	B       *domain.Meeting
+	Overlap time.Duration
+}
+
+type FindConflictsOutput struct {
+	// Conflicts are ordered by A's start time, then B's.
+	Conflicts []MeetingConflict
+	// ScanLimited reports that more meetings matched than the scan cap, so
+	// the result covers only the first ScanLimit of them.
+	ScanLimited bool
+	ScanLimit   int
+}
+
+// FindConflicts detects double-booked meetings in a range. A meeting is
+// taken to end after its transcript span (first to last utterance) or,
+// without a transcript, after the assumed duration.
+type FindConflicts struct {
+	repo        domain.Repository
+	maxMeetings int
+}
+
+func NewFindConflicts(repo domain.Repository) *FindConflicts {
+	return &FindConflicts{repo: repo, maxMeetings: DefaultMaxScanMeetings}
+}
+
+// SetMaxMeetings replaces the per-call meeting scan cap,
+// DefaultMaxScanMeetings by default. Zero or negative disables it.
+func (uc *FindConflicts) SetMaxMeetings(n int) {
+	uc.maxMeetings = n
+}
+
+type meetingRange struct {
+	meeting    *domain.Meeting
+	start, end time.Time
+}
+
+func (uc *FindConflicts) Execute(ctx context.Context, input FindConflictsInput) (*FindConflictsOutput, error) {
+	if input.AssumedDuration < 0 {
+		return nil, domain.ErrInvalidFilter
+	}
+	assumed := input.AssumedDuration
+	if assumed == 0 {
+		assumed = DefaultAssumedMeetingDuration
+	}
+
+	meetings, limited, err := listCapped(ctx, uc.repo, domain.ListFilter{
+		Since: input.Since,
+		Until: input.Until,
+	}, uc.maxMeetings)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := make([]meetingRange, 0, len(meetings))
+	for _, m := range meetings {
+		duration, err := uc.estimateDuration(ctx, m.ID())
+		if err != nil {
+			return nil, err
+		}
+		if duration <= 0 {
+			duration = assumed
+		}
+		start := m.Datetime()
+		ranges = append(ranges, meetingRange{meeting: m, start: start, end: start.Add(duration)})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].start.Before(ranges[j].start)
+	})
+
+	out := &FindConflictsOutput{Conflicts: make([]MeetingConflict, 0)}
+	if limited {
+		out.ScanLimited = true
+		out.ScanLimit = uc.maxMeetings
+	}
+	for i, a := range ranges {
+		for _, b := range ranges[i+1:] {
+			if !b.start.Before(a.end) {
+				break
+			}
+			end := a.end
+			if b.end.Before(end) {
+				end = b.end
+			}
+			out.Conflicts = append(out.Conflicts, MeetingConflict{
+				A:       a.meeting,
+				B:       b.meeting,
+				Overlap: end.Sub(b.start),
+			})
+		}
+	}
+	return out, nil
+}
+
+// estimateDuration returns the meeting's transcript span, or zero when
+// the transcript is missing or not ready.
+func (uc *FindConflicts) estimateDuration(ctx context.Context, id domain.MeetingID) (time.Duration, error) {
+	transcript, err := uc.repo.GetTranscript(ctx, id)
+	if errors.Is(err, domain.ErrTranscriptNotReady) || errors.Is(err, domain.ErrMeetingNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if transcript == nil {
+		return 0, nil
+	}
+	return transcriptSpan(transcript.Utterances()), nil
+}
@@ -0,0 +1,101 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestFindConflicts_PairsOverlappingMeetings(t *testing.T) {
+	repo := newMockRepository()
+	base := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	for _, m := range []struct {
+		id    domain.MeetingID
+		start time.Time
+	}{
+		{"m-standup", base},
+		{"m-review", base.Add(20 * time.Minute)},
+		{"m-lunch", base.Add(3 * time.Hour)},
+	} {
+		mtg, _ := domain.New(m.id, string(m.id), m.start, domain.SourceZoom, nil)
+		mtg.ClearDomainEvents()
+		repo.addMeeting(mtg)
+	}
+	// The standup ran 45 minutes by its transcript; the others get the
+	// assumed 30 minutes.
+	transcript := domain.NewTranscript("m-standup", []domain.Utterance{
+		domain.NewUtterance("Alice", "Morning", base, 0.9),
+		domain.NewUtterance("Bob", "Bye", base.Add(45*time.Minute), 0.9),
+	})
+	repo.addTranscript("m-standup", &transcript)
+
+	out, err := app.NewFindConflicts(repo).Execute(context.Background(), app.FindConflictsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Conflicts) != 1 {
+		t.Fatalf("got %d conflicts, want 1: %+v", len(out.Conflicts), out.Conflicts)
+	}
+	c := out.Conflicts[0]
+	if c.A.ID() != "m-standup" || c.B.ID() != "m-review" {
+		t.Errorf("got pair %s/%s, want m-standup/m-review", c.A.ID(), c.B.ID())
+	}
+	if c.Overlap != 25*time.Minute {
+		t.Errorf("got overlap %s, want 25m", c.Overlap)
+	}
+}
+
+func TestFindConflicts_AssumedDuration(t *testing.T) {
+	repo := newMockRepository()
+	base := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	for i, id := range []domain.MeetingID{"m-1", "m-2"} {
+		mtg, _ := domain.New(id, string(id), base.Add(time.Duration(i)*time.Hour), domain.SourceZoom, nil)
+		mtg.ClearDomainEvents()
+		repo.addMeeting(mtg)
+	}
+	uc := app.NewFindConflicts(repo)
+
+	out, err := uc.Execute(context.Background(), app.FindConflictsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Conflicts == nil || len(out.Conflicts) != 0 {
+		t.Errorf("got %+v, want an empty list", out.Conflicts)
+	}
+
+	out, err = uc.Execute(context.Background(), app.FindConflictsInput{AssumedDuration: 90 * time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Conflicts) != 1 || out.Conflicts[0].Overlap != 30*time.Minute {
+		t.Errorf("got %+v, want one 30m overlap", out.Conflicts)
+	}
+
+	if _, err := uc.Execute(context.Background(), app.FindConflictsInput{AssumedDuration: -time.Minute}); !errors.Is(err, domain.ErrInvalidFilter) {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidFilter)
+	}
+}
+
+func TestFindConflicts_ScanLimited(t *testing.T) {
+	repo := newMockRepository()
+	base := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		m, _ := domain.New(domain.MeetingID("m-"+string(rune('a'+i))), "Meeting", base.Add(time.Duration(i)*10*time.Minute), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	uc := app.NewFindConflicts(repo)
+	uc.SetMaxMeetings(2)
+	out, err := uc.Execute(context.Background(), app.FindConflictsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.ScanLimited || out.ScanLimit != 2 || len(out.Conflicts) != 1 {
+		t.Errorf("got scan_limited=%v limit=%d conflicts=%d, want true, 2, 1", out.ScanLimited, out.ScanLimit, len(out.Conflicts))
+	}
+}
@@ -0,0 +1,46 @@
+package meeting
+
+import (
+	"context"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+type GetActionItemInput struct {
+	MeetingID    domain.MeetingID
+	ActionItemID domain.ActionItemID
+}
+
+type GetActionItemOutput struct {
+	Item *domain.ActionItem
+}
+
+// GetActionItem returns one action item of a meeting. The repository only
+// lists a meeting's items, so it selects the match from that list.
+type GetActionItem struct {
+	repo domain.Repository
+}
+
+func NewGetActionItem(repo domain.Repository) *GetActionItem {
+	return &GetActionItem{repo: repo}
+}
+
+func (uc *GetActionItem) Execute(ctx context.Context, input GetActionItemInput) (*GetActionItemOutput, error) {
+	if input.MeetingID == "" {
+		return nil, domain.ErrInvalidMeetingID
+	}
+	if input.ActionItemID == "" {
+		return nil, domain.ErrInvalidActionItemID
+	}
+
+	items, err := uc.repo.GetActionItems(ctx, input.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.ID() == input.ActionItemID {
+			return &GetActionItemOutput{Item: item}, nil
+		}
+	}
+	return nil, domain.ErrActionItemNotFound
+}
@@ -0,0 +1,48 @@
+package meeting_test
+
+import (
+	"context"
+	"testing"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestGetActionItem_Found(t *testing.T) {
+	repo := newMockRepository()
+	first, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", nil)
+	second, _ := domain.NewActionItem("ai-2", "m-1", "Bob", "Book room", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{first, second})
+
+	uc := app.NewGetActionItem(repo)
+	out, err := uc.Execute(context.Background(), app.GetActionItemInput{MeetingID: "m-1", ActionItemID: "ai-2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Item.ID() != "ai-2" || out.Item.Owner() != "Bob" {
+		t.Errorf("got item %s owned by %s", out.Item.ID(), out.Item.Owner())
+	}
+}
+
+func TestGetActionItem_NotFound(t *testing.T) {
+	repo := newMockRepository()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+
+	uc := app.NewGetActionItem(repo)
+	_, err := uc.Execute(context.Background(), app.GetActionItemInput{MeetingID: "m-1", ActionItemID: "ai-9"})
+	if err != domain.ErrActionItemNotFound {
+		t.Errorf("got error %v, want %v", err, domain.ErrActionItemNotFound)
+	}
+}
+
+func TestGetActionItem_EmptyIDs(t *testing.T) {
+	uc := app.NewGetActionItem(newMockRepository())
+
+	if _, err := uc.Execute(context.Background(), app.GetActionItemInput{ActionItemID: "ai-1"}); err != domain.ErrInvalidMeetingID {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
+	}
+	if _, err := uc.Execute(context.Background(), app.GetActionItemInput{MeetingID: "m-1"}); err != domain.ErrInvalidActionItemID {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidActionItemID)
+	}
+}
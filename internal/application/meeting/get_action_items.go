@@ -2,12 +2,27 @@ package meeting
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
+var ErrInvalidCompletionFilter = errors.New("invalid completion filter")
+
+// CompletionFilter selects action items by completion state.
+type CompletionFilter string
+
+const (
+	CompletionAll  CompletionFilter = "all"
+	CompletionOpen CompletionFilter = "open"
+	CompletionDone CompletionFilter = "done"
+)
+
 type GetActionItemsInput struct {
 	MeetingID domain.MeetingID
+	Owner     string           // case-insensitive substring of the owner; empty matches all
+	Completed CompletionFilter // defaults to CompletionAll
 }
 
 type GetActionItemsOutput struct {
@@ -26,11 +41,35 @@ func (uc *GetActionItems) Execute(ctx context.Context, input GetActionItemsInput
 	if input.MeetingID == "" {
 		return nil, domain.ErrInvalidMeetingID
 	}
+	switch input.Completed {
+	case "", CompletionAll, CompletionOpen, CompletionDone:
+	default:
+		return nil, ErrInvalidCompletionFilter
+	}
 
 	items, err := uc.repo.GetActionItems(ctx, input.MeetingID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetActionItemsOutput{Items: items}, nil
+	if input.Owner == "" && (input.Completed == "" || input.Completed == CompletionAll) {
+		return &GetActionItemsOutput{Items: items}, nil
+	}
+
+	owner := strings.ToLower(input.Owner)
+	filtered := make([]*domain.ActionItem, 0, len(items))
+	for _, item := range items {
+		if owner != "" && !strings.Contains(strings.ToLower(item.Owner()), owner) {
+			continue
+		}
+		if input.Completed == CompletionOpen && item.IsCompleted() {
+			continue
+		}
+		if input.Completed == CompletionDone && !item.IsCompleted() {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	return &GetActionItemsOutput{Items: filtered}, nil
 }
@@ -48,3 +48,52 @@ func TestGetActionItems_EmptyMeetingID(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
 	}
 }
+
+func TestGetActionItems_FilterByOwner(t *testing.T) {
+	repo := newMockRepository()
+	alice, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	bob, _ := domain.NewActionItem("ai-2", "m-1", "Bob Smith", "Book venue", nil)
+	bobDone, _ := domain.NewActionItem("ai-3", "m-1", "bob smith", "Send invites", nil)
+	bobDone.Complete()
+	repo.addActionItems("m-1", []*domain.ActionItem{alice, bob, bobDone})
+
+	uc := app.NewGetActionItems(repo)
+	out, err := uc.Execute(context.Background(), app.GetActionItemsInput{MeetingID: "m-1", Owner: "BOB"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Items) != 2 || out.Items[0].ID() != "ai-2" || out.Items[1].ID() != "ai-3" {
+		t.Fatalf("got %d items, want Bob's two", len(out.Items))
+	}
+
+	out, err = uc.Execute(context.Background(), app.GetActionItemsInput{MeetingID: "m-1", Owner: "bob", Completed: app.CompletionOpen})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Items) != 1 || out.Items[0].ID() != "ai-2" {
+		t.Errorf("got %d items, want only Bob's open item", len(out.Items))
+	}
+}
+
+func TestGetActionItems_FilterDone(t *testing.T) {
+	repo := newMockRepository()
+	open, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	done, _ := domain.NewActionItem("ai-2", "m-1", "Alice", "Review PR", nil)
+	done.Complete()
+	repo.addActionItems("m-1", []*domain.ActionItem{open, done})
+
+	out, err := app.NewGetActionItems(repo).Execute(context.Background(), app.GetActionItemsInput{MeetingID: "m-1", Completed: app.CompletionDone})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Items) != 1 || out.Items[0].ID() != "ai-2" {
+		t.Errorf("got %d items, want only the completed one", len(out.Items))
+	}
+}
+
+func TestGetActionItems_InvalidCompletionFilter(t *testing.T) {
+	_, err := app.NewGetActionItems(newMockRepository()).Execute(context.Background(), app.GetActionItemsInput{MeetingID: "m-1", Completed: "maybe"})
+	if err != app.ErrInvalidCompletionFilter {
+		t.Errorf("got error %v, want %v", err, app.ErrInvalidCompletionFilter)
+	}
+}
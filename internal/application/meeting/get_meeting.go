@@ -2,26 +2,40 @@ package meeting
 
 import (
 	"context"
+	"errors"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
 type GetMeetingInput struct {
 	ID domain.MeetingID
+	// IncludeTranscript also fetches the transcript in the same call.
+	IncludeTranscript bool
 }
 
 type GetMeetingOutput struct {
 	Meeting *domain.Meeting
+	// Transcript is nil unless requested and available.
+	Transcript *domain.Transcript
+	// Tags are the meeting's local tags; nil when it has none or no tag
+	// store is configured.
+	Tags []string
 }
 
 type GetMeeting struct {
 	repo domain.Repository
+	tags domain.TagRepository
 }
 
 func NewGetMeeting(repo domain.Repository) *GetMeeting {
 	return &GetMeeting{repo: repo}
 }
 
+// SetTags reports each meeting's local tags in the output.
+func (uc *GetMeeting) SetTags(tags domain.TagRepository) {
+	uc.tags = tags
+}
+
 func (uc *GetMeeting) Execute(ctx context.Context, input GetMeetingInput) (*GetMeetingOutput, error) {
 	if input.ID == "" {
 		return nil, domain.ErrInvalidMeetingID
@@ -32,5 +46,27 @@ func (uc *GetMeeting) Execute(ctx context.Context, input GetMeetingInput) (*GetM
 		return nil, err
 	}
 
-	return &GetMeetingOutput{Meeting: mtg}, nil
+	out := &GetMeetingOutput{Meeting: mtg}
+	if uc.tags != nil {
+		tags, err := uc.tags.TagsOf(ctx, input.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) > 0 {
+			out.Tags = tags
+		}
+	}
+	if input.IncludeTranscript {
+		transcript, err := uc.repo.GetTranscript(ctx, input.ID)
+		switch {
+		case err == nil:
+			out.Transcript = transcript
+		case errors.Is(err, domain.ErrTranscriptNotReady), errors.Is(err, domain.ErrMeetingNotFound):
+			// No transcript yet; return the meeting without it.
+		default:
+			return nil, err
+		}
+	}
+
+	return out, nil
 }
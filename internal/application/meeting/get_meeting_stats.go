@@ -2,6 +2,7 @@ package meeting
 
 import (
 	"context"
+	"errors"
 	"sort"
 	"strings"
 	"time"
@@ -9,10 +10,24 @@ import (
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
+// Stats grouping modes for GetMeetingStatsInput.GroupBy.
+const (
+	StatsGroupNone      = "none"
+	StatsGroupWorkspace = "workspace"
+)
+
+// UnassignedWorkspace is the ByWorkspace key for meetings whose source
+// reported no workspace.
+const UnassignedWorkspace = "unassigned"
+
+var ErrInvalidGroupBy = errors.New("group_by must be none or workspace")
+
 // GetMeetingStatsInput specifies optional date bounds for statistics.
 type GetMeetingStatsInput struct {
 	Since *time.Time
 	Until *time.Time
+	// GroupBy is StatsGroupNone (the default) or StatsGroupWorkspace.
+	GroupBy string
 }
 
 // GetMeetingStatsOutput contains all aggregated meeting statistics.
@@ -27,6 +42,15 @@ type GetMeetingStatsOutput struct {
 	DayOfWeekHeatmap     []HeatmapEntry         `json:"day_of_week_heatmap"`
 	SpeakerTalkTime      []SpeakerEntry         `json:"speaker_talk_time"`
 	SummaryCoverage      SummaryCoverageStats   `json:"summary_coverage"`
+	PeakInsights         *PeakInsights          `json:"peak_insights,omitempty"`
+	// ScanLimited reports that more meetings matched than the scan cap, so
+	// the statistics cover only the first ScanLimit of them.
+	ScanLimited bool `json:"scan_limited,omitempty"`
+	ScanLimit   int  `json:"scan_limit,omitempty"`
+
+	// ByWorkspace holds the same statistics per workspace ID when grouping
+	// by workspace; it is nil otherwise.
+	ByWorkspace map[string]*GetMeetingStatsOutput `json:"by_workspace,omitempty"`
 }
 
 type DateRange struct {
@@ -74,37 +98,86 @@ type SummaryCoverageStats struct {
 	CoverageRate   float64 `json:"coverage_rate"`
 }
 
+// PeakInsights names the busiest weekday, hour of day, and date. Ties go
+// to the earliest: weekdays run Sunday first, as in the heatmap.
+type PeakInsights struct {
+	BusiestDay       string `json:"busiest_day"`
+	BusiestDayCount  int    `json:"busiest_day_count"`
+	BusiestHour      int    `json:"busiest_hour"`
+	BusiestHourCount int    `json:"busiest_hour_count"`
+	BusiestDate      string `json:"busiest_date"`
+	BusiestDateCount int    `json:"busiest_date_count"`
+}
+
 // GetMeetingStats aggregates meeting data into statistics.
 type GetMeetingStats struct {
-	repo domain.Repository
+	repo        domain.Repository
+	maxMeetings int
 }
 
 // NewGetMeetingStats creates a new GetMeetingStats use case.
 func NewGetMeetingStats(repo domain.Repository) *GetMeetingStats {
-	return &GetMeetingStats{repo: repo}
+	return &GetMeetingStats{repo: repo, maxMeetings: DefaultMaxScanMeetings}
+}
+
+// SetMaxMeetings replaces the per-call meeting scan cap,
+// DefaultMaxScanMeetings by default. Zero or negative disables it.
+func (uc *GetMeetingStats) SetMaxMeetings(n int) {
+	uc.maxMeetings = n
 }
 
 const (
-	maxMeetingsForStats = 10000
-	maxParticipants     = 15
-	maxSpeakers         = 15
+	maxParticipants = 15
+	maxSpeakers     = 15
 )
 
 // Execute computes meeting statistics from repository data.
 func (uc *GetMeetingStats) Execute(ctx context.Context, input GetMeetingStatsInput) (*GetMeetingStatsOutput, error) {
+	switch input.GroupBy {
+	case "", StatsGroupNone, StatsGroupWorkspace:
+	default:
+		return nil, ErrInvalidGroupBy
+	}
+
 	filter := domain.ListFilter{
 		Since: input.Since,
 		Until: input.Until,
-		Limit: maxMeetingsForStats,
 	}
-
-	meetings, err := uc.repo.List(ctx, filter)
+	meetings, limited, err := listCapped(ctx, uc.repo, filter, uc.maxMeetings)
 	if err != nil {
 		return nil, err
 	}
 
+	now := time.Now().UTC()
+	transcripts := uc.transcriptLookup(ctx)
+	out := computeStats(meetings, now, transcripts)
+	if limited {
+		out.ScanLimited = true
+		out.ScanLimit = uc.maxMeetings
+	}
+
+	if input.GroupBy == StatsGroupWorkspace {
+		groups := make(map[string][]*domain.Meeting)
+		for _, m := range meetings {
+			key := m.WorkspaceID()
+			if key == "" {
+				key = UnassignedWorkspace
+			}
+			groups[key] = append(groups[key], m)
+		}
+		out.ByWorkspace = make(map[string]*GetMeetingStatsOutput, len(groups))
+		for id, group := range groups {
+			out.ByWorkspace[id] = computeStats(group, now, transcripts)
+		}
+	}
+
+	return out, nil
+}
+
+// computeStats aggregates one set of meetings.
+func computeStats(meetings []*domain.Meeting, now time.Time, transcripts func(domain.MeetingID) *domain.Transcript) *GetMeetingStatsOutput {
 	out := &GetMeetingStatsOutput{
-		GeneratedAt:          time.Now().UTC(),
+		GeneratedAt:          now,
 		TotalMeetings:        len(meetings),
 		MeetingFrequency:     make([]FrequencyEntry, 0),
 		PlatformDistribution: make([]PlatformEntry, 0),
@@ -114,7 +187,7 @@ func (uc *GetMeetingStats) Execute(ctx context.Context, input GetMeetingStatsInp
 	}
 
 	if len(meetings) == 0 {
-		return out, nil
+		return out
 	}
 
 	out.DateRange = computeDateRange(meetings)
@@ -123,10 +196,30 @@ func (uc *GetMeetingStats) Execute(ctx context.Context, input GetMeetingStatsInp
 	out.TopParticipants = computeTopParticipants(meetings)
 	out.ActionItems = computeActionItemStats(meetings)
 	out.DayOfWeekHeatmap = computeHeatmap(meetings)
+	out.PeakInsights = computePeakInsights(meetings)
 	out.SummaryCoverage = computeSummaryCoverage(meetings)
-	out.SpeakerTalkTime = uc.computeSpeakerTalkTime(ctx, meetings)
+	out.SpeakerTalkTime = computeSpeakerTalkTime(meetings, transcripts)
 
-	return out, nil
+	return out
+}
+
+// transcriptLookup returns a memoized transcript fetcher, so grouped stats
+// fetch each transcript once. Missing transcripts are nil. Speakers come
+// as the repository reports them, already under their canonical names, so
+// stats and transcripts agree.
+func (uc *GetMeetingStats) transcriptLookup(ctx context.Context) func(domain.MeetingID) *domain.Transcript {
+	seen := make(map[domain.MeetingID]*domain.Transcript)
+	return func(id domain.MeetingID) *domain.Transcript {
+		if t, ok := seen[id]; ok {
+			return t
+		}
+		t, err := uc.repo.GetTranscript(ctx, id)
+		if err != nil {
+			t = nil
+		}
+		seen[id] = t
+		return t
+	}
 }
 
 func computeDateRange(meetings []*domain.Meeting) DateRange {
@@ -166,7 +259,12 @@ func computeFrequency(meetings []*domain.Meeting) []FrequencyEntry {
 func computePlatformDistribution(meetings []*domain.Meeting) []PlatformEntry {
 	counts := make(map[string]int)
 	for _, m := range meetings {
-		counts[string(m.Source())]++
+		src := m.Source()
+		if !src.IsKnown() {
+			// Legacy cached values predating source normalization.
+			src = domain.SourceUnknown
+		}
+		counts[string(src)]++
 	}
 
 	entries := make([]PlatformEntry, 0, len(counts))
@@ -259,6 +357,36 @@ func computeHeatmap(meetings []*domain.Meeting) []HeatmapEntry {
 	return entries
 }
 
+func computePeakInsights(meetings []*domain.Meeting) *PeakInsights {
+	var days [7]int
+	var hours [24]int
+	dates := make(map[string]int)
+	for _, m := range meetings {
+		dt := m.Datetime()
+		days[dt.Weekday()]++
+		hours[dt.Hour()]++
+		dates[dt.Format("2006-01-02")]++
+	}
+
+	peaks := &PeakInsights{}
+	for day, count := range days {
+		if count > peaks.BusiestDayCount {
+			peaks.BusiestDay, peaks.BusiestDayCount = time.Weekday(day).String(), count
+		}
+	}
+	for hour, count := range hours {
+		if count > peaks.BusiestHourCount {
+			peaks.BusiestHour, peaks.BusiestHourCount = hour, count
+		}
+	}
+	for date, count := range dates {
+		if count > peaks.BusiestDateCount || (count == peaks.BusiestDateCount && date < peaks.BusiestDate) {
+			peaks.BusiestDate, peaks.BusiestDateCount = date, count
+		}
+	}
+	return peaks
+}
+
 func computeSummaryCoverage(meetings []*domain.Meeting) SummaryCoverageStats {
 	var withSummary, withoutSummary int
 	for _, m := range meetings {
@@ -280,7 +408,7 @@ func computeSummaryCoverage(meetings []*domain.Meeting) SummaryCoverageStats {
 	}
 }
 
-func (uc *GetMeetingStats) computeSpeakerTalkTime(ctx context.Context, meetings []*domain.Meeting) []SpeakerEntry {
+func computeSpeakerTalkTime(meetings []*domain.Meeting, transcripts func(domain.MeetingID) *domain.Transcript) []SpeakerEntry {
 	type speakerStats struct {
 		wordCount      int
 		utteranceCount int
@@ -288,8 +416,8 @@ func (uc *GetMeetingStats) computeSpeakerTalkTime(ctx context.Context, meetings
 	stats := make(map[string]*speakerStats)
 
 	for _, m := range meetings {
-		transcript, err := uc.repo.GetTranscript(ctx, m.ID())
-		if err != nil {
+		transcript := transcripts(m.ID())
+		if transcript == nil {
 			continue
 		}
 		for _, u := range transcript.Utterances() {
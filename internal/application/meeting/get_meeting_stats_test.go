@@ -3,6 +3,7 @@ package meeting_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -44,11 +45,43 @@ func TestGetMeetingStats_EmptyRepository(t *testing.T) {
 	if out.SummaryCoverage.WithSummary != 0 {
 		t.Errorf("got %d with summary, want 0", out.SummaryCoverage.WithSummary)
 	}
+	if out.PeakInsights != nil {
+		t.Errorf("got peaks %+v, want none", out.PeakInsights)
+	}
 	if out.GeneratedAt.IsZero() {
 		t.Error("expected GeneratedAt to be set")
 	}
 }
 
+func TestGetMeetingStats_ScanLimited(t *testing.T) {
+	repo := newMockRepository()
+	base := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		id := domain.MeetingID(fmt.Sprintf("m-%d", i))
+		mtg, _ := domain.New(id, "Standup", base.Add(time.Duration(i)*time.Hour), domain.SourceZoom, nil)
+		repo.addMeeting(mtg)
+	}
+
+	uc := app.NewGetMeetingStats(repo)
+	uc.SetMaxMeetings(3)
+	out, err := uc.Execute(context.Background(), app.GetMeetingStatsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.ScanLimited || out.ScanLimit != 3 || out.TotalMeetings != 3 {
+		t.Errorf("got scan_limited=%v limit=%d total=%d, want true, 3, 3", out.ScanLimited, out.ScanLimit, out.TotalMeetings)
+	}
+
+	uc.SetMaxMeetings(5)
+	out, err = uc.Execute(context.Background(), app.GetMeetingStatsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ScanLimited || out.TotalMeetings != 5 {
+		t.Errorf("got scan_limited=%v total=%d, want false, 5", out.ScanLimited, out.TotalMeetings)
+	}
+}
+
 func TestGetMeetingStats_SingleMeeting(t *testing.T) {
 	repo := newMockRepository()
 	now := time.Date(2025, 6, 15, 14, 30, 0, 0, time.UTC)
@@ -171,6 +204,29 @@ func TestGetMeetingStats_PlatformDistribution(t *testing.T) {
 	}
 }
 
+func TestGetMeetingStats_PlatformDistribution_GroupsUnrecognizedAsUnknown(t *testing.T) {
+	repo := newMockRepository()
+
+	for i, src := range []domain.Source{"webex", "", domain.SourceUnknown, domain.SourceZoom} {
+		m, _ := domain.New(domain.MeetingID(fmt.Sprintf("m-%d", i)), "Meeting", time.Now().UTC(), src, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	uc := app.NewGetMeetingStats(repo)
+	out, err := uc.Execute(context.Background(), app.GetMeetingStatsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(out.PlatformDistribution) != 2 {
+		t.Fatalf("got %d platforms, want 2: %+v", len(out.PlatformDistribution), out.PlatformDistribution)
+	}
+	if out.PlatformDistribution[0].Source != "unknown" || out.PlatformDistribution[0].Count != 3 {
+		t.Errorf("got %+v, want unknown=3 first", out.PlatformDistribution[0])
+	}
+}
+
 func TestGetMeetingStats_TopParticipants(t *testing.T) {
 	repo := newMockRepository()
 
@@ -315,6 +371,40 @@ func TestGetMeetingStats_DayOfWeekHeatmap(t *testing.T) {
 	}
 }
 
+func TestGetMeetingStats_PeakInsights(t *testing.T) {
+	repo := newMockRepository()
+	times := []time.Time{
+		time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),   // Monday
+		time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),  // Monday
+		time.Date(2025, 6, 4, 14, 0, 0, 0, time.UTC),  // Wednesday
+		time.Date(2025, 6, 4, 14, 30, 0, 0, time.UTC), // Wednesday
+		time.Date(2025, 6, 11, 10, 0, 0, 0, time.UTC), // Wednesday
+	}
+	for i, dt := range times {
+		m, _ := domain.New(domain.MeetingID(fmt.Sprintf("m-%d", i)), "Meeting", dt, domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	out, err := app.NewGetMeetingStats(repo).Execute(context.Background(), app.GetMeetingStatsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Hours 10 and 14 tie, as do June 2 and June 4; the earliest wins.
+	want := app.PeakInsights{
+		BusiestDay:       "Wednesday",
+		BusiestDayCount:  3,
+		BusiestHour:      10,
+		BusiestHourCount: 2,
+		BusiestDate:      "2025-06-02",
+		BusiestDateCount: 2,
+	}
+	if out.PeakInsights == nil || *out.PeakInsights != want {
+		t.Errorf("got peaks %+v, want %+v", out.PeakInsights, want)
+	}
+}
+
 func TestGetMeetingStats_SpeakerTalkTime(t *testing.T) {
 	repo := newMockRepository()
 
@@ -456,3 +546,58 @@ func TestGetMeetingStats_DateRange(t *testing.T) {
 		t.Errorf("got latest %q, want 2025-06-20", out.DateRange.Latest)
 	}
 }
+
+func TestGetMeetingStats_GroupByWorkspace(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Date(2025, 6, 15, 14, 30, 0, 0, time.UTC)
+	for i, ws := range []string{"ws-a", "ws-a", "ws-b", ""} {
+		m, err := domain.New(domain.MeetingID(fmt.Sprintf("m-%d", i)), "Meeting", now, domain.SourceZoom, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.SetWorkspaceID(ws)
+		repo.addMeeting(m)
+	}
+
+	uc := app.NewGetMeetingStats(repo)
+	out, err := uc.Execute(context.Background(), app.GetMeetingStatsInput{GroupBy: app.StatsGroupWorkspace})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out.TotalMeetings != 4 {
+		t.Errorf("got total %d, want 4", out.TotalMeetings)
+	}
+	want := map[string]int{"ws-a": 2, "ws-b": 1, app.UnassignedWorkspace: 1}
+	if len(out.ByWorkspace) != len(want) {
+		t.Fatalf("got %d workspaces, want %d", len(out.ByWorkspace), len(want))
+	}
+	for id, n := range want {
+		if got := out.ByWorkspace[id]; got == nil || got.TotalMeetings != n {
+			t.Errorf("workspace %q: got %+v, want %d meetings", id, got, n)
+		}
+	}
+}
+
+func TestGetMeetingStats_UngroupedHasNoBreakdown(t *testing.T) {
+	repo := newMockRepository()
+	m, _ := domain.New("m-1", "Meeting", time.Now(), domain.SourceZoom, nil)
+	m.SetWorkspaceID("ws-a")
+	repo.addMeeting(m)
+
+	out, err := app.NewGetMeetingStats(repo).Execute(context.Background(), app.GetMeetingStatsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ByWorkspace != nil {
+		t.Errorf("got breakdown %v, want nil", out.ByWorkspace)
+	}
+}
+
+func TestGetMeetingStats_InvalidGroupBy(t *testing.T) {
+	uc := app.NewGetMeetingStats(newMockRepository())
+	_, err := uc.Execute(context.Background(), app.GetMeetingStatsInput{GroupBy: "participant"})
+	if !errors.Is(err, app.ErrInvalidGroupBy) {
+		t.Errorf("got %v, want %v", err, app.ErrInvalidGroupBy)
+	}
+}
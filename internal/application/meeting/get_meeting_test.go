@@ -3,6 +3,7 @@ package meeting_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	app "github.com/felixgeelhaar/acai/internal/application/meeting"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -26,6 +27,50 @@ func TestGetMeeting_Found(t *testing.T) {
 	}
 }
 
+func TestGetMeeting_IncludeTranscript(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "Sprint Planning"))
+	u := domain.NewUtterance("Alice", "Hello", time.Now().UTC(), 0.9)
+	tr := domain.NewTranscript("m-1", []domain.Utterance{u})
+	repo.addTranscript("m-1", &tr)
+
+	uc := app.NewGetMeeting(repo)
+	out, err := uc.Execute(context.Background(), app.GetMeetingInput{ID: "m-1", IncludeTranscript: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Transcript == nil || len(out.Transcript.Utterances()) != 1 {
+		t.Fatalf("expected transcript with 1 utterance, got %+v", out.Transcript)
+	}
+}
+
+func TestGetMeeting_IncludeTranscript_NotReadyIsOmitted(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "Sprint Planning"))
+
+	uc := app.NewGetMeeting(repo)
+	out, err := uc.Execute(context.Background(), app.GetMeetingInput{ID: "m-1", IncludeTranscript: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Transcript != nil {
+		t.Error("expected transcript to be omitted")
+	}
+}
+
+func TestGetMeeting_DefaultSkipsTranscript(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "Sprint Planning"))
+
+	uc := app.NewGetMeeting(repo)
+	if _, err := uc.Execute(context.Background(), app.GetMeetingInput{ID: "m-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.getTranscriptCalled {
+		t.Error("transcript should not be fetched unless requested")
+	}
+}
+
 func TestGetMeeting_NotFound(t *testing.T) {
 	repo := newMockRepository()
 	uc := app.NewGetMeeting(repo)
@@ -2,35 +2,112 @@ package meeting
 
 import (
 	"context"
+	"errors"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
+var ErrInvalidPagination = errors.New("offset and limit must not be negative")
+
+// DefaultMaxTranscriptUtterances caps how many utterances one call returns,
+// so a pathologically long transcript cannot produce an unbounded response.
+const DefaultMaxTranscriptUtterances = 5000
+
 type GetTranscriptInput struct {
 	MeetingID domain.MeetingID
+	// Offset and Limit page through utterances. A zero Limit returns
+	// every utterance from Offset onwards.
+	Offset int
+	Limit  int
+	// ConfidenceHistogram adds the confidence distribution of the whole
+	// transcript, not just the page, to the output.
+	ConfidenceHistogram bool
+	// KeepSourceOrder returns utterances in the order the repository
+	// gave them instead of sorting them chronologically first.
+	KeepSourceOrder bool
 }
 
 type GetTranscriptOutput struct {
-	Transcript *domain.Transcript
+	// Transcript holds only the requested page of utterances.
+	Transcript      *domain.Transcript
+	TotalUtterances int
+	HasMore         bool
+	// Truncated reports that the page was cut at the utterance cap;
+	// Omitted counts the utterances after it, reachable with a later Offset.
+	Truncated bool
+	Omitted   int
+	// ConfidenceHistogram is set when the input asked for it.
+	ConfidenceHistogram *ConfidenceHistogram
 }
 
 type GetTranscript struct {
-	repo domain.Repository
+	repo          domain.Repository
+	maxUtterances int
 }
 
 func NewGetTranscript(repo domain.Repository) *GetTranscript {
-	return &GetTranscript{repo: repo}
+	return &GetTranscript{repo: repo, maxUtterances: DefaultMaxTranscriptUtterances}
+}
+
+// SetMaxUtterances replaces the per-call utterance cap. Zero or negative
+// disables it.
+func (uc *GetTranscript) SetMaxUtterances(n int) {
+	uc.maxUtterances = n
 }
 
 func (uc *GetTranscript) Execute(ctx context.Context, input GetTranscriptInput) (*GetTranscriptOutput, error) {
 	if input.MeetingID == "" {
 		return nil, domain.ErrInvalidMeetingID
 	}
+	if input.Offset < 0 || input.Limit < 0 {
+		return nil, ErrInvalidPagination
+	}
 
 	t, err := uc.repo.GetTranscript(ctx, input.MeetingID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetTranscriptOutput{Transcript: t}, nil
+	// Pages may arrive out of order from the source; sort before paging so
+	// offsets stay meaningful.
+	if !input.KeepSourceOrder {
+		sorted := t.SortedByTime()
+		t = &sorted
+	}
+
+	// The repository always returns the full transcript; paginate here.
+	utterances := t.Utterances()
+	total := len(utterances)
+	var histogram *ConfidenceHistogram
+	if input.ConfidenceHistogram {
+		histogram = computeConfidenceHistogram(utterances)
+	}
+	capped := uc.maxUtterances > 0 && total-input.Offset > uc.maxUtterances &&
+		(input.Limit == 0 || input.Limit > uc.maxUtterances)
+	if input.Offset == 0 && input.Limit == 0 && !capped {
+		return &GetTranscriptOutput{Transcript: t, TotalUtterances: total, ConfidenceHistogram: histogram}, nil
+	}
+
+	start := min(input.Offset, total)
+	end := total
+	if input.Limit > 0 {
+		end = min(start+input.Limit, total)
+	}
+	if capped {
+		end = start + uc.maxUtterances
+	}
+	page := domain.NewTranscript(t.MeetingID(), utterances[start:end])
+
+	out := &GetTranscriptOutput{
+		Transcript:      &page,
+		TotalUtterances: total,
+		HasMore:         end < total,
+		Truncated:       capped,
+
+		ConfidenceHistogram: histogram,
+	}
+	if capped {
+		out.Omitted = total - end
+	}
+	return out, nil
 }
@@ -45,3 +45,227 @@ func TestGetTranscript_EmptyMeetingID(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
 	}
 }
+
+func TestGetTranscript_Paginated(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "one", now, 0.9),
+		domain.NewUtterance("Bob", "two", now.Add(time.Second), 0.9),
+		domain.NewUtterance("Alice", "three", now.Add(2*time.Second), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	uc := app.NewGetTranscript(repo)
+
+	tests := []struct {
+		name          string
+		offset, limit int
+		wantTexts     []string
+		wantHasMore   bool
+	}{
+		{"no limit returns all", 0, 0, []string{"one", "two", "three"}, false},
+		{"first page", 0, 2, []string{"one", "two"}, true},
+		{"last page", 2, 2, []string{"three"}, false},
+		{"offset only", 1, 0, []string{"two", "three"}, false},
+		{"offset past end", 5, 2, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := uc.Execute(context.Background(), app.GetTranscriptInput{
+				MeetingID: "m-1", Offset: tt.offset, Limit: tt.limit,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.TotalUtterances != 3 {
+				t.Errorf("TotalUtterances = %d, want 3", out.TotalUtterances)
+			}
+			if out.HasMore != tt.wantHasMore {
+				t.Errorf("HasMore = %v, want %v", out.HasMore, tt.wantHasMore)
+			}
+			got := out.Transcript.Utterances()
+			if len(got) != len(tt.wantTexts) {
+				t.Fatalf("got %d utterances, want %d", len(got), len(tt.wantTexts))
+			}
+			for i, u := range got {
+				if u.Text() != tt.wantTexts[i] {
+					t.Errorf("utterance %d = %q, want %q", i, u.Text(), tt.wantTexts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetTranscript_SortsChronologically(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Bob", "three", now.Add(2*time.Second), 0.9),
+		domain.NewUtterance("Alice", "one", now, 0.9),
+		domain.NewUtterance("Bob", "two", now.Add(time.Second), 0.9),
+		domain.NewUtterance("Alice", "also two", now.Add(time.Second), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	uc := app.NewGetTranscript(repo)
+
+	tests := []struct {
+		name      string
+		input     app.GetTranscriptInput
+		wantTexts []string
+	}{
+		{"sorted by default", app.GetTranscriptInput{MeetingID: "m-1"}, []string{"one", "two", "also two", "three"}},
+		{"sorted before paging", app.GetTranscriptInput{MeetingID: "m-1", Limit: 2}, []string{"one", "two"}},
+		{"source order kept", app.GetTranscriptInput{MeetingID: "m-1", KeepSourceOrder: true}, []string{"three", "one", "two", "also two"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := uc.Execute(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := out.Transcript.Utterances()
+			if len(got) != len(tt.wantTexts) {
+				t.Fatalf("got %d utterances, want %d", len(got), len(tt.wantTexts))
+			}
+			for i, u := range got {
+				if u.Text() != tt.wantTexts[i] {
+					t.Errorf("utterance %d = %q, want %q", i, u.Text(), tt.wantTexts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetTranscript_CapTruncates(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+	utterances := make([]domain.Utterance, 5)
+	for i := range utterances {
+		utterances[i] = domain.NewUtterance("Alice", "line", now.Add(time.Duration(i)*time.Second), 0.9)
+	}
+	transcript := domain.NewTranscript("m-1", utterances)
+	repo.addTranscript("m-1", &transcript)
+
+	uc := app.NewGetTranscript(repo)
+	uc.SetMaxUtterances(2)
+
+	out, err := uc.Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Transcript.Utterances()) != 2 {
+		t.Errorf("got %d utterances, want 2", len(out.Transcript.Utterances()))
+	}
+	if !out.Truncated || out.Omitted != 3 || !out.HasMore || out.TotalUtterances != 5 {
+		t.Errorf("got truncated=%v omitted=%d has_more=%v total=%d, want true/3/true/5",
+			out.Truncated, out.Omitted, out.HasMore, out.TotalUtterances)
+	}
+
+	// A later page stays reachable through the offset.
+	out, err = uc.Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1", Offset: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Transcript.Utterances()) != 1 || out.Truncated {
+		t.Errorf("got %d utterances (truncated=%v), want 1 untruncated", len(out.Transcript.Utterances()), out.Truncated)
+	}
+
+	// A client limit under the cap is not a truncation.
+	out, err = uc.Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1", Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Truncated {
+		t.Error("limit below the cap should not be flagged as truncated")
+	}
+}
+
+func TestGetTranscript_DefaultCap(t *testing.T) {
+	repo := newMockRepository()
+	utterances := make([]domain.Utterance, app.DefaultMaxTranscriptUtterances+1)
+	for i := range utterances {
+		utterances[i] = domain.NewUtterance("Alice", "line", time.Now().UTC(), 0.9)
+	}
+	transcript := domain.NewTranscript("m-1", utterances)
+	repo.addTranscript("m-1", &transcript)
+
+	out, err := app.NewGetTranscript(repo).Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.Truncated || out.Omitted != 1 {
+		t.Errorf("got truncated=%v omitted=%d, want true/1", out.Truncated, out.Omitted)
+	}
+}
+
+func TestGetTranscript_NegativePagination(t *testing.T) {
+	uc := app.NewGetTranscript(newMockRepository())
+
+	_, err := uc.Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1", Limit: -1})
+	if err != app.ErrInvalidPagination {
+		t.Errorf("got error %v, want %v", err, app.ErrInvalidPagination)
+	}
+}
+
+func TestGetTranscript_ConfidenceHistogram(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+	confidences := []float64{0.2, 0.49, 0.5, 0.69, 0.7, 0.75, 0.89, 0.9, 1.0, 0}
+	utterances := make([]domain.Utterance, len(confidences))
+	for i, c := range confidences {
+		utterances[i] = domain.NewUtterance("Alice", "line", now.Add(time.Duration(i)*time.Second), c)
+	}
+	transcript := domain.NewTranscript("m-1", utterances)
+	repo.addTranscript("m-1", &transcript)
+
+	uc := app.NewGetTranscript(repo)
+	out, err := uc.Execute(context.Background(), app.GetTranscriptInput{
+		MeetingID: "m-1", Limit: 2, ConfidenceHistogram: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h := out.ConfidenceHistogram
+	if h == nil {
+		t.Fatal("expected a confidence histogram")
+	}
+	want := map[string]int{"0-0.5": 2, "0.5-0.7": 2, "0.7-0.9": 3, "0.9-1.0": 2}
+	for _, b := range h.Buckets {
+		if b.Count != want[b.Range] {
+			t.Errorf("bucket %s: got %d, want %d", b.Range, b.Count, want[b.Range])
+		}
+	}
+	if len(h.Buckets) != len(want) {
+		t.Errorf("got %d buckets, want %d", len(h.Buckets), len(want))
+	}
+	if h.Scored != 9 || h.Unscored != 1 || !h.HasScores {
+		t.Errorf("got scored=%d unscored=%d has_scores=%v, want 9, 1, true", h.Scored, h.Unscored, h.HasScores)
+	}
+}
+
+func TestGetTranscript_ConfidenceHistogramWithoutScores(t *testing.T) {
+	repo := newMockRepository()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "one", time.Now().UTC(), 0),
+		domain.NewUtterance("Bob", "two", time.Now().UTC(), 0),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	uc := app.NewGetTranscript(repo)
+	out, err := uc.Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1", ConfidenceHistogram: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h := out.ConfidenceHistogram
+	if h == nil || h.HasScores || h.Unscored != 2 || h.Scored != 0 {
+		t.Fatalf("got %+v, want 2 unscored and no scores", h)
+	}
+
+	out, err = uc.Execute(context.Background(), app.GetTranscriptInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ConfidenceHistogram != nil {
+		t.Error("histogram should only be computed when requested")
+	}
+}
@@ -2,11 +2,32 @@ package meeting
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
+var (
+	ErrInvalidSortOrder = errors.New("invalid sort order")
+	// ErrTagsUnavailable is returned for a tag filter when no tag store
+	// is configured.
+	ErrTagsUnavailable = errors.New("meeting tags are not configured")
+)
+
+// SortOrder controls the order of ListMeetings results.
+type SortOrder string
+
+const (
+	SortDateDesc SortOrder = "date_desc"
+	SortDateAsc  SortOrder = "date_asc"
+	SortTitle    SortOrder = "title"
+)
+
 type ListMeetingsInput struct {
 	Since       *time.Time
 	Until       *time.Time
@@ -15,22 +36,47 @@ type ListMeetingsInput struct {
 	Query       *string
 	Limit       int
 	Offset      int
+	SortBy      SortOrder // defaults to SortDateDesc
+	// Tags keeps only meetings carrying every one of these local tags.
+	Tags []string
+	// CountOnly counts every meeting matching the filters, ignoring Limit,
+	// Offset, and SortBy, and leaves Meetings empty.
+	CountOnly bool
 }
 
 type ListMeetingsOutput struct {
 	Meetings []*domain.Meeting
-	Total    int
+	// Total is the number of meetings returned, or with CountOnly the
+	// number matching.
+	Total int
+	// Partial is set when the deadline ended the fetch early and Meetings
+	// holds only what arrived before it.
+	Partial bool
+	// Tags holds the local tags of the listed meetings that have any.
+	// It is nil when no tag store is configured.
+	Tags map[domain.MeetingID][]string
 }
 
 type ListMeetings struct {
 	repo domain.Repository
+	tags domain.TagRepository
 }
 
 func NewListMeetings(repo domain.Repository) *ListMeetings {
 	return &ListMeetings{repo: repo}
 }
 
+// SetTags enables the Tags filter and reports each meeting's tags.
+func (uc *ListMeetings) SetTags(tags domain.TagRepository) {
+	uc.tags = tags
+}
+
 func (uc *ListMeetings) Execute(ctx context.Context, input ListMeetingsInput) (*ListMeetingsOutput, error) {
+	less, err := sortLess(input.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
 	filter := domain.ListFilter{
 		Since:       input.Since,
 		Until:       input.Until,
@@ -45,13 +91,123 @@ func (uc *ListMeetings) Execute(ctx context.Context, input ListMeetingsInput) (*
 		filter.Source = &src
 	}
 
-	meetings, err := uc.repo.List(ctx, filter)
+	wanted, err := normalizeTags(input.Tags)
 	if err != nil {
 		return nil, err
 	}
+	if len(wanted) > 0 && uc.tags == nil {
+		return nil, ErrTagsUnavailable
+	}
+
+	var tags map[domain.MeetingID][]string
+	if uc.tags != nil {
+		if tags, err = uc.tags.ListTags(ctx); err != nil {
+			return nil, fmt.Errorf("load meeting tags: %w", err)
+		}
+	}
 
-	return &ListMeetingsOutput{
+	// The repository pages in Granola's order, newest first, and knows
+	// nothing of local tags. A tag filter or any other order would only
+	// rearrange one page, so fetch every match and page after filtering
+	// and sorting. The repository has no count query either, so counting
+	// fetches every match too.
+	pageLocally := len(wanted) > 0 || (input.SortBy != "" && input.SortBy != SortDateDesc)
+	if pageLocally || input.CountOnly {
+		filter.Limit, filter.Offset = 0, 0
+	}
+
+	meetings, err := uc.repo.List(ctx, filter)
+	partial := errors.Is(err, domain.ErrPartialResults)
+	if err != nil && !partial {
+		return nil, err
+	}
+
+	if len(wanted) > 0 {
+		meetings = filterByTags(meetings, tags, wanted)
+	}
+	if input.CountOnly {
+		return &ListMeetingsOutput{Meetings: []*domain.Meeting{}, Total: len(meetings), Partial: partial}, nil
+	}
+
+	// Sort here rather than trusting the repository, whose order
+	// (e.g. cache map iteration) is not guaranteed.
+	sort.SliceStable(meetings, func(i, j int) bool {
+		return less(meetings[i], meetings[j])
+	})
+
+	if pageLocally {
+		meetings = paginate(meetings, input.Offset, input.Limit)
+	}
+
+	out := &ListMeetingsOutput{
 		Meetings: meetings,
 		Total:    len(meetings),
-	}, nil
+		Partial:  partial,
+	}
+	if tags != nil {
+		out.Tags = make(map[domain.MeetingID][]string)
+		for _, m := range meetings {
+			if t, ok := tags[m.ID()]; ok {
+				out.Tags[m.ID()] = t
+			}
+		}
+	}
+	return out, nil
+}
+
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		t, err := domain.NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, t)
+	}
+	return normalized, nil
+}
+
+func filterByTags(meetings []*domain.Meeting, tags map[domain.MeetingID][]string, wanted []string) []*domain.Meeting {
+	kept := make([]*domain.Meeting, 0, len(meetings))
+	for _, m := range meetings {
+		if hasAllTags(tags[m.ID()], wanted) {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+func hasAllTags(have, wanted []string) bool {
+	for _, w := range wanted {
+		if !slices.Contains(have, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func paginate(meetings []*domain.Meeting, offset, limit int) []*domain.Meeting {
+	if offset >= len(meetings) {
+		return []*domain.Meeting{}
+	}
+	meetings = meetings[offset:]
+	if limit > 0 && limit < len(meetings) {
+		meetings = meetings[:limit]
+	}
+	return meetings
+}
+
+func sortLess(order SortOrder) (func(a, b *domain.Meeting) bool, error) {
+	switch order {
+	case "", SortDateDesc:
+		return func(a, b *domain.Meeting) bool { return a.Datetime().After(b.Datetime()) }, nil
+	case SortDateAsc:
+		return func(a, b *domain.Meeting) bool { return a.Datetime().Before(b.Datetime()) }, nil
+	case SortTitle:
+		return func(a, b *domain.Meeting) bool {
+			return strings.ToLower(a.Title()) < strings.ToLower(b.Title())
+		}, nil
+	default:
+		return nil, ErrInvalidSortOrder
+	}
 }
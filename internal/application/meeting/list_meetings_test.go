@@ -2,6 +2,8 @@ package meeting_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -73,6 +75,21 @@ func TestListMeetings_EmptyResult(t *testing.T) {
 	}
 }
 
+func TestListMeetings_PartialResults(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "Sprint Planning"))
+	repo.listErr = fmt.Errorf("%w: %w", domain.ErrPartialResults, context.DeadlineExceeded)
+
+	uc := app.NewListMeetings(repo)
+	out, err := uc.Execute(context.Background(), app.ListMeetingsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.Partial || len(out.Meetings) != 1 {
+		t.Errorf("got partial=%v with %d meetings, want partial with 1", out.Partial, len(out.Meetings))
+	}
+}
+
 func mustNewMeeting(t *testing.T, id domain.MeetingID, title string) *domain.Meeting {
 	t.Helper()
 	m, err := domain.New(id, title, time.Now().UTC(), domain.SourceZoom, nil)
@@ -82,3 +99,175 @@ func mustNewMeeting(t *testing.T, id domain.MeetingID, title string) *domain.Mee
 	m.ClearDomainEvents()
 	return m
 }
+
+func TestListMeetings_DefaultSortIsDateDesc(t *testing.T) {
+	repo := newMockRepository()
+	base := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, id := range []domain.MeetingID{"m-2", "m-3", "m-1"} {
+		m, err := domain.New(id, string(id), base.Add(time.Duration(i)*time.Hour), domain.SourceZoom, nil)
+		if err != nil {
+			t.Fatalf("failed to create meeting: %v", err)
+		}
+		repo.addMeeting(m)
+	}
+	// m-2 @ 09:00, m-3 @ 10:00, m-1 @ 11:00
+
+	uc := app.NewListMeetings(repo)
+	out, err := uc.Execute(context.Background(), app.ListMeetingsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []domain.MeetingID{"m-1", "m-3", "m-2"}
+	for i, m := range out.Meetings {
+		if m.ID() != want[i] {
+			t.Errorf("position %d: got %s, want %s", i, m.ID(), want[i])
+		}
+	}
+}
+
+func TestListMeetings_SortByTitle(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "retro"))
+	repo.addMeeting(mustNewMeeting(t, "m-2", "Planning"))
+	repo.addMeeting(mustNewMeeting(t, "m-3", "all hands"))
+
+	uc := app.NewListMeetings(repo)
+	out, err := uc.Execute(context.Background(), app.ListMeetingsInput{SortBy: app.SortTitle})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"all hands", "Planning", "retro"}
+	for i, m := range out.Meetings {
+		if m.Title() != want[i] {
+			t.Errorf("position %d: got %q, want %q", i, m.Title(), want[i])
+		}
+	}
+}
+
+func TestListMeetings_SortPagesAfterSorting(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "retro"))
+	repo.addMeeting(mustNewMeeting(t, "m-2", "Planning"))
+	repo.addMeeting(mustNewMeeting(t, "m-3", "all hands"))
+
+	uc := app.NewListMeetings(repo)
+	out, err := uc.Execute(context.Background(), app.ListMeetingsInput{SortBy: app.SortTitle, Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.listFilter.Limit != 0 || repo.listFilter.Offset != 0 {
+		t.Errorf("title sort should page locally, repository got limit %d offset %d", repo.listFilter.Limit, repo.listFilter.Offset)
+	}
+	if len(out.Meetings) != 1 || out.Meetings[0].Title() != "Planning" {
+		t.Errorf("got %v, want the second meeting by title", out.Meetings)
+	}
+
+	if _, err := uc.Execute(context.Background(), app.ListMeetingsInput{Limit: 2, Offset: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.listFilter.Limit != 2 || repo.listFilter.Offset != 1 {
+		t.Errorf("default order should page at the repository, got limit %d offset %d", repo.listFilter.Limit, repo.listFilter.Offset)
+	}
+}
+
+func TestListMeetings_InvalidSortOrder(t *testing.T) {
+	uc := app.NewListMeetings(newMockRepository())
+	_, err := uc.Execute(context.Background(), app.ListMeetingsInput{SortBy: "newest"})
+	if !errors.Is(err, app.ErrInvalidSortOrder) {
+		t.Errorf("got error %v, want %v", err, app.ErrInvalidSortOrder)
+	}
+}
+
+func TestListMeetings_FiltersByTags(t *testing.T) {
+	repo := newMockRepository()
+	for _, id := range []domain.MeetingID{"m-1", "m-2", "m-3"} {
+		repo.addMeeting(mustNewMeeting(t, id, "Meeting "+string(id)))
+	}
+	tags := newMockTagRepository()
+	ctx := context.Background()
+	_ = tags.AddTag(ctx, "m-1", "planning")
+	_ = tags.AddTag(ctx, "m-1", "1:1")
+	_ = tags.AddTag(ctx, "m-2", "planning")
+	_ = tags.AddTag(ctx, "m-3", "1:1")
+
+	uc := app.NewListMeetings(repo)
+	uc.SetTags(tags)
+
+	out, err := uc.Execute(ctx, app.ListMeetingsInput{Tags: []string{"Planning"}, SortBy: app.SortTitle, Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.listFilter.Limit != 0 || repo.listFilter.Offset != 0 {
+		t.Errorf("tag filter should page locally, repository got limit %d offset %d", repo.listFilter.Limit, repo.listFilter.Offset)
+	}
+	if len(out.Meetings) != 1 || out.Meetings[0].ID() != "m-2" {
+		t.Fatalf("got %v, want [m-2]", out.Meetings)
+	}
+	if got := out.Tags["m-2"]; len(got) != 1 || got[0] != "planning" {
+		t.Errorf("tags of m-2: got %v", got)
+	}
+
+	out, err = uc.Execute(ctx, app.ListMeetingsInput{Tags: []string{"planning", "1:1"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Meetings) != 1 || out.Meetings[0].ID() != "m-1" {
+		t.Errorf("every tag must match: got %v, want [m-1]", out.Meetings)
+	}
+}
+
+func TestListMeetings_CountOnly(t *testing.T) {
+	repo := newMockRepository()
+	for _, id := range []domain.MeetingID{"m-1", "m-2", "m-3"} {
+		repo.addMeeting(mustNewMeeting(t, id, "Meeting "+string(id)))
+	}
+	tags := newMockTagRepository()
+	ctx := context.Background()
+	_ = tags.AddTag(ctx, "m-1", "planning")
+	_ = tags.AddTag(ctx, "m-3", "planning")
+
+	uc := app.NewListMeetings(repo)
+	uc.SetTags(tags)
+
+	tests := []struct {
+		name  string
+		input app.ListMeetingsInput
+		want  int
+	}{
+		{"all meetings, limit ignored", app.ListMeetingsInput{CountOnly: true, Limit: 1, Offset: 2}, 3},
+		{"tag filter", app.ListMeetingsInput{CountOnly: true, Tags: []string{"planning"}}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := uc.Execute(ctx, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Total != tt.want || len(out.Meetings) != 0 {
+				t.Errorf("got total %d with %d meetings, want %d and none", out.Total, len(out.Meetings), tt.want)
+			}
+			if repo.listFilter.Limit != 0 || repo.listFilter.Offset != 0 {
+				t.Errorf("count should fetch every match, repository got limit %d offset %d", repo.listFilter.Limit, repo.listFilter.Offset)
+			}
+
+			tt.input.CountOnly, tt.input.Limit, tt.input.Offset = false, 0, 0
+			listed, err := uc.Execute(ctx, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(listed.Meetings) != out.Total {
+				t.Errorf("count %d does not match the %d listed meetings", out.Total, len(listed.Meetings))
+			}
+		})
+	}
+}
+
+func TestListMeetings_TagsWithoutStore(t *testing.T) {
+	uc := app.NewListMeetings(newMockRepository())
+	_, err := uc.Execute(context.Background(), app.ListMeetingsInput{Tags: []string{"planning"}})
+	if !errors.Is(err, app.ErrTagsUnavailable) {
+		t.Errorf("got %v, want %v", err, app.ErrTagsUnavailable)
+	}
+}
@@ -0,0 +1,129 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// Timeline granularities for MeetingTimelineInput.Granularity.
+const (
+	TimelineDay   = "day"
+	TimelineWeek  = "week"
+	TimelineMonth = "month"
+)
+
+var ErrInvalidGranularity = errors.New("granularity must be day, week, or month")
+
+type MeetingTimelineInput struct {
+	Since *time.Time
+	Until *time.Time
+	// Granularity is TimelineDay, TimelineWeek (the default), or
+	// TimelineMonth.
+	Granularity string
+}
+
+// TimelinePeriod counts the meetings starting in one period. Period is
+// the period's first day as YYYY-MM-DD; weeks start on Monday.
+type TimelinePeriod struct {
+	Period string
+	Count  int
+}
+
+type MeetingTimelineOutput struct {
+	// Periods run oldest first from the first to the last period with a
+	// meeting, including empty periods in between.
+	Periods []TimelinePeriod
+	// ScanLimited reports that more meetings matched than the scan cap, so
+	// the result covers only the first ScanLimit of them.
+	ScanLimited bool
+	ScanLimit   int
+}
+
+// MeetingTimeline counts meetings per day, week, or month: the
+// meeting_frequency of GetMeetingStats without the rest of the stats.
+type MeetingTimeline struct {
+	repo        domain.Repository
+	maxMeetings int
+}
+
+func NewMeetingTimeline(repo domain.Repository) *MeetingTimeline {
+	return &MeetingTimeline{repo: repo, maxMeetings: DefaultMaxScanMeetings}
+}
+
+// SetMaxMeetings replaces the per-call meeting scan cap,
+// DefaultMaxScanMeetings by default. Zero or negative disables it.
+func (uc *MeetingTimeline) SetMaxMeetings(n int) {
+	uc.maxMeetings = n
+}
+
+func (uc *MeetingTimeline) Execute(ctx context.Context, input MeetingTimelineInput) (*MeetingTimelineOutput, error) {
+	granularity := input.Granularity
+	if granularity == "" {
+		granularity = TimelineWeek
+	}
+	var next func(time.Time) time.Time
+	switch granularity {
+	case TimelineDay:
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case TimelineWeek:
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case TimelineMonth:
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	default:
+		return nil, ErrInvalidGranularity
+	}
+
+	meetings, limited, err := listCapped(ctx, uc.repo, domain.ListFilter{
+		Since: input.Since,
+		Until: input.Until,
+	}, uc.maxMeetings)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &MeetingTimelineOutput{Periods: make([]TimelinePeriod, 0)}
+	if limited {
+		out.ScanLimited = true
+		out.ScanLimit = uc.maxMeetings
+	}
+	if len(meetings) == 0 {
+		return out, nil
+	}
+
+	counts := make(map[string]int)
+	var first, last time.Time
+	for _, m := range meetings {
+		start := periodStart(m.Datetime(), granularity)
+		counts[start.Format("2006-01-02")]++
+		if first.IsZero() || start.Before(first) {
+			first = start
+		}
+		if start.After(last) {
+			last = start
+		}
+	}
+
+	for p := first; !p.After(last); p = next(p) {
+		period := p.Format("2006-01-02")
+		out.Periods = append(out.Periods, TimelinePeriod{Period: period, Count: counts[period]})
+	}
+	return out, nil
+}
+
+// periodStart truncates t to midnight on the first day of its period, in
+// t's own location.
+func periodStart(t time.Time, granularity string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch granularity {
+	case TimelineWeek:
+		// Weekday counts from Sunday; shift so Monday starts the week.
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case TimelineMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
@@ -0,0 +1,114 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestMeetingTimeline_WeeklyBucketsAcrossAMonth(t *testing.T) {
+	repo := newMockRepository()
+	// June 2025: the 2nd, 9th, 16th, 23rd, and 30th are Mondays.
+	for i, day := range []int{2, 4, 8, 9, 20, 22, 30} {
+		id := domain.MeetingID("m-" + string(rune('a'+i)))
+		m, _ := domain.New(id, "Meeting", time.Date(2025, 6, day, 15, 0, 0, 0, time.UTC), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	out, err := app.NewMeetingTimeline(repo).Execute(context.Background(), app.MeetingTimelineInput{Granularity: app.TimelineWeek})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []app.TimelinePeriod{
+		{Period: "2025-06-02", Count: 3}, // 2nd, 4th, and Sunday the 8th
+		{Period: "2025-06-09", Count: 1},
+		{Period: "2025-06-16", Count: 2}, // 20th and Sunday the 22nd
+		{Period: "2025-06-23", Count: 0},
+		{Period: "2025-06-30", Count: 1},
+	}
+	if len(out.Periods) != len(want) {
+		t.Fatalf("got %+v, want %+v", out.Periods, want)
+	}
+	for i := range want {
+		if out.Periods[i] != want[i] {
+			t.Errorf("period %d: got %+v, want %+v", i, out.Periods[i], want[i])
+		}
+	}
+}
+
+func TestMeetingTimeline_MonthlyAndDaily(t *testing.T) {
+	repo := newMockRepository()
+	for i, dt := range []time.Time{
+		time.Date(2025, 5, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 6, 1, 14, 0, 0, 0, time.UTC),
+	} {
+		m, _ := domain.New(domain.MeetingID("m-"+string(rune('a'+i))), "Meeting", dt, domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+	uc := app.NewMeetingTimeline(repo)
+
+	out, err := uc.Execute(context.Background(), app.MeetingTimelineInput{Granularity: app.TimelineMonth})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Periods) != 2 || out.Periods[0] != (app.TimelinePeriod{Period: "2025-05-01", Count: 1}) || out.Periods[1] != (app.TimelinePeriod{Period: "2025-06-01", Count: 2}) {
+		t.Errorf("got monthly %+v", out.Periods)
+	}
+
+	out, err = uc.Execute(context.Background(), app.MeetingTimelineInput{Granularity: app.TimelineDay})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Periods) != 2 || out.Periods[1] != (app.TimelinePeriod{Period: "2025-06-01", Count: 2}) {
+		t.Errorf("got daily %+v", out.Periods)
+	}
+
+	if _, err := uc.Execute(context.Background(), app.MeetingTimelineInput{Granularity: "year"}); !errors.Is(err, app.ErrInvalidGranularity) {
+		t.Errorf("got error %v, want %v", err, app.ErrInvalidGranularity)
+	}
+}
+
+func TestMeetingTimeline_NoMeetings(t *testing.T) {
+	out, err := app.NewMeetingTimeline(newMockRepository()).Execute(context.Background(), app.MeetingTimelineInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Periods == nil || len(out.Periods) != 0 {
+		t.Errorf("got %+v, want an empty list", out.Periods)
+	}
+}
+
+func TestMeetingTimeline_ScanLimited(t *testing.T) {
+	repo := newMockRepository()
+	for i := 0; i < 4; i++ {
+		m, _ := domain.New(domain.MeetingID("m-"+string(rune('a'+i))), "Meeting", time.Date(2025, 6, 2+i, 10, 0, 0, 0, time.UTC), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	uc := app.NewMeetingTimeline(repo)
+	uc.SetMaxMeetings(3)
+	out, err := uc.Execute(context.Background(), app.MeetingTimelineInput{Granularity: app.TimelineMonth})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.ScanLimited || out.ScanLimit != 3 || out.Periods[0].Count != 3 {
+		t.Errorf("got scan_limited=%v limit=%d periods=%+v, want true, 3, and 3 meetings counted", out.ScanLimited, out.ScanLimit, out.Periods)
+	}
+
+	uc.SetMaxMeetings(4)
+	out, err = uc.Execute(context.Background(), app.MeetingTimelineInput{Granularity: app.TimelineMonth})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ScanLimited || out.Periods[0].Count != 4 {
+		t.Errorf("got scan_limited=%v periods=%+v, want false and 4 meetings counted", out.ScanLimited, out.Periods)
+	}
+}
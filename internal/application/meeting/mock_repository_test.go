@@ -2,28 +2,39 @@ package meeting_test
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
 // mockRepository is a test double implementing domain.Repository.
 // It allows tests to control returned data and verify interactions.
 type mockRepository struct {
+	// mu guards the lookups use cases run concurrently.
+	mu sync.Mutex
+
 	meetings    map[domain.MeetingID]*domain.Meeting
 	transcripts map[domain.MeetingID]*domain.Transcript
 	actionItems map[domain.MeetingID][]*domain.ActionItem
+	// actionItemsErr fails GetActionItems for the listed meetings.
+	actionItemsErr map[domain.MeetingID]error
 
 	findByIDCalled       bool
 	listCalled           bool
 	getTranscriptCalled  bool
 	searchCalled         bool
 	getActionItemsCalled bool
+	getActionItemsCalls  int
 	syncCalled           bool
 
 	listFilter *domain.ListFilter
 	syncSince  *time.Time
 	syncEvents []domain.DomainEvent
+	syncErrors []domain.SyncError
 	syncErr    error
 	listErr    error
 }
@@ -37,6 +48,8 @@ func newMockRepository() *mockRepository {
 }
 
 func (m *mockRepository) FindByID(_ context.Context, id domain.MeetingID) (*domain.Meeting, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.findByIDCalled = true
 	mtg, ok := m.meetings[id]
 	if !ok {
@@ -49,7 +62,9 @@ func (m *mockRepository) List(_ context.Context, filter domain.ListFilter) ([]*d
 	m.listCalled = true
 	m.listFilter = &filter
 
-	if m.listErr != nil {
+	// A partial-results error comes with the meetings, like a List cut
+	// short by its deadline.
+	if m.listErr != nil && !errors.Is(m.listErr, domain.ErrPartialResults) {
 		return nil, m.listErr
 	}
 
@@ -61,10 +76,12 @@ func (m *mockRepository) List(_ context.Context, filter domain.ListFilter) ([]*d
 	if filter.Limit > 0 && len(result) > filter.Limit {
 		result = result[:filter.Limit]
 	}
-	return result, nil
+	return result, m.listErr
 }
 
 func (m *mockRepository) GetTranscript(_ context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.getTranscriptCalled = true
 	t, ok := m.transcripts[id]
 	if !ok {
@@ -83,7 +100,13 @@ func (m *mockRepository) SearchTranscripts(_ context.Context, query string, filt
 }
 
 func (m *mockRepository) GetActionItems(_ context.Context, id domain.MeetingID) ([]*domain.ActionItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.getActionItemsCalled = true
+	m.getActionItemsCalls++
+	if err := m.actionItemsErr[id]; err != nil {
+		return nil, err
+	}
 	items, ok := m.actionItems[id]
 	if !ok {
 		return []*domain.ActionItem{}, nil
@@ -91,13 +114,13 @@ func (m *mockRepository) GetActionItems(_ context.Context, id domain.MeetingID)
 	return items, nil
 }
 
-func (m *mockRepository) Sync(_ context.Context, since *time.Time) ([]domain.DomainEvent, error) {
+func (m *mockRepository) Sync(_ context.Context, since *time.Time) (*domain.SyncResult, error) {
 	m.syncCalled = true
 	m.syncSince = since
 	if m.syncErr != nil {
 		return nil, m.syncErr
 	}
-	return m.syncEvents, nil
+	return &domain.SyncResult{Events: m.syncEvents, Errors: m.syncErrors}, nil
 }
 
 func (m *mockRepository) addMeeting(mtg *domain.Meeting) {
@@ -137,9 +160,95 @@ func (m *mockWriteRepository) GetLocalActionItemState(_ context.Context, id doma
 // mockDispatcher captures dispatched events.
 type mockDispatcher struct {
 	events []domain.DomainEvent
+	calls  int
 }
 
 func (m *mockDispatcher) Dispatch(_ context.Context, events []domain.DomainEvent) error {
+	m.calls++
 	m.events = append(m.events, events...)
 	return nil
 }
+
+// mockAuditLogger captures recorded audit entries.
+type mockAuditLogger struct {
+	entries []audit.Entry
+}
+
+func (m *mockAuditLogger) Record(_ context.Context, entry audit.Entry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+// mockAliasRepository implements domain.SpeakerAliasRepository for tests.
+type mockAliasRepository struct {
+	aliases map[string]string
+	listErr error
+}
+
+func newMockAliasRepository() *mockAliasRepository {
+	return &mockAliasRepository{aliases: make(map[string]string)}
+}
+
+func (m *mockAliasRepository) SaveSpeakerAlias(_ context.Context, alias, canonical string) error {
+	m.aliases[alias] = canonical
+	return nil
+}
+
+func (m *mockAliasRepository) DeleteSpeakerAlias(_ context.Context, alias string) error {
+	delete(m.aliases, alias)
+	return nil
+}
+
+func (m *mockAliasRepository) ListSpeakerAliases(_ context.Context) (map[string]string, error) {
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	return m.aliases, nil
+}
+
+// mockSyncState is an in-memory sync high-water mark.
+type mockSyncState struct {
+	mark *time.Time
+}
+
+func (m *mockSyncState) LastSyncedAt(_ context.Context) (*time.Time, error) {
+	return m.mark, nil
+}
+
+func (m *mockSyncState) SaveLastSyncedAt(_ context.Context, t time.Time) error {
+	m.mark = &t
+	return nil
+}
+
+// mockTagRepository implements domain.TagRepository for tests.
+type mockTagRepository struct {
+	tags map[domain.MeetingID][]string
+}
+
+func newMockTagRepository() *mockTagRepository {
+	return &mockTagRepository{tags: make(map[domain.MeetingID][]string)}
+}
+
+func (m *mockTagRepository) AddTag(_ context.Context, id domain.MeetingID, tag string) error {
+	if !slices.Contains(m.tags[id], tag) {
+		m.tags[id] = append(m.tags[id], tag)
+		slices.Sort(m.tags[id])
+	}
+	return nil
+}
+
+func (m *mockTagRepository) RemoveTag(_ context.Context, id domain.MeetingID, tag string) error {
+	m.tags[id] = slices.DeleteFunc(m.tags[id], func(t string) bool { return t == tag })
+	if len(m.tags[id]) == 0 {
+		delete(m.tags, id)
+	}
+	return nil
+}
+
+func (m *mockTagRepository) TagsOf(_ context.Context, id domain.MeetingID) ([]string, error) {
+	return append([]string{}, m.tags[id]...), nil
+}
+
+func (m *mockTagRepository) ListTags(_ context.Context) (map[domain.MeetingID][]string, error) {
+	return m.tags, nil
+}
@@ -0,0 +1,112 @@
+package meeting
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+type OverdueActionItemsInput struct {
+	// Since and Until bound the meeting dates scanned.
+	Since *time.Time
+	Until *time.Time
+	Owner string // case-insensitive substring of the owner; empty matches all
+}
+
+// OverdueActionItem is an open action item whose due date has passed.
+// DaysOverdue counts whole days since the due date, so an item due
+// earlier today is 0 days overdue.
+type OverdueActionItem struct {
+	Item        *domain.ActionItem
+	DaysOverdue int
+}
+
+// OverdueMeeting groups a meeting's overdue items, most overdue first.
+type OverdueMeeting struct {
+	Meeting *domain.Meeting
+	Items   []OverdueActionItem
+}
+
+type OverdueActionItemsOutput struct {
+	// Meetings are ordered by their most overdue item; meetings without
+	// overdue items are left out.
+	Meetings []OverdueMeeting
+	// ScanLimited reports that more meetings matched than the scan cap, so
+	// the result covers only the first ScanLimit of them.
+	ScanLimited bool
+	ScanLimit   int
+	// Errors holds the failure for each meeting whose action items could
+	// not be read; those meetings are left out.
+	Errors map[domain.MeetingID]error
+}
+
+// OverdueActionItems finds open action items past their due date across
+// the meetings in a range. Each meeting's items come from GetActionItems,
+// read by the shared range scan.
+type OverdueActionItems struct {
+	repo        domain.Repository
+	items       *GetActionItems
+	maxMeetings int
+}
+
+func NewOverdueActionItems(repo domain.Repository, items *GetActionItems) *OverdueActionItems {
+	return &OverdueActionItems{repo: repo, items: items, maxMeetings: DefaultMaxScanMeetings}
+}
+
+// SetMaxMeetings replaces the per-call meeting scan cap,
+// DefaultMaxScanMeetings by default. Zero or negative disables it.
+func (uc *OverdueActionItems) SetMaxMeetings(n int) {
+	uc.maxMeetings = n
+}
+
+func (uc *OverdueActionItems) Execute(ctx context.Context, input OverdueActionItemsInput) (*OverdueActionItemsOutput, error) {
+	meetings, limited, err := listCapped(ctx, uc.repo, domain.ListFilter{
+		Since: input.Since,
+		Until: input.Until,
+	}, uc.maxMeetings)
+	if err != nil {
+		return nil, err
+	}
+
+	scanned, failed, err := scanActionItems(ctx, uc.items, meetings, GetActionItemsInput{
+		Owner:     input.Owner,
+		Completed: CompletionOpen,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	out := &OverdueActionItemsOutput{Meetings: make([]OverdueMeeting, 0), Errors: failed}
+	if limited {
+		out.ScanLimited = true
+		out.ScanLimit = uc.maxMeetings
+	}
+	for _, s := range scanned {
+		var overdue []OverdueActionItem
+		for _, item := range s.items {
+			due := item.DueDate()
+			if due == nil || !due.Before(now) {
+				continue
+			}
+			overdue = append(overdue, OverdueActionItem{
+				Item:        item,
+				DaysOverdue: int(now.Sub(*due) / (24 * time.Hour)),
+			})
+		}
+		if len(overdue) == 0 {
+			continue
+		}
+		sort.SliceStable(overdue, func(i, j int) bool {
+			return overdue[i].Item.DueDate().Before(*overdue[j].Item.DueDate())
+		})
+		out.Meetings = append(out.Meetings, OverdueMeeting{Meeting: s.meeting, Items: overdue})
+	}
+
+	sort.SliceStable(out.Meetings, func(i, j int) bool {
+		return out.Meetings[i].Items[0].Item.DueDate().Before(*out.Meetings[j].Items[0].Item.DueDate())
+	})
+	return out, nil
+}
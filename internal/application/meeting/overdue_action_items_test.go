@@ -0,0 +1,81 @@
+package meeting_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestOverdueActionItems_MostOverdueFirst(t *testing.T) {
+	repo := newMockRepository()
+	now := time.Now().UTC()
+	daysAgo := func(d int) *time.Time {
+		due := now.Add(-time.Duration(d)*24*time.Hour - time.Hour)
+		return &due
+	}
+	tomorrow := now.Add(24 * time.Hour)
+
+	for _, id := range []domain.MeetingID{"m-1", "m-2", "m-3"} {
+		m, _ := domain.New(id, "Meeting "+string(id), now.Add(-30*24*time.Hour), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+
+	a1, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", daysAgo(2))
+	a2, _ := domain.NewActionItem("ai-2", "m-1", "Bob", "Book room", daysAgo(0))
+	a3, _ := domain.NewActionItem("ai-3", "m-1", "Alice", "Not due yet", &tomorrow)
+	b1, _ := domain.NewActionItem("ai-4", "m-2", "Alice", "File report", daysAgo(9))
+	b2, _ := domain.NewActionItem("ai-5", "m-2", "Alice", "Already done", daysAgo(20))
+	b2.Complete()
+	c1, _ := domain.NewActionItem("ai-6", "m-3", "Alice", "No due date", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{a2, a1, a3})
+	repo.addActionItems("m-2", []*domain.ActionItem{b1, b2})
+	repo.addActionItems("m-3", []*domain.ActionItem{c1})
+
+	uc := app.NewOverdueActionItems(repo, app.NewGetActionItems(repo))
+	out, err := uc.Execute(context.Background(), app.OverdueActionItemsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(out.Meetings) != 2 {
+		t.Fatalf("got %d meetings, want 2", len(out.Meetings))
+	}
+	if out.Meetings[0].Meeting.ID() != "m-2" || out.Meetings[1].Meeting.ID() != "m-1" {
+		t.Errorf("got meetings %s, %s; want m-2 (most overdue) then m-1", out.Meetings[0].Meeting.ID(), out.Meetings[1].Meeting.ID())
+	}
+	if items := out.Meetings[0].Items; len(items) != 1 || items[0].Item.ID() != "ai-4" || items[0].DaysOverdue != 9 {
+		t.Errorf("got m-2 items %+v, want ai-4 overdue 9 days", items)
+	}
+	items := out.Meetings[1].Items
+	if len(items) != 2 || items[0].Item.ID() != "ai-1" || items[0].DaysOverdue != 2 || items[1].Item.ID() != "ai-2" || items[1].DaysOverdue != 0 {
+		t.Errorf("got m-1 items %+v, want ai-1 (2 days) then ai-2 (0 days)", items)
+	}
+
+	out, err = uc.Execute(context.Background(), app.OverdueActionItemsInput{Owner: "bob"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Meetings) != 1 || len(out.Meetings[0].Items) != 1 || out.Meetings[0].Items[0].Item.ID() != "ai-2" {
+		t.Errorf("got %+v, want only Bob's ai-2", out.Meetings)
+	}
+}
+
+func TestOverdueActionItems_NoneOverdue(t *testing.T) {
+	repo := newMockRepository()
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	out, err := app.NewOverdueActionItems(repo, app.NewGetActionItems(repo)).Execute(context.Background(), app.OverdueActionItemsInput{Since: &since})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Meetings == nil || len(out.Meetings) != 0 {
+		t.Errorf("got %+v, want an empty list", out.Meetings)
+	}
+	if repo.listFilter == nil || repo.listFilter.Since == nil || !repo.listFilter.Since.Equal(since) {
+		t.Errorf("got filter %+v, want since %s", repo.listFilter, since)
+	}
+}
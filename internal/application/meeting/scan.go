@@ -0,0 +1,89 @@
+package meeting
+
+import (
+	"context"
+	"sync"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultMaxScanMeetings caps how many meetings one range scan reads
+// (stats, overdue items, the action item digest, conflicts, and the
+// timeline), so
+// an unbounded date range cannot page through the whole account.
+const DefaultMaxScanMeetings = 1000
+
+// listCapped lists the meetings matching filter, keeping at most
+// maxMeetings of them when it is positive. limited reports that more
+// meetings matched than were kept.
+func listCapped(ctx context.Context, repo domain.Repository, filter domain.ListFilter, maxMeetings int) (meetings []*domain.Meeting, limited bool, err error) {
+	// Ask for one meeting past the cap to tell a full scan from a cut one.
+	if maxMeetings > 0 {
+		filter.Limit = maxMeetings + 1
+	}
+	meetings, err = repo.List(ctx, filter)
+	if err != nil {
+		return nil, false, err
+	}
+	if maxMeetings > 0 && len(meetings) > maxMeetings {
+		return meetings[:maxMeetings], true, nil
+	}
+	return meetings, false, nil
+}
+
+// actionItemScanConcurrency is how many meetings' action items a range
+// scan reads in parallel.
+const actionItemScanConcurrency = 4
+
+// meetingActionItems is one meeting's action items from a range scan.
+type meetingActionItems struct {
+	meeting *domain.Meeting
+	items   []*domain.ActionItem
+}
+
+// scanActionItems reads the action items of each meeting through items, at
+// most actionItemScanConcurrency at a time, keeping the meetings' order.
+// Items come from the repository, so local overrides apply. A meeting
+// whose items cannot be read is left out and its error recorded, so one
+// bad meeting does not fail the scan; a cancelled context does.
+func scanActionItems(ctx context.Context, items *GetActionItems, meetings []*domain.Meeting, input GetActionItemsInput) ([]meetingActionItems, map[domain.MeetingID]error, error) {
+	results := make([]meetingActionItems, len(meetings))
+	errs := make([]error, len(meetings))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, actionItemScanConcurrency)
+	for i, m := range meetings {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, m *domain.Meeting) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			in := input
+			in.MeetingID = m.ID()
+			out, err := items.Execute(ctx, in)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = meetingActionItems{meeting: m, items: out.Items}
+		}(i, m)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	scanned := make([]meetingActionItems, 0, len(meetings))
+	var failed map[domain.MeetingID]error
+	for i, m := range meetings {
+		if errs[i] != nil {
+			if failed == nil {
+				failed = make(map[domain.MeetingID]error)
+			}
+			failed[m.ID()] = errs[i]
+			continue
+		}
+		scanned = append(scanned, results[i])
+	}
+	return scanned, failed, nil
+}
@@ -0,0 +1,143 @@
+package meeting
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// MatchReason records which search a meeting was found by.
+type MatchReason string
+
+const (
+	MatchTitle      MatchReason = "title"
+	MatchTranscript MatchReason = "transcript"
+	MatchBoth       MatchReason = "both"
+)
+
+// Relevance weights: a title hit outranks a transcript hit, and recency
+// (at most 1) only breaks ties between meetings matched the same way.
+const (
+	titleMatchWeight      = 2.0
+	transcriptMatchWeight = 1.0
+	recencyHalfLifeDays   = 30.0
+)
+
+type SearchMeetingsInput struct {
+	Query string
+	Since *time.Time
+	Until *time.Time
+	Limit int
+}
+
+// SearchMeetingsResult is a matched meeting with its relevance score.
+type SearchMeetingsResult struct {
+	Meeting     *domain.Meeting
+	MatchReason MatchReason
+	Score       float64
+}
+
+type SearchMeetingsOutput struct {
+	Results []SearchMeetingsResult
+	Total   int
+}
+
+// SearchMeetings combines title/metadata matching (List with a query) and
+// transcript matching into a single relevance-ranked result set.
+type SearchMeetings struct {
+	repo domain.Repository
+}
+
+func NewSearchMeetings(repo domain.Repository) *SearchMeetings {
+	return &SearchMeetings{repo: repo}
+}
+
+func (uc *SearchMeetings) Execute(ctx context.Context, input SearchMeetingsInput) (*SearchMeetingsOutput, error) {
+	if input.Query == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	filter := domain.ListFilter{
+		Since: input.Since,
+		Until: input.Until,
+		Limit: input.Limit,
+	}
+
+	titleFilter := filter
+	titleFilter.Query = &input.Query
+	byTitle, err := uc.repo.List(ctx, titleFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	byTranscript, err := uc.repo.SearchTranscripts(ctx, input.Query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[domain.MeetingID]*SearchMeetingsResult)
+	var order []domain.MeetingID
+	add := func(m *domain.Meeting, reason MatchReason) {
+		if r, ok := merged[m.ID()]; ok {
+			if r.MatchReason != reason {
+				r.MatchReason = MatchBoth
+			}
+			return
+		}
+		merged[m.ID()] = &SearchMeetingsResult{Meeting: m, MatchReason: reason}
+		order = append(order, m.ID())
+	}
+	for _, m := range byTitle {
+		add(m, MatchTitle)
+	}
+	for _, m := range byTranscript {
+		add(m, MatchTranscript)
+	}
+
+	results := make([]SearchMeetingsResult, 0, len(order))
+	for _, id := range order {
+		results = append(results, *merged[id])
+	}
+	scoreResults(results)
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if input.Limit > 0 && len(results) > input.Limit {
+		results = results[:input.Limit]
+	}
+
+	return &SearchMeetingsOutput{
+		Results: results,
+		Total:   len(results),
+	}, nil
+}
+
+// scoreResults assigns each result its match weight plus a recency bonus
+// that falls to half at recencyHalfLifeDays older than the newest result.
+// Measuring age against the newest result keeps the ranking independent
+// of the wall clock.
+func scoreResults(results []SearchMeetingsResult) {
+	var newest time.Time
+	for _, r := range results {
+		if r.Meeting.Datetime().After(newest) {
+			newest = r.Meeting.Datetime()
+		}
+	}
+
+	for i := range results {
+		r := &results[i]
+		switch r.MatchReason {
+		case MatchTitle:
+			r.Score = titleMatchWeight
+		case MatchTranscript:
+			r.Score = transcriptMatchWeight
+		case MatchBoth:
+			r.Score = titleMatchWeight + transcriptMatchWeight
+		}
+		ageDays := newest.Sub(r.Meeting.Datetime()).Hours() / 24
+		r.Score += 1 / (1 + ageDays/recencyHalfLifeDays)
+	}
+}
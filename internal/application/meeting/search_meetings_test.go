@@ -0,0 +1,102 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// splitSearchRepo returns fixed, distinct results for title and transcript searches.
+type splitSearchRepo struct {
+	*mockRepository
+	byTitle      []*domain.Meeting
+	byTranscript []*domain.Meeting
+	titleQuery   *string
+}
+
+func (r *splitSearchRepo) List(_ context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	r.titleQuery = filter.Query
+	return r.byTitle, nil
+}
+
+func (r *splitSearchRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	return r.byTranscript, nil
+}
+
+func meetingAt(t *testing.T, id domain.MeetingID, at time.Time) *domain.Meeting {
+	t.Helper()
+	m, err := domain.New(id, string(id), at, domain.SourceZoom, nil)
+	if err != nil {
+		t.Fatalf("failed to create meeting: %v", err)
+	}
+	return m
+}
+
+func TestSearchMeetings_MergesAndRanks(t *testing.T) {
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	oldTitle := meetingAt(t, "old-title", now.AddDate(0, 0, -90))
+	both := meetingAt(t, "both", now.AddDate(0, 0, -10))
+	newTranscript := meetingAt(t, "new-transcript", now)
+
+	repo := &splitSearchRepo{
+		mockRepository: newMockRepository(),
+		byTitle:        []*domain.Meeting{oldTitle, both},
+		byTranscript:   []*domain.Meeting{newTranscript, both},
+	}
+
+	out, err := app.NewSearchMeetings(repo).Execute(context.Background(), app.SearchMeetingsInput{Query: "roadmap"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.titleQuery == nil || *repo.titleQuery != "roadmap" {
+		t.Errorf("title search did not receive the query")
+	}
+	if out.Total != 3 {
+		t.Fatalf("got %d results, want 3 (deduped)", out.Total)
+	}
+
+	want := []struct {
+		id     domain.MeetingID
+		reason app.MatchReason
+	}{
+		{"both", app.MatchBoth},
+		{"old-title", app.MatchTitle},
+		{"new-transcript", app.MatchTranscript},
+	}
+	for i, w := range want {
+		got := out.Results[i]
+		if got.Meeting.ID() != w.id || got.MatchReason != w.reason {
+			t.Errorf("position %d: got %s/%s, want %s/%s", i, got.Meeting.ID(), got.MatchReason, w.id, w.reason)
+		}
+	}
+}
+
+func TestSearchMeetings_RecencyBreaksTies(t *testing.T) {
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	older := meetingAt(t, "older", now.AddDate(0, 0, -30))
+	newer := meetingAt(t, "newer", now)
+
+	repo := &splitSearchRepo{
+		mockRepository: newMockRepository(),
+		byTitle:        []*domain.Meeting{older, newer},
+	}
+
+	out, err := app.NewSearchMeetings(repo).Execute(context.Background(), app.SearchMeetingsInput{Query: "sync", Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Total != 1 || out.Results[0].Meeting.ID() != "newer" {
+		t.Errorf("got %+v, want only the newer meeting", out.Results)
+	}
+}
+
+func TestSearchMeetings_EmptyQuery(t *testing.T) {
+	_, err := app.NewSearchMeetings(newMockRepository()).Execute(context.Background(), app.SearchMeetingsInput{})
+	if !errors.Is(err, app.ErrEmptyQuery) {
+		t.Errorf("got error %v, want %v", err, app.ErrEmptyQuery)
+	}
+}
@@ -3,6 +3,7 @@ package meeting
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -10,16 +11,36 @@ import (
 
 var ErrEmptyQuery = errors.New("search query must not be empty")
 
+// DefaultMaxSnippets caps the snippets returned per meeting when
+// SearchTranscriptsInput.MaxSnippets is not set.
+const DefaultMaxSnippets = 5
+
 type SearchTranscriptsInput struct {
 	Query string
 	Since *time.Time
 	Until *time.Time
 	Limit int
+
+	// IncludeSnippets attaches the matching utterances of each meeting.
+	IncludeSnippets bool
+	// ContextWindow is the number of utterances kept on each side of a match.
+	ContextWindow int
+	// MaxSnippets caps snippets per meeting; zero uses DefaultMaxSnippets.
+	MaxSnippets int
+}
+
+// TranscriptSnippet is a matching utterance with its surrounding context.
+// Utterances[MatchIndex] is the utterance that matched the query.
+type TranscriptSnippet struct {
+	Utterances []domain.Utterance
+	MatchIndex int
 }
 
 type SearchTranscriptsOutput struct {
 	Meetings []*domain.Meeting
 	Total    int
+	// Snippets is keyed by meeting ID; only set when IncludeSnippets is true.
+	Snippets map[domain.MeetingID][]TranscriptSnippet
 }
 
 type SearchTranscripts struct {
@@ -46,8 +67,61 @@ func (uc *SearchTranscripts) Execute(ctx context.Context, input SearchTranscript
 		return nil, err
 	}
 
-	return &SearchTranscriptsOutput{
+	out := &SearchTranscriptsOutput{
 		Meetings: meetings,
 		Total:    len(meetings),
-	}, nil
+	}
+	if !input.IncludeSnippets {
+		return out, nil
+	}
+
+	maxSnippets := input.MaxSnippets
+	if maxSnippets <= 0 {
+		maxSnippets = DefaultMaxSnippets
+	}
+	out.Snippets = make(map[domain.MeetingID][]TranscriptSnippet, len(meetings))
+	for _, m := range meetings {
+		transcript := m.Transcript()
+		if transcript == nil {
+			t, err := uc.repo.GetTranscript(ctx, m.ID())
+			switch {
+			case err == nil:
+				transcript = t
+			case errors.Is(err, domain.ErrTranscriptNotReady), errors.Is(err, domain.ErrMeetingNotFound):
+				continue
+			default:
+				return nil, err
+			}
+		}
+		if snippets := findSnippets(transcript.Utterances(), input.Query, input.ContextWindow, maxSnippets); len(snippets) > 0 {
+			out.Snippets[m.ID()] = snippets
+		}
+	}
+	return out, nil
+}
+
+// findSnippets returns up to limit case-insensitive matches of query, each
+// with up to window utterances of context on either side.
+func findSnippets(utterances []domain.Utterance, query string, window, limit int) []TranscriptSnippet {
+	if window < 0 {
+		window = 0
+	}
+	needle := strings.ToLower(query)
+
+	var snippets []TranscriptSnippet
+	for i, u := range utterances {
+		if len(snippets) == limit {
+			break
+		}
+		if !strings.Contains(strings.ToLower(u.Text()), needle) {
+			continue
+		}
+		start := max(i-window, 0)
+		end := min(i+window+1, len(utterances))
+		snippets = append(snippets, TranscriptSnippet{
+			Utterances: utterances[start:end],
+			MatchIndex: i - start,
+		})
+	}
+	return snippets
 }
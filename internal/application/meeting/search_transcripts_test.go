@@ -3,8 +3,10 @@ package meeting_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
 func TestSearchTranscripts_DelegatesToRepository(t *testing.T) {
@@ -37,3 +39,46 @@ func TestSearchTranscripts_EmptyQuery(t *testing.T) {
 		t.Fatal("expected error for empty query")
 	}
 }
+
+func TestSearchTranscripts_IncludeSnippets(t *testing.T) {
+	repo := newMockRepository()
+	repo.addMeeting(mustNewMeeting(t, "m-1", "Budget Review"))
+	repo.addMeeting(mustNewMeeting(t, "m-2", "No transcript yet"))
+
+	now := time.Now().UTC()
+	var utterances []domain.Utterance
+	for i := 0; i < 10; i++ {
+		text := "filler"
+		if i%2 == 0 {
+			text = "the BUDGET is tight"
+		}
+		utterances = append(utterances, domain.NewUtterance("Alice", text, now.Add(time.Duration(i)*time.Minute), 0.9))
+	}
+	transcript := domain.NewTranscript("m-1", utterances)
+	repo.addTranscript("m-1", &transcript)
+
+	out, err := app.NewSearchTranscripts(repo).Execute(context.Background(), app.SearchTranscriptsInput{
+		Query:           "budget",
+		IncludeSnippets: true,
+		ContextWindow:   1,
+		MaxSnippets:     3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	snippets := out.Snippets["m-1"]
+	if len(snippets) != 3 {
+		t.Fatalf("got %d snippets, want 3 (capped)", len(snippets))
+	}
+	// The first match is utterance 0, so it has no leading context.
+	if len(snippets[0].Utterances) != 2 || snippets[0].MatchIndex != 0 {
+		t.Errorf("first snippet: got %d utterances, match at %d", len(snippets[0].Utterances), snippets[0].MatchIndex)
+	}
+	if len(snippets[1].Utterances) != 3 || snippets[1].MatchIndex != 1 {
+		t.Errorf("second snippet: got %d utterances, match at %d", len(snippets[1].Utterances), snippets[1].MatchIndex)
+	}
+	if _, ok := out.Snippets["m-2"]; ok {
+		t.Error("meeting without a transcript should have no snippets")
+	}
+}
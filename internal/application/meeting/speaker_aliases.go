@@ -0,0 +1,91 @@
+package meeting
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// SpeakerAlias is one alias → canonical speaker name mapping.
+type SpeakerAlias struct {
+	Alias     string
+	Canonical string
+}
+
+type SetSpeakerAliasInput struct {
+	Alias     string
+	Canonical string
+}
+
+// SetSpeakerAlias maps a transcript speaker label to a canonical name, so
+// transcripts and speaker statistics report one person under one name.
+type SetSpeakerAlias struct {
+	repo domain.SpeakerAliasRepository
+}
+
+func NewSetSpeakerAlias(repo domain.SpeakerAliasRepository) *SetSpeakerAlias {
+	return &SetSpeakerAlias{repo: repo}
+}
+
+func (uc *SetSpeakerAlias) Execute(ctx context.Context, input SetSpeakerAliasInput) error {
+	alias, canonical := strings.TrimSpace(input.Alias), strings.TrimSpace(input.Canonical)
+	if alias == "" || canonical == "" || strings.EqualFold(alias, canonical) {
+		return domain.ErrInvalidSpeakerAlias
+	}
+	return uc.repo.SaveSpeakerAlias(ctx, alias, canonical)
+}
+
+type RemoveSpeakerAliasInput struct {
+	Alias string
+}
+
+// RemoveSpeakerAlias deletes an alias; removing an unknown alias is a no-op.
+type RemoveSpeakerAlias struct {
+	repo domain.SpeakerAliasRepository
+}
+
+func NewRemoveSpeakerAlias(repo domain.SpeakerAliasRepository) *RemoveSpeakerAlias {
+	return &RemoveSpeakerAlias{repo: repo}
+}
+
+func (uc *RemoveSpeakerAlias) Execute(ctx context.Context, input RemoveSpeakerAliasInput) error {
+	alias := strings.TrimSpace(input.Alias)
+	if alias == "" {
+		return domain.ErrInvalidSpeakerAlias
+	}
+	return uc.repo.DeleteSpeakerAlias(ctx, alias)
+}
+
+type ListSpeakerAliasesOutput struct {
+	Aliases []SpeakerAlias
+}
+
+// ListSpeakerAliases returns every alias, sorted by canonical name and
+// then by alias.
+type ListSpeakerAliases struct {
+	repo domain.SpeakerAliasRepository
+}
+
+func NewListSpeakerAliases(repo domain.SpeakerAliasRepository) *ListSpeakerAliases {
+	return &ListSpeakerAliases{repo: repo}
+}
+
+func (uc *ListSpeakerAliases) Execute(ctx context.Context) (*ListSpeakerAliasesOutput, error) {
+	names, err := uc.repo.ListSpeakerAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aliases := make([]SpeakerAlias, 0, len(names))
+	for alias, canonical := range names {
+		aliases = append(aliases, SpeakerAlias{Alias: alias, Canonical: canonical})
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		if aliases[i].Canonical != aliases[j].Canonical {
+			return aliases[i].Canonical < aliases[j].Canonical
+		}
+		return aliases[i].Alias < aliases[j].Alias
+	})
+	return &ListSpeakerAliasesOutput{Aliases: aliases}, nil
+}
@@ -0,0 +1,61 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestSpeakerAliases_SetListRemove(t *testing.T) {
+	repo := newMockAliasRepository()
+	ctx := context.Background()
+
+	set := app.NewSetSpeakerAlias(repo)
+	if err := set.Execute(ctx, app.SetSpeakerAliasInput{Alias: " Speaker 2 ", Canonical: "Bob"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := set.Execute(ctx, app.SetSpeakerAliasInput{Alias: "Speaker 1", Canonical: "Alice"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := set.Execute(ctx, app.SetSpeakerAliasInput{Alias: "A. Smith", Canonical: "Alice"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+
+	out, err := app.NewListSpeakerAliases(repo).Execute(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	aliases := out.Aliases
+	want := []app.SpeakerAlias{
+		{Alias: "A. Smith", Canonical: "Alice"},
+		{Alias: "Speaker 1", Canonical: "Alice"},
+		{Alias: "Speaker 2", Canonical: "Bob"},
+	}
+	if len(aliases) != len(want) {
+		t.Fatalf("got %v, want %v", aliases, want)
+	}
+	for i := range want {
+		if aliases[i] != want[i] {
+			t.Errorf("aliases[%d]: got %v, want %v", i, aliases[i], want[i])
+		}
+	}
+
+	if err := app.NewRemoveSpeakerAlias(repo).Execute(ctx, app.RemoveSpeakerAliasInput{Alias: "Speaker 2"}); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if _, ok := repo.aliases["Speaker 2"]; ok {
+		t.Error("alias should be removed")
+	}
+}
+
+func TestSetSpeakerAlias_Invalid(t *testing.T) {
+	uc := app.NewSetSpeakerAlias(newMockAliasRepository())
+	for _, tc := range [][2]string{{"", "Alice"}, {"Speaker 1", "  "}, {"alice", "Alice"}} {
+		if err := uc.Execute(context.Background(), app.SetSpeakerAliasInput{Alias: tc[0], Canonical: tc[1]}); !errors.Is(err, domain.ErrInvalidSpeakerAlias) {
+			t.Errorf("%q -> %q: got %v, want %v", tc[0], tc[1], err, domain.ErrInvalidSpeakerAlias)
+		}
+	}
+}
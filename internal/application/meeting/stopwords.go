@@ -0,0 +1,128 @@
+package meeting
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// DefaultKeywordLanguage is the stopword pack used when no language is given.
+const DefaultKeywordLanguage = "en"
+
+// stopwordPack is a language's function words plus its elided prefixes
+// ("l'" in "l'équipe"), which are stripped before the stopword check.
+type stopwordPack struct {
+	words    map[string]bool
+	elisions []string
+}
+
+// filter reports whether term is a stopword, after stripping elisions.
+// The stripped term is returned for counting.
+func (p *stopwordPack) filter(term string) (string, bool) {
+	for _, e := range p.elisions {
+		if rest, ok := strings.CutPrefix(term, e); ok && rest != "" {
+			term = rest
+			break
+		}
+	}
+	return term, p.words[term]
+}
+
+// stopwordPacks are the built-in languages, keyed by ISO 639-1 code.
+var stopwordPacks = map[string]*stopwordPack{
+	"en": {words: englishStopwords},
+	"de": {words: germanStopwords},
+	"fr": {words: frenchStopwords, elisions: []string{"c'", "d'", "j'", "l'", "m'", "n'", "qu'", "s'", "t'"}},
+}
+
+// ParseStopwords reads a stopword file: one word per line, with blank
+// lines and lines starting with # ignored. Words are lower-cased to match
+// the tokenizer.
+func ParseStopwords(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, strings.ToLower(line))
+	}
+	return words, scanner.Err()
+}
+
+// englishStopwords are common English function words plus the filler that
+// spoken transcripts are full of.
+var englishStopwords = toSet(
+	"a", "about", "above", "after", "again", "against", "all", "also", "am", "an", "and",
+	"any", "are", "aren't", "as", "at", "be", "because", "been", "before", "being",
+	"below", "between", "both", "but", "by", "can", "can't", "cannot", "could",
+	"couldn't", "did", "didn't", "do", "does", "doesn't", "doing", "don't", "down",
+	"during", "each", "even", "few", "for", "from", "further", "get", "gets", "getting",
+	"go", "going", "gonna", "got", "had", "hadn't", "has", "hasn't", "have", "haven't",
+	"having", "he", "he'd", "he'll", "he's", "her", "here", "here's", "hers", "herself",
+	"him", "himself", "his", "how", "how's", "i", "i'd", "i'll", "i'm", "i've", "if",
+	"in", "into", "is", "isn't", "it", "it's", "its", "itself", "just", "know", "let's",
+	"like", "me", "might", "more", "most", "much", "must", "mustn't", "my", "myself",
+	"need", "no", "nor", "not", "now", "of", "off", "okay", "ok", "on", "once", "one",
+	"only", "or", "other", "ought", "our", "ours", "ourselves", "out", "over", "own",
+	"really", "right", "said", "same", "say", "see", "shall", "shan't", "she", "she'd",
+	"she'll", "she's", "should", "shouldn't", "so", "some", "still", "such", "sure",
+	"than", "that", "that's", "the", "their", "theirs", "them", "themselves", "then",
+	"there", "there's", "these", "they", "they'd", "they'll", "they're", "they've",
+	"thing", "things", "think", "this", "those", "through", "to", "too", "um", "uh",
+	"under", "until", "up", "very", "want", "was", "wasn't", "we", "we'd", "we'll",
+	"we're", "we've", "well", "were", "weren't", "what", "what's", "when", "when's",
+	"where", "where's", "which", "while", "who", "who's", "whom", "why", "why's",
+	"will", "with", "won't", "would", "wouldn't", "yeah", "yes", "you", "you'd",
+	"you'll", "you're", "you've", "your", "yours", "yourself", "yourselves",
+)
+
+// germanStopwords are common German function words and spoken filler.
+var germanStopwords = toSet(
+	"aber", "alle", "allem", "allen", "aller", "alles", "als", "also", "am", "an", "ander",
+	"andere", "anderen", "auch", "auf", "aus", "bei", "beim", "bin", "bis", "bist", "bitte",
+	"da", "dabei", "dadurch", "dafür", "dagegen", "damit", "dann", "daran", "darauf", "darum",
+	"das", "dass", "dazu", "dein", "deine", "dem", "den", "denn", "der", "des", "dessen",
+	"deshalb", "die", "dies", "diese", "diesem", "diesen", "dieser", "dieses", "doch", "dort",
+	"du", "durch", "ein", "eine", "einem", "einen", "einer", "eines", "einfach", "er", "es",
+	"etwa", "etwas", "euch", "euer", "für", "gar", "gegen", "genau", "gibt", "ganz", "gehen",
+	"geht", "gerade", "gewesen", "gut", "hab", "habe", "haben", "hast", "hat", "hatte",
+	"hätte", "hier", "hin", "hinter", "ich", "ihm", "ihn", "ihnen", "ihr", "ihre", "ihrem",
+	"ihren", "ihrer", "im", "immer", "in", "ins", "ist", "ja", "jede", "jedem", "jeden",
+	"jeder", "jedes", "jetzt", "kann", "kannst", "kein", "keine", "keinen", "können", "könnte",
+	"machen", "macht", "mal", "man", "mehr", "mein", "meine", "meinem", "meinen", "mich",
+	"mir", "mit", "muss", "müssen", "nach", "nein", "nicht", "nichts", "noch", "nur", "ob",
+	"oder", "ohne", "okay", "schon", "sehr", "sein", "seine", "seinem", "seinen", "seiner",
+	"selbst", "sich", "sie", "sind", "so", "sollen", "soll", "sollte", "sondern", "sonst",
+	"über", "um", "und", "uns", "unser", "unsere", "unter", "viel", "vom", "von", "vor",
+	"wann", "war", "waren", "warum", "was", "weil", "weiter", "welche", "welchem", "welchen",
+	"welcher", "welches", "wenn", "wer", "werde", "werden", "wie", "wieder", "will", "wir",
+	"wird", "wirklich", "wo", "wollen", "würde", "würden", "zu", "zum", "zur", "zwar",
+	"zwischen", "äh", "ähm", "halt", "eben", "quasi",
+)
+
+// frenchStopwords are common French function words and spoken filler.
+// Elided forms ("l'", "qu'") are handled by the pack's elisions.
+var frenchStopwords = toSet(
+	"à", "ai", "aie", "ainsi", "alors", "as", "au", "aucun", "aussi", "autre", "aux", "avais",
+	"avait", "avec", "avoir", "avons", "bah", "beaucoup", "ben", "bien", "bon", "ça", "car",
+	"ce", "cela", "celle", "celui", "ces", "cet", "cette", "chaque", "chez", "comme", "comment",
+	"dans", "de", "des", "déjà", "donc", "dont", "du", "elle", "elles", "en", "encore", "entre",
+	"es", "est", "et", "étaient", "était", "été", "être", "eu", "euh", "eux", "fait", "faire",
+	"fais", "faut", "il", "ils", "je", "juste", "la", "là", "le", "les", "leur", "leurs", "lui",
+	"ma", "mais", "me", "même", "mes", "moi", "mon", "ne", "ni", "non", "nos", "notre", "nous",
+	"ok", "on", "ont", "ou", "où", "oui", "par", "parce", "pas", "peu", "peut", "peux", "plus",
+	"pour", "pourquoi", "quand", "que", "quel", "quelle", "quelque", "qui", "quoi", "sa",
+	"sans", "se", "ses", "si", "son", "sont", "sous", "suis", "sur", "ta", "te", "tes", "toi",
+	"ton", "tous", "tout", "toute", "toutes", "très", "tu", "un", "une", "va", "vais", "voilà",
+	"vos", "votre", "vous", "vraiment", "y",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
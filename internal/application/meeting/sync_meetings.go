@@ -2,6 +2,7 @@ package meeting
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -9,25 +10,85 @@ import (
 
 type SyncMeetingsInput struct {
 	Since *time.Time
+	// Full ignores the stored high-water mark when Since is nil.
+	Full bool
 }
 
 type SyncMeetingsOutput struct {
 	Events []domain.DomainEvent
+	// Errors lists documents skipped because they could not be mapped.
+	Errors []domain.SyncError
+	// Since is the bound the sync used; Resumed reports that it came from
+	// the stored high-water mark. Since is nil for a full sync.
+	Since   *time.Time
+	Resumed bool
+}
+
+// WorkspaceInvalidator drops a cached workspace list. Implemented by
+// granola.WorkspaceRepository.
+type WorkspaceInvalidator interface {
+	Invalidate()
 }
 
 type SyncMeetings struct {
-	repo domain.Repository
+	repo       domain.Repository
+	state      domain.SyncStateRepository
+	workspaces WorkspaceInvalidator
 }
 
 func NewSyncMeetings(repo domain.Repository) *SyncMeetings {
 	return &SyncMeetings{repo: repo}
 }
 
+// SetSyncState enables incremental sync: a sync without Since resumes
+// from the start of the last successful sync, and each sync that covers
+// that point without skipping a document advances it.
+func (uc *SyncMeetings) SetSyncState(state domain.SyncStateRepository) {
+	uc.state = state
+}
+
+// SetWorkspaceInvalidator drops the cached workspace list after each
+// sync, so workspace changes show up without waiting out the cache TTL.
+func (uc *SyncMeetings) SetWorkspaceInvalidator(inv WorkspaceInvalidator) {
+	uc.workspaces = inv
+}
+
 func (uc *SyncMeetings) Execute(ctx context.Context, input SyncMeetingsInput) (*SyncMeetingsOutput, error) {
-	events, err := uc.repo.Sync(ctx, input.Since)
+	var mark *time.Time
+	if uc.state != nil {
+		var err error
+		if mark, err = uc.state.LastSyncedAt(ctx); err != nil {
+			return nil, fmt.Errorf("load sync high-water mark: %w", err)
+		}
+	}
+
+	since, resumed := input.Since, false
+	if since == nil && !input.Full && mark != nil {
+		since, resumed = mark, true
+	}
+
+	startedAt := time.Now().UTC()
+	result, err := uc.repo.Sync(ctx, since)
 	if err != nil {
 		return nil, err
 	}
 
-	return &SyncMeetingsOutput{Events: events}, nil
+	// The mark only advances when the sync covered everything since the
+	// previous one: a full sync, or one reaching back to the mark. An
+	// explicit Since without a mark leaves everything before it unsynced.
+	// Skipped documents hold it back too, so the next sync retries them.
+	// A failed save is not an error: the next sync re-reads the same window.
+	if uc.state != nil && len(result.Errors) == 0 && (since == nil || (mark != nil && !since.After(*mark))) {
+		_ = uc.state.SaveLastSyncedAt(ctx, startedAt)
+	}
+	if uc.workspaces != nil {
+		uc.workspaces.Invalidate()
+	}
+
+	return &SyncMeetingsOutput{
+		Events:  result.Events,
+		Errors:  result.Errors,
+		Since:   since,
+		Resumed: resumed,
+	}, nil
 }
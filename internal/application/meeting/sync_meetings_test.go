@@ -30,6 +30,51 @@ func TestSyncMeetings_DelegatesToRepository(t *testing.T) {
 	}
 }
 
+func TestSyncMeetings_ReportsSkippedDocuments(t *testing.T) {
+	repo := newMockRepository()
+	repo.syncEvents = []domain.DomainEvent{
+		domain.NewMeetingCreatedEvent("m-1", "New Meeting", time.Now().UTC()),
+	}
+	repo.syncErrors = []domain.SyncError{{MeetingID: "m-bad", Err: domain.ErrInvalidTitle}}
+
+	out, err := app.NewSyncMeetings(repo).Execute(context.Background(), app.SyncMeetingsInput{})
+	if err != nil {
+		t.Fatalf("partial failure should not be an error: %v", err)
+	}
+	if len(out.Events) != 1 {
+		t.Errorf("got %d events, want 1", len(out.Events))
+	}
+	if len(out.Errors) != 1 || out.Errors[0].MeetingID != "m-bad" {
+		t.Errorf("got errors %v, want m-bad skipped", out.Errors)
+	}
+}
+
+type fakeWorkspaceInvalidator struct{ calls int }
+
+func (f *fakeWorkspaceInvalidator) Invalidate() { f.calls++ }
+
+func TestSyncMeetings_InvalidatesWorkspaces(t *testing.T) {
+	repo := newMockRepository()
+	inv := &fakeWorkspaceInvalidator{}
+
+	uc := app.NewSyncMeetings(repo)
+	uc.SetWorkspaceInvalidator(inv)
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inv.calls != 1 {
+		t.Errorf("got %d invalidations, want 1", inv.calls)
+	}
+
+	repo.syncErr = errors.New("network failure")
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if inv.calls != 1 {
+		t.Errorf("failed sync invalidated workspaces: got %d calls, want 1", inv.calls)
+	}
+}
+
 func TestSyncMeetings_PropagatesErrors(t *testing.T) {
 	repo := newMockRepository()
 	repo.syncErr = errors.New("network failure")
@@ -43,3 +88,105 @@ func TestSyncMeetings_PropagatesErrors(t *testing.T) {
 		t.Errorf("got error %q", err.Error())
 	}
 }
+
+func TestSyncMeetings_ResumesFromStoredMark(t *testing.T) {
+	repo := newMockRepository()
+	state := &mockSyncState{}
+	uc := app.NewSyncMeetings(repo)
+	uc.SetSyncState(state)
+	ctx := context.Background()
+
+	before := time.Now().UTC()
+	out, err := uc.Execute(ctx, app.SyncMeetingsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.syncSince != nil || out.Resumed {
+		t.Errorf("first sync: got since %v, resumed %v; want a full sync", repo.syncSince, out.Resumed)
+	}
+	if state.mark == nil || state.mark.Before(before) {
+		t.Fatalf("got mark %v, want the first sync's start time", state.mark)
+	}
+	mark := *state.mark
+
+	out, err = uc.Execute(ctx, app.SyncMeetingsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.syncSince == nil || !repo.syncSince.Equal(mark) || !out.Resumed {
+		t.Errorf("second sync: got since %v, resumed %v; want %v", repo.syncSince, out.Resumed, mark)
+	}
+	if !state.mark.After(mark) {
+		t.Errorf("mark should advance past %v, got %v", mark, state.mark)
+	}
+
+	if _, err := uc.Execute(ctx, app.SyncMeetingsInput{Full: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.syncSince != nil {
+		t.Errorf("full sync: got since %v, want nil", repo.syncSince)
+	}
+}
+
+func TestSyncMeetings_LaterExplicitSinceKeepsMark(t *testing.T) {
+	repo := newMockRepository()
+	mark := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := &mockSyncState{mark: &mark}
+	uc := app.NewSyncMeetings(repo)
+	uc.SetSyncState(state)
+
+	since := mark.AddDate(0, 1, 0)
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{Since: &since}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !state.mark.Equal(mark) {
+		t.Errorf("got mark %v, want it unchanged at %v", state.mark, mark)
+	}
+
+	repo.syncErr = errors.New("network failure")
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if !state.mark.Equal(mark) {
+		t.Errorf("failed sync moved the mark to %v", state.mark)
+	}
+}
+
+func TestSyncMeetings_FirstExplicitSinceSavesNoMark(t *testing.T) {
+	repo := newMockRepository()
+	state := &mockSyncState{}
+	uc := app.NewSyncMeetings(repo)
+	uc.SetSyncState(state)
+
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{Since: &since}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.mark != nil {
+		t.Errorf("got mark %v, want none: meetings before %v were never synced", state.mark, since)
+	}
+}
+
+func TestSyncMeetings_SkippedDocumentsKeepMark(t *testing.T) {
+	repo := newMockRepository()
+	repo.syncErrors = []domain.SyncError{{MeetingID: "m-bad", Err: domain.ErrInvalidTitle}}
+	mark := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := &mockSyncState{mark: &mark}
+	uc := app.NewSyncMeetings(repo)
+	uc.SetSyncState(state)
+
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{}); err != nil {
+		t.Fatalf("partial failure should not be an error: %v", err)
+	}
+	if !state.mark.Equal(mark) {
+		t.Errorf("partial sync moved the mark to %v, want it kept so m-bad is retried", state.mark)
+	}
+
+	repo.syncErrors = nil
+	if _, err := uc.Execute(context.Background(), app.SyncMeetingsInput{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !repo.syncSince.Equal(mark) || !state.mark.After(mark) {
+		t.Errorf("got since %v and mark %v, want a retry from %v that then advances", repo.syncSince, state.mark, mark)
+	}
+}
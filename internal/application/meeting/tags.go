@@ -0,0 +1,74 @@
+package meeting
+
+import (
+	"context"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+type TagMeetingInput struct {
+	ID  domain.MeetingID
+	Tag string
+}
+
+// TagMeetingOutput holds the meeting's tags after the change, sorted.
+type TagMeetingOutput struct {
+	Tags []string
+}
+
+// TagMeeting adds a local tag to a meeting. Tags are normalized to lower
+// case; tagging a meeting twice with the same tag is a no-op.
+type TagMeeting struct {
+	repo domain.TagRepository
+}
+
+func NewTagMeeting(repo domain.TagRepository) *TagMeeting {
+	return &TagMeeting{repo: repo}
+}
+
+func (uc *TagMeeting) Execute(ctx context.Context, input TagMeetingInput) (*TagMeetingOutput, error) {
+	tag, err := validateTagInput(input.ID, input.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.AddTag(ctx, input.ID, tag); err != nil {
+		return nil, err
+	}
+	return currentTags(ctx, uc.repo, input.ID)
+}
+
+// UntagMeeting removes a local tag from a meeting; removing a tag the
+// meeting lacks is a no-op.
+type UntagMeeting struct {
+	repo domain.TagRepository
+}
+
+func NewUntagMeeting(repo domain.TagRepository) *UntagMeeting {
+	return &UntagMeeting{repo: repo}
+}
+
+func (uc *UntagMeeting) Execute(ctx context.Context, input TagMeetingInput) (*TagMeetingOutput, error) {
+	tag, err := validateTagInput(input.ID, input.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RemoveTag(ctx, input.ID, tag); err != nil {
+		return nil, err
+	}
+	return currentTags(ctx, uc.repo, input.ID)
+}
+
+func validateTagInput(id domain.MeetingID, tag string) (string, error) {
+	if id == "" {
+		return "", domain.ErrInvalidMeetingID
+	}
+	return domain.NormalizeTag(tag)
+}
+
+func currentTags(ctx context.Context, repo domain.TagRepository, id domain.MeetingID) (*TagMeetingOutput, error) {
+	tags, err := repo.TagsOf(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &TagMeetingOutput{Tags: tags}, nil
+}
@@ -0,0 +1,45 @@
+package meeting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestTagMeeting_AddsAndRemoves(t *testing.T) {
+	tags := newMockTagRepository()
+	ctx := context.Background()
+
+	out, err := app.NewTagMeeting(tags).Execute(ctx, app.TagMeetingInput{ID: "m-1", Tag: " Planning "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := app.NewTagMeeting(tags).Execute(ctx, app.TagMeetingInput{ID: "m-1", Tag: "1:1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Tags) != 1 || out.Tags[0] != "planning" {
+		t.Errorf("got %v, want [planning]", out.Tags)
+	}
+
+	out, err = app.NewUntagMeeting(tags).Execute(ctx, app.TagMeetingInput{ID: "m-1", Tag: "PLANNING"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Tags) != 1 || out.Tags[0] != "1:1" {
+		t.Errorf("after untag: got %v, want [1:1]", out.Tags)
+	}
+}
+
+func TestTagMeeting_Invalid(t *testing.T) {
+	uc := app.NewTagMeeting(newMockTagRepository())
+
+	if _, err := uc.Execute(context.Background(), app.TagMeetingInput{Tag: "planning"}); !errors.Is(err, domain.ErrInvalidMeetingID) {
+		t.Errorf("got %v, want %v", err, domain.ErrInvalidMeetingID)
+	}
+	if _, err := uc.Execute(context.Background(), app.TagMeetingInput{ID: "m-1", Tag: "  "}); !errors.Is(err, domain.ErrInvalidTag) {
+		t.Errorf("got %v, want %v", err, domain.ErrInvalidTag)
+	}
+}
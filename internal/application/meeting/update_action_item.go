@@ -2,7 +2,9 @@ package meeting
 
 import (
 	"context"
+	"time"
 
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -17,15 +19,27 @@ type UpdateActionItemOutput struct {
 }
 
 type UpdateActionItem struct {
-	repo       domain.Repository
-	writeRepo  domain.WriteRepository
-	dispatcher domain.EventDispatcher
+	repo        domain.Repository
+	writeRepo   domain.WriteRepository
+	dispatcher  domain.EventDispatcher
+	audit       audit.Logger
+	invalidator CacheInvalidator
 }
 
 func NewUpdateActionItem(repo domain.Repository, writeRepo domain.WriteRepository, dispatcher domain.EventDispatcher) *UpdateActionItem {
 	return &UpdateActionItem{repo: repo, writeRepo: writeRepo, dispatcher: dispatcher}
 }
 
+// SetAuditLogger records each text update to the audit log.
+func (uc *UpdateActionItem) SetAuditLogger(l audit.Logger) {
+	uc.audit = l
+}
+
+// SetCacheInvalidator drops the meeting's cached entry after each update.
+func (uc *UpdateActionItem) SetCacheInvalidator(inv CacheInvalidator) {
+	uc.invalidator = inv
+}
+
 func (uc *UpdateActionItem) Execute(ctx context.Context, input UpdateActionItemInput) (*UpdateActionItemOutput, error) {
 	if input.MeetingID == "" {
 		return nil, domain.ErrInvalidMeetingID
@@ -58,10 +72,22 @@ func (uc *UpdateActionItem) Execute(ctx context.Context, input UpdateActionItemI
 	if err := item.UpdateText(input.Text); err != nil {
 		return nil, err
 	}
+	item.MarkModified(time.Now().UTC())
 
 	if err := uc.writeRepo.SaveActionItemState(ctx, item); err != nil {
 		return nil, err
 	}
+	if uc.invalidator != nil {
+		_ = uc.invalidator.Invalidate(ctx, input.MeetingID)
+	}
+
+	recordAudit(ctx, uc.audit, audit.Entry{
+		Operation: audit.OpUpdateActionItem,
+		TargetID:  string(item.ID()),
+		Actor:     audit.ActorFromContext(ctx),
+		Timestamp: time.Now().UTC(),
+		Details:   "text: " + input.Text,
+	})
 
 	// Dispatch event
 	event := domain.NewActionItemUpdatedEvent(input.MeetingID, input.ActionItemID, input.Text)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	app "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -85,3 +86,41 @@ func TestUpdateActionItem_EmptyMeetingID(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, domain.ErrInvalidMeetingID)
 	}
 }
+
+func TestUpdateActionItem_RecordsAudit(t *testing.T) {
+	repo := newMockRepository()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	logger := &mockAuditLogger{}
+
+	uc := app.NewUpdateActionItem(repo, newMockWriteRepository(), nil)
+	uc.SetAuditLogger(logger)
+	ctx := audit.WithActor(context.Background(), "mcp")
+	if _, err := uc.Execute(ctx, app.UpdateActionItemInput{MeetingID: "m-1", ActionItemID: "ai-1", Text: "Write final report"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(logger.entries))
+	}
+	e := logger.entries[0]
+	if e.Operation != audit.OpUpdateActionItem || e.Actor != "mcp" || e.Details != "text: Write final report" {
+		t.Errorf("got entry %+v", e)
+	}
+}
+
+func TestUpdateActionItem_InvalidatesCache(t *testing.T) {
+	repo := newMockRepository()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	inv := &mockCacheInvalidator{}
+
+	uc := app.NewUpdateActionItem(repo, newMockWriteRepository(), nil)
+	uc.SetCacheInvalidator(inv)
+	if _, err := uc.Execute(context.Background(), app.UpdateActionItemInput{MeetingID: "m-1", ActionItemID: "ai-1", Text: "Write the report"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.ids) != 1 || inv.ids[0] != "m-1" {
+		t.Errorf("got invalidated %v, want [m-1]", inv.ids)
+	}
+}
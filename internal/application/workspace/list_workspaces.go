@@ -3,14 +3,24 @@ package workspace
 
 import (
 	"context"
+	"errors"
 
 	"github.com/felixgeelhaar/acai/internal/domain/workspace"
 )
 
-type ListWorkspacesInput struct{}
+var ErrInvalidPagination = errors.New("offset and limit must not be negative")
+
+type ListWorkspacesInput struct {
+	// Offset and Limit page through the workspaces in repository order.
+	// A zero Limit returns every workspace from Offset onwards.
+	Limit  int
+	Offset int
+}
 
 type ListWorkspacesOutput struct {
 	Workspaces []*workspace.Workspace
+	// Total counts all workspaces, before pagination.
+	Total int
 }
 
 type ListWorkspaces struct {
@@ -21,10 +31,21 @@ func NewListWorkspaces(repo workspace.Repository) *ListWorkspaces {
 	return &ListWorkspaces{repo: repo}
 }
 
-func (uc *ListWorkspaces) Execute(ctx context.Context, _ ListWorkspacesInput) (*ListWorkspacesOutput, error) {
+func (uc *ListWorkspaces) Execute(ctx context.Context, input ListWorkspacesInput) (*ListWorkspacesOutput, error) {
+	if input.Offset < 0 || input.Limit < 0 {
+		return nil, ErrInvalidPagination
+	}
+
 	workspaces, err := uc.repo.List(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &ListWorkspacesOutput{Workspaces: workspaces}, nil
+
+	total := len(workspaces)
+	start := min(input.Offset, total)
+	end := total
+	if input.Limit > 0 {
+		end = min(start+input.Limit, total)
+	}
+	return &ListWorkspacesOutput{Workspaces: workspaces[start:end], Total: total}, nil
 }
@@ -76,3 +76,56 @@ func TestListWorkspaces_RepositoryError(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestListWorkspaces_Paginates(t *testing.T) {
+	repo := &mockWorkspaceRepo{
+		workspaces: []*workspace.Workspace{
+			mustWorkspace(t, "ws-1", "Engineering", "engineering"),
+			mustWorkspace(t, "ws-2", "Design", "design"),
+			mustWorkspace(t, "ws-3", "Sales", "sales"),
+			mustWorkspace(t, "ws-4", "Support", "support"),
+			mustWorkspace(t, "ws-5", "Finance", "finance"),
+		},
+	}
+	uc := workspaceapp.NewListWorkspaces(repo)
+
+	tests := []struct {
+		name          string
+		limit, offset int
+		want          []workspace.WorkspaceID
+	}{
+		{"first page", 2, 0, []workspace.WorkspaceID{"ws-1", "ws-2"}},
+		{"middle page", 2, 2, []workspace.WorkspaceID{"ws-3", "ws-4"}},
+		{"short last page", 2, 4, []workspace.WorkspaceID{"ws-5"}},
+		{"offset past the end", 2, 10, nil},
+		{"offset without limit", 0, 3, []workspace.WorkspaceID{"ws-4", "ws-5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := uc.Execute(context.Background(), workspaceapp.ListWorkspacesInput{Limit: tt.limit, Offset: tt.offset})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.Total != 5 {
+				t.Errorf("got total %d, want 5", out.Total)
+			}
+			if len(out.Workspaces) != len(tt.want) {
+				t.Fatalf("got %d workspaces, want %d", len(out.Workspaces), len(tt.want))
+			}
+			for i, id := range tt.want {
+				if out.Workspaces[i].ID() != id {
+					t.Errorf("workspace %d: got %s, want %s", i, out.Workspaces[i].ID(), id)
+				}
+			}
+		})
+	}
+}
+
+func TestListWorkspaces_NegativePagination(t *testing.T) {
+	uc := workspaceapp.NewListWorkspaces(&mockWorkspaceRepo{})
+
+	_, err := uc.Execute(context.Background(), workspaceapp.ListWorkspacesInput{Offset: -1})
+	if !errors.Is(err, workspaceapp.ErrInvalidPagination) {
+		t.Errorf("got %v, want ErrInvalidPagination", err)
+	}
+}
@@ -10,5 +10,6 @@ var (
 	ErrInvalidMeetingID   = errors.New("meeting id must not be empty")
 	ErrInvalidNoteContent = errors.New("note content must not be empty")
 	ErrInvalidAuthor      = errors.New("note author must not be empty")
+	ErrNoteTooLong        = errors.New("note content exceeds the maximum length")
 	ErrNoteNotFound       = errors.New("note not found")
 )
@@ -52,3 +52,23 @@ func (e NoteDeleted) EventName() string     { return "note.deleted" }
 func (e NoteDeleted) OccurredAt() time.Time { return e.occurred }
 func (e NoteDeleted) NoteID() string        { return e.noteID }
 func (e NoteDeleted) MeetingID() string     { return e.meetingID }
+
+// NotesCleared is raised once when all agent notes of a meeting are removed.
+type NotesCleared struct {
+	meetingID string
+	count     int
+	occurred  time.Time
+}
+
+func NewNotesClearedEvent(meetingID string, count int) NotesCleared {
+	return NotesCleared{
+		meetingID: meetingID,
+		count:     count,
+		occurred:  time.Now().UTC(),
+	}
+}
+
+func (e NotesCleared) EventName() string     { return "notes.cleared" }
+func (e NotesCleared) OccurredAt() time.Time { return e.occurred }
+func (e NotesCleared) MeetingID() string     { return e.meetingID }
+func (e NotesCleared) Count() int            { return e.count }
@@ -1,6 +1,9 @@
 package annotation
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // NoteID is a strongly-typed identifier for agent notes.
 type NoteID string
@@ -13,7 +16,11 @@ type AgentNote struct {
 	meetingID string
 	author    string
 	content   string
+	tags      []string
 	createdAt time.Time
+	// idempotencyKey is the optional client-supplied key that makes
+	// retried creates return this note instead of a duplicate.
+	idempotencyKey string
 }
 
 // NewAgentNote constructs a valid AgentNote, enforcing creation invariants.
@@ -36,17 +43,19 @@ func NewAgentNote(id NoteID, meetingID, author, content string) (*AgentNote, err
 		meetingID: meetingID,
 		author:    author,
 		content:   content,
+		tags:      []string{},
 		createdAt: time.Now().UTC(),
 	}, nil
 }
 
 // ReconstructAgentNote reconstitutes a note from persistence without raising events.
-func ReconstructAgentNote(id NoteID, meetingID, author, content string, createdAt time.Time) *AgentNote {
+func ReconstructAgentNote(id NoteID, meetingID, author, content string, tags []string, createdAt time.Time) *AgentNote {
 	return &AgentNote{
 		id:        id,
 		meetingID: meetingID,
 		author:    author,
 		content:   content,
+		tags:      normalizeTags(tags),
 		createdAt: createdAt,
 	}
 }
@@ -56,3 +65,54 @@ func (n *AgentNote) MeetingID() string { return n.meetingID }
 func (n *AgentNote) Author() string    { return n.author }
 func (n *AgentNote) Content() string   { return n.content }
 func (n *AgentNote) CreatedAt() time.Time { return n.createdAt }
+
+func (n *AgentNote) IdempotencyKey() string { return n.idempotencyKey }
+
+// SetIdempotencyKey records the client-supplied key the note was created with.
+func (n *AgentNote) SetIdempotencyKey(key string) {
+	n.idempotencyKey = strings.TrimSpace(key)
+}
+
+func (n *AgentNote) Tags() []string {
+	copied := make([]string, len(n.tags))
+	copy(copied, n.tags)
+	return copied
+}
+
+// SetTags replaces the note's tags. Tags are trimmed, lowercased,
+// and de-duplicated; blank tags are dropped.
+func (n *AgentNote) SetTags(tags []string) {
+	n.tags = normalizeTags(tags)
+}
+
+// HasAllTags reports whether the note carries every one of the given tags.
+// An empty set of required tags always matches.
+func (n *AgentNote) HasAllTags(required []string) bool {
+	for _, r := range normalizeTags(required) {
+		found := false
+		for _, t := range n.tags {
+			if t == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
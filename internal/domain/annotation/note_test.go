@@ -60,7 +60,7 @@ func TestNewAgentNote_RejectsEmptyContent(t *testing.T) {
 func TestReconstructAgentNote(t *testing.T) {
 	note, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "content")
 	reconstructed := annotation.ReconstructAgentNote(
-		note.ID(), note.MeetingID(), note.Author(), note.Content(), note.CreatedAt(),
+		note.ID(), note.MeetingID(), note.Author(), note.Content(), []string{"decision"}, note.CreatedAt(),
 	)
 
 	if reconstructed.ID() != note.ID() {
@@ -69,6 +69,38 @@ func TestReconstructAgentNote(t *testing.T) {
 	if reconstructed.CreatedAt() != note.CreatedAt() {
 		t.Error("created_at mismatch")
 	}
+	if tags := reconstructed.Tags(); len(tags) != 1 || tags[0] != "decision" {
+		t.Errorf("got tags %v, want [decision]", tags)
+	}
+}
+
+func TestAgentNote_SetTags_Normalizes(t *testing.T) {
+	note, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "content")
+	if len(note.Tags()) != 0 {
+		t.Fatalf("new note should have no tags, got %v", note.Tags())
+	}
+
+	note.SetTags([]string{" Decision ", "risk", "", "decision"})
+
+	tags := note.Tags()
+	if len(tags) != 2 || tags[0] != "decision" || tags[1] != "risk" {
+		t.Errorf("got tags %v, want [decision risk]", tags)
+	}
+}
+
+func TestAgentNote_HasAllTags(t *testing.T) {
+	note, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "content")
+	note.SetTags([]string{"decision", "risk"})
+
+	if !note.HasAllTags(nil) {
+		t.Error("empty requirement should match")
+	}
+	if !note.HasAllTags([]string{"Risk", "decision"}) {
+		t.Error("expected match for all present tags")
+	}
+	if note.HasAllTags([]string{"decision", "followup"}) {
+		t.Error("expected no match when a tag is missing")
+	}
 }
 
 func TestNoteAdded_Event(t *testing.T) {
@@ -107,3 +139,20 @@ func TestNoteDeleted_Event(t *testing.T) {
 		t.Error("occurred_at should not be zero")
 	}
 }
+
+func TestNotesCleared_Event(t *testing.T) {
+	event := annotation.NewNotesClearedEvent("m-1", 3)
+
+	if event.EventName() != "notes.cleared" {
+		t.Errorf("got event name %q", event.EventName())
+	}
+	if event.MeetingID() != "m-1" {
+		t.Errorf("got meeting id %q", event.MeetingID())
+	}
+	if event.Count() != 3 {
+		t.Errorf("got count %d", event.Count())
+	}
+	if event.OccurredAt().IsZero() {
+		t.Error("occurred_at should not be zero")
+	}
+}
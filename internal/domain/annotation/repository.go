@@ -7,6 +7,14 @@ import "context"
 type NoteRepository interface {
 	Save(ctx context.Context, note *AgentNote) error
 	FindByID(ctx context.Context, id NoteID) (*AgentNote, error)
+	// FindByIdempotencyKey returns the note created with key, or ErrNoteNotFound.
+	FindByIdempotencyKey(ctx context.Context, key string) (*AgentNote, error)
 	ListByMeeting(ctx context.Context, meetingID string) ([]*AgentNote, error)
+	// ListAll returns every note, ordered by meeting and then creation time.
+	ListAll(ctx context.Context) ([]*AgentNote, error)
 	Delete(ctx context.Context, id NoteID) error
+	// DeleteByMeeting removes every note of a meeting and returns how many were deleted.
+	DeleteByMeeting(ctx context.Context, meetingID string) (int, error)
+	// Search returns up to limit notes whose content matches every query term.
+	Search(ctx context.Context, query string, limit int) ([]*AgentNote, error)
 }
@@ -0,0 +1,79 @@
+// Package audit contains the Audit bounded context: an append-only record
+// of local write operations, so people can review who changed what and when.
+// It is separate from the outbox, which exists for upstream sync.
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrInvalidOperation = errors.New("operation must be one of add_note, delete_note, clear_notes, complete_action_item, update_action_item")
+
+// Operation names an audited write.
+type Operation string
+
+const (
+	OpAddNote            Operation = "add_note"
+	OpDeleteNote         Operation = "delete_note"
+	OpClearNotes         Operation = "clear_notes"
+	OpCompleteActionItem Operation = "complete_action_item"
+	OpUpdateActionItem   Operation = "update_action_item"
+)
+
+// IsKnown reports whether o is one of the audited operations.
+func (o Operation) IsKnown() bool {
+	switch o {
+	case OpAddNote, OpDeleteNote, OpClearNotes, OpCompleteActionItem, OpUpdateActionItem:
+		return true
+	}
+	return false
+}
+
+// Entry is one audit log record.
+type Entry struct {
+	Operation Operation `json:"operation"`
+	TargetID  string    `json:"target_id"`
+	Actor     string    `json:"actor"`
+	Timestamp time.Time `json:"timestamp"`
+	Details   string    `json:"details,omitempty"`
+}
+
+// Filter narrows an audit log listing. Zero values match everything.
+type Filter struct {
+	Since     *time.Time
+	Operation Operation
+}
+
+// Logger is the port the write use cases record to.
+// Defined in the domain layer, implemented in infrastructure (local store).
+type Logger interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// Repository is the read side of the audit log.
+type Repository interface {
+	Logger
+	// List returns matching entries, oldest first.
+	List(ctx context.Context, filter Filter) ([]Entry, error)
+}
+
+// DefaultActor is recorded when the context names no actor.
+const DefaultActor = "unknown"
+
+type actorKey struct{}
+
+// WithActor names the actor (for example "cli" or "mcp") that write
+// operations on ctx are attributed to.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or DefaultActor.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return DefaultActor
+}
@@ -0,0 +1,28 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+)
+
+func TestActorFromContext(t *testing.T) {
+	if got := audit.ActorFromContext(context.Background()); got != audit.DefaultActor {
+		t.Errorf("got %q, want %q", got, audit.DefaultActor)
+	}
+	if got := audit.ActorFromContext(audit.WithActor(context.Background(), "cli")); got != "cli" {
+		t.Errorf("got %q, want cli", got)
+	}
+}
+
+func TestOperation_IsKnown(t *testing.T) {
+	for _, op := range []audit.Operation{audit.OpAddNote, audit.OpDeleteNote, audit.OpClearNotes, audit.OpCompleteActionItem, audit.OpUpdateActionItem} {
+		if !op.IsKnown() {
+			t.Errorf("%q should be known", op)
+		}
+	}
+	if audit.Operation("sync").IsKnown() {
+		t.Error("sync should not be an audited operation")
+	}
+}
@@ -13,6 +13,7 @@ var (
 	ErrNotAuthenticated = errors.New("not authenticated")
 	ErrTokenExpired     = errors.New("token has expired")
 	ErrInvalidToken     = errors.New("invalid token")
+	ErrNoRefreshToken   = errors.New("no refresh token available")
 )
 
 // AuthMethod represents how the user authenticates.
@@ -41,13 +42,48 @@ func NewToken(accessToken, refreshToken string, expiresAt time.Time) Token {
 func (t Token) AccessToken() string   { return t.accessToken }
 func (t Token) RefreshToken() string  { return t.refreshToken }
 func (t Token) ExpiresAt() time.Time  { return t.expiresAt }
-func (t Token) IsExpired() bool       { return time.Now().UTC().After(t.expiresAt) }
+
+// IsExpired reports whether the token is past its expiry. A zero expiry
+// means the lifetime is unknown, and such a token is not expired.
+func (t Token) IsExpired() bool {
+	return !t.expiresAt.IsZero() && time.Now().UTC().After(t.expiresAt)
+}
+
+// Identity is a value object naming the account a credential belongs to.
+// Credentials saved before identities were recorded have a zero Identity.
+type Identity struct {
+	accountID string
+	email     string
+	name      string
+}
+
+func NewIdentity(accountID, email, name string) Identity {
+	return Identity{accountID: accountID, email: email, name: name}
+}
+
+func (i Identity) AccountID() string { return i.accountID }
+func (i Identity) Email() string     { return i.email }
+func (i Identity) Name() string      { return i.name }
+func (i Identity) IsZero() bool      { return i == Identity{} }
+
+// String is the email, falling back to the name and then the account ID.
+func (i Identity) String() string {
+	switch {
+	case i.email != "":
+		return i.email
+	case i.name != "":
+		return i.name
+	default:
+		return i.accountID
+	}
+}
 
 // Credential is an entity representing the user's stored authentication state.
 type Credential struct {
 	method    AuthMethod
 	token     Token
 	workspace string
+	identity  Identity
 	createdAt time.Time
 }
 
@@ -64,6 +100,14 @@ func (c *Credential) Method() AuthMethod  { return c.method }
 func (c *Credential) Token() Token        { return c.token }
 func (c *Credential) Workspace() string   { return c.workspace }
 func (c *Credential) CreatedAt() time.Time { return c.createdAt }
+func (c *Credential) Identity() Identity { return c.identity }
+
+// WithIdentity returns a copy of the credential for the given account.
+func (c *Credential) WithIdentity(identity Identity) *Credential {
+	cp := *c
+	cp.identity = identity
+	return &cp
+}
 
 func (c *Credential) IsValid() bool {
 	return c.token.accessToken != "" && !c.token.IsExpired()
@@ -75,4 +119,5 @@ type Service interface {
 	Login(ctx context.Context, method AuthMethod) (*Credential, error)
 	Status(ctx context.Context) (*Credential, error)
 	Logout(ctx context.Context) error
+	Refresh(ctx context.Context) (*Credential, error)
 }
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -31,6 +32,14 @@ func TestToken_ExpiredInPast(t *testing.T) {
 	}
 }
 
+func TestToken_UnknownExpiryIsNotExpired(t *testing.T) {
+	token := auth.NewToken("access", "refresh", time.Time{})
+
+	if token.IsExpired() {
+		t.Error("token with a zero (unknown) expiry should not be expired")
+	}
+}
+
 func TestCredential_IsValid(t *testing.T) {
 	future := time.Now().Add(1 * time.Hour).UTC()
 	token := auth.NewToken("access", "refresh", future)
@@ -66,3 +75,58 @@ func TestCredential_InvalidWhenEmptyToken(t *testing.T) {
 		t.Error("credential with empty access token should not be valid")
 	}
 }
+
+func TestCredential_WithIdentity(t *testing.T) {
+	token := auth.NewToken("access", "", time.Now().Add(1*time.Hour).UTC())
+	cred := auth.NewCredential(auth.AuthOAuth, token, "ws")
+	if !cred.Identity().IsZero() {
+		t.Error("new credential should have no identity")
+	}
+
+	withID := cred.WithIdentity(auth.NewIdentity("u-1", "alice@example.com", "Alice"))
+	if withID.Identity().Email() != "alice@example.com" || withID.Identity().AccountID() != "u-1" {
+		t.Errorf("got identity %+v", withID.Identity())
+	}
+	if !cred.Identity().IsZero() {
+		t.Error("WithIdentity should not modify the original credential")
+	}
+}
+
+func TestIdentity_String(t *testing.T) {
+	tests := []struct {
+		identity auth.Identity
+		want     string
+	}{
+		{auth.NewIdentity("u-1", "alice@example.com", "Alice"), "alice@example.com"},
+		{auth.NewIdentity("u-1", "", "Alice"), "Alice"},
+		{auth.NewIdentity("u-1", "", ""), "u-1"},
+	}
+	for _, tt := range tests {
+		if got := tt.identity.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestProfileFromContext_DefaultsWhenUnset(t *testing.T) {
+	if got := auth.ProfileFromContext(context.Background()); got != auth.DefaultProfile {
+		t.Errorf("got profile %q, want %q", got, auth.DefaultProfile)
+	}
+	ctx := auth.WithProfile(context.Background(), "work")
+	if got := auth.ProfileFromContext(ctx); got != "work" {
+		t.Errorf("got profile %q, want work", got)
+	}
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"work", "client-a", "team_2"} {
+		if err := auth.ValidateProfileName(name); err != nil {
+			t.Errorf("%q should be valid: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "../x", "a/b", "has space"} {
+		if err := auth.ValidateProfileName(name); err != auth.ErrInvalidProfile {
+			t.Errorf("%q should be invalid, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+var ErrInvalidProfile = errors.New("invalid profile name")
+
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidateProfileName rejects names that are not safe to use as file names.
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return ErrInvalidProfile
+	}
+	return nil
+}
+
+// Profile is a named, saved credential.
+type Profile struct {
+	name       string
+	credential *Credential
+}
+
+func NewProfile(name string, credential *Credential) Profile {
+	return Profile{name: name, credential: credential}
+}
+
+func (p Profile) Name() string            { return p.name }
+func (p Profile) Credential() *Credential { return p.credential }
+
+// ProfileRepository is the port for enumerating saved profiles.
+// Implemented in the infrastructure layer.
+type ProfileRepository interface {
+	ListProfiles(ctx context.Context) ([]Profile, error)
+}
+
+type profileKey struct{}
+
+// WithProfile selects the credential profile for auth operations on ctx.
+func WithProfile(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, profileKey{}, name)
+}
+
+// ProfileFromContext returns the selected profile, or DefaultProfile.
+func ProfileFromContext(ctx context.Context) string {
+	if name, ok := ctx.Value(profileKey{}).(string); ok && name != "" {
+		return name
+	}
+	return DefaultProfile
+}
@@ -14,6 +14,9 @@ type ActionItem struct {
 	text      string
 	dueDate   *time.Time
 	completed bool
+	// modifiedAt is when a local override last changed the item; nil for
+	// items as Granola returned them.
+	modifiedAt *time.Time
 }
 
 func NewActionItem(id ActionItemID, meetingID MeetingID, owner, text string, dueDate *time.Time) (*ActionItem, error) {
@@ -55,6 +58,21 @@ func (a *ActionItem) DueDate() *time.Time {
 
 func (a *ActionItem) IsCompleted() bool { return a.completed }
 
+// ModifiedAt returns when the item was last changed locally, or nil if it
+// has no local override.
+func (a *ActionItem) ModifiedAt() *time.Time {
+	if a.modifiedAt == nil {
+		return nil
+	}
+	t := *a.modifiedAt
+	return &t
+}
+
+// MarkModified records that a local override changed the item at t.
+func (a *ActionItem) MarkModified(t time.Time) {
+	a.modifiedAt = &t
+}
+
 // Complete marks the action item as done. This is a domain behavior on the entity.
 func (a *ActionItem) Complete() {
 	a.completed = true
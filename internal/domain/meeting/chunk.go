@@ -64,6 +64,13 @@ func NewChunk(
 	}, nil
 }
 
+// WithContent returns a copy of the chunk holding content instead, for
+// rewrites such as redaction that keep its position and metadata.
+func (c Chunk) WithContent(content string) Chunk {
+	c.content = content
+	return c
+}
+
 func isValidChunkSource(s ChunkSource) bool {
 	switch s {
 	case ChunkSourceTranscript, ChunkSourceSummary, ChunkSourceNote:
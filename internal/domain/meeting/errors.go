@@ -9,7 +9,13 @@ var (
 	ErrInvalidActionItemID  = errors.New("action item id must not be empty")
 	ErrInvalidActionItemText = errors.New("action item text must not be empty")
 	ErrMeetingNotFound      = errors.New("meeting not found")
+	ErrActionItemNotFound   = errors.New("action item not found")
 	ErrTranscriptNotReady   = errors.New("transcript not yet available")
 	ErrAccessDenied         = errors.New("access denied to meeting")
 	ErrInvalidFilter        = errors.New("invalid filter parameters")
+	ErrRateLimited          = errors.New("meeting source rate limit exceeded")
+	ErrSourceUnavailable    = errors.New("meeting source temporarily unavailable")
+	// ErrPartialResults accompanies the meetings a List fetched before its
+	// context ended; the list is incomplete but usable.
+	ErrPartialResults = errors.New("partial results: deadline reached before all meetings were fetched")
 )
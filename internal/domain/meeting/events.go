@@ -30,6 +30,23 @@ func (e MeetingCreated) OccurredAt() time.Time { return e.occurred }
 func (e MeetingCreated) MeetingID() MeetingID  { return e.meetingID }
 func (e MeetingCreated) Title() string         { return e.title }
 
+// MeetingDeleted is raised when a meeting is removed upstream.
+type MeetingDeleted struct {
+	meetingID MeetingID
+	occurred  time.Time
+}
+
+func NewMeetingDeletedEvent(meetingID MeetingID) MeetingDeleted {
+	return MeetingDeleted{
+		meetingID: meetingID,
+		occurred:  time.Now().UTC(),
+	}
+}
+
+func (e MeetingDeleted) EventName() string     { return "meeting.deleted" }
+func (e MeetingDeleted) OccurredAt() time.Time { return e.occurred }
+func (e MeetingDeleted) MeetingID() MeetingID  { return e.meetingID }
+
 // TranscriptUpdated is raised when a transcript is attached or modified.
 type TranscriptUpdated struct {
 	meetingID      MeetingID
@@ -20,6 +20,7 @@ type Meeting struct {
 	summary      *Summary
 	actionItems  []*ActionItem
 	metadata     Metadata
+	workspaceID  string
 	createdAt    time.Time
 	updatedAt    time.Time
 	events       []DomainEvent
@@ -68,6 +69,10 @@ func (m *Meeting) CreatedAt() time.Time { return m.createdAt }
 func (m *Meeting) UpdatedAt() time.Time { return m.updatedAt }
 func (m *Meeting) Metadata() Metadata   { return m.metadata }
 
+// WorkspaceID is the ID of the workspace that owns the meeting, or empty
+// when the source did not report one.
+func (m *Meeting) WorkspaceID() string { return m.workspaceID }
+
 func (m *Meeting) Participants() []Participant {
 	copied := make([]Participant, len(m.participants))
 	copy(copied, m.participants)
@@ -122,6 +127,12 @@ func (m *Meeting) SetMetadata(meta Metadata) {
 	m.updatedAt = time.Now().UTC()
 }
 
+// SetWorkspaceID associates the meeting with its owning workspace.
+func (m *Meeting) SetWorkspaceID(id string) {
+	m.workspaceID = id
+	m.updatedAt = time.Now().UTC()
+}
+
 // --- Domain Events ---
 
 // DomainEvents returns the uncommitted domain events.
@@ -1,5 +1,11 @@
 package meeting
 
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
 // ParticipantRole represents a participant's role in a meeting.
 type ParticipantRole string
 
@@ -31,3 +37,28 @@ func (p Participant) Role() ParticipantRole { return p.role }
 func (p Participant) Equals(other Participant) bool {
 	return p.name == other.name && p.email == other.email && p.role == other.role
 }
+
+var emailPattern = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+
+// MaskEmail hides the local part of an email address but keeps its
+// domain for context: "alice@example.com" becomes "a***@example.com".
+// A value without an "@" is masked entirely.
+func MaskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return "***"
+	}
+	if local == "" {
+		return "***@" + domain
+	}
+	_, size := utf8.DecodeRuneInString(local)
+	return local[:size] + "***@" + domain
+}
+
+// MaskEmails applies MaskEmail to every email address found in text.
+func MaskEmails(text string) string {
+	return emailPattern.ReplaceAllStringFunc(text, MaskEmail)
+}
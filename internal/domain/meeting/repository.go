@@ -17,17 +17,41 @@ type ListFilter struct {
 	Offset      int
 }
 
+// SyncError records a document that Sync skipped because it could not be
+// mapped into a valid Meeting.
+type SyncError struct {
+	MeetingID MeetingID
+	Err       error
+}
+
+func (e SyncError) Error() string {
+	return "meeting " + string(e.MeetingID) + ": " + e.Err.Error()
+}
+
+func (e SyncError) Unwrap() error { return e.Err }
+
+// SyncResult is the outcome of a sync. A malformed document is reported in
+// Errors rather than aborting the batch, so Events covers every document
+// that synced successfully.
+type SyncResult struct {
+	Events []DomainEvent
+	Errors []SyncError
+}
+
 // Repository is the port (interface) for meeting persistence (read-only).
 // Defined in the domain layer, implemented in infrastructure.
 // This is the DDD repository pattern — it provides collection-like access
 // to aggregates while hiding persistence details.
+//
+// List may return the meetings fetched so far together with an error
+// wrapping ErrPartialResults when its context ends midway.
 type Repository interface {
 	FindByID(ctx context.Context, id MeetingID) (*Meeting, error)
 	List(ctx context.Context, filter ListFilter) ([]*Meeting, error)
 	GetTranscript(ctx context.Context, id MeetingID) (*Transcript, error)
 	SearchTranscripts(ctx context.Context, query string, filter ListFilter) ([]*Meeting, error)
 	GetActionItems(ctx context.Context, id MeetingID) ([]*ActionItem, error)
-	Sync(ctx context.Context, since *time.Time) ([]DomainEvent, error)
+	Sync(ctx context.Context, since *time.Time) (*SyncResult, error)
 }
 
 // WriteRepository is a separate port for local write operations (ISP).
@@ -37,3 +61,11 @@ type WriteRepository interface {
 	SaveActionItemState(ctx context.Context, item *ActionItem) error
 	GetLocalActionItemState(ctx context.Context, id ActionItemID) (*ActionItem, error)
 }
+
+// SyncStateRepository persists the sync high-water mark: the start time of
+// the last successful sync. Like notes, it is local metadata.
+type SyncStateRepository interface {
+	// LastSyncedAt returns nil when no sync has completed yet.
+	LastSyncedAt(ctx context.Context) (*time.Time, error)
+	SaveLastSyncedAt(ctx context.Context, t time.Time) error
+}
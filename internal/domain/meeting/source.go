@@ -8,8 +8,20 @@ const (
 	SourceMeet  Source = "google_meet"
 	SourceTeams Source = "teams"
 	SourceOther Source = "other"
+	// SourceUnknown marks meetings whose platform was missing or unrecognized.
+	SourceUnknown Source = "unknown"
 )
 
 func (s Source) String() string {
 	return string(s)
 }
+
+// IsKnown reports whether s is one of the canonical sources.
+func (s Source) IsKnown() bool {
+	switch s {
+	case SourceZoom, SourceMeet, SourceTeams, SourceOther, SourceUnknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,61 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidSpeakerAlias = errors.New("speaker alias and canonical name must be non-empty and differ")
+
+// SpeakerAliases maps the labels transcripts use for a person ("Alice",
+// "A. Smith") to one canonical name. Lookups ignore case and surrounding
+// whitespace. Aliases resolve in a single step; a canonical name is not
+// itself looked up again. The zero value maps nothing.
+type SpeakerAliases struct {
+	names map[string]string
+}
+
+// NewSpeakerAliases builds the lookup from alias → canonical pairs.
+func NewSpeakerAliases(aliases map[string]string) SpeakerAliases {
+	names := make(map[string]string, len(aliases))
+	for alias, canonical := range aliases {
+		names[speakerKey(alias)] = canonical
+	}
+	return SpeakerAliases{names: names}
+}
+
+// Canonical returns the canonical name for speaker, or speaker unchanged
+// when it has no alias.
+func (a SpeakerAliases) Canonical(speaker string) string {
+	if canonical, ok := a.names[speakerKey(speaker)]; ok {
+		return canonical
+	}
+	return speaker
+}
+
+// Apply returns t with every aliased speaker replaced by its canonical name.
+func (a SpeakerAliases) Apply(t Transcript) Transcript {
+	if len(a.names) == 0 {
+		return t
+	}
+	utterances := t.Utterances()
+	for i, u := range utterances {
+		utterances[i] = u.WithSpeaker(a.Canonical(u.Speaker()))
+	}
+	return NewTranscript(t.MeetingID(), utterances)
+}
+
+func speakerKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// SpeakerAliasRepository persists speaker aliases. It is local metadata,
+// like notes, so it is not part of the read-only Repository.
+type SpeakerAliasRepository interface {
+	// SaveSpeakerAlias maps alias to canonical, replacing any earlier mapping.
+	SaveSpeakerAlias(ctx context.Context, alias, canonical string) error
+	DeleteSpeakerAlias(ctx context.Context, alias string) error
+	// ListSpeakerAliases returns every alias → canonical pair.
+	ListSpeakerAliases(ctx context.Context) (map[string]string, error)
+}
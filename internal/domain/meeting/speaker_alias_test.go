@@ -0,0 +1,53 @@
+package meeting_test
+
+import (
+	"testing"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+func TestSpeakerAliases_Canonical(t *testing.T) {
+	aliases := domain.NewSpeakerAliases(map[string]string{
+		"Alice":    "Alice Smith",
+		"A. Smith": "Alice Smith",
+	})
+
+	tests := map[string]string{
+		"Alice":     "Alice Smith",
+		" alice ":   "Alice Smith",
+		"a. smith":  "Alice Smith",
+		"Bob":       "Bob",
+		"Alice Smi": "Alice Smi",
+	}
+	for in, want := range tests {
+		if got := aliases.Canonical(in); got != want {
+			t.Errorf("Canonical(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	var none domain.SpeakerAliases
+	if got := none.Canonical("Alice"); got != "Alice" {
+		t.Errorf("zero value: got %q", got)
+	}
+}
+
+func TestSpeakerAliases_Apply(t *testing.T) {
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Hi", now, 0.9),
+		domain.NewUtterance("Bob", "Hello", now, 0.9),
+	})
+
+	got := domain.NewSpeakerAliases(map[string]string{"alice": "Alice Smith"}).Apply(transcript)
+	utterances := got.Utterances()
+	if utterances[0].Speaker() != "Alice Smith" || utterances[1].Speaker() != "Bob" {
+		t.Errorf("got speakers %q, %q", utterances[0].Speaker(), utterances[1].Speaker())
+	}
+	if utterances[0].Text() != "Hi" || got.MeetingID() != "m-1" {
+		t.Error("Apply should only change speakers")
+	}
+	if transcript.Utterances()[0].Speaker() != "Alice" {
+		t.Error("Apply must not modify the original transcript")
+	}
+}
@@ -0,0 +1,37 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTagLength is the longest tag, in characters, NormalizeTag accepts.
+const MaxTagLength = 64
+
+var ErrInvalidTag = errors.New("tag must be non-empty and at most 64 characters")
+
+// NormalizeTag returns tag trimmed and lower-cased, so "Planning" and
+// " planning " are the same tag.
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// TagRepository persists meeting tags. Tags are local metadata, like
+// notes, so they are not part of the read-only Repository and survive
+// re-syncs from Granola.
+type TagRepository interface {
+	// AddTag tags a meeting; adding a tag it already has is a no-op.
+	AddTag(ctx context.Context, id MeetingID, tag string) error
+	// RemoveTag untags a meeting; removing a tag it lacks is a no-op.
+	RemoveTag(ctx context.Context, id MeetingID, tag string) error
+	// TagsOf returns the tags of one meeting, sorted.
+	TagsOf(ctx context.Context, id MeetingID) ([]string, error)
+	// ListTags returns the sorted tags of every tagged meeting.
+	ListTags(ctx context.Context) (map[MeetingID][]string, error)
+}
@@ -1,6 +1,10 @@
 package meeting
 
-import "time"
+import (
+	"slices"
+	"strings"
+	"time"
+)
 
 // Utterance is an immutable value object representing a single spoken segment.
 type Utterance struct {
@@ -8,6 +12,7 @@ type Utterance struct {
 	text       string
 	timestamp  time.Time
 	confidence float64
+	estimated  bool
 }
 
 func NewUtterance(speaker, text string, timestamp time.Time, confidence float64) Utterance {
@@ -24,6 +29,24 @@ func (u Utterance) Text() string         { return u.text }
 func (u Utterance) Timestamp() time.Time { return u.timestamp }
 func (u Utterance) Confidence() float64  { return u.confidence }
 
+// TimestampEstimated reports whether the timestamp was estimated because
+// the source did not report one.
+func (u Utterance) TimestampEstimated() bool { return u.estimated }
+
+// WithEstimatedTimestamp returns a copy of u carrying ts as an estimated
+// timestamp.
+func (u Utterance) WithEstimatedTimestamp(ts time.Time) Utterance {
+	u.timestamp = ts
+	u.estimated = true
+	return u
+}
+
+// WithSpeaker returns a copy of u attributed to speaker.
+func (u Utterance) WithSpeaker(speaker string) Utterance {
+	u.speaker = speaker
+	return u
+}
+
 // Transcript is an immutable value object containing ordered utterances for a meeting.
 type Transcript struct {
 	meetingID  MeetingID
@@ -46,3 +69,76 @@ func (t Transcript) Utterances() []Utterance {
 	copy(copied, t.utterances)
 	return copied
 }
+
+// SortedByTime returns a copy with the timestamped utterances in
+// chronological order. The sort is stable, and utterances without a
+// timestamp keep their positions, since there is nothing to order them by.
+func (t Transcript) SortedByTime() Transcript {
+	sorted := t.Utterances()
+	var positions []int
+	var timed []Utterance
+	for i, u := range sorted {
+		if !u.timestamp.IsZero() {
+			positions = append(positions, i)
+			timed = append(timed, u)
+		}
+	}
+	slices.SortStableFunc(timed, func(a, b Utterance) int {
+		return a.timestamp.Compare(b.timestamp)
+	})
+	for i, pos := range positions {
+		sorted[pos] = timed[i]
+	}
+	return Transcript{meetingID: t.meetingID, utterances: sorted}
+}
+
+// SpeakerSegment is a run of consecutive utterances by one speaker,
+// merged into a single turn.
+type SpeakerSegment struct {
+	speaker    string
+	text       string
+	start      time.Time
+	end        time.Time
+	utterances int
+}
+
+func (s SpeakerSegment) Speaker() string { return s.speaker }
+func (s SpeakerSegment) Text() string    { return s.text }
+
+// Start is the timestamp of the segment's first utterance.
+func (s SpeakerSegment) Start() time.Time { return s.start }
+
+// End is the timestamp of the segment's last utterance; utterances carry
+// no duration, so it marks when the last fragment began.
+func (s SpeakerSegment) End() time.Time { return s.end }
+
+// Utterances counts the utterances merged into the segment.
+func (s SpeakerSegment) Utterances() int { return s.utterances }
+
+// SpeakerSegments merges adjacent utterances by the same speaker, joining
+// their text with spaces. Blank utterances are skipped so they do not
+// split a turn.
+func (t Transcript) SpeakerSegments() []SpeakerSegment {
+	segments := make([]SpeakerSegment, 0, len(t.utterances))
+	for _, u := range t.utterances {
+		text := strings.TrimSpace(u.text)
+		if text == "" {
+			continue
+		}
+		if n := len(segments); n > 0 && segments[n-1].speaker == u.speaker {
+			last := &segments[n-1]
+			last.text += " " + text
+			last.end = u.timestamp
+			last.utterances++
+			continue
+		}
+		segments = append(segments, SpeakerSegment{
+			speaker:    u.speaker,
+			text:       text,
+			start:      u.timestamp,
+			end:        u.timestamp,
+			utterances: 1,
+		})
+	}
+	return segments
+}
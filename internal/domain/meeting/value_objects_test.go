@@ -1,6 +1,7 @@
 package meeting_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -36,6 +37,32 @@ func TestParticipant_EqualityByValue(t *testing.T) {
 	}
 }
 
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"alice@example.com", "a***@example.com"},
+		{"a@example.com", "a***@example.com"},
+		{"élodie@example.fr", "é***@example.fr"},
+		{"@example.com", "***@example.com"},
+		{"not-an-email", "***"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := meeting.MaskEmail(tt.in); got != tt.want {
+			t.Errorf("MaskEmail(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMaskEmails(t *testing.T) {
+	got := meeting.MaskEmails("Alice (alice@example.com), bob.smith+x@mail.example.org and no@ one")
+	want := "Alice (a***@example.com), b***@mail.example.org and no@ one"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
 // --- Utterance Value Object ---
 
 func TestUtterance_Fields(t *testing.T) {
@@ -80,6 +107,66 @@ func TestTranscript_Immutability(t *testing.T) {
 	}
 }
 
+func TestTranscript_SpeakerSegments(t *testing.T) {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time { return start.Add(time.Duration(sec) * time.Second) }
+	tr := meeting.NewTranscript("m-1", []meeting.Utterance{
+		meeting.NewUtterance("Alice", "So", at(0), 0.9),
+		meeting.NewUtterance("Alice", "about the launch", at(1), 0.9),
+		meeting.NewUtterance("Bob", "Yes?", at(3), 0.9),
+		meeting.NewUtterance("Alice", "it slips", at(4), 0.9),
+		meeting.NewUtterance("Alice", "  ", at(5), 0.9),
+		meeting.NewUtterance("Alice", "a week.", at(6), 0.9),
+		meeting.NewUtterance("Bob", "Okay.", at(8), 0.9),
+	})
+
+	got := tr.SpeakerSegments()
+	want := []struct {
+		speaker, text string
+		start, end    time.Time
+		utterances    int
+	}{
+		{"Alice", "So about the launch", at(0), at(1), 2},
+		{"Bob", "Yes?", at(3), at(3), 1},
+		{"Alice", "it slips a week.", at(4), at(6), 2},
+		{"Bob", "Okay.", at(8), at(8), 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d segments, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Speaker() != w.speaker || g.Text() != w.text || !g.Start().Equal(w.start) || !g.End().Equal(w.end) || g.Utterances() != w.utterances {
+			t.Errorf("segment %d: got %s %q %v-%v (%d), want %s %q %v-%v (%d)", i,
+				g.Speaker(), g.Text(), g.Start(), g.End(), g.Utterances(),
+				w.speaker, w.text, w.start, w.end, w.utterances)
+		}
+	}
+}
+
+func TestTranscript_SortedByTime(t *testing.T) {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	tr := meeting.NewTranscript("m-1", []meeting.Utterance{
+		meeting.NewUtterance("Alice", "later", start.Add(time.Minute), 0.9),
+		meeting.NewUtterance("Bob", "untimed", time.Time{}, 0.9),
+		meeting.NewUtterance("Alice", "first", start, 0.9),
+		meeting.NewUtterance("Bob", "also first", start, 0.9),
+	})
+
+	var got []string
+	for _, u := range tr.SortedByTime().Utterances() {
+		got = append(got, u.Text())
+	}
+	// Untimed utterances stay put; equal timestamps keep their order.
+	want := []string{"first", "untimed", "also first", "later"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if tr.Utterances()[0].Text() != "later" {
+		t.Error("sorting must not modify the original transcript")
+	}
+}
+
 // --- Summary Value Object ---
 
 func TestSummary_Fields(t *testing.T) {
@@ -162,11 +249,24 @@ func TestSource_ValidValues(t *testing.T) {
 		meeting.SourceMeet,
 		meeting.SourceTeams,
 		meeting.SourceOther,
+		meeting.SourceUnknown,
 	}
 
 	for _, s := range sources {
 		if s.String() == "" {
 			t.Errorf("source %v should have a string representation", s)
 		}
+		if !s.IsKnown() {
+			t.Errorf("source %v should be known", s)
+		}
+	}
+}
+
+func TestSource_IsKnown_RejectsArbitraryValues(t *testing.T) {
+	if meeting.Source("webex").IsKnown() {
+		t.Error("webex should not be a known source")
+	}
+	if meeting.Source("").IsKnown() {
+		t.Error("empty source should not be known")
 	}
 }
@@ -1,8 +1,31 @@
 package policy
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrAccessDenied  = errors.New("access denied by policy")
 	ErrInvalidPolicy = errors.New("invalid policy configuration")
 )
+
+// DeniedError reports which rule denied a tool call. Rule is empty when
+// the default effect denied it. It matches ErrAccessDenied via errors.Is.
+type DeniedError struct {
+	Rule    string
+	Message string
+}
+
+func (e *DeniedError) Error() string {
+	msg := ErrAccessDenied.Error() + " (default deny)"
+	if e.Rule != "" {
+		msg = fmt.Sprintf("%s rule %q", ErrAccessDenied, e.Rule)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+func (e *DeniedError) Unwrap() error { return ErrAccessDenied }
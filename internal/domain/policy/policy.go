@@ -21,6 +21,7 @@ type Rule struct {
 	Effect     Effect
 	Tools      []string   // Tool names this rule applies to (empty = all tools)
 	Conditions Conditions
+	Message    string // Optional explanation returned when the rule denies a call
 }
 
 // MeetingContext provides meeting metadata for policy evaluation.
@@ -40,12 +41,19 @@ type Policy struct {
 // Evaluate checks if a tool invocation is allowed given the meeting context.
 // Uses first-match-wins semantics. If no rule matches, applies DefaultEffect.
 func (p *Policy) Evaluate(tool string, ctx MeetingContext) Effect {
-	for _, rule := range p.Rules {
+	effect, _ := p.Match(tool, ctx)
+	return effect
+}
+
+// Match is Evaluate that also returns the rule that decided the effect,
+// or nil when the default effect applied.
+func (p *Policy) Match(tool string, ctx MeetingContext) (Effect, *Rule) {
+	for i, rule := range p.Rules {
 		if matchesRule(rule, tool, ctx) {
-			return rule.Effect
+			return rule.Effect, &p.Rules[i]
 		}
 	}
-	return p.DefaultEffect
+	return p.DefaultEffect, nil
 }
 
 // matchesRule checks if a rule applies to the given tool and meeting context.
@@ -1,9 +1,13 @@
 package auth_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,6 +40,73 @@ func TestFileTokenStore_SaveAndLoad(t *testing.T) {
 	}
 }
 
+func TestFileTokenStore_CreatesPrivateFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "acai")
+	store := infraauth.NewFileTokenStore(dir)
+	ctx := domain.WithProfile(context.Background(), "work")
+	if err := store.Save(ctx, *testCredential()); err != nil {
+		t.Fatalf("save error: %v", err)
+	}
+
+	for path, want := range map[string]os.FileMode{
+		dir:                            0o700,
+		filepath.Join(dir, "profiles"): 0o700,
+		filepath.Join(dir, "profiles", "work.json"): 0o600,
+	} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := info.Mode().Perm(); got != want {
+			t.Errorf("%s: got mode %#o, want %#o", path, got, want)
+		}
+	}
+}
+
+func TestFileTokenStore_WarnsOnLoosePermissions(t *testing.T) {
+	dir := t.TempDir()
+	store := infraauth.NewFileTokenStore(dir)
+	var logs bytes.Buffer
+	store.SetLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	if err := store.Save(context.Background(), *testCredential()); err != nil {
+		t.Fatalf("save error: %v", err)
+	}
+	if logs.Len() != 0 {
+		t.Fatalf("unexpected warning for a private file: %s", logs.String())
+	}
+
+	path := filepath.Join(dir, "credentials.json")
+	if err := os.Chmod(path, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(context.Background()); err != nil {
+		t.Fatalf("load error: %v", err)
+	}
+	if out := logs.String(); !strings.Contains(out, "accessible by other users") || !strings.Contains(out, "mode=0644") {
+		t.Errorf("expected a permissions warning, got: %q", out)
+	}
+
+	store.SetStrictPermissions(true)
+	if _, err := store.Load(context.Background()); !errors.Is(err, infraauth.ErrInsecurePermissions) {
+		t.Errorf("load: got %v, want %v", err, infraauth.ErrInsecurePermissions)
+	}
+
+	// Saving again, as a fresh login does, replaces the loose file.
+	if err := store.Save(context.Background(), *testCredential()); err != nil {
+		t.Fatalf("save: got %v, want the file rewritten 0600", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != 0o600 {
+		t.Errorf("got mode %#o after save, want 0600", got)
+	}
+	if _, err := store.Load(context.Background()); err != nil {
+		t.Errorf("load after save: %v", err)
+	}
+}
+
 func TestFileTokenStore_LoadNotFound(t *testing.T) {
 	dir := t.TempDir()
 	store := infraauth.NewFileTokenStore(dir)
@@ -75,7 +146,7 @@ func TestFileTokenStore_DeleteNonexistent(t *testing.T) {
 func TestService_LoginAndStatus(t *testing.T) {
 	dir := t.TempDir()
 	store := infraauth.NewFileTokenStore(dir)
-	svc := infraauth.NewService(store)
+	svc := infraauth.NewService(store, nil)
 
 	cred, err := svc.Login(context.Background(), domain.AuthOAuth)
 	if err != nil {
@@ -94,10 +165,57 @@ func TestService_LoginAndStatus(t *testing.T) {
 	}
 }
 
+type stubIdentityFetcher struct {
+	gotToken string
+	identity domain.Identity
+	err      error
+}
+
+func (s *stubIdentityFetcher) FetchIdentity(_ context.Context, accessToken string) (domain.Identity, error) {
+	s.gotToken = accessToken
+	return s.identity, s.err
+}
+
+func TestService_LoginRecordsIdentity(t *testing.T) {
+	store := infraauth.NewFileTokenStore(t.TempDir())
+	svc := infraauth.NewService(store, nil)
+	fetcher := &stubIdentityFetcher{identity: domain.NewIdentity("u-1", "alice@example.com", "Alice")}
+	svc.SetIdentityFetcher(fetcher)
+
+	cred, err := svc.Login(context.Background(), domain.AuthOAuth)
+	if err != nil {
+		t.Fatalf("login error: %v", err)
+	}
+	if fetcher.gotToken != cred.Token().AccessToken() {
+		t.Errorf("fetcher got token %q", fetcher.gotToken)
+	}
+
+	loaded, err := svc.Status(context.Background())
+	if err != nil {
+		t.Fatalf("status error: %v", err)
+	}
+	if loaded.Identity() != fetcher.identity {
+		t.Errorf("got persisted identity %+v", loaded.Identity())
+	}
+}
+
+func TestService_LoginSurvivesIdentityLookupFailure(t *testing.T) {
+	svc := infraauth.NewService(infraauth.NewFileTokenStore(t.TempDir()), nil)
+	svc.SetIdentityFetcher(&stubIdentityFetcher{err: errors.New("boom")})
+
+	cred, err := svc.Login(context.Background(), domain.AuthOAuth)
+	if err != nil {
+		t.Fatalf("login should not fail on identity lookup: %v", err)
+	}
+	if !cred.Identity().IsZero() {
+		t.Errorf("got identity %+v, want none", cred.Identity())
+	}
+}
+
 func TestService_Logout(t *testing.T) {
 	dir := t.TempDir()
 	store := infraauth.NewFileTokenStore(dir)
-	svc := infraauth.NewService(store)
+	svc := infraauth.NewService(store, nil)
 
 	_, _ = svc.Login(context.Background(), domain.AuthOAuth)
 	if err := svc.Logout(context.Background()); err != nil {
@@ -110,6 +228,142 @@ func TestService_Logout(t *testing.T) {
 	}
 }
 
+func TestFileTokenStore_NamedProfile(t *testing.T) {
+	dir := t.TempDir()
+	store := infraauth.NewFileTokenStore(dir)
+	ctx := domain.WithProfile(context.Background(), "work")
+
+	if err := store.Save(ctx, *testCredential()); err != nil {
+		t.Fatalf("save error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "profiles", "work.json")); err != nil {
+		t.Fatalf("profile file not found: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "credentials.json")); !os.IsNotExist(err) {
+		t.Error("named profile should not write the default credentials file")
+	}
+
+	if _, err := store.Load(ctx); err != nil {
+		t.Fatalf("load profile: %v", err)
+	}
+	if _, err := store.Load(context.Background()); err != domain.ErrNotAuthenticated {
+		t.Errorf("default profile should be empty, got %v", err)
+	}
+}
+
+func TestFileTokenStore_InvalidProfileName(t *testing.T) {
+	store := infraauth.NewFileTokenStore(t.TempDir())
+	ctx := domain.WithProfile(context.Background(), "../escape")
+
+	if err := store.Save(ctx, *testCredential()); err != domain.ErrInvalidProfile {
+		t.Errorf("got error %v, want %v", err, domain.ErrInvalidProfile)
+	}
+}
+
+func TestFileTokenStore_ListProfiles(t *testing.T) {
+	dir := t.TempDir()
+	store := infraauth.NewFileTokenStore(dir)
+	ctx := context.Background()
+
+	_ = store.Save(ctx, *testCredential())
+	for _, name := range []string{"work", "personal"} {
+		token := domain.NewToken("a-"+name, "", time.Now().Add(time.Hour).UTC())
+		cred := domain.NewCredential(domain.AuthAPIToken, token, name+"-ws")
+		if err := store.Save(domain.WithProfile(ctx, name), *cred); err != nil {
+			t.Fatalf("save %s: %v", name, err)
+		}
+	}
+
+	profiles, err := store.ListProfiles(ctx)
+	if err != nil {
+		t.Fatalf("list profiles: %v", err)
+	}
+	want := []string{"default", "personal", "work"}
+	if len(profiles) != len(want) {
+		t.Fatalf("got %d profiles, want %d", len(profiles), len(want))
+	}
+	for i, name := range want {
+		if profiles[i].Name() != name {
+			t.Errorf("profile %d: got %q, want %q", i, profiles[i].Name(), name)
+		}
+	}
+	if profiles[2].Credential().Workspace() != "work-ws" || profiles[2].Credential().Method() != domain.AuthAPIToken {
+		t.Errorf("unexpected work profile credential")
+	}
+}
+
+func TestFileTokenStore_ListProfiles_Empty(t *testing.T) {
+	store := infraauth.NewFileTokenStore(t.TempDir())
+	profiles, err := store.ListProfiles(context.Background())
+	if err != nil {
+		t.Fatalf("list profiles: %v", err)
+	}
+	if len(profiles) != 0 {
+		t.Errorf("got %d profiles, want 0", len(profiles))
+	}
+}
+
+type stubRefresher struct {
+	gotRefreshToken string
+	token           domain.Token
+	err             error
+}
+
+func (s *stubRefresher) RefreshAccessToken(_ context.Context, refreshToken string) (domain.Token, error) {
+	s.gotRefreshToken = refreshToken
+	return s.token, s.err
+}
+
+func TestService_Refresh_PersistsNewToken(t *testing.T) {
+	dir := t.TempDir()
+	store := infraauth.NewFileTokenStore(dir)
+	expired := domain.NewToken("old-access", "old-refresh", time.Now().Add(-1*time.Hour).UTC())
+	identity := domain.NewIdentity("u-1", "alice@example.com", "")
+	if err := store.Save(context.Background(), *domain.NewCredential(domain.AuthOAuth, expired, "ws").WithIdentity(identity)); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	refresher := &stubRefresher{token: domain.NewToken("new-access", "", time.Now().Add(1*time.Hour).UTC())}
+	svc := infraauth.NewService(store, refresher)
+
+	cred, err := svc.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("refresh error: %v", err)
+	}
+	if refresher.gotRefreshToken != "old-refresh" {
+		t.Errorf("refresher got %q, want old-refresh", refresher.gotRefreshToken)
+	}
+	if !cred.IsValid() {
+		t.Error("refreshed credential should be valid")
+	}
+
+	loaded, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if loaded.Token().AccessToken() != "new-access" {
+		t.Errorf("got persisted access token %q", loaded.Token().AccessToken())
+	}
+	if loaded.Token().RefreshToken() != "old-refresh" {
+		t.Errorf("refresh token should be kept when not rotated, got %q", loaded.Token().RefreshToken())
+	}
+	if loaded.Identity() != identity {
+		t.Errorf("identity should survive refresh, got %+v", loaded.Identity())
+	}
+}
+
+func TestService_Refresh_APITokenHasNoRefresh(t *testing.T) {
+	dir := t.TempDir()
+	store := infraauth.NewFileTokenStore(dir)
+	token := domain.NewToken("gra_xxx", "", time.Now().Add(1*time.Hour).UTC())
+	_ = store.Save(context.Background(), *domain.NewCredential(domain.AuthAPIToken, token, "ws"))
+
+	svc := infraauth.NewService(store, &stubRefresher{})
+	if _, err := svc.Refresh(context.Background()); err != domain.ErrNoRefreshToken {
+		t.Errorf("got error %v, want %v", err, domain.ErrNoRefreshToken)
+	}
+}
+
 func testCredential() *domain.Credential {
 	token := domain.NewToken("test-access", "test-refresh", time.Now().Add(1*time.Hour).UTC())
 	return domain.NewCredential(domain.AuthOAuth, token, "test-ws")
@@ -14,13 +14,33 @@ type TokenStore interface {
 	Delete(ctx context.Context) error
 }
 
+// TokenRefresher exchanges an OAuth refresh token for a new access token.
+type TokenRefresher interface {
+	RefreshAccessToken(ctx context.Context, refreshToken string) (domain.Token, error)
+}
+
+// IdentityFetcher looks up the account an access token belongs to.
+type IdentityFetcher interface {
+	FetchIdentity(ctx context.Context, accessToken string) (domain.Identity, error)
+}
+
 // Service implements domain.Service for authentication.
 type Service struct {
-	store TokenStore
+	store     TokenStore
+	refresher TokenRefresher
+	identity  IdentityFetcher
+}
+
+// NewService creates an auth service. If refresher is nil, Refresh always
+// fails with domain.ErrNoRefreshToken.
+func NewService(store TokenStore, refresher TokenRefresher) *Service {
+	return &Service{store: store, refresher: refresher}
 }
 
-func NewService(store TokenStore) *Service {
-	return &Service{store: store}
+// SetIdentityFetcher makes Login record the authenticated account on the
+// credential. Without one, credentials carry no identity.
+func (s *Service) SetIdentityFetcher(f IdentityFetcher) {
+	s.identity = f
 }
 
 func (s *Service) Login(ctx context.Context, method domain.AuthMethod) (*domain.Credential, error) {
@@ -29,6 +49,12 @@ func (s *Service) Login(ctx context.Context, method domain.AuthMethod) (*domain.
 	// This is a placeholder — the real OAuth flow will be added in Phase 1.
 	token := domain.NewToken("placeholder", "", time.Now().Add(24*time.Hour).UTC())
 	cred := domain.NewCredential(method, token, "default")
+	// The identity is informational, so a failed lookup does not fail login.
+	if s.identity != nil {
+		if identity, err := s.identity.FetchIdentity(ctx, token.AccessToken()); err == nil {
+			cred = cred.WithIdentity(identity)
+		}
+	}
 
 	if err := s.store.Save(ctx, *cred); err != nil {
 		return nil, err
@@ -44,3 +70,31 @@ func (s *Service) Status(ctx context.Context) (*domain.Credential, error) {
 func (s *Service) Logout(ctx context.Context) error {
 	return s.store.Delete(ctx)
 }
+
+// Refresh obtains a new access token using the stored OAuth refresh token
+// and persists the updated credential.
+func (s *Service) Refresh(ctx context.Context) (*domain.Credential, error) {
+	cred, err := s.store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken := cred.Token().RefreshToken()
+	if s.refresher == nil || cred.Method() != domain.AuthOAuth || refreshToken == "" {
+		return nil, domain.ErrNoRefreshToken
+	}
+
+	token, err := s.refresher.RefreshAccessToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	// Providers may omit the refresh token when it is not rotated.
+	if token.RefreshToken() == "" {
+		token = domain.NewToken(token.AccessToken(), refreshToken, token.ExpiresAt())
+	}
+
+	refreshed := domain.NewCredential(cred.Method(), token, cred.Workspace()).WithIdentity(cred.Identity())
+	if err := s.store.Save(ctx, *refreshed); err != nil {
+		return nil, err
+	}
+	return refreshed, nil
+}
@@ -6,8 +6,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/auth"
@@ -20,19 +25,52 @@ type tokenFile struct {
 	RefreshToken string    `json:"refresh_token"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	Workspace    string    `json:"workspace"`
+	AccountID    string    `json:"account_id,omitempty"`
+	Email        string    `json:"email,omitempty"`
+	Name         string    `json:"name,omitempty"`
 }
 
-// FileTokenStore persists credentials to a JSON file.
+// ErrInsecurePermissions is returned in strict mode for a credentials
+// file that users other than its owner can access.
+var ErrInsecurePermissions = errors.New("credentials file is accessible by other users")
+
+// FileTokenStore persists credentials to JSON files, one per profile.
+// The default profile lives at <dir>/credentials.json; named profiles
+// live at <dir>/profiles/<name>.json. The profile is taken from the context.
+//
+// Files are written 0600 in 0700 directories, replacing any existing file,
+// so saving again tightens a loose one. A file with group or world
+// permissions is logged as a warning on load, or rejected with
+// ErrInsecurePermissions in strict mode.
 type FileTokenStore struct {
-	dir string
+	dir    string
+	strict bool
+	logger *slog.Logger
 }
 
 func NewFileTokenStore(dir string) *FileTokenStore {
-	return &FileTokenStore{dir: dir}
+	return &FileTokenStore{dir: dir, logger: slog.Default()}
+}
+
+// SetStrictPermissions makes loose credentials file permissions an error
+// instead of a warning.
+func (s *FileTokenStore) SetStrictPermissions(strict bool) {
+	s.strict = strict
 }
 
-func (s *FileTokenStore) Save(_ context.Context, cred domain.Credential) error {
-	if err := os.MkdirAll(s.dir, 0700); err != nil {
+// SetLogger sets the logger that receives permission warnings.
+func (s *FileTokenStore) SetLogger(logger *slog.Logger) {
+	if logger != nil {
+		s.logger = logger
+	}
+}
+
+func (s *FileTokenStore) Save(ctx context.Context, cred domain.Credential) error {
+	path, err := s.path(ctx)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
 		return err
 	}
 
@@ -42,6 +80,9 @@ func (s *FileTokenStore) Save(_ context.Context, cred domain.Credential) error {
 		RefreshToken: cred.Token().RefreshToken(),
 		ExpiresAt:    cred.Token().ExpiresAt(),
 		Workspace:    cred.Workspace(),
+		AccountID:    cred.Identity().AccountID(),
+		Email:        cred.Identity().Email(),
+		Name:         cred.Identity().Name(),
 	}
 
 	data, err := json.MarshalIndent(f, "", "  ")
@@ -49,11 +90,46 @@ func (s *FileTokenStore) Save(_ context.Context, cred domain.Credential) error {
 		return err
 	}
 
-	return os.WriteFile(s.path(), data, 0600)
+	return writePrivateFile(path, data)
+}
+
+// writePrivateFile writes data to a 0600 temporary file next to path and
+// renames it into place. Unlike os.WriteFile, the result never keeps the
+// mode of the file it replaces.
+func writePrivateFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := tmp.Chmod(0600); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *FileTokenStore) Load(ctx context.Context) (*domain.Credential, error) {
+	path, err := s.path(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.loadCredential(path)
 }
 
-func (s *FileTokenStore) Load(_ context.Context) (*domain.Credential, error) {
-	data, err := os.ReadFile(s.path())
+func (s *FileTokenStore) loadCredential(path string) (*domain.Credential, error) {
+	if err := s.checkPermissions(path); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil, domain.ErrNotAuthenticated
@@ -67,18 +143,89 @@ func (s *FileTokenStore) Load(_ context.Context) (*domain.Credential, error) {
 	}
 
 	token := domain.NewToken(f.AccessToken, f.RefreshToken, f.ExpiresAt)
-	cred := domain.NewCredential(domain.AuthMethod(f.Method), token, f.Workspace)
+	cred := domain.NewCredential(domain.AuthMethod(f.Method), token, f.Workspace).
+		WithIdentity(domain.NewIdentity(f.AccountID, f.Email, f.Name))
 
 	return cred, nil
 }
 
-func (s *FileTokenStore) Delete(_ context.Context) error {
-	if err := os.Remove(s.path()); err != nil && !errors.Is(err, os.ErrNotExist) {
+func (s *FileTokenStore) Delete(ctx context.Context) error {
+	path, err := s.path(ctx)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
 		return err
 	}
 	return nil
 }
 
-func (s *FileTokenStore) path() string {
-	return filepath.Join(s.dir, "credentials.json")
+// ListProfiles returns every saved profile, the default first and the rest by name.
+func (s *FileTokenStore) ListProfiles(_ context.Context) ([]domain.Profile, error) {
+	var profiles []domain.Profile
+
+	if cred, err := s.loadCredential(filepath.Join(s.dir, "credentials.json")); err == nil {
+		profiles = append(profiles, domain.NewProfile(domain.DefaultProfile, cred))
+	} else if !errors.Is(err, domain.ErrNotAuthenticated) {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, "profiles"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if e.IsDir() || !ok || domain.ValidateProfileName(name) != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		cred, err := s.loadCredential(filepath.Join(s.dir, "profiles", name+".json"))
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, domain.NewProfile(name, cred))
+	}
+	return profiles, nil
 }
+
+// checkPermissions warns about, or in strict mode rejects, a credentials
+// file with any group or world permission bits. A missing file passes;
+// Windows has no such bits to check.
+func (s *FileTokenStore) checkPermissions(path string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+	mode := info.Mode().Perm()
+	if mode&0o077 == 0 {
+		return nil
+	}
+	if s.strict {
+		return fmt.Errorf("%w: %s has mode %#o, run chmod 600 on it", ErrInsecurePermissions, path, mode)
+	}
+	s.logger.Warn("auth: credentials file is accessible by other users; run chmod 600 on it",
+		"path", path, "mode", fmt.Sprintf("%#o", mode))
+	return nil
+}
+
+func (s *FileTokenStore) path(ctx context.Context) (string, error) {
+	profile := domain.ProfileFromContext(ctx)
+	if profile == domain.DefaultProfile {
+		return filepath.Join(s.dir, "credentials.json"), nil
+	}
+	if err := domain.ValidateProfileName(profile); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, "profiles", profile+".json"), nil
+}
+
+var _ domain.ProfileRepository = (*FileTokenStore)(nil)
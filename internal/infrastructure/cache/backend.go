@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend stores the cache's entries. CachedRepository decides what to
+// cache and honours expiry; a backend only keeps values with their expiry
+// time, so SQLite, in-process memory, or a shared store like Redis can
+// hold them.
+type Backend interface {
+	// Get returns the value stored under key and when it expires. Expired
+	// entries are still returned until evicted, so they can be served
+	// stale in offline mode.
+	Get(key string) (value []byte, expiresAt time.Time, ok bool)
+	// Set stores value under key, replacing any previous entry, until ttl
+	// from now.
+	Set(key string, value []byte, ttl time.Duration) error
+	// Delete removes the entry under key, if any.
+	Delete(key string) error
+	// DeletePrefix removes every entry whose key starts with prefix.
+	DeletePrefix(prefix string) error
+	// Evict removes every entry that has expired.
+	Evict() error
+}
+
+// MemoryBackend keeps entries in process memory. They are lost on exit
+// and not shared between instances, which suits tests and short-lived
+// processes. Safe for concurrent use.
+type MemoryBackend struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryBackend creates an empty in-memory backend.
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{entries: make(map[string]memoryEntry)}
+}
+
+func (b *MemoryBackend) Get(key string) ([]byte, time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, ok := b.entries[key]
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	return append([]byte(nil), e.value...), e.expiresAt, true
+}
+
+func (b *MemoryBackend) Set(key string, value []byte, ttl time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.entries[key] = memoryEntry{
+		value:     append([]byte(nil), value...),
+		expiresAt: time.Now().UTC().Add(ttl),
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Delete(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.entries, key)
+	return nil
+}
+
+func (b *MemoryBackend) DeletePrefix(prefix string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key := range b.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(b.entries, key)
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Evict() error {
+	now := time.Now().UTC()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key, e := range b.entries {
+		if !e.expiresAt.After(now) {
+			delete(b.entries, key)
+		}
+	}
+	return nil
+}
+
+var _ Backend = (*MemoryBackend)(nil)
@@ -0,0 +1,125 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/cache"
+)
+
+// backends returns a fresh instance of every Backend implementation.
+func backends(t *testing.T) map[string]cache.Backend {
+	t.Helper()
+	db := openTestDB(t)
+	// Each :memory: connection is a separate database; pin to one.
+	db.SetMaxOpenConns(1)
+	sqlite, err := cache.NewSQLiteBackend(db)
+	if err != nil {
+		t.Fatalf("new sqlite backend: %v", err)
+	}
+	return map[string]cache.Backend{
+		"sqlite": sqlite,
+		"memory": cache.NewMemoryBackend(),
+	}
+}
+
+func TestBackend_Contract(t *testing.T) {
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			if _, _, ok := b.Get("missing"); ok {
+				t.Error("expected a miss for an unknown key")
+			}
+
+			before := time.Now().UTC()
+			if err := b.Set("meeting:m-1", []byte("one"), time.Hour); err != nil {
+				t.Fatalf("set: %v", err)
+			}
+			if err := b.Set("meeting:m-1", []byte("two"), time.Hour); err != nil {
+				t.Fatalf("set: %v", err)
+			}
+			value, expiresAt, ok := b.Get("meeting:m-1")
+			if !ok || string(value) != "two" {
+				t.Fatalf("got %q, %v; want the replaced value", value, ok)
+			}
+			if expiresAt.Before(before.Add(time.Hour)) || expiresAt.After(time.Now().UTC().Add(time.Hour)) {
+				t.Errorf("got expiry %v, want an hour from now", expiresAt)
+			}
+
+			// Expired entries stay readable until evicted.
+			_ = b.Set("meeting:m-old", []byte("old"), -time.Second)
+			if _, _, ok := b.Get("meeting:m-old"); !ok {
+				t.Error("expected the expired entry before eviction")
+			}
+			if err := b.Evict(); err != nil {
+				t.Fatalf("evict: %v", err)
+			}
+			if _, _, ok := b.Get("meeting:m-old"); ok {
+				t.Error("expected the expired entry to be evicted")
+			}
+			if _, _, ok := b.Get("meeting:m-1"); !ok {
+				t.Error("eviction must keep live entries")
+			}
+
+			_ = b.Set("search:a", []byte("a"), time.Hour)
+			_ = b.Set("search:b", []byte("b"), time.Hour)
+			_ = b.Set("search_x", []byte("x"), time.Hour)
+			if err := b.DeletePrefix("search:"); err != nil {
+				t.Fatalf("delete prefix: %v", err)
+			}
+			for key, want := range map[string]bool{"search:a": false, "search:b": false, "search_x": true, "meeting:m-1": true} {
+				if _, _, ok := b.Get(key); ok != want {
+					t.Errorf("%s: present %v, want %v", key, ok, want)
+				}
+			}
+
+			if err := b.Delete("meeting:m-1"); err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+			if _, _, ok := b.Get("meeting:m-1"); ok {
+				t.Error("expected the deleted entry to be gone")
+			}
+		})
+	}
+}
+
+func TestCachedRepository_WithEachBackend(t *testing.T) {
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			inner := newMockRepo()
+			inner.meetings["m-1"] = mustMeeting(t, "m-1", "Sprint Planning")
+			repo := cache.NewCachedRepositoryWithBackend(inner, b, time.Millisecond)
+			stale := false
+			repo.SetServeStale(func() bool { return stale })
+			ctx := context.Background()
+
+			_, _ = repo.FindByID(ctx, "m-1")
+			time.Sleep(5 * time.Millisecond)
+			_, _ = repo.FindByID(ctx, "m-1")
+			if inner.findCalls != 2 {
+				t.Errorf("expected an expired entry to miss, got %d inner calls", inner.findCalls)
+			}
+
+			stale = true
+			time.Sleep(5 * time.Millisecond)
+			if err := repo.Evict(); err != nil {
+				t.Fatalf("evict: %v", err)
+			}
+			if m, err := repo.FindByID(ctx, "m-1"); err != nil || m.Title() != "Sprint Planning" {
+				t.Fatalf("got %v, %v; want the stale cached meeting", m, err)
+			}
+			if inner.findCalls != 2 {
+				t.Errorf("expected the stale entry to be served, got %d inner calls", inner.findCalls)
+			}
+
+			stale = false
+			if err := repo.Invalidate(ctx, "m-1"); err != nil {
+				t.Fatalf("invalidate: %v", err)
+			}
+			_, _ = repo.FindByID(ctx, "m-1")
+			if inner.findCalls != 3 {
+				t.Errorf("expected a miss after invalidation, got %d inner calls", inner.findCalls)
+			}
+		})
+	}
+}
@@ -1,86 +1,228 @@
-// Package cache provides a SQLite-backed repository decorator
-// that caches meeting data locally to reduce API calls to Granola.
+// Package cache provides a repository decorator that caches meeting data
+// to reduce API calls to Granola, in SQLite by default or any Backend.
 // Implements the decorator pattern: wraps a domain.Repository,
 // checks local cache first, falls through to inner on miss.
 package cache
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
+	"log"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
 )
 
-// CachedRepository decorates a domain.Repository with local SQLite caching.
+// CachedRepository decorates a domain.Repository with caching in a Backend.
 type CachedRepository struct {
-	inner domain.Repository
-	db    *sql.DB
-	ttl   time.Duration
+	inner   domain.Repository
+	backend Backend
+	ttl     time.Duration
+	metrics *metrics.Registry
+
+	// searchTTL is how long SearchTranscripts results are reused; zero
+	// disables search caching.
+	searchTTL time.Duration
+
+	// serveStale, when it returns true, makes expired entries servable
+	// and pauses eviction (offline mode, where the cache is the only source).
+	serveStale func() bool
 }
 
-// NewCachedRepository creates a cached repository decorator.
-// It initializes the cache schema on the provided database connection.
+// NewCachedRepository creates a cached repository decorator backed by
+// SQLite. It initializes the cache schema on the provided database
+// connection.
 func NewCachedRepository(inner domain.Repository, db *sql.DB, ttl time.Duration) (*CachedRepository, error) {
-	if err := initSchema(db); err != nil {
+	backend, err := NewSQLiteBackend(db)
+	if err != nil {
 		return nil, err
 	}
-	return &CachedRepository{inner: inner, db: db, ttl: ttl}, nil
+	return NewCachedRepositoryWithBackend(inner, backend, ttl), nil
+}
+
+// NewCachedRepositoryWithBackend creates a cached repository decorator
+// storing its entries in backend.
+func NewCachedRepositoryWithBackend(inner domain.Repository, backend Backend, ttl time.Duration) *CachedRepository {
+	return &CachedRepository{inner: inner, backend: backend, ttl: ttl}
 }
 
-func initSchema(db *sql.DB) error {
-	_, err := db.Exec(`
-		CREATE TABLE IF NOT EXISTS cache_entries (
-			key        TEXT PRIMARY KEY,
-			value      BLOB NOT NULL,
-			expires_at DATETIME NOT NULL
-		);
-		CREATE INDEX IF NOT EXISTS idx_cache_expires ON cache_entries(expires_at);
-	`)
-	return err
+// SetMetrics records cache hits and misses in the given registry.
+func (r *CachedRepository) SetMetrics(m *metrics.Registry) {
+	r.metrics = m
+}
+
+// SetSearchTTL caches SearchTranscripts results for ttl, keyed on the
+// query and filter, so repeated identical searches skip the inner
+// repository. Zero, the default, disables it. Sync drops every cached
+// search.
+func (r *CachedRepository) SetSearchTTL(ttl time.Duration) {
+	r.searchTTL = ttl
+}
+
+// SetServeStale makes the cache ignore expiry, and skip eviction, whenever fn
+// returns true.
+func (r *CachedRepository) SetServeStale(fn func() bool) {
+	r.serveStale = fn
+}
+
+func (r *CachedRepository) stale() bool {
+	return r.serveStale != nil && r.serveStale()
 }
 
 func (r *CachedRepository) get(key string) ([]byte, bool) {
-	var data []byte
-	err := r.db.QueryRow(
-		"SELECT value FROM cache_entries WHERE key = ? AND expires_at > ?",
-		key, time.Now().UTC(),
-	).Scan(&data)
-	if err != nil {
+	data, expiresAt, ok := r.backend.Get(key)
+	if !ok || (!expiresAt.After(time.Now().UTC()) && !r.stale()) {
 		return nil, false
 	}
 	return data, true
 }
 
-func (r *CachedRepository) set(key string, value []byte) {
-	_, _ = r.db.Exec(
-		"INSERT OR REPLACE INTO cache_entries (key, value, expires_at) VALUES (?, ?, ?)",
-		key, value, time.Now().UTC().Add(r.ttl),
-	)
+func (r *CachedRepository) set(key string, value []byte, ttl time.Duration) {
+	_ = r.backend.Set(key, value, ttl)
 }
 
-// Evict removes expired entries from the cache.
+// Evict removes expired entries from the cache. It does nothing while
+// stale entries are being served.
 func (r *CachedRepository) Evict() error {
-	_, err := r.db.Exec("DELETE FROM cache_entries WHERE expires_at <= ?", time.Now().UTC())
-	return err
+	if r.stale() {
+		return nil
+	}
+	return r.backend.Evict()
+}
+
+// DefaultEvictInterval is how often StartEvictor runs when no interval is given.
+const DefaultEvictInterval = time.Hour
+
+// StartEvictor calls Evict every interval in a background goroutine until
+// ctx is cancelled. A non-positive interval uses DefaultEvictInterval.
+// The returned channel is closed once the goroutine has exited.
+func (r *CachedRepository) StartEvictor(ctx context.Context, interval time.Duration) <-chan struct{} {
+	if interval <= 0 {
+		interval = DefaultEvictInterval
+	}
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Evict(); err != nil {
+					log.Printf("cache: eviction failed: %v", err)
+				}
+			}
+		}
+	}()
+	return done
 }
 
 // meetingCacheEntry is the serialized form of a Meeting for cache storage.
+// It keeps everything but the transcript, which is fetched separately.
 type meetingCacheEntry struct {
 	ID       string `json:"id"`
 	Title    string `json:"title"`
 	Datetime string `json:"datetime"`
 	Source   string `json:"source"`
+
+	WorkspaceID  string                  `json:"workspace_id,omitempty"`
+	Participants []participantCacheEntry `json:"participants,omitempty"`
+	Summary      *summaryCacheEntry      `json:"summary,omitempty"`
+	ActionItems  []actionItemCacheEntry  `json:"action_items,omitempty"`
+	Tags         []string                `json:"tags,omitempty"`
+	Links        []string                `json:"links,omitempty"`
+	ExternalRefs map[string]string       `json:"external_refs,omitempty"`
+}
+
+type participantCacheEntry struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+type summaryCacheEntry struct {
+	Content string `json:"content"`
+	Kind    string `json:"kind"`
+}
+
+type actionItemCacheEntry struct {
+	ID        string     `json:"id"`
+	Owner     string     `json:"owner,omitempty"`
+	Text      string     `json:"text"`
+	DueDate   *time.Time `json:"due_date,omitempty"`
+	Completed bool       `json:"completed,omitempty"`
 }
 
 func toMeetingCacheEntry(m *domain.Meeting) meetingCacheEntry {
-	return meetingCacheEntry{
+	e := meetingCacheEntry{
 		ID:       string(m.ID()),
 		Title:    m.Title(),
 		Datetime: m.Datetime().Format(time.RFC3339),
 		Source:   string(m.Source()),
+
+		WorkspaceID:  m.WorkspaceID(),
+		Tags:         m.Metadata().Tags(),
+		Links:        m.Metadata().Links(),
+		ExternalRefs: m.Metadata().ExternalRefs(),
+	}
+	for _, p := range m.Participants() {
+		e.Participants = append(e.Participants, participantCacheEntry{Name: p.Name(), Email: p.Email(), Role: string(p.Role())})
+	}
+	if s := m.Summary(); s != nil {
+		e.Summary = &summaryCacheEntry{Content: s.Content(), Kind: string(s.Kind())}
+	}
+	for _, item := range m.ActionItems() {
+		e.ActionItems = append(e.ActionItems, actionItemCacheEntry{
+			ID:        string(item.ID()),
+			Owner:     item.Owner(),
+			Text:      item.Text(),
+			DueDate:   item.DueDate(),
+			Completed: item.IsCompleted(),
+		})
 	}
+	return e
+}
+
+func (e meetingCacheEntry) toMeeting() (*domain.Meeting, error) {
+	dt, _ := time.Parse(time.RFC3339, e.Datetime)
+	participants := make([]domain.Participant, len(e.Participants))
+	for i, p := range e.Participants {
+		participants[i] = domain.NewParticipant(p.Name, p.Email, domain.ParticipantRole(p.Role))
+	}
+	m, err := domain.New(domain.MeetingID(e.ID), e.Title, dt, domain.Source(e.Source), participants)
+	if err != nil {
+		return nil, err
+	}
+	if e.WorkspaceID != "" {
+		m.SetWorkspaceID(e.WorkspaceID)
+	}
+	if e.Summary != nil {
+		m.AttachSummary(domain.NewSummary(m.ID(), e.Summary.Content, domain.SummaryKind(e.Summary.Kind)))
+	}
+	for _, ai := range e.ActionItems {
+		item, err := domain.NewActionItem(domain.ActionItemID(ai.ID), m.ID(), ai.Owner, ai.Text, ai.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		if ai.Completed {
+			item.Complete()
+		}
+		m.AddActionItem(item)
+	}
+	if len(e.Tags) > 0 || len(e.Links) > 0 || len(e.ExternalRefs) > 0 {
+		m.SetMetadata(domain.NewMetadata(e.Tags, e.Links, e.ExternalRefs))
+	}
+	m.ClearDomainEvents()
+	return m, nil
 }
 
 func (r *CachedRepository) FindByID(ctx context.Context, id domain.MeetingID) (*domain.Meeting, error) {
@@ -88,14 +230,13 @@ func (r *CachedRepository) FindByID(ctx context.Context, id domain.MeetingID) (*
 	if data, ok := r.get(cacheKey); ok {
 		var entry meetingCacheEntry
 		if json.Unmarshal(data, &entry) == nil {
-			dt, _ := time.Parse(time.RFC3339, entry.Datetime)
-			m, err := domain.New(domain.MeetingID(entry.ID), entry.Title, dt, domain.Source(entry.Source), nil)
-			if err == nil {
-				m.ClearDomainEvents()
+			if m, err := entry.toMeeting(); err == nil {
+				r.metrics.CacheHit()
 				return m, nil
 			}
 		}
 	}
+	r.metrics.CacheMiss()
 
 	m, err := r.inner.FindByID(ctx, id)
 	if err != nil {
@@ -103,7 +244,7 @@ func (r *CachedRepository) FindByID(ctx context.Context, id domain.MeetingID) (*
 	}
 
 	if data, marshalErr := json.Marshal(toMeetingCacheEntry(m)); marshalErr == nil {
-		r.set(cacheKey, data)
+		r.set(cacheKey, data, r.ttl)
 	}
 	return m, nil
 }
@@ -119,8 +260,71 @@ func (r *CachedRepository) GetTranscript(ctx context.Context, id domain.MeetingI
 }
 
 func (r *CachedRepository) SearchTranscripts(ctx context.Context, query string, filter domain.ListFilter) ([]*domain.Meeting, error) {
-	// Search is parameterized — delegate directly to inner.
-	return r.inner.SearchTranscripts(ctx, query, filter)
+	if r.searchTTL <= 0 {
+		return r.inner.SearchTranscripts(ctx, query, filter)
+	}
+
+	cacheKey, keyErr := searchCacheKey(query, filter)
+	if keyErr == nil {
+		if meetings, ok := r.cachedSearch(cacheKey); ok {
+			r.metrics.CacheHit()
+			return meetings, nil
+		}
+	}
+	r.metrics.CacheMiss()
+
+	meetings, err := r.inner.SearchTranscripts(ctx, query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyErr == nil {
+		entries := make([]meetingCacheEntry, len(meetings))
+		for i, m := range meetings {
+			entries[i] = toMeetingCacheEntry(m)
+		}
+		if data, marshalErr := json.Marshal(entries); marshalErr == nil {
+			r.set(cacheKey, data, r.searchTTL)
+		}
+	}
+	return meetings, nil
+}
+
+// searchCachePrefix starts every cached search key, so Sync can drop them
+// all at once.
+const searchCachePrefix = "search:"
+
+// searchCacheKey hashes the query and filter into a fixed-length key.
+func searchCacheKey(query string, filter domain.ListFilter) (string, error) {
+	data, err := json.Marshal(struct {
+		Query  string
+		Filter domain.ListFilter
+	}{query, filter})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return searchCachePrefix + hex.EncodeToString(sum[:]), nil
+}
+
+func (r *CachedRepository) cachedSearch(key string) ([]*domain.Meeting, bool) {
+	data, ok := r.get(key)
+	if !ok {
+		return nil, false
+	}
+	var entries []meetingCacheEntry
+	if json.Unmarshal(data, &entries) != nil {
+		return nil, false
+	}
+	meetings := make([]*domain.Meeting, len(entries))
+	for i, entry := range entries {
+		m, err := entry.toMeeting()
+		if err != nil {
+			return nil, false
+		}
+		meetings[i] = m
+	}
+	return meetings, true
 }
 
 func (r *CachedRepository) GetActionItems(ctx context.Context, id domain.MeetingID) ([]*domain.ActionItem, error) {
@@ -128,17 +332,25 @@ func (r *CachedRepository) GetActionItems(ctx context.Context, id domain.Meeting
 	return r.inner.GetActionItems(ctx, id)
 }
 
-func (r *CachedRepository) Sync(ctx context.Context, since *time.Time) ([]domain.DomainEvent, error) {
+func (r *CachedRepository) Sync(ctx context.Context, since *time.Time) (*domain.SyncResult, error) {
 	// Sync always hits the API — and invalidates relevant cache entries.
-	events, err := r.inner.Sync(ctx, since)
+	result, err := r.inner.Sync(ctx, since)
 	if err != nil {
 		return nil, err
 	}
 	// Invalidate cache for any meetings referenced in events.
-	for _, e := range events {
+	for _, e := range result.Events {
 		if mc, ok := e.(domain.MeetingCreated); ok {
-			_, _ = r.db.Exec("DELETE FROM cache_entries WHERE key = ?", "meeting:"+string(mc.MeetingID()))
+			_ = r.Invalidate(ctx, mc.MeetingID())
 		}
 	}
-	return events, nil
+	// Synced meetings may change any search's results.
+	_ = r.backend.DeletePrefix(searchCachePrefix)
+	return result, nil
+}
+
+// Invalidate drops the cached entry for a meeting, e.g. after it was
+// deleted upstream, so the next lookup goes to the inner repository.
+func (r *CachedRepository) Invalidate(_ context.Context, id domain.MeetingID) error {
+	return r.backend.Delete("meeting:" + string(id))
 }
@@ -3,16 +3,21 @@ package cache_test
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 	"github.com/felixgeelhaar/acai/internal/infrastructure/cache"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 type mockRepo struct {
 	meetings    map[domain.MeetingID]*domain.Meeting
+	actionItems map[domain.MeetingID][]*domain.ActionItem
 	findCalls   int
 	listCalls   int
 	syncCalls   int
@@ -46,16 +51,28 @@ func (m *mockRepo) GetTranscript(_ context.Context, _ domain.MeetingID) (*domain
 
 func (m *mockRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListFilter) ([]*domain.Meeting, error) {
 	m.searchCalls++
-	return nil, nil
+	result := make([]*domain.Meeting, 0, len(m.meetings))
+	for _, meeting := range m.meetings {
+		result = append(result, meeting)
+	}
+	return result, nil
+}
+
+func (m *mockRepo) GetActionItems(_ context.Context, id domain.MeetingID) ([]*domain.ActionItem, error) {
+	return m.actionItems[id], nil
 }
 
-func (m *mockRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
+// mockWriteRepo accepts action item overrides without storing them.
+type mockWriteRepo struct{}
+
+func (mockWriteRepo) SaveActionItemState(_ context.Context, _ *domain.ActionItem) error { return nil }
+func (mockWriteRepo) GetLocalActionItemState(_ context.Context, _ domain.ActionItemID) (*domain.ActionItem, error) {
 	return nil, nil
 }
 
-func (m *mockRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
+func (m *mockRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
 	m.syncCalls++
-	return nil, nil
+	return &domain.SyncResult{}, nil
 }
 
 func openTestDB(t *testing.T) *sql.DB {
@@ -125,6 +142,50 @@ func TestCachedRepository_FindByID_CacheHit(t *testing.T) {
 	}
 }
 
+func TestCachedRepository_FindByID_CacheHitKeepsWorkspace(t *testing.T) {
+	db := openTestDB(t)
+	inner := newMockRepo()
+	m := mustMeeting(t, "m-1", "Sprint Planning")
+	m.SetWorkspaceID("ws-1")
+	inner.meetings["m-1"] = m
+
+	repo, err := cache.NewCachedRepository(inner, db, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+
+	_, _ = repo.FindByID(context.Background(), "m-1")
+	cached, err := repo.FindByID(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cached.WorkspaceID() != "ws-1" {
+		t.Errorf("got workspace %q, want ws-1", cached.WorkspaceID())
+	}
+}
+
+func TestCachedRepository_FindByID_RecordsMetrics(t *testing.T) {
+	db := openTestDB(t)
+	inner := newMockRepo()
+	inner.meetings["m-1"] = mustMeeting(t, "m-1", "Sprint Planning")
+
+	repo, err := cache.NewCachedRepository(inner, db, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	registry := metrics.NewRegistry()
+	repo.SetMetrics(registry)
+
+	_, _ = repo.FindByID(context.Background(), "m-1")
+	_, _ = repo.FindByID(context.Background(), "m-1")
+
+	var b strings.Builder
+	_, _ = registry.WriteTo(&b)
+	if !strings.Contains(b.String(), "acai_cache_hits_total 1") || !strings.Contains(b.String(), "acai_cache_misses_total 1") {
+		t.Errorf("expected one hit and one miss:\n%s", b.String())
+	}
+}
+
 func TestCachedRepository_FindByID_NotFound(t *testing.T) {
 	db := openTestDB(t)
 	inner := newMockRepo()
@@ -196,6 +257,78 @@ func TestCachedRepository_Evict(t *testing.T) {
 	}
 }
 
+func TestCachedRepository_ServeStale(t *testing.T) {
+	db := openTestDB(t)
+	inner := newMockRepo()
+	inner.meetings["m-1"] = mustMeeting(t, "m-1", "Old Meeting")
+
+	repo, err := cache.NewCachedRepository(inner, db, 1*time.Millisecond)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	stale := true
+	repo.SetServeStale(func() bool { return stale })
+
+	_, _ = repo.FindByID(context.Background(), "m-1")
+	time.Sleep(5 * time.Millisecond)
+
+	if err := repo.Evict(); err != nil {
+		t.Fatalf("evict error: %v", err)
+	}
+	m, err := repo.FindByID(context.Background(), "m-1")
+	if err != nil || m.Title() != "Old Meeting" {
+		t.Fatalf("got %v, %v; want the expired cached meeting", m, err)
+	}
+	if inner.findCalls != 1 {
+		t.Errorf("expected expired entry to be served from cache, got %d inner calls", inner.findCalls)
+	}
+
+	stale = false
+	_, _ = repo.FindByID(context.Background(), "m-1")
+	if inner.findCalls != 2 {
+		t.Errorf("expected expiry to apply again once stale serving stops, got %d inner calls", inner.findCalls)
+	}
+}
+
+func TestCachedRepository_StartEvictor_EvictsOnInterval(t *testing.T) {
+	db := openTestDB(t)
+	// Each :memory: connection is a separate database; pin to one.
+	db.SetMaxOpenConns(1)
+	inner := newMockRepo()
+	inner.meetings["m-1"] = mustMeeting(t, "m-1", "Old Meeting")
+
+	repo, err := cache.NewCachedRepository(inner, db, 1*time.Millisecond)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	_, _ = repo.FindByID(context.Background(), "m-1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := repo.StartEvictor(ctx, 5*time.Millisecond)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		var n int
+		if err := db.QueryRow("SELECT COUNT(*) FROM cache_entries").Scan(&n); err != nil {
+			t.Fatalf("count entries: %v", err)
+		}
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expired entry not evicted, %d rows remain", n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("evictor did not stop after cancellation")
+	}
+}
+
 func TestCachedRepository_SearchDelegatesToInner(t *testing.T) {
 	db := openTestDB(t)
 	inner := newMockRepo()
@@ -206,7 +339,160 @@ func TestCachedRepository_SearchDelegatesToInner(t *testing.T) {
 	}
 
 	_, _ = repo.SearchTranscripts(context.Background(), "query", domain.ListFilter{})
+	_, _ = repo.SearchTranscripts(context.Background(), "query", domain.ListFilter{})
+	if inner.searchCalls != 2 {
+		t.Errorf("expected 2 search calls without a search TTL, got %d", inner.searchCalls)
+	}
+}
+
+func TestCachedRepository_SearchCachedWithinTTL(t *testing.T) {
+	db := openTestDB(t)
+	inner := newMockRepo()
+	inner.meetings["m-1"] = mustMeeting(t, "m-1", "Sprint Planning")
+
+	repo, err := cache.NewCachedRepository(inner, db, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	repo.SetSearchTTL(time.Minute)
+	ctx := context.Background()
+	query := "roadmap"
+	filter := domain.ListFilter{Query: &query, Limit: 10}
+
+	_, _ = repo.SearchTranscripts(ctx, "roadmap", filter)
+	meetings, err := repo.SearchTranscripts(ctx, "roadmap", filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(meetings) != 1 || meetings[0].Title() != "Sprint Planning" {
+		t.Errorf("got %+v, want the cached meeting", meetings)
+	}
 	if inner.searchCalls != 1 {
-		t.Errorf("expected 1 search call, got %d", inner.searchCalls)
+		t.Errorf("expected 1 inner search call (cache hit), got %d", inner.searchCalls)
+	}
+
+	// A different filter is a different key.
+	_, _ = repo.SearchTranscripts(ctx, "roadmap", domain.ListFilter{Limit: 5})
+	if inner.searchCalls != 2 {
+		t.Errorf("expected a new inner call for another filter, got %d calls", inner.searchCalls)
+	}
+
+	// Sync drops cached searches.
+	if _, err := repo.Sync(ctx, nil); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	_, _ = repo.SearchTranscripts(ctx, "roadmap", filter)
+	if inner.searchCalls != 3 {
+		t.Errorf("expected an inner call after sync, got %d calls", inner.searchCalls)
+	}
+}
+
+func TestCachedRepository_SearchCacheKeepsMeetingDetails(t *testing.T) {
+	db := openTestDB(t)
+	inner := newMockRepo()
+	due := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	m, err := domain.New("m-1", "Sprint Planning", time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC), domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+		domain.NewParticipant("Bob", "", domain.RoleAttendee),
+	})
+	if err != nil {
+		t.Fatalf("create meeting: %v", err)
+	}
+	m.AttachSummary(domain.NewSummary("m-1", "We planned the sprint.", domain.SummaryAuto))
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write the plan", &due)
+	item.Complete()
+	m.AddActionItem(item)
+	m.SetMetadata(domain.NewMetadata([]string{"planning"}, nil, nil))
+	m.ClearDomainEvents()
+	inner.meetings["m-1"] = m
+
+	repo, err := cache.NewCachedRepository(inner, db, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	repo.SetSearchTTL(time.Minute)
+	ctx := context.Background()
+
+	first, err := repo.SearchTranscripts(ctx, "sprint", domain.ListFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := repo.SearchTranscripts(ctx, "sprint", domain.ListFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inner.searchCalls != 1 {
+		t.Fatalf("expected the second search served from cache, got %d inner calls", inner.searchCalls)
+	}
+	if got, want := describeMeeting(second[0]), describeMeeting(first[0]); got != want {
+		t.Errorf("cached search result differs:\n got %s\nwant %s", got, want)
+	}
+}
+
+// describeMeeting renders the parts of a meeting the cache must keep.
+func describeMeeting(m *domain.Meeting) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q %s %s tags=%v", m.ID(), m.Title(), m.Datetime().UTC().Format(time.RFC3339), m.Source(), m.Metadata().Tags())
+	for _, p := range m.Participants() {
+		fmt.Fprintf(&b, " participant=%s/%s/%s", p.Name(), p.Email(), p.Role())
+	}
+	if s := m.Summary(); s != nil {
+		fmt.Fprintf(&b, " summary=%s/%q", s.Kind(), s.Content())
+	}
+	for _, ai := range m.ActionItems() {
+		fmt.Fprintf(&b, " item=%s/%s/%q/%v/%v", ai.ID(), ai.Owner(), ai.Text(), ai.DueDate(), ai.IsCompleted())
+	}
+	return b.String()
+}
+
+func TestCachedRepository_SearchExpires(t *testing.T) {
+	db := openTestDB(t)
+	inner := newMockRepo()
+
+	repo, err := cache.NewCachedRepository(inner, db, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	repo.SetSearchTTL(time.Millisecond)
+
+	_, _ = repo.SearchTranscripts(context.Background(), "query", domain.ListFilter{})
+	time.Sleep(5 * time.Millisecond)
+	_, _ = repo.SearchTranscripts(context.Background(), "query", domain.ListFilter{})
+	if inner.searchCalls != 2 {
+		t.Errorf("expected 2 inner search calls after expiry, got %d", inner.searchCalls)
+	}
+}
+
+func TestCachedRepository_InvalidatedByActionItemWrites(t *testing.T) {
+	inner := newMockRepo()
+	inner.meetings["m-1"] = mustMeeting(t, "m-1", "Sprint Planning")
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	inner.actionItems = map[domain.MeetingID][]*domain.ActionItem{"m-1": {item}}
+
+	repo, err := cache.NewCachedRepository(inner, openTestDB(t), 15*time.Minute)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	complete := meetingapp.NewCompleteActionItem(repo, mockWriteRepo{}, nil)
+	complete.SetCacheInvalidator(repo)
+	update := meetingapp.NewUpdateActionItem(repo, mockWriteRepo{}, nil)
+	update.SetCacheInvalidator(repo)
+	ctx := context.Background()
+
+	_, _ = repo.FindByID(ctx, "m-1")
+	if _, err := complete.Execute(ctx, meetingapp.CompleteActionItemInput{MeetingID: "m-1", ActionItemID: "ai-1"}); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	_, _ = repo.FindByID(ctx, "m-1")
+	if inner.findCalls != 2 {
+		t.Errorf("got %d inner calls, want a re-fetch after completing", inner.findCalls)
+	}
+
+	if _, err := update.Execute(ctx, meetingapp.UpdateActionItemInput{MeetingID: "m-1", ActionItemID: "ai-1", Text: "Write the report"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	_, _ = repo.FindByID(ctx, "m-1")
+	if inner.findCalls != 3 {
+		t.Errorf("got %d inner calls, want a re-fetch after updating", inner.findCalls)
 	}
 }
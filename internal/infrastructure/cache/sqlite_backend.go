@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// SQLiteBackend keeps entries in the cache_entries table of a SQLite
+// database, so they survive restarts.
+type SQLiteBackend struct {
+	db *sql.DB
+}
+
+// NewSQLiteBackend creates the cache schema on db if needed.
+func NewSQLiteBackend(db *sql.DB) (*SQLiteBackend, error) {
+	if err := initSchema(db); err != nil {
+		return nil, err
+	}
+	return &SQLiteBackend{db: db}, nil
+}
+
+func initSchema(db *sql.DB) error {
+	_, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS cache_entries (
+			key        TEXT PRIMARY KEY,
+			value      BLOB NOT NULL,
+			expires_at DATETIME NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS idx_cache_expires ON cache_entries(expires_at);
+	`)
+	return err
+}
+
+func (b *SQLiteBackend) Get(key string) ([]byte, time.Time, bool) {
+	var data []byte
+	var expiresAt time.Time
+	err := b.db.QueryRow(
+		"SELECT value, expires_at FROM cache_entries WHERE key = ?", key,
+	).Scan(&data, &expiresAt)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	return data, expiresAt, true
+}
+
+func (b *SQLiteBackend) Set(key string, value []byte, ttl time.Duration) error {
+	_, err := b.db.Exec(
+		"INSERT OR REPLACE INTO cache_entries (key, value, expires_at) VALUES (?, ?, ?)",
+		key, value, time.Now().UTC().Add(ttl),
+	)
+	return err
+}
+
+func (b *SQLiteBackend) Delete(key string) error {
+	_, err := b.db.Exec("DELETE FROM cache_entries WHERE key = ?", key)
+	return err
+}
+
+func (b *SQLiteBackend) DeletePrefix(prefix string) error {
+	// Escape LIKE wildcards so the prefix matches literally.
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix)
+	_, err := b.db.Exec(`DELETE FROM cache_entries WHERE key LIKE ? ESCAPE '\'`, escaped+"%")
+	return err
+}
+
+func (b *SQLiteBackend) Evict() error {
+	_, err := b.db.Exec("DELETE FROM cache_entries WHERE expires_at <= ?", time.Now().UTC())
+	return err
+}
+
+var _ Backend = (*SQLiteBackend)(nil)
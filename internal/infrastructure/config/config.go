@@ -3,9 +3,12 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,16 +22,94 @@ type Config struct {
 	Sync       SyncConfig
 	Logging    LoggingConfig
 	Webhook    WebhookConfig
+	Events     EventsConfig
+	Metrics    MetricsConfig
+	Display    DisplayConfig
+	Notes      NotesConfig
+	SQLite     SQLiteConfig
+
+	// envProblems are the environment values Load could not use; Validate
+	// reports them with the other problems.
+	envProblems []string
+}
+
+// SQLiteConfig holds the pragmas set on the cache and local store
+// databases. Empty or zero values keep SQLite's defaults.
+type SQLiteConfig struct {
+	JournalMode string
+	BusyTimeout time.Duration
+	Synchronous string
+}
+
+type NotesConfig struct {
+	// MaxLength caps note content in characters; zero disables the cap.
+	MaxLength int
+	// SanitizeHTML escapes HTML in note content before it is stored.
+	SanitizeHTML bool
+}
+
+type DisplayConfig struct {
+	// Timezone is the IANA zone dates are shown in; empty means UTC.
+	Timezone string
+}
+
+type MetricsConfig struct {
+	// Enabled exposes /metrics on the HTTP transport.
+	Enabled bool
+}
+
+type EventsConfig struct {
+	// RecentBufferSize is how many dispatched events events://recent retains.
+	RecentBufferSize int
+	// OutboxMaxAttempts is how many times a failed event dispatch is
+	// attempted before its outbox entries are marked failed.
+	OutboxMaxAttempts int
+	// OutboxBackoff is the delay before the first retry; it doubles after each.
+	OutboxBackoff time.Duration
+	// OutboxMaxPending is the pending backlog above which /health/outbox
+	// reports unhealthy.
+	OutboxMaxPending int
 }
 
 type WebhookConfig struct {
 	Secret string
+	// Path is where serve mounts the inbound webhook handler.
+	Path string
+	// TimestampTolerance bounds the age of signed requests; zero uses the handler default.
+	TimestampTolerance time.Duration
+	// OutboundEnabled turns on POSTing note and action item events to OutboundURL.
+	OutboundEnabled bool
+	OutboundURL     string
+	// OutboundSecret signs outbound requests like inbound ones are verified;
+	// empty sends them unsigned.
+	OutboundSecret string
 }
 
 type GranolaConfig struct {
-	APIURL     string
+	APIURL string
+	// APIVersion is the path prefix between APIURL and each endpoint.
+	APIVersion string
 	AuthMethod string
 	APIToken   string
+	// Offline serves only cached and local data, never calling the API.
+	Offline bool
+	// SlowRequestThreshold is how long an API request may take before a
+	// warning is logged; zero disables the warning.
+	SlowRequestThreshold time.Duration
+	// MaxIdleConns, MaxIdleConnsPerHost, and IdleConnTimeout size the HTTP
+	// connection pool; zero keeps the Go defaults.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	// TLSMinVersion is the lowest TLS version accepted for the API ("1.0"
+	// through "1.3"); empty keeps the Go default of 1.2.
+	TLSMinVersion string
+	// CAFile is a PEM bundle of root CAs trusted in addition to the
+	// system pool, for TLS-inspecting proxies.
+	CAFile string
+	// ListPageSize is how many documents each list request fetches while
+	// paging through meetings; zero keeps the repository default.
+	ListPageSize int
 }
 
 type MCPConfig struct {
@@ -36,12 +117,57 @@ type MCPConfig struct {
 	Transport        string
 	HTTPPort         int
 	EnabledResources []string
+	DisabledTools    []string
+	// ToolOverrides tailors tool descriptions and UI resources, keyed by tool name.
+	ToolOverrides map[string]ToolOverride
+	// MaxTranscriptUtterances caps utterances per transcript response;
+	// zero disables the cap.
+	MaxTranscriptUtterances int
+	// MaxScanMeetings caps how many meetings meeting_stats,
+	// overdue_action_items, action_item_digest, find_conflicts, and
+	// meeting_timeline scan; zero disables the cap.
+	MaxScanMeetings int
+	// KeywordStopwordsFile lists extra words extract_keywords drops in
+	// every language, one per line.
+	KeywordStopwordsFile string
+	// DefaultListLimit applies to list tools called without a limit;
+	// larger requested limits are clamped to MaxListLimit.
+	DefaultListLimit int
+	MaxListLimit     int
+	// HTTP server limits; zero values fall back to the server defaults.
+	HTTPReadHeaderTimeout time.Duration
+	HTTPReadTimeout       time.Duration
+	HTTPWriteTimeout      time.Duration
+	HTTPIdleTimeout       time.Duration
+	HTTPMaxHeaderBytes    int
+	HTTPMaxInFlight       int
+	HTTPMaxStreams        int
+}
+
+// ToolOverride customizes one MCP tool's catalog entry.
+type ToolOverride struct {
+	Description       string `json:"description"`
+	DisableUIResource bool   `json:"disable_ui_resource"`
 }
 
 type CacheConfig struct {
 	Enabled bool
 	Dir     string
 	TTL     time.Duration
+	// EvictInterval is how often expired cache rows are purged.
+	EvictInterval time.Duration
+	// WorkspaceTTL is how long the workspace list is reused in memory;
+	// zero disables it.
+	WorkspaceTTL time.Duration
+	// InvalidateOnWrite drops a meeting's cached entry when one of its
+	// action items is completed or updated.
+	InvalidateOnWrite bool
+	// SearchTTL is how long identical transcript searches are served
+	// from the cache; zero disables it.
+	SearchTTL time.Duration
+	// Backend is where entries are stored: "sqlite" (the default, in Dir)
+	// or "memory", for hosts without a writable disk.
+	Backend string
 }
 
 type ResilienceConfig struct {
@@ -49,6 +175,16 @@ type ResilienceConfig struct {
 	RateLimit      RateLimitConfig
 	Retry          RetryConfig
 	Timeout        time.Duration
+	// ListTimeout and TranscriptTimeout override Timeout for those
+	// operations; zero means use Timeout.
+	ListTimeout       time.Duration
+	TranscriptTimeout time.Duration
+}
+
+// MaxTimeout returns the longest configured operation timeout.
+// The shared HTTP client must not cut off requests before it.
+func (c ResilienceConfig) MaxTimeout() time.Duration {
+	return max(c.Timeout, c.ListTimeout, c.TranscriptTimeout)
 }
 
 type CircuitBreakerConfig struct {
@@ -73,6 +209,11 @@ type PrivacyConfig struct {
 	RedactSpeakers bool
 	RedactKeywords []string
 	LocalOnly      bool
+	// RedactExportEmails masks participant emails in exports by default.
+	RedactExportEmails bool
+	// StrictTokenPermissions refuses to use a credentials file that other
+	// users can access, instead of only warning about it.
+	StrictTokenPermissions bool
 }
 
 type PolicyConfig struct {
@@ -96,21 +237,232 @@ func Load() *Config {
 	if v := os.Getenv("ACAI_GRANOLA_API_URL"); v != "" {
 		cfg.Granola.APIURL = v
 	}
+	if v := os.Getenv("ACAI_GRANOLA_API_VERSION"); v != "" {
+		cfg.Granola.APIVersion = v
+	}
 	if v := os.Getenv("ACAI_GRANOLA_API_TOKEN"); v != "" {
 		cfg.Granola.APIToken = v
 		cfg.Granola.AuthMethod = "api_token"
 	}
+	if v := os.Getenv("ACAI_GRANOLA_SLOW_REQUEST_THRESHOLD"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			cfg.Granola.SlowRequestThreshold = d
+		} else {
+			cfg.invalidEnv("ACAI_GRANOLA_SLOW_REQUEST_THRESHOLD", v)
+		}
+	}
+	if v := os.Getenv("ACAI_GRANOLA_MAX_IDLE_CONNS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.Granola.MaxIdleConns = n
+		} else {
+			cfg.invalidEnv("ACAI_GRANOLA_MAX_IDLE_CONNS", v)
+		}
+	}
+	if v := os.Getenv("ACAI_GRANOLA_MAX_IDLE_CONNS_PER_HOST"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.Granola.MaxIdleConnsPerHost = n
+		} else {
+			cfg.invalidEnv("ACAI_GRANOLA_MAX_IDLE_CONNS_PER_HOST", v)
+		}
+	}
+	if v := os.Getenv("ACAI_GRANOLA_IDLE_CONN_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			cfg.Granola.IdleConnTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_GRANOLA_IDLE_CONN_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_GRANOLA_LIST_PAGE_SIZE"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.Granola.ListPageSize = n
+		} else {
+			cfg.invalidEnv("ACAI_GRANOLA_LIST_PAGE_SIZE", v)
+		}
+	}
+	if v := os.Getenv("ACAI_GRANOLA_TLS_MIN_VERSION"); v != "" {
+		cfg.Granola.TLSMinVersion = v
+	}
+	if v := os.Getenv("ACAI_GRANOLA_CA_FILE"); v != "" {
+		cfg.Granola.CAFile = v
+	}
+	if v := os.Getenv("ACAI_OFFLINE"); v != "" {
+		if offline, err := strconv.ParseBool(v); err == nil {
+			cfg.Granola.Offline = offline
+		} else {
+			cfg.invalidEnv("ACAI_OFFLINE", v)
+		}
+	}
 	if v := os.Getenv("ACAI_MCP_TRANSPORT"); v != "" {
 		cfg.MCP.Transport = v
 	}
 	if v := os.Getenv("ACAI_MCP_HTTP_PORT"); v != "" {
 		if port, err := strconv.Atoi(v); err == nil {
 			cfg.MCP.HTTPPort = port
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_PORT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_READ_HEADER_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			cfg.MCP.HTTPReadHeaderTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_READ_HEADER_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_READ_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			cfg.MCP.HTTPReadTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_READ_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_WRITE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			cfg.MCP.HTTPWriteTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_WRITE_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_IDLE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			cfg.MCP.HTTPIdleTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_IDLE_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_MAX_HEADER_BYTES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.MCP.HTTPMaxHeaderBytes = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_MAX_HEADER_BYTES", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_MAX_IN_FLIGHT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.MCP.HTTPMaxInFlight = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_MAX_IN_FLIGHT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_HTTP_MAX_STREAMS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.MCP.HTTPMaxStreams = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_HTTP_MAX_STREAMS", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_DISABLED_TOOLS"); v != "" {
+		cfg.MCP.DisabledTools = splitList(v)
+	}
+	if v := os.Getenv("ACAI_MCP_MAX_TRANSCRIPT_UTTERANCES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.MCP.MaxTranscriptUtterances = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_MAX_TRANSCRIPT_UTTERANCES", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_MAX_SCAN_MEETINGS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.MCP.MaxScanMeetings = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_MAX_SCAN_MEETINGS", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_KEYWORD_STOPWORDS_FILE"); v != "" {
+		cfg.MCP.KeywordStopwordsFile = v
+	}
+	if v := os.Getenv("ACAI_MCP_DEFAULT_LIMIT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.MCP.DefaultListLimit = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_DEFAULT_LIMIT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_MAX_LIMIT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.MCP.MaxListLimit = n
+		} else {
+			cfg.invalidEnv("ACAI_MCP_MAX_LIMIT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_MCP_TOOL_OVERRIDES"); v != "" {
+		var overrides map[string]ToolOverride
+		if err := json.Unmarshal([]byte(v), &overrides); err == nil {
+			cfg.MCP.ToolOverrides = overrides
+		} else {
+			cfg.invalidEnv("ACAI_MCP_TOOL_OVERRIDES", v)
 		}
 	}
 	if v := os.Getenv("ACAI_CACHE_TTL"); v != "" {
 		if d, err := time.ParseDuration(v); err == nil {
 			cfg.Cache.TTL = d
+		} else {
+			cfg.invalidEnv("ACAI_CACHE_TTL", v)
+		}
+	}
+	if v := os.Getenv("ACAI_CACHE_EVICT_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			cfg.Cache.EvictInterval = d
+		} else {
+			cfg.invalidEnv("ACAI_CACHE_EVICT_INTERVAL", v)
+		}
+	}
+	if v := os.Getenv("ACAI_CACHE_WORKSPACE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			cfg.Cache.WorkspaceTTL = d
+		} else {
+			cfg.invalidEnv("ACAI_CACHE_WORKSPACE_TTL", v)
+		}
+	}
+	if v := os.Getenv("ACAI_CACHE_INVALIDATE_ON_WRITE"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.Cache.InvalidateOnWrite = enabled
+		} else {
+			cfg.invalidEnv("ACAI_CACHE_INVALIDATE_ON_WRITE", v)
+		}
+	}
+	if v := os.Getenv("ACAI_CACHE_SEARCH_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			cfg.Cache.SearchTTL = d
+		} else {
+			cfg.invalidEnv("ACAI_CACHE_SEARCH_TTL", v)
+		}
+	}
+	if v := os.Getenv("ACAI_CACHE_BACKEND"); v != "" {
+		cfg.Cache.Backend = strings.ToLower(v)
+	}
+	if v := os.Getenv("ACAI_SQLITE_JOURNAL_MODE"); v != "" {
+		cfg.SQLite.JournalMode = strings.ToUpper(v)
+	}
+	if v := os.Getenv("ACAI_SQLITE_BUSY_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			cfg.SQLite.BusyTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_SQLITE_BUSY_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_SQLITE_SYNCHRONOUS"); v != "" {
+		cfg.SQLite.Synchronous = strings.ToUpper(v)
+	}
+	if v := os.Getenv("ACAI_RESILIENCE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Resilience.Timeout = d
+		} else {
+			cfg.invalidEnv("ACAI_RESILIENCE_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_RESILIENCE_LIST_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Resilience.ListTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_RESILIENCE_LIST_TIMEOUT", v)
+		}
+	}
+	if v := os.Getenv("ACAI_RESILIENCE_TRANSCRIPT_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Resilience.TranscriptTimeout = d
+		} else {
+			cfg.invalidEnv("ACAI_RESILIENCE_TRANSCRIPT_TIMEOUT", v)
 		}
 	}
 	if v := os.Getenv("ACAI_LOGGING_LEVEL"); v != "" {
@@ -119,9 +471,98 @@ func Load() *Config {
 	if v := os.Getenv("ACAI_LOGGING_FORMAT"); v != "" {
 		cfg.Logging.Format = v
 	}
+	if v := os.Getenv("ACAI_EVENTS_BUFFER_SIZE"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.Events.RecentBufferSize = n
+		} else {
+			cfg.invalidEnv("ACAI_EVENTS_BUFFER_SIZE", v)
+		}
+	}
+	if v := os.Getenv("ACAI_EVENTS_OUTBOX_MAX_ATTEMPTS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.Events.OutboxMaxAttempts = n
+		} else {
+			cfg.invalidEnv("ACAI_EVENTS_OUTBOX_MAX_ATTEMPTS", v)
+		}
+	}
+	if v := os.Getenv("ACAI_EVENTS_OUTBOX_MAX_PENDING"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.Events.OutboxMaxPending = n
+		} else {
+			cfg.invalidEnv("ACAI_EVENTS_OUTBOX_MAX_PENDING", v)
+		}
+	}
+	if v := os.Getenv("ACAI_EVENTS_OUTBOX_BACKOFF"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			cfg.Events.OutboxBackoff = d
+		} else {
+			cfg.invalidEnv("ACAI_EVENTS_OUTBOX_BACKOFF", v)
+		}
+	}
+	if v := os.Getenv("ACAI_METRICS_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.Metrics.Enabled = enabled
+		} else {
+			cfg.invalidEnv("ACAI_METRICS_ENABLED", v)
+		}
+	}
 	if v := os.Getenv("ACAI_WEBHOOK_SECRET"); v != "" {
 		cfg.Webhook.Secret = v
 	}
+	if v := os.Getenv("ACAI_WEBHOOK_PATH"); v != "" {
+		cfg.Webhook.Path = v
+	}
+	if v := os.Getenv("ACAI_WEBHOOK_TIMESTAMP_TOLERANCE"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Webhook.TimestampTolerance = d
+		} else {
+			cfg.invalidEnv("ACAI_WEBHOOK_TIMESTAMP_TOLERANCE", v)
+		}
+	}
+	if v := os.Getenv("ACAI_WEBHOOK_OUTBOUND_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.Webhook.OutboundEnabled = enabled
+		} else {
+			cfg.invalidEnv("ACAI_WEBHOOK_OUTBOUND_ENABLED", v)
+		}
+	}
+	if v := os.Getenv("ACAI_WEBHOOK_OUTBOUND_URL"); v != "" {
+		cfg.Webhook.OutboundURL = v
+	}
+	if v := os.Getenv("ACAI_WEBHOOK_OUTBOUND_SECRET"); v != "" {
+		cfg.Webhook.OutboundSecret = v
+	}
+	if v := os.Getenv("ACAI_TIMEZONE"); v != "" {
+		cfg.Display.Timezone = v
+	}
+	if v := os.Getenv("ACAI_NOTES_MAX_LENGTH"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.Notes.MaxLength = n
+		} else {
+			cfg.invalidEnv("ACAI_NOTES_MAX_LENGTH", v)
+		}
+	}
+	if v := os.Getenv("ACAI_NOTES_SANITIZE_HTML"); v != "" {
+		if sanitize, err := strconv.ParseBool(v); err == nil {
+			cfg.Notes.SanitizeHTML = sanitize
+		} else {
+			cfg.invalidEnv("ACAI_NOTES_SANITIZE_HTML", v)
+		}
+	}
+	if v := os.Getenv("ACAI_EXPORT_REDACT_EMAILS"); v != "" {
+		if redact, err := strconv.ParseBool(v); err == nil {
+			cfg.Privacy.RedactExportEmails = redact
+		} else {
+			cfg.invalidEnv("ACAI_EXPORT_REDACT_EMAILS", v)
+		}
+	}
+	if v := os.Getenv("ACAI_STRICT_TOKEN_PERMISSIONS"); v != "" {
+		if strict, err := strconv.ParseBool(v); err == nil {
+			cfg.Privacy.StrictTokenPermissions = strict
+		} else {
+			cfg.invalidEnv("ACAI_STRICT_TOKEN_PERMISSIONS", v)
+		}
+	}
 	if v := os.Getenv("ACAI_POLICY_FILE"); v != "" {
 		cfg.Policy.FilePath = v
 		cfg.Policy.Enabled = true
@@ -130,12 +571,35 @@ func Load() *Config {
 	return cfg
 }
 
+// invalidEnv records an environment value that did not parse or was out
+// of range, leaving the default in place.
+func (c *Config) invalidEnv(name, value string) {
+	c.envProblems = append(c.envProblems, fmt.Sprintf("%s: invalid value %q", name, value))
+}
+
+// splitList parses a comma-separated env value, dropping blank entries.
+func splitList(v string) []string {
+	var out []string
+	for _, part := range strings.Split(v, ",") {
+		if p := strings.TrimSpace(part); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
 func Default() *Config {
 	homeDir, _ := os.UserHomeDir()
 	return &Config{
 		Granola: GranolaConfig{
-			APIURL:     "https://api.granola.ai",
-			AuthMethod: "oauth",
+			APIURL:               "https://api.granola.ai",
+			APIVersion:           "v2",
+			AuthMethod:           "oauth",
+			SlowRequestThreshold: 2 * time.Second,
+			MaxIdleConns:         100,
+			MaxIdleConnsPerHost:  10,
+			IdleConnTimeout:      90 * time.Second,
+			ListPageSize:         100,
 		},
 		MCP: MCPConfig{
 			ServerName: "acai",
@@ -144,11 +608,31 @@ func Default() *Config {
 			EnabledResources: []string{
 				"meeting", "transcript", "summary", "action_item", "metadata",
 			},
+			MaxTranscriptUtterances: 5000,
+			MaxScanMeetings:         1000,
+			DefaultListLimit:        20,
+			MaxListLimit:            200,
+			HTTPReadHeaderTimeout:   10 * time.Second,
+			HTTPReadTimeout:         30 * time.Second,
+			HTTPWriteTimeout:        60 * time.Second,
+			HTTPIdleTimeout:         120 * time.Second,
+			HTTPMaxHeaderBytes:      1 << 20,
+			HTTPMaxInFlight:         100,
+			HTTPMaxStreams:          20,
 		},
 		Cache: CacheConfig{
-			Enabled: true,
-			Dir:     filepath.Join(homeDir, ".acai", "cache"),
-			TTL:     15 * time.Minute,
+			Enabled:           true,
+			Dir:               filepath.Join(homeDir, ".acai", "cache"),
+			TTL:               15 * time.Minute,
+			EvictInterval:     time.Hour,
+			WorkspaceTTL:      5 * time.Minute,
+			Backend:           "sqlite",
+			InvalidateOnWrite: true,
+		},
+		SQLite: SQLiteConfig{
+			JournalMode: "WAL",
+			BusyTimeout: 5 * time.Second,
+			Synchronous: "NORMAL",
 		},
 		Resilience: ResilienceConfig{
 			CircuitBreaker: CircuitBreakerConfig{
@@ -181,5 +665,17 @@ func Default() *Config {
 			Level:  "info",
 			Format: "console",
 		},
+		Events: EventsConfig{
+			RecentBufferSize:  100,
+			OutboxMaxAttempts: 3,
+			OutboxBackoff:     100 * time.Millisecond,
+			OutboxMaxPending:  1000,
+		},
+		Webhook: WebhookConfig{
+			Path: "/webhook/granola",
+		},
+		Notes: NotesConfig{
+			MaxLength: 10000,
+		},
 	}
 }
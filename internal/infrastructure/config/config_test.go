@@ -22,6 +22,9 @@ func TestDefault_HasSaneDefaults(t *testing.T) {
 	if cfg.Cache.TTL != 15*time.Minute {
 		t.Errorf("got cache ttl %v", cfg.Cache.TTL)
 	}
+	if cfg.Cache.EvictInterval != time.Hour {
+		t.Errorf("got cache evict interval %v", cfg.Cache.EvictInterval)
+	}
 	if cfg.Resilience.Retry.MaxAttempts != 3 {
 		t.Errorf("got max attempts %d", cfg.Resilience.Retry.MaxAttempts)
 	}
@@ -81,3 +84,400 @@ func TestDefault_PolicyDisabled(t *testing.T) {
 		t.Errorf("got policy file %q, want empty", cfg.Policy.FilePath)
 	}
 }
+
+func TestLoad_DisabledToolsEnv(t *testing.T) {
+	t.Setenv("ACAI_MCP_DISABLED_TOOLS", "add_note, delete_note,,complete_action_item")
+
+	cfg := config.Load()
+
+	want := []string{"add_note", "delete_note", "complete_action_item"}
+	if len(cfg.MCP.DisabledTools) != len(want) {
+		t.Fatalf("got disabled tools %v, want %v", cfg.MCP.DisabledTools, want)
+	}
+	for i, name := range want {
+		if cfg.MCP.DisabledTools[i] != name {
+			t.Errorf("disabled tool %d: got %q, want %q", i, cfg.MCP.DisabledTools[i], name)
+		}
+	}
+}
+
+func TestLoad_ToolOverridesEnv(t *testing.T) {
+	t.Setenv("ACAI_MCP_TOOL_OVERRIDES", `{"get_meeting":{"description":"Fetch a customer call"},"meeting_stats":{"disable_ui_resource":true}}`)
+
+	cfg := config.Load()
+
+	if got := cfg.MCP.ToolOverrides["get_meeting"].Description; got != "Fetch a customer call" {
+		t.Errorf("get_meeting description: got %q", got)
+	}
+	if !cfg.MCP.ToolOverrides["meeting_stats"].DisableUIResource {
+		t.Error("meeting_stats UI resource should be disabled")
+	}
+}
+
+func TestLoad_ToolOverridesEnv_InvalidJSON(t *testing.T) {
+	t.Setenv("ACAI_MCP_TOOL_OVERRIDES", "not json")
+
+	cfg := config.Load()
+
+	if cfg.MCP.ToolOverrides != nil {
+		t.Errorf("got overrides %v, want none for invalid JSON", cfg.MCP.ToolOverrides)
+	}
+}
+
+func TestLoad_EventsBufferSize(t *testing.T) {
+	if got := config.Default().Events.RecentBufferSize; got != 100 {
+		t.Errorf("default buffer size: got %d, want 100", got)
+	}
+
+	t.Setenv("ACAI_EVENTS_BUFFER_SIZE", "25")
+	if got := config.Load().Events.RecentBufferSize; got != 25 {
+		t.Errorf("got buffer size %d, want 25", got)
+	}
+}
+
+func TestLoad_OutboxRetry(t *testing.T) {
+	def := config.Default().Events
+	if def.OutboxMaxAttempts != 3 || def.OutboxBackoff != 100*time.Millisecond {
+		t.Errorf("got default retry %d/%v, want 3/100ms", def.OutboxMaxAttempts, def.OutboxBackoff)
+	}
+
+	t.Setenv("ACAI_EVENTS_OUTBOX_MAX_ATTEMPTS", "5")
+	t.Setenv("ACAI_EVENTS_OUTBOX_BACKOFF", "1s")
+	cfg := config.Load()
+	if cfg.Events.OutboxMaxAttempts != 5 {
+		t.Errorf("got max attempts %d, want 5", cfg.Events.OutboxMaxAttempts)
+	}
+	if cfg.Events.OutboxBackoff != time.Second {
+		t.Errorf("got backoff %v, want 1s", cfg.Events.OutboxBackoff)
+	}
+}
+
+func TestLoad_OutboxMaxPending(t *testing.T) {
+	if got := config.Default().Events.OutboxMaxPending; got != 1000 {
+		t.Errorf("got default max pending %d, want 1000", got)
+	}
+
+	t.Setenv("ACAI_EVENTS_OUTBOX_MAX_PENDING", "250")
+	if got := config.Load().Events.OutboxMaxPending; got != 250 {
+		t.Errorf("got max pending %d, want 250", got)
+	}
+}
+
+func TestLoad_MaxScanMeetings(t *testing.T) {
+	if got := config.Default().MCP.MaxScanMeetings; got != 1000 {
+		t.Errorf("default cap: got %d, want 1000", got)
+	}
+
+	t.Setenv("ACAI_MCP_MAX_SCAN_MEETINGS", "250")
+	if got := config.Load().MCP.MaxScanMeetings; got != 250 {
+		t.Errorf("got cap %d, want 250", got)
+	}
+}
+
+func TestLoad_MaxTranscriptUtterances(t *testing.T) {
+	if got := config.Default().MCP.MaxTranscriptUtterances; got != 5000 {
+		t.Errorf("default cap: got %d, want 5000", got)
+	}
+
+	t.Setenv("ACAI_MCP_MAX_TRANSCRIPT_UTTERANCES", "0")
+	if got := config.Load().MCP.MaxTranscriptUtterances; got != 0 {
+		t.Errorf("got cap %d, want 0 (disabled)", got)
+	}
+}
+
+func TestLoad_ListLimits(t *testing.T) {
+	cfg := config.Default()
+	if cfg.MCP.DefaultListLimit != 20 || cfg.MCP.MaxListLimit != 200 {
+		t.Errorf("got defaults %d/%d, want 20/200", cfg.MCP.DefaultListLimit, cfg.MCP.MaxListLimit)
+	}
+
+	t.Setenv("ACAI_MCP_DEFAULT_LIMIT", "50")
+	t.Setenv("ACAI_MCP_MAX_LIMIT", "0")
+	cfg = config.Load()
+	if cfg.MCP.DefaultListLimit != 50 {
+		t.Errorf("got default limit %d, want 50", cfg.MCP.DefaultListLimit)
+	}
+	if cfg.MCP.MaxListLimit != 200 {
+		t.Errorf("got max limit %d, want 200 (non-positive ignored)", cfg.MCP.MaxListLimit)
+	}
+}
+
+func TestLoad_PerOperationTimeouts(t *testing.T) {
+	t.Setenv("ACAI_RESILIENCE_LIST_TIMEOUT", "5s")
+	t.Setenv("ACAI_RESILIENCE_TRANSCRIPT_TIMEOUT", "2m")
+
+	cfg := config.Load()
+	if cfg.Resilience.ListTimeout != 5*time.Second {
+		t.Errorf("got list timeout %v, want 5s", cfg.Resilience.ListTimeout)
+	}
+	if cfg.Resilience.TranscriptTimeout != 2*time.Minute {
+		t.Errorf("got transcript timeout %v, want 2m", cfg.Resilience.TranscriptTimeout)
+	}
+	if got := cfg.Resilience.MaxTimeout(); got != 2*time.Minute {
+		t.Errorf("got max timeout %v, want 2m", got)
+	}
+}
+
+func TestLoad_MetricsEnabled(t *testing.T) {
+	if config.Default().Metrics.Enabled {
+		t.Error("metrics should be disabled by default")
+	}
+
+	t.Setenv("ACAI_METRICS_ENABLED", "true")
+	if !config.Load().Metrics.Enabled {
+		t.Error("expected metrics enabled from env")
+	}
+}
+
+func TestLoad_WebhookPath(t *testing.T) {
+	if got := config.Default().Webhook.Path; got != "/webhook/granola" {
+		t.Errorf("got default webhook path %q, want /webhook/granola", got)
+	}
+
+	t.Setenv("ACAI_WEBHOOK_PATH", "/ingress/acai/webhook")
+	if got := config.Load().Webhook.Path; got != "/ingress/acai/webhook" {
+		t.Errorf("got webhook path %q from env", got)
+	}
+}
+
+func TestLoad_OutboundWebhook(t *testing.T) {
+	if config.Default().Webhook.OutboundEnabled {
+		t.Error("outbound webhooks should be disabled by default")
+	}
+
+	t.Setenv("ACAI_WEBHOOK_OUTBOUND_ENABLED", "true")
+	t.Setenv("ACAI_WEBHOOK_OUTBOUND_URL", "https://hooks.example.com/acai")
+	t.Setenv("ACAI_WEBHOOK_OUTBOUND_SECRET", "out-secret")
+	cfg := config.Load()
+	if !cfg.Webhook.OutboundEnabled {
+		t.Error("expected outbound webhooks enabled from env")
+	}
+	if cfg.Webhook.OutboundURL != "https://hooks.example.com/acai" {
+		t.Errorf("got outbound URL %q", cfg.Webhook.OutboundURL)
+	}
+	if cfg.Webhook.OutboundSecret != "out-secret" {
+		t.Errorf("got outbound secret %q", cfg.Webhook.OutboundSecret)
+	}
+}
+
+func TestLoad_Offline(t *testing.T) {
+	if config.Default().Granola.Offline {
+		t.Error("offline mode should be off by default")
+	}
+
+	t.Setenv("ACAI_OFFLINE", "true")
+	if !config.Load().Granola.Offline {
+		t.Error("expected offline mode from env")
+	}
+}
+
+func TestLoad_APIVersion(t *testing.T) {
+	if got := config.Default().Granola.APIVersion; got != "v2" {
+		t.Errorf("got default api version %q, want v2", got)
+	}
+
+	t.Setenv("ACAI_GRANOLA_API_VERSION", "v3")
+	if got := config.Load().Granola.APIVersion; got != "v3" {
+		t.Errorf("got api version %q, want v3", got)
+	}
+}
+
+func TestLoad_Notes(t *testing.T) {
+	cfg := config.Default()
+	if cfg.Notes.MaxLength != 10000 || cfg.Notes.SanitizeHTML {
+		t.Errorf("got defaults %+v, want 10000 characters without sanitizing", cfg.Notes)
+	}
+
+	t.Setenv("ACAI_NOTES_MAX_LENGTH", "0")
+	t.Setenv("ACAI_NOTES_SANITIZE_HTML", "true")
+	cfg = config.Load()
+	if cfg.Notes.MaxLength != 0 || !cfg.Notes.SanitizeHTML {
+		t.Errorf("got %+v, want no cap with sanitizing", cfg.Notes)
+	}
+}
+
+func TestLoad_ExportRedactEmails(t *testing.T) {
+	if config.Default().Privacy.RedactExportEmails {
+		t.Error("emails should not be redacted by default")
+	}
+
+	t.Setenv("ACAI_EXPORT_REDACT_EMAILS", "true")
+	if !config.Load().Privacy.RedactExportEmails {
+		t.Error("got emails unredacted, want redacted")
+	}
+}
+
+func TestLoad_StrictTokenPermissions(t *testing.T) {
+	if config.Default().Privacy.StrictTokenPermissions {
+		t.Error("token permissions should only warn by default")
+	}
+
+	t.Setenv("ACAI_STRICT_TOKEN_PERMISSIONS", "true")
+	if !config.Load().Privacy.StrictTokenPermissions {
+		t.Error("got lenient token permissions, want strict")
+	}
+}
+
+func TestLoad_SQLite(t *testing.T) {
+	want := config.SQLiteConfig{JournalMode: "WAL", BusyTimeout: 5 * time.Second, Synchronous: "NORMAL"}
+	if got := config.Default().SQLite; got != want {
+		t.Errorf("got defaults %+v, want %+v", got, want)
+	}
+
+	t.Setenv("ACAI_SQLITE_JOURNAL_MODE", "delete")
+	t.Setenv("ACAI_SQLITE_BUSY_TIMEOUT", "0")
+	t.Setenv("ACAI_SQLITE_SYNCHRONOUS", "full")
+	want = config.SQLiteConfig{JournalMode: "DELETE", Synchronous: "FULL"}
+	if got := config.Load().SQLite; got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestLoad_Timezone(t *testing.T) {
+	if tz := config.Default().Display.Timezone; tz != "" {
+		t.Errorf("default timezone = %q, want empty (UTC)", tz)
+	}
+
+	t.Setenv("ACAI_TIMEZONE", "Europe/Berlin")
+	if tz := config.Load().Display.Timezone; tz != "Europe/Berlin" {
+		t.Errorf("timezone = %q, want Europe/Berlin", tz)
+	}
+}
+
+func TestLoad_HTTPLimits(t *testing.T) {
+	cfg := config.Default()
+	if cfg.MCP.HTTPReadHeaderTimeout != 10*time.Second || cfg.MCP.HTTPMaxHeaderBytes != 1<<20 || cfg.MCP.HTTPMaxInFlight != 100 {
+		t.Errorf("defaults: got read header %v, max header bytes %d", cfg.MCP.HTTPReadHeaderTimeout, cfg.MCP.HTTPMaxHeaderBytes)
+	}
+
+	t.Setenv("ACAI_MCP_HTTP_READ_HEADER_TIMEOUT", "2s")
+	t.Setenv("ACAI_MCP_HTTP_WRITE_TIMEOUT", "5m")
+	t.Setenv("ACAI_MCP_HTTP_MAX_HEADER_BYTES", "4096")
+	t.Setenv("ACAI_MCP_HTTP_MAX_IN_FLIGHT", "8")
+	t.Setenv("ACAI_MCP_HTTP_MAX_STREAMS", "3")
+	cfg = config.Load()
+	if cfg.MCP.HTTPReadHeaderTimeout != 2*time.Second {
+		t.Errorf("read header timeout: got %v, want 2s", cfg.MCP.HTTPReadHeaderTimeout)
+	}
+	if cfg.MCP.HTTPWriteTimeout != 5*time.Minute {
+		t.Errorf("write timeout: got %v, want 5m", cfg.MCP.HTTPWriteTimeout)
+	}
+	if cfg.MCP.HTTPMaxHeaderBytes != 4096 {
+		t.Errorf("max header bytes: got %d, want 4096", cfg.MCP.HTTPMaxHeaderBytes)
+	}
+	if cfg.MCP.HTTPMaxInFlight != 8 {
+		t.Errorf("max in flight: got %d, want 8", cfg.MCP.HTTPMaxInFlight)
+	}
+	if cfg.MCP.HTTPMaxStreams != 3 {
+		t.Errorf("max streams: got %d, want 3", cfg.MCP.HTTPMaxStreams)
+	}
+}
+
+func TestLoad_ConnectionPool(t *testing.T) {
+	cfg := config.Default()
+	if cfg.Granola.MaxIdleConns != 100 || cfg.Granola.MaxIdleConnsPerHost != 10 || cfg.Granola.IdleConnTimeout != 90*time.Second {
+		t.Errorf("defaults: got max idle %d, per host %d, idle timeout %v",
+			cfg.Granola.MaxIdleConns, cfg.Granola.MaxIdleConnsPerHost, cfg.Granola.IdleConnTimeout)
+	}
+
+	t.Setenv("ACAI_GRANOLA_MAX_IDLE_CONNS", "20")
+	t.Setenv("ACAI_GRANOLA_MAX_IDLE_CONNS_PER_HOST", "20")
+	t.Setenv("ACAI_GRANOLA_IDLE_CONN_TIMEOUT", "30s")
+	cfg = config.Load()
+	if cfg.Granola.MaxIdleConns != 20 || cfg.Granola.MaxIdleConnsPerHost != 20 || cfg.Granola.IdleConnTimeout != 30*time.Second {
+		t.Errorf("got max idle %d, per host %d, idle timeout %v",
+			cfg.Granola.MaxIdleConns, cfg.Granola.MaxIdleConnsPerHost, cfg.Granola.IdleConnTimeout)
+	}
+}
+
+func TestLoad_ListPageSize(t *testing.T) {
+	if got := config.Default().Granola.ListPageSize; got != 100 {
+		t.Errorf("default: got %d, want 100", got)
+	}
+
+	t.Setenv("ACAI_GRANOLA_LIST_PAGE_SIZE", "250")
+	if got := config.Load().Granola.ListPageSize; got != 250 {
+		t.Errorf("got %d, want 250", got)
+	}
+}
+
+func TestLoad_TLSOptions(t *testing.T) {
+	cfg := config.Default()
+	if cfg.Granola.TLSMinVersion != "" || cfg.Granola.CAFile != "" {
+		t.Errorf("defaults: got TLS min version %q, CA file %q", cfg.Granola.TLSMinVersion, cfg.Granola.CAFile)
+	}
+
+	t.Setenv("ACAI_GRANOLA_TLS_MIN_VERSION", "1.3")
+	t.Setenv("ACAI_GRANOLA_CA_FILE", "/etc/ssl/corp-ca.pem")
+	cfg = config.Load()
+	if cfg.Granola.TLSMinVersion != "1.3" || cfg.Granola.CAFile != "/etc/ssl/corp-ca.pem" {
+		t.Errorf("got TLS min version %q, CA file %q", cfg.Granola.TLSMinVersion, cfg.Granola.CAFile)
+	}
+}
+
+func TestLoad_SlowRequestThreshold(t *testing.T) {
+	if got := config.Default().Granola.SlowRequestThreshold; got != 2*time.Second {
+		t.Errorf("default: got %v, want 2s", got)
+	}
+
+	t.Setenv("ACAI_GRANOLA_SLOW_REQUEST_THRESHOLD", "500ms")
+	if got := config.Load().Granola.SlowRequestThreshold; got != 500*time.Millisecond {
+		t.Errorf("got %v, want 500ms", got)
+	}
+
+	t.Setenv("ACAI_GRANOLA_SLOW_REQUEST_THRESHOLD", "0")
+	if got := config.Load().Granola.SlowRequestThreshold; got != 0 {
+		t.Errorf("zero should disable: got %v", got)
+	}
+}
+
+func TestLoad_CacheInvalidateOnWrite(t *testing.T) {
+	if !config.Default().Cache.InvalidateOnWrite {
+		t.Error("cache invalidation on write should be on by default")
+	}
+
+	t.Setenv("ACAI_CACHE_INVALIDATE_ON_WRITE", "false")
+	if config.Load().Cache.InvalidateOnWrite {
+		t.Error("expected cache invalidation on write disabled from env")
+	}
+}
+
+func TestLoad_WorkspaceCacheTTL(t *testing.T) {
+	if got := config.Default().Cache.WorkspaceTTL; got != 5*time.Minute {
+		t.Errorf("default: got %v, want 5m", got)
+	}
+
+	t.Setenv("ACAI_CACHE_WORKSPACE_TTL", "0")
+	if got := config.Load().Cache.WorkspaceTTL; got != 0 {
+		t.Errorf("got %v, want 0 (disabled)", got)
+	}
+}
+
+func TestLoad_SearchCacheTTL(t *testing.T) {
+	if got := config.Default().Cache.SearchTTL; got != 0 {
+		t.Errorf("default: got %v, want 0 (disabled)", got)
+	}
+
+	t.Setenv("ACAI_CACHE_SEARCH_TTL", "30s")
+	if got := config.Load().Cache.SearchTTL; got != 30*time.Second {
+		t.Errorf("got %v, want 30s", got)
+	}
+}
+
+func TestLoad_CacheBackend(t *testing.T) {
+	if got := config.Default().Cache.Backend; got != "sqlite" {
+		t.Errorf("default: got %q, want sqlite", got)
+	}
+
+	t.Setenv("ACAI_CACHE_BACKEND", "Memory")
+	if got := config.Load().Cache.Backend; got != "memory" {
+		t.Errorf("got %q, want memory", got)
+	}
+}
+
+func TestLoad_KeywordStopwordsFile(t *testing.T) {
+	t.Setenv("ACAI_MCP_KEYWORD_STOPWORDS_FILE", "/etc/acai/stopwords.txt")
+	if got := config.Load().MCP.KeywordStopwordsFile; got != "/etc/acai/stopwords.txt" {
+		t.Errorf("got stopwords file %q", got)
+	}
+}
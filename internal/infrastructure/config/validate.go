@@ -0,0 +1,188 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// reservedHTTPPaths are the routes acai serve mounts besides the webhook,
+// which the webhook path must not collide with.
+var reservedHTTPPaths = []string{"/health", "/health/outbox", "/metrics", "/events"}
+
+// ValidationError lists every problem found in a configuration.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Validate checks the loaded configuration for values that would
+// otherwise fail later with a less obvious error, and for environment
+// values Load could not use, and reports all of them at once as a
+// *ValidationError.
+func (c *Config) Validate() error {
+	v := &validator{problems: append([]string(nil), c.envProblems...)}
+
+	if u, err := url.Parse(c.Granola.APIURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.addf("granola API URL %q must be an absolute http(s) URL", c.Granola.APIURL)
+	}
+	v.nonNegative("granola slow request threshold", c.Granola.SlowRequestThreshold)
+	v.nonNegativeInt("granola max idle connections", c.Granola.MaxIdleConns)
+	v.nonNegativeInt("granola max idle connections per host", c.Granola.MaxIdleConnsPerHost)
+	v.nonNegative("granola idle connection timeout", c.Granola.IdleConnTimeout)
+	v.nonNegativeInt("granola list page size", c.Granola.ListPageSize)
+	switch c.Granola.TLSMinVersion {
+	case "", "1.0", "1.1", "1.2", "1.3":
+	default:
+		v.addf("unknown granola TLS min version %q (want 1.0, 1.1, 1.2, or 1.3)", c.Granola.TLSMinVersion)
+	}
+	if c.Granola.CAFile != "" {
+		if _, err := os.Stat(c.Granola.CAFile); err != nil {
+			v.addf("granola CA file %s: %v", c.Granola.CAFile, err)
+		}
+	}
+
+	v.nonNegativeInt("MCP max transcript utterances", c.MCP.MaxTranscriptUtterances)
+	v.nonNegativeInt("MCP max scan meetings", c.MCP.MaxScanMeetings)
+	v.nonNegativeInt("MCP default list limit", c.MCP.DefaultListLimit)
+	v.nonNegativeInt("MCP max list limit", c.MCP.MaxListLimit)
+	if c.MCP.DefaultListLimit > 0 && c.MCP.MaxListLimit > 0 && c.MCP.DefaultListLimit > c.MCP.MaxListLimit {
+		v.addf("MCP default list limit %d exceeds the max list limit %d", c.MCP.DefaultListLimit, c.MCP.MaxListLimit)
+	}
+	v.nonNegative("MCP HTTP read header timeout", c.MCP.HTTPReadHeaderTimeout)
+	v.nonNegative("MCP HTTP read timeout", c.MCP.HTTPReadTimeout)
+	v.nonNegative("MCP HTTP write timeout", c.MCP.HTTPWriteTimeout)
+	v.nonNegative("MCP HTTP idle timeout", c.MCP.HTTPIdleTimeout)
+	v.nonNegativeInt("MCP HTTP max header bytes", c.MCP.HTTPMaxHeaderBytes)
+	v.nonNegativeInt("MCP HTTP max in flight", c.MCP.HTTPMaxInFlight)
+	v.nonNegativeInt("MCP HTTP max streams", c.MCP.HTTPMaxStreams)
+
+	if c.Cache.Enabled {
+		v.nonNegative("cache TTL", c.Cache.TTL)
+		v.positive("cache evict interval", c.Cache.EvictInterval)
+		v.nonNegative("search cache TTL", c.Cache.SearchTTL)
+		if c.Cache.Backend != "sqlite" && c.Cache.Backend != "memory" {
+			v.addf("unknown cache backend %q (want sqlite or memory)", c.Cache.Backend)
+		}
+	}
+	v.nonNegative("workspace cache TTL", c.Cache.WorkspaceTTL)
+
+	v.positive("resilience timeout", c.Resilience.Timeout)
+	v.nonNegative("resilience list timeout", c.Resilience.ListTimeout)
+	v.nonNegative("resilience transcript timeout", c.Resilience.TranscriptTimeout)
+	if c.Resilience.RateLimit.Rate <= 0 {
+		v.addf("rate limit must be positive, got %d", c.Resilience.RateLimit.Rate)
+	}
+	v.positive("rate limit interval", c.Resilience.RateLimit.Interval)
+	if c.Resilience.CircuitBreaker.FailureThreshold == 0 {
+		v.addf("circuit breaker failure threshold must be positive")
+	}
+	if c.Resilience.CircuitBreaker.SuccessThreshold == 0 {
+		v.addf("circuit breaker success threshold must be positive")
+	}
+	v.positive("circuit breaker half-open timeout", c.Resilience.CircuitBreaker.HalfOpenTimeout)
+	if c.Resilience.Retry.MaxAttempts < 1 {
+		v.addf("retry max attempts must be at least 1, got %d", c.Resilience.Retry.MaxAttempts)
+	}
+	v.nonNegative("retry initial delay", c.Resilience.Retry.InitialDelay)
+	v.nonNegative("retry max delay", c.Resilience.Retry.MaxDelay)
+
+	if c.Sync.AutoSync {
+		v.positive("sync polling interval", c.Sync.PollingInterval)
+	}
+
+	if c.Events.RecentBufferSize <= 0 {
+		v.addf("events buffer size must be positive, got %d", c.Events.RecentBufferSize)
+	}
+	if c.Events.OutboxMaxAttempts <= 0 {
+		v.addf("outbox max attempts must be positive, got %d", c.Events.OutboxMaxAttempts)
+	}
+	v.nonNegative("outbox backoff", c.Events.OutboxBackoff)
+	if c.Events.OutboxMaxPending <= 0 {
+		v.addf("outbox max pending must be positive, got %d", c.Events.OutboxMaxPending)
+	}
+
+	switch c.SQLite.JournalMode {
+	case "", "DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF":
+	default:
+		v.addf("unknown SQLite journal mode %q", c.SQLite.JournalMode)
+	}
+	v.nonNegative("SQLite busy timeout", c.SQLite.BusyTimeout)
+	switch c.SQLite.Synchronous {
+	case "", "OFF", "NORMAL", "FULL", "EXTRA":
+	default:
+		v.addf("unknown SQLite synchronous level %q", c.SQLite.Synchronous)
+	}
+
+	switch {
+	case !strings.HasPrefix(c.Webhook.Path, "/"):
+		v.addf("webhook path %q must start with /", c.Webhook.Path)
+	case strings.ContainsAny(c.Webhook.Path, "{} \t"):
+		v.addf("webhook path %q must be a plain path, without {, }, or spaces", c.Webhook.Path)
+	case slices.Contains(reservedHTTPPaths, c.Webhook.Path):
+		v.addf("webhook path %q is already served by acai serve", c.Webhook.Path)
+	}
+	v.nonNegative("webhook timestamp tolerance", c.Webhook.TimestampTolerance)
+	if c.Webhook.OutboundEnabled {
+		if u, err := url.Parse(c.Webhook.OutboundURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.addf("outbound webhook URL %q must be an absolute http(s) URL", c.Webhook.OutboundURL)
+		}
+	}
+	v.nonNegativeInt("notes max length", c.Notes.MaxLength)
+
+	if c.Display.Timezone != "" {
+		if _, err := time.LoadLocation(c.Display.Timezone); err != nil {
+			v.addf("unknown timezone %q", c.Display.Timezone)
+		}
+	}
+
+	if c.MCP.KeywordStopwordsFile != "" {
+		if _, err := os.Stat(c.MCP.KeywordStopwordsFile); err != nil {
+			v.addf("keyword stopwords file %s: %v", c.MCP.KeywordStopwordsFile, err)
+		}
+	}
+	if c.Policy.Enabled {
+		if c.Policy.FilePath == "" {
+			v.addf("policy is enabled but no policy file is set")
+		} else if _, err := os.Stat(c.Policy.FilePath); err != nil {
+			v.addf("policy file %s: %v", c.Policy.FilePath, err)
+		}
+	}
+
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: v.problems}
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(format string, args ...any) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) positive(name string, d time.Duration) {
+	if d <= 0 {
+		v.addf("%s must be positive, got %s", name, d)
+	}
+}
+
+func (v *validator) nonNegative(name string, d time.Duration) {
+	if d < 0 {
+		v.addf("%s must not be negative, got %s", name, d)
+	}
+}
+
+func (v *validator) nonNegativeInt(name string, n int) {
+	if n < 0 {
+		v.addf("%s must not be negative, got %d", name, n)
+	}
+}
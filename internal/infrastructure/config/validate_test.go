@@ -0,0 +1,122 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/config"
+)
+
+func TestValidate_DefaultIsValid(t *testing.T) {
+	if err := config.Default().Validate(); err != nil {
+		t.Fatalf("default config should be valid: %v", err)
+	}
+}
+
+func TestValidate_ReportsEachProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*config.Config)
+		want   string
+	}{
+		{"relative API URL", func(c *config.Config) { c.Granola.APIURL = "api.granola.ai" }, "granola API URL"},
+		{"malformed API URL", func(c *config.Config) { c.Granola.APIURL = "https://exa mple.com" }, "granola API URL"},
+		{"negative timeout", func(c *config.Config) { c.Resilience.Timeout = -time.Second }, "resilience timeout must be positive"},
+		{"zero rate limit", func(c *config.Config) { c.Resilience.RateLimit.Rate = 0 }, "rate limit must be positive"},
+		{"negative idle connections", func(c *config.Config) { c.Granola.MaxIdleConnsPerHost = -1 }, "idle connections per host must not be negative"},
+		{"negative list page size", func(c *config.Config) { c.Granola.ListPageSize = -1 }, "granola list page size must not be negative"},
+		{"unknown TLS version", func(c *config.Config) { c.Granola.TLSMinVersion = "1.4" }, "unknown granola TLS min version"},
+		{"missing CA file", func(c *config.Config) {
+			c.Granola.CAFile = filepath.Join(t.TempDir(), "missing.pem")
+		}, "granola CA file"},
+		{"unknown cache backend", func(c *config.Config) { c.Cache.Backend = "redis" }, "unknown cache backend"},
+		{"negative search cache TTL", func(c *config.Config) { c.Cache.SearchTTL = -time.Second }, "search cache TTL must not be negative"},
+		{"negative list limit", func(c *config.Config) { c.MCP.MaxListLimit = -1 }, "MCP max list limit must not be negative"},
+		{"default above max", func(c *config.Config) { c.MCP.DefaultListLimit = 500 }, "exceeds the max list limit"},
+		{"unknown journal mode", func(c *config.Config) { c.SQLite.JournalMode = "ROLLBACK" }, "unknown SQLite journal mode"},
+		{"unknown synchronous level", func(c *config.Config) { c.SQLite.Synchronous = "SOMETIMES" }, "unknown SQLite synchronous level"},
+		{"unknown timezone", func(c *config.Config) { c.Display.Timezone = "Mars/Olympus" }, "unknown timezone"},
+		{"missing policy file", func(c *config.Config) {
+			c.Policy.Enabled = true
+			c.Policy.FilePath = filepath.Join(t.TempDir(), "missing.yaml")
+		}, "policy file"},
+		{"policy without file", func(c *config.Config) { c.Policy.Enabled = true }, "no policy file"},
+		{"missing stopwords file", func(c *config.Config) {
+			c.MCP.KeywordStopwordsFile = filepath.Join(t.TempDir(), "missing.txt")
+		}, "keyword stopwords file"},
+		{"relative webhook path", func(c *config.Config) { c.Webhook.Path = "hooks/granola" }, "webhook path"},
+		{"reserved webhook path", func(c *config.Config) { c.Webhook.Path = "/metrics" }, "already served"},
+		{"webhook path pattern", func(c *config.Config) { c.Webhook.Path = "/hooks/{id}" }, "plain path"},
+		{"zero outbox max pending", func(c *config.Config) { c.Events.OutboxMaxPending = 0 }, "outbox max pending"},
+		{"outbound webhook without URL", func(c *config.Config) { c.Webhook.OutboundEnabled = true }, "outbound webhook URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			var verr *config.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("got %v, want a *ValidationError", err)
+			}
+			if len(verr.Problems) != 1 || !strings.Contains(verr.Problems[0], tt.want) {
+				t.Errorf("got problems %q, want one containing %q", verr.Problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ListsAllProblemsAtOnce(t *testing.T) {
+	cfg := config.Default()
+	cfg.Granola.APIURL = "://nope"
+	cfg.Resilience.Timeout = 0
+	cfg.Events.OutboxMaxAttempts = 0
+
+	err := cfg.Validate()
+	var verr *config.ValidationError
+	if !errors.As(err, &verr) || len(verr.Problems) != 3 {
+		t.Fatalf("got %v, want 3 problems", err)
+	}
+	if msg := err.Error(); !strings.HasPrefix(msg, "invalid configuration:") || strings.Count(msg, "\n  - ") != 3 {
+		t.Errorf("got message %q", msg)
+	}
+}
+
+func TestValidate_ExistingPolicyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte("rules: []\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.Default()
+	cfg.Policy.Enabled = true
+	cfg.Policy.FilePath = path
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidate_ReportsUnusableEnvValues(t *testing.T) {
+	t.Setenv("ACAI_EVENTS_OUTBOX_MAX_PENDING", "-1")
+	t.Setenv("ACAI_CACHE_TTL", "soon")
+
+	cfg := config.Load()
+	if got := cfg.Events.OutboxMaxPending; got != config.Default().Events.OutboxMaxPending {
+		t.Errorf("got max pending %d, want the default kept", got)
+	}
+
+	err := cfg.Validate()
+	var verr *config.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("got %v, want a *ValidationError", err)
+	}
+	for _, want := range []string{`ACAI_EVENTS_OUTBOX_MAX_PENDING: invalid value "-1"`, `ACAI_CACHE_TTL: invalid value "soon"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
@@ -47,6 +47,15 @@ func (d *Dispatcher) dispatchEvent(event domain.DomainEvent) {
 			log.Printf("event dispatch: notify resource list changed: %v", err)
 		}
 
+	case domain.MeetingDeleted:
+		uri := fmt.Sprintf("meeting://%s", e.MeetingID())
+		if err := d.notifier.NotifyResourceUpdated(uri); err != nil {
+			log.Printf("event dispatch: notify resource updated %q: %v", uri, err)
+		}
+		if err := d.notifier.NotifyResourceListChanged(); err != nil {
+			log.Printf("event dispatch: notify resource list changed: %v", err)
+		}
+
 	case domain.TranscriptUpdated:
 		uri := fmt.Sprintf("transcript://%s", e.MeetingID())
 		if err := d.notifier.NotifyResourceUpdated(uri); err != nil {
@@ -73,7 +82,7 @@ func (d *Dispatcher) dispatchEvent(event domain.DomainEvent) {
 
 	default:
 		// Annotation events and other unknown types — log but don't fail.
-		// Annotation events (note.added, note.deleted) trigger note resource updates
+		// Annotation events (note.added, note.deleted, notes.cleared) trigger note resource updates
 		// via the note://{meeting_id} URI pattern, handled at the interface level.
 		log.Printf("event dispatch: unknown event type %q", event.EventName())
 	}
@@ -44,6 +44,23 @@ func TestDispatcher_MeetingCreated_NotifiesResourceAndList(t *testing.T) {
 	}
 }
 
+func TestDispatcher_MeetingDeleted_NotifiesResourceAndList(t *testing.T) {
+	n := &mockNotifier{}
+	d := events.NewDispatcher(n)
+
+	err := d.Dispatch(context.Background(), []domain.DomainEvent{domain.NewMeetingDeletedEvent("m-1")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(n.updatedURIs) != 1 || n.updatedURIs[0] != "meeting://m-1" {
+		t.Errorf("expected [meeting://m-1], got %v", n.updatedURIs)
+	}
+	if n.listChangedCnt != 1 {
+		t.Errorf("expected 1 list changed, got %d", n.listChangedCnt)
+	}
+}
+
 func TestDispatcher_TranscriptUpdated_NotifiesTranscriptResource(t *testing.T) {
 	n := &mockNotifier{}
 	d := events.NewDispatcher(n)
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultRecentEventsSize is the ring buffer capacity used when none is configured.
+const DefaultRecentEventsSize = 100
+
+// RecordedEvent is a snapshot of a dispatched domain event.
+type RecordedEvent struct {
+	EventName  string
+	MeetingID  string
+	OccurredAt time.Time
+}
+
+// RecentEvents keeps the last N dispatched domain events in a fixed-size
+// ring buffer so clients can poll for recent changes. Safe for concurrent use.
+type RecentEvents struct {
+	mu    sync.Mutex
+	buf   []RecordedEvent
+	next  int
+	count int
+}
+
+// NewRecentEvents creates a ring buffer holding up to size events.
+// A non-positive size falls back to DefaultRecentEventsSize.
+func NewRecentEvents(size int) *RecentEvents {
+	if size <= 0 {
+		size = DefaultRecentEventsSize
+	}
+	return &RecentEvents{buf: make([]RecordedEvent, size)}
+}
+
+// Record appends an event, overwriting the oldest entry once the buffer is full.
+func (r *RecentEvents) Record(event domain.DomainEvent) {
+	rec := RecordedEvent{
+		EventName:  event.EventName(),
+		MeetingID:  eventMeetingID(event),
+		OccurredAt: event.OccurredAt(),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.buf[r.next] = rec
+	r.next = (r.next + 1) % len(r.buf)
+	if r.count < len(r.buf) {
+		r.count++
+	}
+}
+
+// Recent returns the buffered events, oldest first.
+func (r *RecentEvents) Recent() []RecordedEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]RecordedEvent, r.count)
+	start := (r.next - r.count + len(r.buf)) % len(r.buf)
+	for i := 0; i < r.count; i++ {
+		out[i] = r.buf[(start+i)%len(r.buf)]
+	}
+	return out
+}
+
+// eventMeetingID extracts the meeting ID from events of either bounded context.
+// Meeting events return a typed MeetingID; annotation events return a plain string.
+func eventMeetingID(event domain.DomainEvent) string {
+	switch e := event.(type) {
+	case interface{ MeetingID() domain.MeetingID }:
+		return string(e.MeetingID())
+	case interface{ MeetingID() string }:
+		return e.MeetingID()
+	default:
+		return ""
+	}
+}
+
+// RecordingDispatcher decorates a domain.EventDispatcher, recording every
+// dispatched event into a RecentEvents buffer before forwarding it.
+type RecordingDispatcher struct {
+	inner  domain.EventDispatcher
+	recent *RecentEvents
+}
+
+// NewRecordingDispatcher creates a new recording dispatcher decorator.
+func NewRecordingDispatcher(inner domain.EventDispatcher, recent *RecentEvents) *RecordingDispatcher {
+	return &RecordingDispatcher{inner: inner, recent: recent}
+}
+
+// Dispatch records each event, then forwards the batch to the inner dispatcher.
+func (d *RecordingDispatcher) Dispatch(ctx context.Context, events []domain.DomainEvent) error {
+	for _, event := range events {
+		d.recent.Record(event)
+	}
+	return d.inner.Dispatch(ctx, events)
+}
+
+var _ domain.EventDispatcher = (*RecordingDispatcher)(nil)
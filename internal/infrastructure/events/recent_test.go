@@ -0,0 +1,72 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/events"
+)
+
+func TestRecentEvents_KeepsLastN(t *testing.T) {
+	r := events.NewRecentEvents(2)
+
+	r.Record(domain.NewTranscriptUpdatedEvent("m-1", 1))
+	r.Record(domain.NewTranscriptUpdatedEvent("m-2", 1))
+	r.Record(domain.NewTranscriptUpdatedEvent("m-3", 1))
+
+	got := r.Recent()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(got))
+	}
+	if got[0].MeetingID != "m-2" || got[1].MeetingID != "m-3" {
+		t.Errorf("expected [m-2 m-3], got [%s %s]", got[0].MeetingID, got[1].MeetingID)
+	}
+}
+
+func TestRecentEvents_DefaultSize(t *testing.T) {
+	r := events.NewRecentEvents(0)
+	for i := 0; i < events.DefaultRecentEventsSize+5; i++ {
+		r.Record(domain.NewTranscriptUpdatedEvent("m-1", i))
+	}
+	if got := len(r.Recent()); got != events.DefaultRecentEventsSize {
+		t.Errorf("expected %d events, got %d", events.DefaultRecentEventsSize, got)
+	}
+}
+
+func TestRecentEvents_AnnotationEventMeetingID(t *testing.T) {
+	r := events.NewRecentEvents(10)
+	r.Record(annotation.NewNoteAddedEvent("n-1", "m-1", "claude"))
+
+	got := r.Recent()
+	if len(got) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(got))
+	}
+	if got[0].EventName != "note.added" || got[0].MeetingID != "m-1" {
+		t.Errorf("unexpected event %+v", got[0])
+	}
+	if got[0].OccurredAt.IsZero() {
+		t.Error("expected occurred_at to be set")
+	}
+}
+
+func TestRecordingDispatcher_RecordsAndForwards(t *testing.T) {
+	n := &mockNotifier{}
+	recent := events.NewRecentEvents(10)
+	d := events.NewRecordingDispatcher(events.NewDispatcher(n), recent)
+
+	err := d.Dispatch(context.Background(), []domain.DomainEvent{
+		domain.NewTranscriptUpdatedEvent("m-1", 3),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(recent.Recent()) != 1 {
+		t.Errorf("expected 1 recorded event, got %d", len(recent.Recent()))
+	}
+	if len(n.updatedURIs) != 1 || n.updatedURIs[0] != "transcript://m-1" {
+		t.Errorf("expected [transcript://m-1], got %v", n.updatedURIs)
+	}
+}
@@ -0,0 +1,183 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultSubscriberBuffer is how many events a subscriber may fall behind
+// before further events are dropped for it.
+const DefaultSubscriberBuffer = 64
+
+// Broadcaster fans dispatched events out to live subscribers. Publishing
+// never blocks: a subscriber whose buffer is full misses events rather
+// than stalling dispatch. Safe for concurrent use.
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[chan RecordedEvent]struct{}
+}
+
+// NewBroadcaster creates a broadcaster with no subscribers.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subs: make(map[chan RecordedEvent]struct{})}
+}
+
+// Subscribe registers a subscriber buffering up to buffer events; a
+// non-positive buffer uses DefaultSubscriberBuffer. The returned cancel
+// func unregisters it and closes the channel.
+func (b *Broadcaster) Subscribe(buffer int) (<-chan RecordedEvent, func()) {
+	if buffer <= 0 {
+		buffer = DefaultSubscriberBuffer
+	}
+	ch := make(chan RecordedEvent, buffer)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish sends event to every subscriber with room in its buffer.
+func (b *Broadcaster) Publish(event domain.DomainEvent) {
+	rec := RecordedEvent{
+		EventName:  event.EventName(),
+		MeetingID:  eventMeetingID(event),
+		OccurredAt: event.OccurredAt(),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		select {
+		case ch <- rec:
+		default:
+		}
+	}
+}
+
+// BroadcastingDispatcher decorates a domain.EventDispatcher, publishing
+// every dispatched event to a Broadcaster before forwarding it.
+type BroadcastingDispatcher struct {
+	inner       domain.EventDispatcher
+	broadcaster *Broadcaster
+}
+
+// NewBroadcastingDispatcher creates a new broadcasting dispatcher decorator.
+func NewBroadcastingDispatcher(inner domain.EventDispatcher, b *Broadcaster) *BroadcastingDispatcher {
+	return &BroadcastingDispatcher{inner: inner, broadcaster: b}
+}
+
+// Dispatch publishes each event, then forwards the batch to the inner dispatcher.
+func (d *BroadcastingDispatcher) Dispatch(ctx context.Context, events []domain.DomainEvent) error {
+	for _, event := range events {
+		d.broadcaster.Publish(event)
+	}
+	return d.inner.Dispatch(ctx, events)
+}
+
+var _ domain.EventDispatcher = (*BroadcastingDispatcher)(nil)
+
+// StreamEvent is the JSON data of each server-sent event on the stream.
+type StreamEvent struct {
+	Event      string    `json:"event"`
+	MeetingID  string    `json:"meeting_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// DefaultKeepAlive is how often an idle stream sends a comment line, so
+// proxies do not close it for inactivity.
+const DefaultKeepAlive = 15 * time.Second
+
+// StreamHandler serves dispatched events as server-sent events: one
+// "event: <name>" / "data: <StreamEvent JSON>" message per event, until
+// the client disconnects. A ": keepalive" comment is sent when no event
+// has gone out for the keepalive interval.
+type StreamHandler struct {
+	broadcaster *Broadcaster
+	keepAlive   time.Duration
+}
+
+// NewStreamHandler creates an SSE handler streaming b's events, using
+// DefaultKeepAlive.
+func NewStreamHandler(b *Broadcaster) *StreamHandler {
+	return &StreamHandler{broadcaster: b, keepAlive: DefaultKeepAlive}
+}
+
+// SetKeepAlive replaces the keepalive interval. Zero or negative disables
+// keepalive comments.
+func (h *StreamHandler) SetKeepAlive(d time.Duration) {
+	h.keepAlive = d
+}
+
+func (h *StreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	events, cancel := h.broadcaster.Subscribe(0)
+	defer cancel()
+
+	// The stream outlives the server's write timeout by design.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	var keepAlive <-chan time.Time
+	if h.keepAlive > 0 {
+		ticker := time.NewTicker(h.keepAlive)
+		defer ticker.Stop()
+		keepAlive = ticker.C
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive:
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		case rec := <-events:
+			data, err := json.Marshal(StreamEvent{
+				Event:      rec.EventName,
+				MeetingID:  rec.MeetingID,
+				OccurredAt: rec.OccurredAt,
+			})
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", rec.EventName, data); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/events"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/webhook"
+)
+
+func TestBroadcaster_FansOutAndUnsubscribes(t *testing.T) {
+	b := events.NewBroadcaster()
+	first, cancelFirst := b.Subscribe(1)
+	second, cancelSecond := b.Subscribe(1)
+	defer cancelSecond()
+
+	b.Publish(domain.NewMeetingDeletedEvent("m-1"))
+	for _, ch := range []<-chan events.RecordedEvent{first, second} {
+		if rec := <-ch; rec.EventName != "meeting.deleted" || rec.MeetingID != "m-1" {
+			t.Errorf("got %+v, want meeting.deleted for m-1", rec)
+		}
+	}
+
+	// A full buffer drops events instead of blocking the publisher.
+	b.Publish(domain.NewMeetingDeletedEvent("m-2"))
+	b.Publish(domain.NewMeetingDeletedEvent("m-3"))
+	for _, ch := range []<-chan events.RecordedEvent{first, second} {
+		if rec := <-ch; rec.MeetingID != "m-2" {
+			t.Errorf("got %+v, want m-2", rec)
+		}
+	}
+
+	cancelFirst()
+	cancelFirst()
+	if _, open := <-first; open {
+		t.Error("expected the channel to be closed after cancel")
+	}
+}
+
+func TestStreamHandler_StreamsWebhookEvents(t *testing.T) {
+	b := events.NewBroadcaster()
+	dispatcher := events.NewBroadcastingDispatcher(events.NewDispatcher(nil), b)
+	// Deletions are dispatched without syncing, so no sync use case is needed.
+	hook := webhook.NewHandler(nil, dispatcher, "")
+
+	srv := httptest.NewServer(events.NewStreamHandler(b))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("got content type %q", ct)
+	}
+
+	w := httptest.NewRecorder()
+	hook.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook/granola",
+		strings.NewReader(`{"event":"meeting.deleted","meeting_id":"m-1"}`)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("webhook: got %d", w.Code)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	var lines []string
+	for scanner.Scan() && len(lines) < 2 {
+		if line := scanner.Text(); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) != 2 || lines[0] != "event: meeting.deleted" || !strings.HasPrefix(lines[1], "data: ") {
+		t.Fatalf("got %q, want a meeting.deleted message", lines)
+	}
+	var got events.StreamEvent
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data: ")), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.Event != "meeting.deleted" || got.MeetingID != "m-1" || got.OccurredAt.IsZero() {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestStreamHandler_RejectsNonGet(t *testing.T) {
+	w := httptest.NewRecorder()
+	events.NewStreamHandler(events.NewBroadcaster()).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/events", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("got %d, want 405", w.Code)
+	}
+}
+
+func TestStreamHandler_SendsKeepAlive(t *testing.T) {
+	h := events.NewStreamHandler(events.NewBroadcaster())
+	h.SetKeepAlive(10 * time.Millisecond)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	scanner := bufio.NewScanner(resp.Body)
+	if !scanner.Scan() || scanner.Text() != ": keepalive" {
+		t.Errorf("got %q, want a keepalive comment on an idle stream", scanner.Text())
+	}
+}
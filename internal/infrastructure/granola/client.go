@@ -3,20 +3,46 @@ package granola
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/tracing"
 )
 
+// DefaultAPIVersion is the path prefix of the Granola API endpoints.
+const DefaultAPIVersion = "v2"
+
+// DefaultUserAgent identifies requests until SetUserAgent adds a version.
+const DefaultUserAgent = "acai"
+
+// DefaultSlowRequestThreshold is how long an API request may take before
+// it is logged as slow.
+const DefaultSlowRequestThreshold = 2 * time.Second
+
 // Client wraps the Granola REST API.
 // This is an infrastructure concern — the domain has no knowledge of HTTP.
 type Client struct {
 	baseURL    string
+	apiPrefix  string
+	userAgent  string
 	httpClient *http.Client
-	token      string
+	slowAfter  time.Duration
+
+	mu        sync.RWMutex
+	token     string
+	refresher func(ctx context.Context) (string, error)
+
+	// refreshMu serializes 401 refreshes, so concurrent requests that hit
+	// the same expired token refresh it once.
+	refreshMu sync.Mutex
 }
 
 func NewClient(baseURL string, httpClient *http.Client, token string) *Client {
@@ -24,16 +50,101 @@ func NewClient(baseURL string, httpClient *http.Client, token string) *Client {
 		httpClient = &http.Client{Timeout: 30 * time.Second}
 	}
 	return &Client{
-		baseURL:    baseURL,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiPrefix:  "/" + DefaultAPIVersion,
+		userAgent:  DefaultUserAgent,
 		httpClient: httpClient,
+		slowAfter:  DefaultSlowRequestThreshold,
 		token:      token,
 	}
 }
 
+// SetAPIVersion sets the path prefix placed between the base URL and each
+// endpoint, e.g. "v3" or "proxy/granola/v2". Surrounding slashes are
+// ignored; an empty version requests endpoints directly under the base URL.
+// The OAuth token endpoint is not versioned.
+func (c *Client) SetAPIVersion(version string) {
+	if version = strings.Trim(version, "/"); version != "" {
+		version = "/" + version
+	}
+	c.apiPrefix = version
+}
+
+// SetUserAgent sets the User-Agent header of every request, typically
+// "acai/<version>", so Granola can tell client versions apart.
+func (c *Client) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetSlowRequestThreshold sets how long an API request may take before a
+// warning is logged with its method, path, and duration. Zero disables it.
+func (c *Client) SetSlowRequestThreshold(d time.Duration) {
+	c.slowAfter = d
+}
+
 func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.token = token
 }
 
+// SetTokenRefresher registers a callback that obtains a fresh access token.
+// When set, a 401 response triggers one refresh and retry before
+// ErrUnauthorized is returned.
+func (c *Client) SetTokenRefresher(fn func(ctx context.Context) (string, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refresher = fn
+}
+
+func (c *Client) currentToken() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+// RefreshAccessToken exchanges an OAuth refresh token at the token endpoint.
+// It never triggers the 401 refresh path itself.
+func (c *Client) RefreshAccessToken(ctx context.Context, refreshToken string) (*TokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if id := tracing.CorrelationIDFromContext(ctx); id != "" {
+		req.Header.Set(tracing.CorrelationHeader, id)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusBadRequest {
+		return nil, ErrUnauthorized
+	}
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("api error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var tr TokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("decoding response: missing access_token")
+	}
+	return &tr, nil
+}
+
 func (c *Client) GetDocuments(ctx context.Context, since *time.Time, limit, offset int) (*DocumentListResponse, error) {
 	params := url.Values{}
 	if since != nil {
@@ -47,7 +158,7 @@ func (c *Client) GetDocuments(ctx context.Context, since *time.Time, limit, offs
 	}
 
 	var resp DocumentListResponse
-	if err := c.get(ctx, "/v2/get-documents", params, &resp); err != nil {
+	if err := c.get(ctx, "/get-documents", params, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -58,33 +169,163 @@ func (c *Client) GetDocument(ctx context.Context, id string) (*DocumentDTO, erro
 	params.Set("id", id)
 
 	var resp DocumentDTO
-	if err := c.get(ctx, "/v2/get-document", params, &resp); err != nil {
+	if err := c.get(ctx, "/get-document", params, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+// GetTranscript returns the full transcript of a meeting. When the API
+// pages long transcripts, it follows next_cursor and concatenates the
+// pages, stopping between pages if ctx is done.
 func (c *Client) GetTranscript(ctx context.Context, meetingID string) (*TranscriptResponse, error) {
 	params := url.Values{}
 	params.Set("meeting_id", meetingID)
 
-	var resp TranscriptResponse
-	if err := c.get(ctx, "/v2/get-document-transcript", params, &resp); err != nil {
+	var full TranscriptResponse
+	seen := make(map[string]bool)
+	for {
+		var page TranscriptResponse
+		if err := c.get(ctx, "/get-document-transcript", params, &page); err != nil {
+			return nil, err
+		}
+		if full.MeetingID == "" {
+			full.MeetingID = page.MeetingID
+		}
+		full.Utterances = append(full.Utterances, page.Utterances...)
+
+		if page.NextCursor == "" {
+			return &full, nil
+		}
+		if seen[page.NextCursor] {
+			return nil, fmt.Errorf("granola: transcript cursor %q repeated", page.NextCursor)
+		}
+		seen[page.NextCursor] = true
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		params.Set("cursor", page.NextCursor)
+	}
+}
+
+// GetDocumentRaw returns the get-document response body unmodified,
+// bypassing DTO decoding. Intended for diagnostics.
+func (c *Client) GetDocumentRaw(ctx context.Context, id string) (json.RawMessage, error) {
+	params := url.Values{}
+	params.Set("id", id)
+
+	var raw json.RawMessage
+	if err := c.get(ctx, "/get-document", params, &raw); err != nil {
 		return nil, err
 	}
-	return &resp, nil
+	return raw, nil
+}
+
+// GetTranscriptRaw returns the transcript response body unmodified,
+// bypassing DTO decoding. Intended for diagnostics.
+func (c *Client) GetTranscriptRaw(ctx context.Context, meetingID string) (json.RawMessage, error) {
+	params := url.Values{}
+	params.Set("meeting_id", meetingID)
+
+	var raw json.RawMessage
+	if err := c.get(ctx, "/get-document-transcript", params, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
 }
 
 func (c *Client) GetWorkspaces(ctx context.Context) (*WorkspaceListResponse, error) {
 	var resp WorkspaceListResponse
-	if err := c.get(ctx, "/v2/get-workspaces", nil, &resp); err != nil {
+	if err := c.get(ctx, "/get-workspaces", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCurrentUser returns the account the client's token belongs to.
+func (c *Client) GetCurrentUser(ctx context.Context) (*UserDTO, error) {
+	var resp UserDTO
+	if err := c.get(ctx, "/get-user", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetUserWithToken returns the account accessToken belongs to. The
+// request carries accessToken instead of the client's token, which it
+// leaves unchanged, and a 401 is returned as is rather than refreshed.
+func (c *Client) GetUserWithToken(ctx context.Context, accessToken string) (*UserDTO, error) {
+	var resp UserDTO
+	if err := c.doGetAs(ctx, accessToken, "/get-user", nil, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
 func (c *Client) get(ctx context.Context, path string, params url.Values, target interface{}) error {
-	u := c.baseURL + path
+	staleToken := c.currentToken()
+	err := c.doGet(ctx, path, params, target)
+	if !errors.Is(err, ErrUnauthorized) {
+		return err
+	}
+
+	c.mu.RLock()
+	refresher := c.refresher
+	c.mu.RUnlock()
+	if refresher == nil {
+		return err
+	}
+
+	if !c.refreshToken(ctx, refresher, staleToken) {
+		return err
+	}
+	return c.doGet(ctx, path, params, target)
+}
+
+// refreshToken replaces staleToken using refresher and reports whether a
+// fresh token is in place. When another request already replaced it
+// while this one waited, that token is reused without refreshing again.
+func (c *Client) refreshToken(ctx context.Context, refresher func(ctx context.Context) (string, error), staleToken string) bool {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if c.currentToken() != staleToken {
+		return true
+	}
+
+	token, err := refresher(ctx)
+	if err != nil {
+		log.Printf("granola: token refresh failed%s: %v", logCorrelation(ctx), err)
+		return false
+	}
+	c.SetToken(token)
+	return true
+}
+
+// logIfSlow warns when the request, including reading its body, took
+// longer than the slow-request threshold.
+func (c *Client) logIfSlow(ctx context.Context, req *http.Request, start time.Time) {
+	elapsed := time.Since(start)
+	if c.slowAfter <= 0 || elapsed <= c.slowAfter {
+		return
+	}
+	log.Printf("granola: slow request %s %s took %s%s", req.Method, req.URL.Path, elapsed, logCorrelation(ctx))
+}
+
+// logCorrelation formats the context's correlation ID for log lines.
+func logCorrelation(ctx context.Context) string {
+	if id := tracing.CorrelationIDFromContext(ctx); id != "" {
+		return " [correlation_id=" + id + "]"
+	}
+	return ""
+}
+
+func (c *Client) doGet(ctx context.Context, path string, params url.Values, target interface{}) error {
+	return c.doGetAs(ctx, c.currentToken(), path, params, target)
+}
+
+// doGetAs performs one GET authorized with token.
+func (c *Client) doGetAs(ctx context.Context, token, path string, params url.Values, target interface{}) error {
+	u := c.baseURL + c.apiPrefix + path
 	if len(params) > 0 {
 		u += "?" + params.Encode()
 	}
@@ -94,10 +335,17 @@ func (c *Client) get(ctx context.Context, path string, params url.Values, target
 		return fmt.Errorf("creating request: %w", err)
 	}
 
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if id := tracing.CorrelationIDFromContext(ctx); id != "" {
+		req.Header.Set(tracing.CorrelationHeader, id)
+	}
+
+	start := time.Now()
+	defer c.logIfSlow(ctx, req, start)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -114,6 +362,10 @@ func (c *Client) get(ctx context.Context, path string, params url.Values, target
 	if resp.StatusCode == http.StatusUnauthorized {
 		return ErrUnauthorized
 	}
+	if resp.StatusCode >= 500 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w (status %d): %s", ErrServerError, resp.StatusCode, string(body))
+	}
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("api error (status %d): %s", resp.StatusCode, string(body))
@@ -1,14 +1,22 @@
 package granola_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"log"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/felixgeelhaar/acai/internal/infrastructure/granola"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/tracing"
 )
 
 func TestClient_GetDocuments(t *testing.T) {
@@ -49,6 +57,68 @@ func TestClient_GetDocuments(t *testing.T) {
 	}
 }
 
+func TestClient_SetAPIVersion_CustomPrefix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/proxy/granola/v3/get-document" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(granola.DocumentDTO{ID: "m-1"})
+	}))
+	defer server.Close()
+
+	// Slashes on either side of the base URL and version are tolerated.
+	for _, tc := range []struct{ base, version string }{
+		{server.URL + "/proxy", "granola/v3"},
+		{server.URL + "/proxy/", "/granola/v3/"},
+		{server.URL, "proxy/granola/v3"},
+	} {
+		client := granola.NewClient(tc.base, server.Client(), "token")
+		client.SetAPIVersion(tc.version)
+		doc, err := client.GetDocument(context.Background(), "m-1")
+		if err != nil {
+			t.Fatalf("base %q, version %q: unexpected error: %v", tc.base, tc.version, err)
+		}
+		if doc.ID != "m-1" {
+			t.Errorf("got id %q", doc.ID)
+		}
+	}
+}
+
+func TestClient_SetUserAgent(t *testing.T) {
+	var agents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.Header.Get("User-Agent"))
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(granola.TokenResponse{AccessToken: "new"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(granola.DocumentDTO{ID: "m-1"})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "token")
+	if _, err := client.GetDocument(context.Background(), "m-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client.SetUserAgent("acai/1.4.2")
+	if _, err := client.GetDocument(context.Background(), "m-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.RefreshAccessToken(context.Background(), "refresh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{granola.DefaultUserAgent, "acai/1.4.2", "acai/1.4.2"}
+	for i, w := range want {
+		if agents[i] != w {
+			t.Errorf("request %d: got User-Agent %q, want %q", i, agents[i], w)
+		}
+	}
+}
+
 func TestClient_GetDocument_NotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
@@ -85,6 +155,81 @@ func TestClient_GetTranscript(t *testing.T) {
 	}
 }
 
+func TestClient_GetTranscript_FollowsCursor(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		resp := granola.TranscriptResponse{MeetingID: "m-1"}
+		switch cursor {
+		case "":
+			resp.Utterances = []granola.UtteranceDTO{
+				{Speaker: "Alice", Text: "Hello", Timestamp: now},
+				{Speaker: "Bob", Text: "Hi", Timestamp: now.Add(time.Second)},
+			}
+			resp.NextCursor = "page-2"
+		case "page-2":
+			resp.Utterances = []granola.UtteranceDTO{
+				{Speaker: "Alice", Text: "Bye", Timestamp: now.Add(2 * time.Second)},
+			}
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "test-token")
+	resp, err := client.GetTranscript(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cursors) != 2 || cursors[1] != "page-2" {
+		t.Errorf("got cursors %q, want two pages", cursors)
+	}
+	var texts []string
+	for _, u := range resp.Utterances {
+		texts = append(texts, u.Text)
+	}
+	if got := strings.Join(texts, ","); got != "Hello,Hi,Bye" {
+		t.Errorf("got utterances %s, want Hello,Hi,Bye", got)
+	}
+	if resp.MeetingID != "m-1" || resp.NextCursor != "" {
+		t.Errorf("got meeting %q cursor %q", resp.MeetingID, resp.NextCursor)
+	}
+}
+
+func TestClient_GetTranscript_StopsBetweenPages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") != "" {
+			t.Error("fetched a page after the context was canceled")
+		}
+		cancel()
+		_ = json.NewEncoder(w).Encode(granola.TranscriptResponse{MeetingID: "m-1", NextCursor: "page-2"})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "test-token")
+	if _, err := client.GetTranscript(ctx, "m-1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestClient_GetTranscript_RepeatedCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(granola.TranscriptResponse{MeetingID: "m-1", NextCursor: "same"})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "test-token")
+	if _, err := client.GetTranscript(context.Background(), "m-1"); err == nil || !strings.Contains(err.Error(), "repeated") {
+		t.Errorf("got %v, want a repeated cursor error", err)
+	}
+}
+
 func TestClient_Unauthorized(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusUnauthorized)
@@ -98,6 +243,202 @@ func TestClient_Unauthorized(t *testing.T) {
 	}
 }
 
+func TestClient_Unauthorized_RefreshesOnceAndRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.DocumentListResponse{})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "expired-token")
+	refreshes := 0
+	client.SetTokenRefresher(func(_ context.Context) (string, error) {
+		refreshes++
+		return "fresh-token", nil
+	})
+
+	if _, err := client.GetDocuments(context.Background(), nil, 0, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refreshes != 1 {
+		t.Errorf("expected 1 refresh, got %d", refreshes)
+	}
+}
+
+func TestClient_Unauthorized_RefreshFailsSurfacesUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "expired-token")
+	refreshes := 0
+	client.SetTokenRefresher(func(_ context.Context) (string, error) {
+		refreshes++
+		return "still-bad", nil
+	})
+
+	_, err := client.GetDocuments(context.Background(), nil, 0, 0)
+	if !errors.Is(err, granola.ErrUnauthorized) {
+		t.Errorf("got error %v, want %v", err, granola.ErrUnauthorized)
+	}
+	if refreshes != 1 {
+		t.Errorf("expected exactly 1 refresh attempt, got %d", refreshes)
+	}
+}
+
+func TestClient_RefreshAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/oauth/token" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("refresh_token") != "rt-1" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.TokenResponse{AccessToken: "at-2", RefreshToken: "rt-2", ExpiresIn: 3600})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "")
+	token, err := granola.NewTokenRefresher(client).RefreshAccessToken(context.Background(), "rt-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken() != "at-2" || token.RefreshToken() != "rt-2" {
+		t.Errorf("got tokens %q/%q", token.AccessToken(), token.RefreshToken())
+	}
+	if token.IsExpired() {
+		t.Error("refreshed token should not be expired")
+	}
+}
+
+func TestClient_Unauthorized_ConcurrentRequestsRefreshOnce(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.DocumentListResponse{})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "expired-token")
+	var refreshes atomic.Int32
+	client.SetTokenRefresher(func(_ context.Context) (string, error) {
+		refreshes.Add(1)
+		time.Sleep(20 * time.Millisecond) // let the other requests queue up
+		return "fresh-token", nil
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetDocuments(context.Background(), nil, 0, 0)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("expected 1 refresh for concurrent 401s, got %d", got)
+	}
+}
+
+func TestClient_RefreshAccessToken_WithoutExpiresIn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.TokenResponse{AccessToken: "at-2", RefreshToken: "rt-2"})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "")
+	token, err := granola.NewTokenRefresher(client).RefreshAccessToken(context.Background(), "rt-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !token.ExpiresAt().IsZero() {
+		t.Errorf("got expiry %v, want unknown (zero)", token.ExpiresAt())
+	}
+	if token.IsExpired() {
+		t.Error("a token with an unknown expiry should not be expired")
+	}
+}
+
+func TestIdentityFetcher_FetchIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/get-user" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer at-1" {
+			t.Errorf("got Authorization %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.UserDTO{ID: "u-1", Email: "alice@example.com", Name: "Alice"})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "")
+	identity, err := granola.NewIdentityFetcher(client).FetchIdentity(context.Background(), "at-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if identity.AccountID() != "u-1" || identity.Email() != "alice@example.com" || identity.Name() != "Alice" {
+		t.Errorf("got identity %+v", identity)
+	}
+}
+
+func TestIdentityFetcher_LeavesClientTokenAndSkipsRefresh(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("Authorization"))
+		if r.URL.Path == "/v2/get-user" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.WorkspaceListResponse{})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "old-token")
+	refreshed := false
+	client.SetTokenRefresher(func(_ context.Context) (string, error) {
+		refreshed = true
+		return "refreshed-token", nil
+	})
+
+	_, err := granola.NewIdentityFetcher(client).FetchIdentity(context.Background(), "new-token")
+	if !errors.Is(err, granola.ErrUnauthorized) {
+		t.Fatalf("got %v, want ErrUnauthorized", err)
+	}
+	if refreshed {
+		t.Error("an identity lookup should not refresh the stored credential")
+	}
+
+	if _, err := client.GetWorkspaces(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"Bearer new-token", "Bearer old-token"}
+	if len(seen) != len(want) || seen[0] != want[0] || seen[1] != want[1] {
+		t.Errorf("got Authorization headers %v, want %v", seen, want)
+	}
+}
+
 func TestClient_RateLimited(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusTooManyRequests)
@@ -110,3 +451,74 @@ func TestClient_RateLimited(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestClient_ForwardsCorrelationID(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(tracing.CorrelationHeader)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(granola.DocumentListResponse{})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "test-token")
+	ctx := tracing.WithCorrelationID(context.Background(), "abc123")
+	if _, err := client.GetDocuments(ctx, nil, 10, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "abc123" {
+		t.Errorf("got %s header %q, want %q", tracing.CorrelationHeader, got, "abc123")
+	}
+}
+
+func TestClient_GetDocumentRaw_PreservesBody(t *testing.T) {
+	body := `{"id":"m-1","title":"Sync","field_the_mapper_drops":[1,2,3]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/get-document" || r.URL.Query().Get("id") != "m-1" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "test-token")
+	raw, err := client.GetDocumentRaw(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(raw) != body {
+		t.Errorf("got %s, want %s", raw, body)
+	}
+}
+
+func TestClient_LogsSlowRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") == "slow" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte(`{"id":"m-1"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	client := granola.NewClient(server.URL, server.Client(), "test-token")
+	client.SetSlowRequestThreshold(20 * time.Millisecond)
+
+	if _, err := client.GetDocument(context.Background(), "fast"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("fast request logged: %s", buf.String())
+	}
+
+	if _, err := client.GetDocument(context.Background(), "slow"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := buf.String()
+	if want := "granola: slow request GET /v2/get-document took "; !strings.Contains(got, want) {
+		t.Errorf("log %q missing %q", got, want)
+	}
+}
@@ -8,4 +8,6 @@ var (
 	ErrNotFound     = errors.New("granola: resource not found")
 	ErrRateLimited  = errors.New("granola: rate limited")
 	ErrUnauthorized = errors.New("granola: unauthorized")
+	// ErrServerError wraps 5xx responses, which may succeed on retry.
+	ErrServerError = errors.New("granola: server error")
 )
@@ -0,0 +1,95 @@
+package granola
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// tlsVersions maps HTTPClientOptions.MinTLSVersion names to their
+// crypto/tls constants.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// HTTPClientOptions tunes the HTTP client used for the Granola API. Zero
+// pool values keep the defaults of http.DefaultTransport.
+type HTTPClientOptions struct {
+	// Timeout caps each request, including reading the response body.
+	Timeout time.Duration
+	// MaxIdleConns caps idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost caps idle connections kept to the API host, so
+	// concurrent fetches reuse connections instead of dialing new ones.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is how long an idle connection stays pooled.
+	IdleConnTimeout time.Duration
+	// MinTLSVersion is the lowest TLS version accepted: "1.0", "1.1",
+	// "1.2", or "1.3". Empty keeps the Go default.
+	MinTLSVersion string
+	// CAFile is a PEM bundle of extra root CAs trusted alongside the
+	// system pool, e.g. for a TLS-inspecting proxy.
+	CAFile string
+}
+
+// NewHTTPClient builds an HTTP client whose transport is a copy of
+// http.DefaultTransport with opts' pool and TLS settings applied. It
+// fails if MinTLSVersion is unknown or CAFile holds no usable certificate.
+func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.MaxIdleConns > 0 {
+		transport.MaxIdleConns = opts.MaxIdleConns
+	}
+	if opts.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+	if opts.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = opts.IdleConnTimeout
+	}
+
+	if opts.MinTLSVersion != "" || opts.CAFile != "" {
+		// Clone already copied the TLS config, which carries the HTTP/2
+		// protocol negotiation, so adjust it rather than replacing it.
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		if opts.MinTLSVersion != "" {
+			version, ok := tlsVersions[opts.MinTLSVersion]
+			if !ok {
+				return nil, fmt.Errorf("unknown minimum TLS version %q", opts.MinTLSVersion)
+			}
+			transport.TLSClientConfig.MinVersion = version
+		}
+		if opts.CAFile != "" {
+			pool, err := loadCAPool(opts.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			transport.TLSClientConfig.RootCAs = pool
+		}
+	}
+	return &http.Client{Timeout: opts.Timeout, Transport: transport}, nil
+}
+
+// loadCAPool returns the system root pool with the certificates in the
+// PEM file at path added.
+func loadCAPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("CA file %s contains no PEM certificates", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,105 @@
+package granola_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/granola"
+)
+
+func TestNewHTTPClient_ConfiguresTransport(t *testing.T) {
+	client, err := granola.NewHTTPClient(granola.HTTPClientOptions{
+		Timeout:             30 * time.Second,
+		MaxIdleConns:        50,
+		MaxIdleConnsPerHost: 20,
+		IdleConnTimeout:     time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.Timeout != 30*time.Second {
+		t.Errorf("got timeout %v", client.Timeout)
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("got transport %T, want *http.Transport", client.Transport)
+	}
+	if transport.MaxIdleConns != 50 || transport.MaxIdleConnsPerHost != 20 || transport.IdleConnTimeout != time.Minute {
+		t.Errorf("got max idle %d, per host %d, idle timeout %v",
+			transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+	if transport == http.DefaultTransport {
+		t.Error("the default transport must not be modified")
+	}
+}
+
+func TestNewHTTPClient_ZeroKeepsDefaults(t *testing.T) {
+	client, err := granola.NewHTTPClient(granola.HTTPClientOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transport := client.Transport.(*http.Transport)
+	def := http.DefaultTransport.(*http.Transport)
+	if transport.MaxIdleConns != def.MaxIdleConns || transport.MaxIdleConnsPerHost != def.MaxIdleConnsPerHost ||
+		transport.IdleConnTimeout != def.IdleConnTimeout {
+		t.Errorf("got max idle %d, per host %d, idle timeout %v; want the default transport's",
+			transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+	if c := transport.TLSClientConfig; c != nil && (c.MinVersion != 0 || c.RootCAs != nil) {
+		t.Errorf("got TLS min version %d, root CAs %v; want the defaults", c.MinVersion, c.RootCAs)
+	}
+}
+
+func TestNewHTTPClient_TrustsCustomCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := granola.NewHTTPClient(granola.HTTPClientOptions{MinTLSVersion: "1.3", CAFile: caFile})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tlsConfig := client.Transport.(*http.Transport).TLSClientConfig
+	if tlsConfig == nil || tlsConfig.MinVersion != tls.VersionTLS13 {
+		t.Fatalf("got TLS config %+v, want minimum TLS 1.3", tlsConfig)
+	}
+	if !slices.Contains(tlsConfig.NextProtos, "h2") {
+		t.Errorf("got next protos %v, want HTTP/2 kept", tlsConfig.NextProtos)
+	}
+	if _, err := srv.Certificate().Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs}); err != nil {
+		t.Errorf("test CA is not in the root pool: %v", err)
+	}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request to the test server failed: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestNewHTTPClient_InvalidTLSOptions(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for name, opts := range map[string]granola.HTTPClientOptions{
+		"missing CA file":     {CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"CA file without PEM": {CAFile: notPEM},
+		"unknown TLS version": {MinTLSVersion: "2.0"},
+	} {
+		if _, err := granola.NewHTTPClient(opts); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
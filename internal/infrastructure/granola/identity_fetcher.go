@@ -0,0 +1,29 @@
+package granola
+
+import (
+	"context"
+
+	"github.com/felixgeelhaar/acai/internal/domain/auth"
+)
+
+// IdentityFetcher adapts the client's user endpoint to auth identities.
+type IdentityFetcher struct {
+	client *Client
+}
+
+// NewIdentityFetcher creates an identity fetcher backed by the Granola client.
+func NewIdentityFetcher(client *Client) *IdentityFetcher {
+	return &IdentityFetcher{client: client}
+}
+
+// FetchIdentity looks up the account behind accessToken. The lookup runs
+// before the credential is stored, so it neither swaps the client's token
+// nor refreshes on a 401, which would refresh the previously stored
+// credential instead.
+func (f *IdentityFetcher) FetchIdentity(ctx context.Context, accessToken string) (auth.Identity, error) {
+	user, err := f.client.GetUserWithToken(ctx, accessToken)
+	if err != nil {
+		return auth.Identity{}, err
+	}
+	return auth.NewIdentity(user.ID, user.Email, user.Name), nil
+}
@@ -1,6 +1,9 @@
 package granola
 
 import (
+	"strings"
+	"time"
+
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
@@ -45,6 +48,10 @@ func mapDocumentToDomain(dto DocumentDTO) (*domain.Meeting, error) {
 		mtg.SetMetadata(mapMetadataToDomain(*dto.Metadata))
 	}
 
+	if dto.WorkspaceID != "" {
+		mtg.SetWorkspaceID(dto.WorkspaceID)
+	}
+
 	// Clear all events — reconstitution should not produce events
 	mtg.ClearDomainEvents()
 
@@ -59,16 +66,20 @@ func mapParticipantToDomain(dto ParticipantDTO) domain.Participant {
 	return domain.NewParticipant(dto.Name, dto.Email, role)
 }
 
+// mapSourceToDomain normalizes Granola's platform string to a canonical source.
+// Known aliases are folded together; empty or unrecognized values become SourceUnknown.
 func mapSourceToDomain(source string) domain.Source {
-	switch source {
-	case "zoom":
+	switch strings.ToLower(strings.TrimSpace(source)) {
+	case "zoom", "zoom_meeting", "zoom.us":
 		return domain.SourceZoom
-	case "google_meet":
+	case "google_meet", "google-meet", "googlemeet", "meet", "gmeet", "hangouts":
 		return domain.SourceMeet
-	case "teams":
+	case "teams", "microsoft_teams", "microsoft-teams", "ms_teams", "msteams":
 		return domain.SourceTeams
-	default:
+	case "other":
 		return domain.SourceOther
+	default:
+		return domain.SourceUnknown
 	}
 }
 
@@ -106,6 +117,43 @@ func mapTranscriptToDomain(meetingID domain.MeetingID, dto TranscriptResponse) *
 	for i, u := range dto.Utterances {
 		utterances[i] = domain.NewUtterance(u.Speaker, u.Text, u.Timestamp, u.Confidence)
 	}
+	estimateMissingTimestamps(utterances)
 	t := domain.NewTranscript(meetingID, utterances)
 	return &t
 }
+
+// estimateMissingTimestamps fills in utterances Granola sent without a
+// timestamp, so durations and talk-time stats never see year-one dates.
+// Gaps between two reported timestamps are interpolated linearly; gaps at
+// either end take the nearest reported one. A transcript with no
+// timestamps at all is left as is.
+func estimateMissingTimestamps(utterances []domain.Utterance) {
+	prev := -1
+	for i := 0; i < len(utterances); i++ {
+		if !utterances[i].Timestamp().IsZero() {
+			prev = i
+			continue
+		}
+		next := i + 1
+		for next < len(utterances) && utterances[next].Timestamp().IsZero() {
+			next++
+		}
+		for j := i; j < next; j++ {
+			var ts time.Time
+			switch {
+			case prev >= 0 && next < len(utterances):
+				from, to := utterances[prev].Timestamp(), utterances[next].Timestamp()
+				step := to.Sub(from) / time.Duration(next-prev)
+				ts = from.Add(step * time.Duration(j-prev))
+			case prev >= 0:
+				ts = utterances[prev].Timestamp()
+			case next < len(utterances):
+				ts = utterances[next].Timestamp()
+			default:
+				return
+			}
+			utterances[j] = utterances[j].WithEstimatedTimestamp(ts)
+		}
+		i = next - 1
+	}
+}
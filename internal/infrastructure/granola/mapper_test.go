@@ -27,6 +27,7 @@ func TestMapDocumentToDomain(t *testing.T) {
 			Links:        []string{"https://jira.example.com"},
 			ExternalRefs: map[string]string{"jira": "SPRINT-1"},
 		},
+		WorkspaceID: "ws-1",
 	}
 
 	mtg, err := mapDocumentToDomain(dto)
@@ -58,6 +59,9 @@ func TestMapDocumentToDomain(t *testing.T) {
 	if mtg.Metadata().Tags()[0] != "sprint" {
 		t.Error("metadata tags not mapped")
 	}
+	if mtg.WorkspaceID() != "ws-1" {
+		t.Errorf("got workspace %q", mtg.WorkspaceID())
+	}
 
 	// Reconstitution should not produce domain events
 	if len(mtg.DomainEvents()) != 0 {
@@ -73,8 +77,14 @@ func TestMapSourceToDomain(t *testing.T) {
 		{"zoom", domain.SourceZoom},
 		{"google_meet", domain.SourceMeet},
 		{"teams", domain.SourceTeams},
-		{"webex", domain.SourceOther},
-		{"", domain.SourceOther},
+		{"other", domain.SourceOther},
+		{"Zoom", domain.SourceZoom},
+		{" google-meet ", domain.SourceMeet},
+		{"meet", domain.SourceMeet},
+		{"microsoft_teams", domain.SourceTeams},
+		{"MSTeams", domain.SourceTeams},
+		{"webex", domain.SourceUnknown},
+		{"", domain.SourceUnknown},
 	}
 
 	for _, tt := range tests {
@@ -107,6 +117,59 @@ func TestMapTranscriptToDomain(t *testing.T) {
 	}
 }
 
+func TestMapTranscriptToDomain_MissingTimestampInMiddle(t *testing.T) {
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	dto := TranscriptResponse{
+		MeetingID: "m-1",
+		Utterances: []UtteranceDTO{
+			{Speaker: "Alice", Text: "Hello", Timestamp: start},
+			{Speaker: "Bob", Text: "Hi"},
+			{Speaker: "Alice", Text: "Shall we start?"},
+			{Speaker: "Bob", Text: "Yes", Timestamp: start.Add(30 * time.Second)},
+		},
+	}
+
+	utterances := mapTranscriptToDomain("m-1", dto).Utterances()
+
+	want := []time.Time{start, start.Add(10 * time.Second), start.Add(20 * time.Second), start.Add(30 * time.Second)}
+	for i, u := range utterances {
+		if !u.Timestamp().Equal(want[i]) {
+			t.Errorf("utterance %d: got %v, want %v", i, u.Timestamp(), want[i])
+		}
+		if estimated := i == 1 || i == 2; u.TimestampEstimated() != estimated {
+			t.Errorf("utterance %d: got estimated %v, want %v", i, u.TimestampEstimated(), estimated)
+		}
+	}
+}
+
+func TestMapTranscriptToDomain_MissingTimestampsAtEnds(t *testing.T) {
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	dto := TranscriptResponse{
+		Utterances: []UtteranceDTO{
+			{Speaker: "Alice", Text: "Hello"},
+			{Speaker: "Bob", Text: "Hi", Timestamp: start},
+			{Speaker: "Alice", Text: "Bye"},
+		},
+	}
+
+	for i, u := range mapTranscriptToDomain("m-1", dto).Utterances() {
+		if !u.Timestamp().Equal(start) {
+			t.Errorf("utterance %d: got %v, want %v", i, u.Timestamp(), start)
+		}
+	}
+}
+
+func TestMapTranscriptToDomain_NoTimestamps(t *testing.T) {
+	dto := TranscriptResponse{
+		Utterances: []UtteranceDTO{{Speaker: "Alice", Text: "Hello"}},
+	}
+
+	u := mapTranscriptToDomain("m-1", dto).Utterances()[0]
+	if !u.Timestamp().IsZero() || u.TimestampEstimated() {
+		t.Errorf("got %v (estimated %v), want zero and not estimated", u.Timestamp(), u.TimestampEstimated())
+	}
+}
+
 func TestMapActionItemToDomain_Completed(t *testing.T) {
 	dto := ActionItemDTO{
 		ID:    "ai-1",
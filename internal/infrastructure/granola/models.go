@@ -22,6 +22,7 @@ type DocumentDTO struct {
 	Summary      *SummaryDTO      `json:"summary,omitempty"`
 	ActionItems  []ActionItemDTO  `json:"action_items,omitempty"`
 	Metadata     *MetadataDTO     `json:"metadata,omitempty"`
+	WorkspaceID  string           `json:"workspace_id,omitempty"`
 }
 
 type ParticipantDTO struct {
@@ -33,6 +34,8 @@ type ParticipantDTO struct {
 type TranscriptResponse struct {
 	MeetingID  string         `json:"meeting_id"`
 	Utterances []UtteranceDTO `json:"utterances"`
+	// NextCursor, when set, fetches the following page of utterances.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type UtteranceDTO struct {
@@ -70,3 +73,17 @@ type WorkspaceDTO struct {
 type WorkspaceListResponse struct {
 	Workspaces []WorkspaceDTO `json:"workspaces"`
 }
+
+// UserDTO is the account behind the current access token.
+type UserDTO struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// TokenResponse is the OAuth token endpoint response.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
@@ -3,6 +3,8 @@ package granola
 import (
 	"context"
 	"errors"
+	"fmt"
+	"iter"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -12,13 +14,21 @@ import (
 // This is the adapter side of the Ports & Adapters (Hexagonal) architecture —
 // it translates between infrastructure (HTTP API) and domain concepts.
 type Repository struct {
-	client *Client
+	client   *Client
+	aliases  domain.SpeakerAliasRepository
+	pageSize int
 }
 
 func NewRepository(client *Client) *Repository {
 	return &Repository{client: client}
 }
 
+// SetSpeakerAliases makes GetTranscript rewrite speaker labels to their
+// canonical names. A failed alias lookup leaves the labels unchanged.
+func (r *Repository) SetSpeakerAliases(aliases domain.SpeakerAliasRepository) {
+	r.aliases = aliases
+}
+
 func (r *Repository) FindByID(ctx context.Context, id domain.MeetingID) (*domain.Meeting, error) {
 	dto, err := r.client.GetDocument(ctx, string(id))
 	if err != nil {
@@ -28,31 +38,101 @@ func (r *Repository) FindByID(ctx context.Context, id domain.MeetingID) (*domain
 	return mapDocumentToDomain(*dto)
 }
 
-func (r *Repository) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
-	resp, err := r.client.GetDocuments(ctx, filter.Since, filter.Limit, filter.Offset)
-	if err != nil {
-		return nil, r.mapError(err)
-	}
+// DefaultListPageSize is how many documents List requests per page.
+const DefaultListPageSize = 100
 
-	meetings := make([]*domain.Meeting, 0, len(resp.Documents))
-	for _, dto := range resp.Documents {
-		mtg, err := mapDocumentToDomain(dto)
+// SetListPageSize sets how many documents each GetDocuments call asks
+// for. Zero or negative restores DefaultListPageSize.
+func (r *Repository) SetListPageSize(n int) {
+	r.pageSize = n
+}
+
+// List fetches documents page by page through ListAll. If the context
+// ends between or during pages, the meetings fetched so far are returned
+// with an error wrapping domain.ErrPartialResults.
+func (r *Repository) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	meetings := make([]*domain.Meeting, 0)
+	for mtg, err := range r.ListAll(ctx, filter) {
 		if err != nil {
-			continue
+			if errors.Is(err, domain.ErrPartialResults) {
+				return meetings, err
+			}
+			return nil, err
 		}
 		meetings = append(meetings, mtg)
 	}
-
 	return meetings, nil
 }
 
+// ListAll iterates over every document from filter.Offset onwards,
+// requesting the next page only once the previous one is consumed. It
+// stops when a page comes back short, after filter.Limit meetings when
+// the limit is positive, or when the loop breaks. A failure ends the
+// sequence with one non-nil error, wrapping domain.ErrPartialResults if
+// the context ended after the first page.
+func (r *Repository) ListAll(ctx context.Context, filter domain.ListFilter) iter.Seq2[*domain.Meeting, error] {
+	return func(yield func(*domain.Meeting, error) bool) {
+		pageSize := r.pageSize
+		if pageSize <= 0 {
+			pageSize = DefaultListPageSize
+		}
+		offset, fetched := filter.Offset, 0
+		for {
+			size := pageSize
+			if filter.Limit > 0 && filter.Limit-fetched < size {
+				size = filter.Limit - fetched
+			}
+
+			resp, err := r.client.GetDocuments(ctx, filter.Since, size, offset)
+			if err != nil {
+				if fetched > 0 && ctx.Err() != nil {
+					yield(nil, fmt.Errorf("%w: %w", domain.ErrPartialResults, ctx.Err()))
+					return
+				}
+				yield(nil, r.mapError(err))
+				return
+			}
+
+			for _, dto := range resp.Documents {
+				mtg, err := mapDocumentToDomain(dto)
+				if err != nil {
+					continue
+				}
+				if !yield(mtg, nil) {
+					return
+				}
+			}
+
+			n := len(resp.Documents)
+			fetched += n
+			offset += n
+			// A short page is the last one; a long one means the API ignored
+			// the page size and already sent everything.
+			if n != size || (filter.Limit > 0 && fetched >= filter.Limit) {
+				return
+			}
+			if ctx.Err() != nil {
+				yield(nil, fmt.Errorf("%w: %w", domain.ErrPartialResults, ctx.Err()))
+				return
+			}
+		}
+	}
+}
+
 func (r *Repository) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
 	resp, err := r.client.GetTranscript(ctx, string(id))
 	if err != nil {
 		return nil, r.mapError(err)
 	}
 
-	return mapTranscriptToDomain(id, *resp), nil
+	t := mapTranscriptToDomain(id, *resp)
+	if r.aliases != nil {
+		if names, err := r.aliases.ListSpeakerAliases(ctx); err == nil {
+			aliased := domain.NewSpeakerAliases(names).Apply(*t)
+			t = &aliased
+		}
+	}
+	return t, nil
 }
 
 func (r *Repository) SearchTranscripts(ctx context.Context, query string, filter domain.ListFilter) ([]*domain.Meeting, error) {
@@ -80,22 +160,24 @@ func (r *Repository) GetActionItems(ctx context.Context, id domain.MeetingID) ([
 	return items, nil
 }
 
-func (r *Repository) Sync(ctx context.Context, since *time.Time) ([]domain.DomainEvent, error) {
+func (r *Repository) Sync(ctx context.Context, since *time.Time) (*domain.SyncResult, error) {
 	resp, err := r.client.GetDocuments(ctx, since, 0, 0)
 	if err != nil {
 		return nil, r.mapError(err)
 	}
 
-	events := make([]domain.DomainEvent, 0, len(resp.Documents))
+	result := &domain.SyncResult{Events: make([]domain.DomainEvent, 0, len(resp.Documents))}
 	for _, dto := range resp.Documents {
-		events = append(events, domain.NewMeetingCreatedEvent(
-			domain.MeetingID(dto.ID),
-			dto.Title,
-			dto.CreatedAt,
-		))
+		// Map each document so one malformed entry is skipped, not fatal.
+		mtg, err := mapDocumentToDomain(dto)
+		if err != nil {
+			result.Errors = append(result.Errors, domain.SyncError{MeetingID: domain.MeetingID(dto.ID), Err: err})
+			continue
+		}
+		result.Events = append(result.Events, domain.NewMeetingCreatedEvent(mtg.ID(), mtg.Title(), mtg.Datetime()))
 	}
 
-	return events, nil
+	return result, nil
 }
 
 // mapError translates infrastructure errors to domain errors.
@@ -107,5 +189,12 @@ func (r *Repository) mapError(err error) error {
 	if errors.Is(err, ErrUnauthorized) {
 		return domain.ErrAccessDenied
 	}
+	if errors.Is(err, ErrRateLimited) {
+		return domain.ErrRateLimited
+	}
+	if errors.Is(err, ErrServerError) {
+		// Keep the status and body for diagnostics.
+		return fmt.Errorf("%w: %w", domain.ErrSourceUnavailable, err)
+	}
 	return err
 }
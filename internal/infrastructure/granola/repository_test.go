@@ -3,8 +3,12 @@ package granola_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
@@ -84,6 +88,129 @@ func TestRepository_List(t *testing.T) {
 	}
 }
 
+// pagedDocumentsServer serves total documents honoring limit and offset,
+// stalling every page after the first for delay.
+func pagedDocumentsServer(t *testing.T, total int, delay time.Duration) *httptest.Server {
+	t.Helper()
+	now := time.Now().UTC()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if offset > 0 && delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		end := min(offset+limit, total)
+		docs := []granola.DocumentDTO{}
+		for i := offset; i < end; i++ {
+			docs = append(docs, granola.DocumentDTO{ID: fmt.Sprintf("m-%d", i), Title: "Meeting", CreatedAt: now, Source: "zoom"})
+		}
+		_ = json.NewEncoder(w).Encode(granola.DocumentListResponse{Documents: docs})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRepository_List_Paginates(t *testing.T) {
+	server := pagedDocumentsServer(t, 150, 0)
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+
+	meetings, err := repo.List(context.Background(), domain.ListFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(meetings) != 150 {
+		t.Errorf("got %d meetings, want 150", len(meetings))
+	}
+
+	meetings, err = repo.List(context.Background(), domain.ListFilter{Limit: 120})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(meetings) != 120 {
+		t.Errorf("got %d meetings, want 120", len(meetings))
+	}
+}
+
+func TestRepository_ListAll_ConcatenatesPages(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if limit := r.URL.Query().Get("limit"); limit != "2" {
+			t.Errorf("got limit %s, want the page size 2", limit)
+		}
+		// Three pages: two full, one short.
+		docs := []granola.DocumentDTO{}
+		for i := offset; i < min(offset+2, 5); i++ {
+			docs = append(docs, granola.DocumentDTO{ID: fmt.Sprintf("m-%d", i), Title: "Meeting", CreatedAt: time.Now().UTC(), Source: "zoom"})
+		}
+		_ = json.NewEncoder(w).Encode(granola.DocumentListResponse{Documents: docs})
+	}))
+	defer server.Close()
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+	repo.SetListPageSize(2)
+
+	var ids []domain.MeetingID
+	for mtg, err := range repo.ListAll(context.Background(), domain.ListFilter{}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, mtg.ID())
+	}
+	if want := []domain.MeetingID{"m-0", "m-1", "m-2", "m-3", "m-4"}; !slices.Equal(ids, want) {
+		t.Errorf("got %v, want %v", ids, want)
+	}
+	if requests != 3 {
+		t.Errorf("got %d requests, want 3 pages", requests)
+	}
+
+	// Breaking out of the loop fetches no further pages.
+	requests = 0
+	for range repo.ListAll(context.Background(), domain.ListFilter{}) {
+		break
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests after an early break, want 1", requests)
+	}
+}
+
+func TestRepository_List_PartialOnDeadline(t *testing.T) {
+	server := pagedDocumentsServer(t, 250, time.Second)
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	meetings, err := repo.List(ctx, domain.ListFilter{})
+	if !errors.Is(err, domain.ErrPartialResults) {
+		t.Fatalf("got %v, want %v", err, domain.ErrPartialResults)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want it to wrap the deadline", err)
+	}
+	if len(meetings) != 100 {
+		t.Errorf("got %d meetings, want the first page of 100", len(meetings))
+	}
+}
+
+func TestRepository_List_DeadlineBeforeFirstPage(t *testing.T) {
+	server := pagedDocumentsServer(t, 10, 0)
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	meetings, err := repo.List(ctx, domain.ListFilter{})
+	if err == nil || errors.Is(err, domain.ErrPartialResults) {
+		t.Fatalf("got %v, want a plain error with nothing fetched", err)
+	}
+	if meetings != nil {
+		t.Errorf("got %d meetings, want nil", len(meetings))
+	}
+}
+
 func TestRepository_GetTranscript(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -108,6 +235,41 @@ func TestRepository_GetTranscript(t *testing.T) {
 	}
 }
 
+type staticAliases map[string]string
+
+func (a staticAliases) SaveSpeakerAlias(context.Context, string, string) error { return nil }
+func (a staticAliases) DeleteSpeakerAlias(context.Context, string) error       { return nil }
+func (a staticAliases) ListSpeakerAliases(context.Context) (map[string]string, error) {
+	return a, nil
+}
+
+func TestRepository_GetTranscript_SpeakerAliases(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(granola.TranscriptResponse{
+			MeetingID: "m-1",
+			Utterances: []granola.UtteranceDTO{
+				{Speaker: "speaker 1", Text: "Hello", Timestamp: now, Confidence: 0.95},
+				{Speaker: "Bob", Text: "Hi", Timestamp: now, Confidence: 0.95},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := granola.NewClient(server.URL, server.Client(), "token")
+	repo := granola.NewRepository(client)
+	repo.SetSpeakerAliases(staticAliases{"Speaker 1": "Alice"})
+
+	transcript, err := repo.GetTranscript(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	utterances := transcript.Utterances()
+	if utterances[0].Speaker() != "Alice" || utterances[1].Speaker() != "Bob" {
+		t.Errorf("got speakers %q, %q; want Alice, Bob", utterances[0].Speaker(), utterances[1].Speaker())
+	}
+}
+
 func TestRepository_Sync(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -123,15 +285,48 @@ func TestRepository_Sync(t *testing.T) {
 	repo := granola.NewRepository(client)
 
 	since := now.Add(-1 * time.Hour)
-	events, err := repo.Sync(context.Background(), &since)
+	result, err := repo.Sync(context.Background(), &since)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(events) != 1 {
-		t.Errorf("got %d events, want 1", len(events))
+	if len(result.Events) != 1 {
+		t.Errorf("got %d events, want 1", len(result.Events))
 	}
-	if events[0].EventName() != "meeting.created" {
-		t.Errorf("got event %q", events[0].EventName())
+	if result.Events[0].EventName() != "meeting.created" {
+		t.Errorf("got event %q", result.Events[0].EventName())
+	}
+}
+
+func TestRepository_Sync_SkipsMalformedDocuments(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(granola.DocumentListResponse{
+			Documents: []granola.DocumentDTO{
+				{ID: "m-good", Title: "Good Meeting", CreatedAt: now, Source: "zoom"},
+				{ID: "m-no-title", CreatedAt: now},
+				{ID: "m-no-date", Title: "Undated"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+
+	result, err := repo.Sync(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("malformed documents should not fail the sync: %v", err)
+	}
+	if len(result.Events) != 1 {
+		t.Errorf("got %d events, want 1", len(result.Events))
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("got %d sync errors, want 2", len(result.Errors))
+	}
+	if result.Errors[0].MeetingID != "m-no-title" || !errors.Is(result.Errors[0], domain.ErrInvalidTitle) {
+		t.Errorf("got %v, want invalid title for m-no-title", result.Errors[0])
+	}
+	if result.Errors[1].MeetingID != "m-no-date" || !errors.Is(result.Errors[1], domain.ErrInvalidDatetime) {
+		t.Errorf("got %v, want invalid datetime for m-no-date", result.Errors[1])
 	}
 }
 
@@ -149,3 +344,31 @@ func TestRepository_FindByID_Unauthorized(t *testing.T) {
 		t.Errorf("got error %v, want %v", err, domain.ErrAccessDenied)
 	}
 }
+
+func TestRepository_FindByID_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+
+	_, err := repo.FindByID(context.Background(), "m-1")
+	if err != domain.ErrRateLimited {
+		t.Errorf("got error %v, want %v", err, domain.ErrRateLimited)
+	}
+}
+
+func TestRepository_FindByID_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	repo := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+
+	_, err := repo.FindByID(context.Background(), "m-1")
+	if !errors.Is(err, domain.ErrSourceUnavailable) || !errors.Is(err, granola.ErrServerError) {
+		t.Errorf("got error %v, want it to wrap %v", err, domain.ErrSourceUnavailable)
+	}
+}
@@ -0,0 +1,32 @@
+package granola
+
+import (
+	"context"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/auth"
+)
+
+// TokenRefresher adapts the client's OAuth token endpoint to auth tokens.
+type TokenRefresher struct {
+	client *Client
+}
+
+// NewTokenRefresher creates a token refresher backed by the Granola client.
+func NewTokenRefresher(client *Client) *TokenRefresher {
+	return &TokenRefresher{client: client}
+}
+
+// RefreshAccessToken exchanges refreshToken for a new token. A response
+// without expires_in gives a token with an unknown (zero) expiry.
+func (r *TokenRefresher) RefreshAccessToken(ctx context.Context, refreshToken string) (auth.Token, error) {
+	resp, err := r.client.RefreshAccessToken(ctx, refreshToken)
+	if err != nil {
+		return auth.Token{}, err
+	}
+	var expiresAt time.Time
+	if resp.ExpiresIn > 0 {
+		expiresAt = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second).UTC()
+	}
+	return auth.NewToken(resp.AccessToken, resp.RefreshToken, expiresAt), nil
+}
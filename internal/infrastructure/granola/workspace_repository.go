@@ -2,22 +2,73 @@ package granola
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/felixgeelhaar/acai/internal/domain/workspace"
 )
 
+// DefaultWorkspaceCacheTTL is how long a fetched workspace list is reused.
+const DefaultWorkspaceCacheTTL = 5 * time.Minute
+
 // WorkspaceRepository implements workspace.Repository using the Granola API client.
+// Workspaces rarely change, so the list is kept in memory for a short TTL.
 type WorkspaceRepository struct {
 	client *Client
+
+	mu        sync.Mutex
+	ttl       time.Duration
+	cached    []*workspace.Workspace
+	fetchedAt time.Time
 }
 
 // NewWorkspaceRepository creates a new workspace repository.
 func NewWorkspaceRepository(client *Client) *WorkspaceRepository {
-	return &WorkspaceRepository{client: client}
+	return &WorkspaceRepository{client: client, ttl: DefaultWorkspaceCacheTTL}
+}
+
+// SetCacheTTL changes how long the workspace list is reused; a
+// non-positive ttl disables caching.
+func (r *WorkspaceRepository) SetCacheTTL(ttl time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ttl = ttl
+	r.cached = nil
 }
 
-// List returns all workspaces from the Granola API.
+// Invalidate drops the cached list so the next call refetches it.
+func (r *WorkspaceRepository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cached = nil
+}
+
+// List returns all workspaces, from the API unless a list fetched within
+// the cache TTL is at hand.
 func (r *WorkspaceRepository) List(ctx context.Context) ([]*workspace.Workspace, error) {
+	r.mu.Lock()
+	if r.cached != nil && time.Since(r.fetchedAt) < r.ttl {
+		cached := append([]*workspace.Workspace(nil), r.cached...)
+		r.mu.Unlock()
+		return cached, nil
+	}
+	r.mu.Unlock()
+
+	workspaces, err := r.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	if r.ttl > 0 {
+		r.cached = append([]*workspace.Workspace(nil), workspaces...)
+		r.fetchedAt = time.Now()
+	}
+	r.mu.Unlock()
+	return workspaces, nil
+}
+
+func (r *WorkspaceRepository) fetch(ctx context.Context) ([]*workspace.Workspace, error) {
 	resp, err := r.client.GetWorkspaces(ctx)
 	if err != nil {
 		return nil, err
@@ -35,7 +86,8 @@ func (r *WorkspaceRepository) List(ctx context.Context) ([]*workspace.Workspace,
 }
 
 // FindByID returns a specific workspace by ID.
-// Since the Granola API has no get-by-id endpoint, we list all and filter.
+// Since the Granola API has no get-by-id endpoint, we list all (usually
+// from the cache) and filter.
 func (r *WorkspaceRepository) FindByID(ctx context.Context, id workspace.WorkspaceID) (*workspace.Workspace, error) {
 	workspaces, err := r.List(ctx)
 	if err != nil {
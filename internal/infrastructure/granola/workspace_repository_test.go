@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/felixgeelhaar/acai/internal/domain/workspace"
@@ -129,3 +130,50 @@ func TestWorkspaceRepository_FindByID_NotFound(t *testing.T) {
 		t.Errorf("expected ErrWorkspaceNotFound, got %v", err)
 	}
 }
+
+func TestWorkspaceRepository_CachesList(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		resp := map[string]any{
+			"workspaces": []map[string]any{
+				{"id": "ws-1", "name": "Engineering", "slug": "engineering"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer ts.Close()
+
+	client := granola.NewClient(ts.URL, ts.Client(), "test-token")
+	repo := granola.NewWorkspaceRepository(client)
+	ctx := context.Background()
+
+	if _, err := repo.List(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := repo.List(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := repo.FindByID(ctx, "ws-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("got %d API calls within the TTL, want 1", got)
+	}
+
+	repo.Invalidate()
+	if _, err := repo.List(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("got %d API calls after Invalidate, want 2", got)
+	}
+
+	repo.SetCacheTTL(0)
+	_, _ = repo.List(ctx)
+	_, _ = repo.List(ctx)
+	if got := hits.Load(); got != 4 {
+		t.Errorf("got %d API calls with caching disabled, want 4", got)
+	}
+}
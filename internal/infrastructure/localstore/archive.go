@@ -0,0 +1,362 @@
+package localstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ArchiveVersion is the format version Export writes and Import accepts.
+const ArchiveVersion = 1
+
+var (
+	ErrUnsupportedArchiveVersion = errors.New("unsupported archive version")
+	ErrInvalidConflictPolicy     = errors.New("conflict policy must be skip or overwrite")
+)
+
+// ConflictPolicy decides what Import does with a record whose key is
+// already in the database.
+type ConflictPolicy string
+
+const (
+	// ConflictSkip keeps the existing row.
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite replaces the existing row with the archived one.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+)
+
+// Archive is a portable snapshot of the local data that cannot be
+// re-fetched from Granola: agent notes, action item overrides, the outbox
+// of write events, meeting tags, and speaker aliases.
+//
+// The sync high-water mark and the audit log are left out. The mark
+// describes this machine's cache, so restoring it onto an empty cache
+// would make the next sync skip older meetings; the audit log records
+// what happened on this machine.
+type Archive struct {
+	Version             int                        `json:"version"`
+	ExportedAt          time.Time                  `json:"exported_at"`
+	Notes               []NoteRecord               `json:"notes"`
+	ActionItemOverrides []ActionItemOverrideRecord `json:"action_item_overrides"`
+	OutboxEntries       []OutboxRecord             `json:"outbox_entries"`
+	MeetingTags         []MeetingTagRecord         `json:"meeting_tags"`
+	SpeakerAliases      []SpeakerAliasRecord       `json:"speaker_aliases"`
+}
+
+// NoteRecord is an agent note row.
+type NoteRecord struct {
+	ID             string    `json:"id"`
+	MeetingID      string    `json:"meeting_id"`
+	Author         string    `json:"author"`
+	Content        string    `json:"content"`
+	Tags           []string  `json:"tags,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	IdempotencyKey string    `json:"idempotency_key,omitempty"`
+}
+
+// ActionItemOverrideRecord is an action item override row. Nil Text or
+// Completed means the override leaves that field to Granola.
+type ActionItemOverrideRecord struct {
+	ActionItemID string     `json:"action_item_id"`
+	MeetingID    string     `json:"meeting_id"`
+	Text         *string    `json:"text,omitempty"`
+	Completed    *bool      `json:"completed,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// OutboxRecord is an outbox entry row, kept with its delivery state.
+type OutboxRecord struct {
+	ID        string     `json:"id"`
+	EventType string     `json:"event_type"`
+	Payload   []byte     `json:"payload"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	SyncedAt  *time.Time `json:"synced_at,omitempty"`
+	Attempts  int        `json:"attempts"`
+}
+
+// MeetingTagRecord is a meeting tag row.
+type MeetingTagRecord struct {
+	MeetingID string    `json:"meeting_id"`
+	Tag       string    `json:"tag"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SpeakerAliasRecord is a speaker alias row.
+type SpeakerAliasRecord struct {
+	Alias     string    `json:"alias"`
+	Canonical string    `json:"canonical"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ImportCounts reports how many records of one kind were written and how
+// many were skipped as conflicts.
+type ImportCounts struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
+
+// ImportResult reports the outcome of an Import per kind of record.
+type ImportResult struct {
+	Notes               ImportCounts `json:"notes"`
+	ActionItemOverrides ImportCounts `json:"action_item_overrides"`
+	OutboxEntries       ImportCounts `json:"outbox_entries"`
+	MeetingTags         ImportCounts `json:"meeting_tags"`
+	SpeakerAliases      ImportCounts `json:"speaker_aliases"`
+}
+
+// Archiver exports the local database to an Archive and imports one back,
+// e.g. to move notes and overrides to another machine.
+type Archiver struct {
+	db        *sql.DB
+	notes     *NoteRepository
+	overrides *WriteRepository
+}
+
+// NewArchiver creates an archiver over an initialised local database.
+func NewArchiver(db *sql.DB) *Archiver {
+	return &Archiver{db: db, notes: NewNoteRepository(db), overrides: NewWriteRepository(db)}
+}
+
+// Export reads every note, override, outbox entry, meeting tag, and
+// speaker alias into an Archive.
+func (a *Archiver) Export(ctx context.Context) (*Archive, error) {
+	notes, err := a.notes.ExportNotes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export notes: %w", err)
+	}
+	overrides, err := a.overrides.ExportOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export action item overrides: %w", err)
+	}
+	entries, err := a.exportOutbox()
+	if err != nil {
+		return nil, fmt.Errorf("export outbox: %w", err)
+	}
+	tags, err := a.exportMeetingTags()
+	if err != nil {
+		return nil, fmt.Errorf("export meeting tags: %w", err)
+	}
+	aliases, err := a.exportSpeakerAliases()
+	if err != nil {
+		return nil, fmt.Errorf("export speaker aliases: %w", err)
+	}
+	return &Archive{
+		Version:             ArchiveVersion,
+		ExportedAt:          time.Now().UTC(),
+		Notes:               notes,
+		ActionItemOverrides: overrides,
+		OutboxEntries:       entries,
+		MeetingTags:         tags,
+		SpeakerAliases:      aliases,
+	}, nil
+}
+
+// Import writes an archive's records, resolving key conflicts per policy.
+// Each kind of record is imported in its own transaction, so a failure
+// leaves the kinds before it imported.
+func (a *Archiver) Import(ctx context.Context, archive *Archive, policy ConflictPolicy) (*ImportResult, error) {
+	if archive.Version != ArchiveVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, archive.Version)
+	}
+	if policy != ConflictSkip && policy != ConflictOverwrite {
+		return nil, ErrInvalidConflictPolicy
+	}
+
+	var (
+		result ImportResult
+		err    error
+	)
+	if result.Notes, err = a.notes.ImportNotes(ctx, archive.Notes, policy); err != nil {
+		return nil, fmt.Errorf("import notes: %w", err)
+	}
+	if result.ActionItemOverrides, err = a.overrides.ImportOverrides(ctx, archive.ActionItemOverrides, policy); err != nil {
+		return nil, fmt.Errorf("import action item overrides: %w", err)
+	}
+	if result.OutboxEntries, err = a.importOutbox(archive.OutboxEntries, policy); err != nil {
+		return nil, fmt.Errorf("import outbox: %w", err)
+	}
+	if result.MeetingTags, err = a.importMeetingTags(archive.MeetingTags, policy); err != nil {
+		return nil, fmt.Errorf("import meeting tags: %w", err)
+	}
+	if result.SpeakerAliases, err = a.importSpeakerAliases(archive.SpeakerAliases, policy); err != nil {
+		return nil, fmt.Errorf("import speaker aliases: %w", err)
+	}
+	return &result, nil
+}
+
+func (a *Archiver) exportOutbox() ([]OutboxRecord, error) {
+	rows, err := a.db.Query(
+		"SELECT id, event_type, payload, status, created_at, synced_at, attempts FROM outbox_entries ORDER BY created_at ASC, id ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	records := []OutboxRecord{}
+	for rows.Next() {
+		var (
+			rec      OutboxRecord
+			syncedAt sql.NullTime
+		)
+		if err := rows.Scan(&rec.ID, &rec.EventType, &rec.Payload, &rec.Status, &rec.CreatedAt, &syncedAt, &rec.Attempts); err != nil {
+			return nil, err
+		}
+		if syncedAt.Valid {
+			rec.SyncedAt = &syncedAt.Time
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+func (a *Archiver) importOutbox(records []OutboxRecord, policy ConflictPolicy) (ImportCounts, error) {
+	var counts ImportCounts
+	tx, err := a.db.Begin()
+	if err != nil {
+		return counts, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	verb := "INSERT OR IGNORE"
+	if policy == ConflictOverwrite {
+		verb = "INSERT OR REPLACE"
+	}
+	for _, rec := range records {
+		payload := rec.Payload
+		if payload == nil {
+			payload = []byte("{}")
+		}
+		status := rec.Status
+		if status == "" {
+			status = "pending"
+		}
+		var syncedAt sql.NullTime
+		if rec.SyncedAt != nil {
+			syncedAt = sql.NullTime{Time: rec.SyncedAt.UTC(), Valid: true}
+		}
+		res, err := tx.Exec(
+			verb+" INTO outbox_entries (id, event_type, payload, status, created_at, synced_at, attempts) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			rec.ID, rec.EventType, payload, status, rec.CreatedAt.UTC(), syncedAt, rec.Attempts,
+		)
+		if err != nil {
+			return counts, fmt.Errorf("outbox entry %s: %w", rec.ID, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return counts, err
+		}
+		if affected == 0 {
+			counts.Skipped++
+		} else {
+			counts.Imported++
+		}
+	}
+	return counts, tx.Commit()
+}
+
+func (a *Archiver) exportMeetingTags() ([]MeetingTagRecord, error) {
+	rows, err := a.db.Query("SELECT meeting_id, tag, created_at FROM meeting_tags ORDER BY meeting_id, tag")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	records := []MeetingTagRecord{}
+	for rows.Next() {
+		var rec MeetingTagRecord
+		if err := rows.Scan(&rec.MeetingID, &rec.Tag, &rec.CreatedAt); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+func (a *Archiver) importMeetingTags(records []MeetingTagRecord, policy ConflictPolicy) (ImportCounts, error) {
+	var counts ImportCounts
+	tx, err := a.db.Begin()
+	if err != nil {
+		return counts, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	verb := "INSERT OR IGNORE"
+	if policy == ConflictOverwrite {
+		verb = "INSERT OR REPLACE"
+	}
+	for _, rec := range records {
+		res, err := tx.Exec(
+			verb+" INTO meeting_tags (meeting_id, tag, created_at) VALUES (?, ?, ?)",
+			rec.MeetingID, rec.Tag, rec.CreatedAt.UTC(),
+		)
+		if err != nil {
+			return counts, fmt.Errorf("meeting tag %s on %s: %w", rec.Tag, rec.MeetingID, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return counts, err
+		}
+		if affected == 0 {
+			counts.Skipped++
+		} else {
+			counts.Imported++
+		}
+	}
+	return counts, tx.Commit()
+}
+
+func (a *Archiver) exportSpeakerAliases() ([]SpeakerAliasRecord, error) {
+	rows, err := a.db.Query("SELECT alias, canonical, updated_at FROM speaker_aliases ORDER BY alias")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	records := []SpeakerAliasRecord{}
+	for rows.Next() {
+		var rec SpeakerAliasRecord
+		if err := rows.Scan(&rec.Alias, &rec.Canonical, &rec.UpdatedAt); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+func (a *Archiver) importSpeakerAliases(records []SpeakerAliasRecord, policy ConflictPolicy) (ImportCounts, error) {
+	var counts ImportCounts
+	tx, err := a.db.Begin()
+	if err != nil {
+		return counts, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	verb := "INSERT OR IGNORE"
+	if policy == ConflictOverwrite {
+		verb = "INSERT OR REPLACE"
+	}
+	for _, rec := range records {
+		res, err := tx.Exec(
+			verb+" INTO speaker_aliases (alias, canonical, updated_at) VALUES (?, ?, ?)",
+			rec.Alias, rec.Canonical, rec.UpdatedAt.UTC(),
+		)
+		if err != nil {
+			return counts, fmt.Errorf("speaker alias %s: %w", rec.Alias, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return counts, err
+		}
+		if affected == 0 {
+			counts.Skipped++
+		} else {
+			counts.Imported++
+		}
+	}
+	return counts, tx.Commit()
+}
@@ -0,0 +1,189 @@
+package localstore_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+func openArchiveTestDB(t *testing.T, name string) *sql.DB {
+	t.Helper()
+	db, err := localstore.Open(filepath.Join(t.TempDir(), name), localstore.Pragmas{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	return db
+}
+
+func seedArchiveDB(t *testing.T, db *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+
+	notes := localstore.NewNoteRepository(db)
+	n1, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "budget approved")
+	n1.SetTags([]string{"finance"})
+	n1.SetIdempotencyKey("key-1")
+	n2, _ := annotation.NewAgentNote("n-2", "m-2", "claude", "follow up with legal")
+	for _, n := range []*annotation.AgentNote{n1, n2} {
+		if err := notes.Save(ctx, n); err != nil {
+			t.Fatalf("save note: %v", err)
+		}
+	}
+
+	writes := localstore.NewWriteRepository(db)
+	item, _ := domain.NewActionItem("ai-1", "m-1", "alice", "Send the deck", nil)
+	item.Complete()
+	if err := writes.SaveActionItemState(ctx, item); err != nil {
+		t.Fatalf("save override: %v", err)
+	}
+
+	if _, err := db.Exec(
+		"INSERT INTO outbox_entries (id, event_type, payload, status, created_at, attempts) VALUES (?, ?, ?, ?, ?, ?)",
+		"note.added-1", "note.added", []byte(`{"note_id":"n-1"}`), "failed", time.Now().UTC(), 3,
+	); err != nil {
+		t.Fatalf("seed outbox: %v", err)
+	}
+
+	if err := localstore.NewMeetingTagStore(db).AddTag(ctx, "m-1", "q3-planning"); err != nil {
+		t.Fatalf("save tag: %v", err)
+	}
+	if err := localstore.NewSpeakerAliasStore(db).SaveSpeakerAlias(ctx, "AJ", "Alice Johnson"); err != nil {
+		t.Fatalf("save speaker alias: %v", err)
+	}
+}
+
+func TestArchiver_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := openArchiveTestDB(t, "src.db")
+	seedArchiveDB(t, src)
+
+	archive, err := localstore.NewArchiver(src).Export(ctx)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	// Round-trip through JSON, as the CLI does.
+	data, err := json.Marshal(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded localstore.Archive
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := openArchiveTestDB(t, "dst.db")
+	result, err := localstore.NewArchiver(dst).Import(ctx, &decoded, localstore.ConflictSkip)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Notes.Imported != 2 || result.ActionItemOverrides.Imported != 1 || result.OutboxEntries.Imported != 1 ||
+		result.MeetingTags.Imported != 1 || result.SpeakerAliases.Imported != 1 {
+		t.Errorf("got result %+v, want 2 notes and 1 override, outbox entry, tag, and alias imported", result)
+	}
+
+	note, err := localstore.NewNoteRepository(dst).FindByID(ctx, "n-1")
+	if err != nil {
+		t.Fatalf("find imported note: %v", err)
+	}
+	if note.Content() != "budget approved" || note.IdempotencyKey() != "key-1" || len(note.Tags()) != 1 || note.Tags()[0] != "finance" {
+		t.Errorf("imported note lost fields: content %q key %q tags %v", note.Content(), note.IdempotencyKey(), note.Tags())
+	}
+	found, err := localstore.NewNoteRepository(dst).Search(ctx, "legal", 10)
+	if err != nil || len(found) != 1 || found[0].ID() != "n-2" {
+		t.Errorf("imported notes should be searchable, got %v (err %v)", found, err)
+	}
+
+	item, err := localstore.NewWriteRepository(dst).GetLocalActionItemState(ctx, "ai-1")
+	if err != nil {
+		t.Fatalf("find imported override: %v", err)
+	}
+	if !item.IsCompleted() || item.Text() != "Send the deck" {
+		t.Errorf("got override %q completed=%v", item.Text(), item.IsCompleted())
+	}
+
+	var (
+		status   string
+		attempts int
+	)
+	if err := dst.QueryRow("SELECT status, attempts FROM outbox_entries WHERE id = ?", "note.added-1").Scan(&status, &attempts); err != nil {
+		t.Fatalf("find imported outbox entry: %v", err)
+	}
+	if status != "failed" || attempts != 3 {
+		t.Errorf("got outbox entry %s with %d attempts, want failed with 3", status, attempts)
+	}
+
+	tags, err := localstore.NewMeetingTagStore(dst).TagsOf(ctx, "m-1")
+	if err != nil || len(tags) != 1 || tags[0] != "q3-planning" {
+		t.Errorf("got tags %v (err %v), want q3-planning", tags, err)
+	}
+	aliases, err := localstore.NewSpeakerAliasStore(dst).ListSpeakerAliases(ctx)
+	if err != nil || aliases["AJ"] != "Alice Johnson" {
+		t.Errorf("got aliases %v (err %v), want AJ -> Alice Johnson", aliases, err)
+	}
+}
+
+func TestArchiver_ImportConflicts(t *testing.T) {
+	ctx := context.Background()
+	src := openArchiveTestDB(t, "src.db")
+	seedArchiveDB(t, src)
+	archive, err := localstore.NewArchiver(src).Export(ctx)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	dst := openArchiveTestDB(t, "dst.db")
+	notes := localstore.NewNoteRepository(dst)
+	local, _ := annotation.NewAgentNote("n-1", "m-1", "alice", "local edit")
+	if err := notes.Save(ctx, local); err != nil {
+		t.Fatal(err)
+	}
+
+	archiver := localstore.NewArchiver(dst)
+	result, err := archiver.Import(ctx, archive, localstore.ConflictSkip)
+	if err != nil {
+		t.Fatalf("import skip: %v", err)
+	}
+	if result.Notes.Imported != 1 || result.Notes.Skipped != 1 {
+		t.Errorf("got notes %+v, want 1 imported and 1 skipped", result.Notes)
+	}
+	if n, _ := notes.FindByID(ctx, "n-1"); n.Content() != "local edit" {
+		t.Errorf("skip should keep the local note, got %q", n.Content())
+	}
+
+	result, err = archiver.Import(ctx, archive, localstore.ConflictOverwrite)
+	if err != nil {
+		t.Fatalf("import overwrite: %v", err)
+	}
+	if result.Notes.Imported != 2 || result.Notes.Skipped != 0 {
+		t.Errorf("got notes %+v, want 2 imported", result.Notes)
+	}
+	if n, _ := notes.FindByID(ctx, "n-1"); n.Content() != "budget approved" {
+		t.Errorf("overwrite should replace the local note, got %q", n.Content())
+	}
+}
+
+func TestArchiver_ImportRejectsBadInput(t *testing.T) {
+	archiver := localstore.NewArchiver(openArchiveTestDB(t, "dst.db"))
+
+	_, err := archiver.Import(context.Background(), &localstore.Archive{Version: 99}, localstore.ConflictSkip)
+	if !errors.Is(err, localstore.ErrUnsupportedArchiveVersion) {
+		t.Errorf("got %v, want ErrUnsupportedArchiveVersion", err)
+	}
+	_, err = archiver.Import(context.Background(), &localstore.Archive{Version: localstore.ArchiveVersion}, "merge")
+	if !errors.Is(err, localstore.ErrInvalidConflictPolicy) {
+		t.Errorf("got %v, want ErrInvalidConflictPolicy", err)
+	}
+}
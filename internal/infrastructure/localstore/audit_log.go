@@ -0,0 +1,75 @@
+package localstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+)
+
+// AuditLog implements audit.Repository using SQLite. Entries are only
+// ever inserted; nothing in the application updates or deletes them.
+type AuditLog struct {
+	db *sql.DB
+}
+
+// NewAuditLog creates a new SQLite-backed audit log.
+func NewAuditLog(db *sql.DB) *AuditLog {
+	return &AuditLog{db: db}
+}
+
+func (l *AuditLog) Record(_ context.Context, entry audit.Entry) error {
+	ts := entry.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	_, err := l.db.Exec(
+		"INSERT INTO audit_log (operation, target_id, actor, details, created_at) VALUES (?, ?, ?, ?, ?)",
+		string(entry.Operation), entry.TargetID, entry.Actor, entry.Details, ts.UTC(),
+	)
+	return err
+}
+
+func (l *AuditLog) List(_ context.Context, filter audit.Filter) ([]audit.Entry, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if filter.Since != nil {
+		where = append(where, "created_at >= ?")
+		args = append(args, filter.Since.UTC())
+	}
+	if filter.Operation != "" {
+		where = append(where, "operation = ?")
+		args = append(args, string(filter.Operation))
+	}
+	query := "SELECT operation, target_id, actor, details, created_at FROM audit_log"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY created_at ASC, id ASC"
+
+	rows, err := l.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	entries := []audit.Entry{}
+	for rows.Next() {
+		var (
+			e  audit.Entry
+			op string
+		)
+		if err := rows.Scan(&op, &e.TargetID, &e.Actor, &e.Details, &e.Timestamp); err != nil {
+			return nil, err
+		}
+		e.Operation = audit.Operation(op)
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+var _ audit.Repository = (*AuditLog)(nil)
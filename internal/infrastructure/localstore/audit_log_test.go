@@ -0,0 +1,77 @@
+package localstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+func setupAuditLog(t *testing.T) *localstore.AuditLog {
+	t.Helper()
+	db := openTestDB(t)
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	return localstore.NewAuditLog(db)
+}
+
+func TestAuditLog_RecordAndList(t *testing.T) {
+	log := setupAuditLog(t)
+	ctx := context.Background()
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	entries := []audit.Entry{
+		{Operation: audit.OpAddNote, TargetID: "note-1", Actor: "agent", Timestamp: base, Details: "meeting m-1"},
+		{Operation: audit.OpCompleteActionItem, TargetID: "ai-1", Actor: "cli", Timestamp: base.Add(time.Hour)},
+		{Operation: audit.OpDeleteNote, TargetID: "note-1", Actor: "mcp", Timestamp: base.Add(2 * time.Hour)},
+	}
+	for _, e := range entries {
+		if err := log.Record(ctx, e); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+
+	all, err := log.List(ctx, audit.Filter{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("got %d entries, want 3", len(all))
+	}
+	if all[0].Operation != audit.OpAddNote || all[0].Actor != "agent" || all[0].Details != "meeting m-1" {
+		t.Errorf("got first entry %+v", all[0])
+	}
+	if !all[0].Timestamp.Equal(base) {
+		t.Errorf("got timestamp %v, want %v", all[0].Timestamp, base)
+	}
+
+	since := base.Add(30 * time.Minute)
+	recent, err := log.List(ctx, audit.Filter{Since: &since})
+	if err != nil {
+		t.Fatalf("list since: %v", err)
+	}
+	if len(recent) != 2 {
+		t.Errorf("got %d entries since %v, want 2", len(recent), since)
+	}
+
+	deletes, err := log.List(ctx, audit.Filter{Operation: audit.OpDeleteNote})
+	if err != nil {
+		t.Fatalf("list by operation: %v", err)
+	}
+	if len(deletes) != 1 || deletes[0].Actor != "mcp" {
+		t.Errorf("got %+v, want the single delete_note entry", deletes)
+	}
+}
+
+func TestAuditLog_ListEmpty(t *testing.T) {
+	entries, err := setupAuditLog(t).List(context.Background(), audit.Filter{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if entries == nil || len(entries) != 0 {
+		t.Errorf("got %v, want empty slice", entries)
+	}
+}
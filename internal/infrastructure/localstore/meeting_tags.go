@@ -0,0 +1,71 @@
+package localstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// MeetingTagStore implements domain.TagRepository using SQLite.
+type MeetingTagStore struct {
+	db *sql.DB
+}
+
+// NewMeetingTagStore creates a new SQLite-backed meeting tag store.
+func NewMeetingTagStore(db *sql.DB) *MeetingTagStore {
+	return &MeetingTagStore{db: db}
+}
+
+func (s *MeetingTagStore) AddTag(_ context.Context, id domain.MeetingID, tag string) error {
+	_, err := s.db.Exec(
+		`INSERT INTO meeting_tags (meeting_id, tag, created_at) VALUES (?, ?, ?)
+		 ON CONFLICT(meeting_id, tag) DO NOTHING`,
+		string(id), tag, time.Now().UTC(),
+	)
+	return err
+}
+
+func (s *MeetingTagStore) RemoveTag(_ context.Context, id domain.MeetingID, tag string) error {
+	_, err := s.db.Exec("DELETE FROM meeting_tags WHERE meeting_id = ? AND tag = ?", string(id), tag)
+	return err
+}
+
+func (s *MeetingTagStore) TagsOf(_ context.Context, id domain.MeetingID) ([]string, error) {
+	rows, err := s.db.Query("SELECT tag FROM meeting_tags WHERE meeting_id = ? ORDER BY tag", string(id))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+func (s *MeetingTagStore) ListTags(_ context.Context) (map[domain.MeetingID][]string, error) {
+	rows, err := s.db.Query("SELECT meeting_id, tag FROM meeting_tags ORDER BY meeting_id, tag")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	tags := make(map[domain.MeetingID][]string)
+	for rows.Next() {
+		var id, tag string
+		if err := rows.Scan(&id, &tag); err != nil {
+			return nil, err
+		}
+		tags[domain.MeetingID(id)] = append(tags[domain.MeetingID(id)], tag)
+	}
+	return tags, rows.Err()
+}
+
+var _ domain.TagRepository = (*MeetingTagStore)(nil)
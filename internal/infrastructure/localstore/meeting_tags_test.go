@@ -0,0 +1,59 @@
+package localstore_test
+
+import (
+	"context"
+	"testing"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+func setupMeetingTagStore(t *testing.T) *localstore.MeetingTagStore {
+	t.Helper()
+	db := openTestDB(t)
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	return localstore.NewMeetingTagStore(db)
+}
+
+func TestMeetingTagStore_AddListRemove(t *testing.T) {
+	store := setupMeetingTagStore(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		id  domain.MeetingID
+		tag string
+	}{{"m-1", "planning"}, {"m-1", "1:1"}, {"m-1", "planning"}, {"m-2", "planning"}} {
+		if err := store.AddTag(ctx, tc.id, tc.tag); err != nil {
+			t.Fatalf("add %s/%s: %v", tc.id, tc.tag, err)
+		}
+	}
+
+	tags, err := store.TagsOf(ctx, "m-1")
+	if err != nil {
+		t.Fatalf("tags of: %v", err)
+	}
+	if len(tags) != 2 || tags[0] != "1:1" || tags[1] != "planning" {
+		t.Errorf("m-1 tags: got %v, want [1:1 planning]", tags)
+	}
+
+	if err := store.RemoveTag(ctx, "m-1", "planning"); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if err := store.RemoveTag(ctx, "m-1", "unknown"); err != nil {
+		t.Fatalf("remove unknown: %v", err)
+	}
+
+	all, err := store.ListTags(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(all) != 2 || len(all["m-1"]) != 1 || all["m-1"][0] != "1:1" || len(all["m-2"]) != 1 {
+		t.Errorf("got %v", all)
+	}
+
+	if tags, _ := store.TagsOf(ctx, "m-404"); len(tags) != 0 {
+		t.Errorf("untagged meeting: got %v, want none", tags)
+	}
+}
@@ -3,6 +3,10 @@ package localstore
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/felixgeelhaar/acai/internal/domain/annotation"
@@ -11,6 +15,9 @@ import (
 // NoteRepository implements annotation.NoteRepository using SQLite.
 type NoteRepository struct {
 	db *sql.DB
+
+	ftsOnce sync.Once
+	fts     bool
 }
 
 // NewNoteRepository creates a new SQLite-backed note repository.
@@ -19,44 +26,258 @@ func NewNoteRepository(db *sql.DB) *NoteRepository {
 }
 
 func (r *NoteRepository) Save(_ context.Context, note *annotation.AgentNote) error {
-	_, err := r.db.Exec(
-		"INSERT OR REPLACE INTO agent_notes (id, meeting_id, author, content, created_at) VALUES (?, ?, ?, ?, ?)",
-		string(note.ID()), note.MeetingID(), note.Author(), note.Content(), note.CreatedAt().UTC(),
+	tags, err := json.Marshal(note.Tags())
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// An upsert on id rather than INSERT OR REPLACE, so a reused idempotency
+	// key fails instead of replacing the note that holds it.
+	_, err = tx.Exec(
+		`INSERT INTO agent_notes (id, meeting_id, author, content, tags, created_at, idempotency_key) VALUES (?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(id) DO UPDATE SET meeting_id = excluded.meeting_id, author = excluded.author, content = excluded.content,
+		 tags = excluded.tags, created_at = excluded.created_at, idempotency_key = excluded.idempotency_key`,
+		string(note.ID()), note.MeetingID(), note.Author(), note.Content(), string(tags), note.CreatedAt().UTC(), nullString(note.IdempotencyKey()),
 	)
+	if err != nil {
+		return err
+	}
+	if r.ftsEnabled() {
+		if err := indexNote(tx, string(note.ID()), note.Content()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ExportNotes returns every note as an archive record, in ListAll order.
+func (r *NoteRepository) ExportNotes(ctx context.Context) ([]NoteRecord, error) {
+	notes, err := r.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]NoteRecord, len(notes))
+	for i, n := range notes {
+		records[i] = NoteRecord{
+			ID:             string(n.ID()),
+			MeetingID:      n.MeetingID(),
+			Author:         n.Author(),
+			Content:        n.Content(),
+			Tags:           n.Tags(),
+			CreatedAt:      n.CreatedAt().UTC(),
+			IdempotencyKey: n.IdempotencyKey(),
+		}
+	}
+	return records, nil
+}
+
+// ImportNotes writes archived notes in one transaction. Under ConflictSkip
+// a note whose ID or idempotency key is already taken is left alone;
+// under ConflictOverwrite a note with the same ID is replaced.
+func (r *NoteRepository) ImportNotes(_ context.Context, records []NoteRecord, policy ConflictPolicy) (ImportCounts, error) {
+	var counts ImportCounts
+	fts := r.ftsEnabled()
+	tx, err := r.db.Begin()
+	if err != nil {
+		return counts, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	insert := "INSERT OR IGNORE INTO agent_notes (id, meeting_id, author, content, tags, created_at, idempotency_key) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	if policy == ConflictOverwrite {
+		insert = `INSERT INTO agent_notes (id, meeting_id, author, content, tags, created_at, idempotency_key) VALUES (?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(id) DO UPDATE SET meeting_id = excluded.meeting_id, author = excluded.author, content = excluded.content,
+		 tags = excluded.tags, created_at = excluded.created_at, idempotency_key = excluded.idempotency_key`
+	}
+	for _, rec := range records {
+		tags := rec.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		rawTags, err := json.Marshal(tags)
+		if err != nil {
+			return counts, err
+		}
+		res, err := tx.Exec(insert,
+			rec.ID, rec.MeetingID, rec.Author, rec.Content, string(rawTags), rec.CreatedAt.UTC(), nullString(rec.IdempotencyKey),
+		)
+		if err != nil {
+			return counts, fmt.Errorf("note %s: %w", rec.ID, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return counts, err
+		}
+		if affected == 0 {
+			counts.Skipped++
+			continue
+		}
+		if fts {
+			if err := indexNote(tx, rec.ID, rec.Content); err != nil {
+				return counts, err
+			}
+		}
+		counts.Imported++
+	}
+	return counts, tx.Commit()
+}
+
+// indexNote replaces the note's row in the FTS5 index.
+func indexNote(tx *sql.Tx, noteID, content string) error {
+	if _, err := tx.Exec("DELETE FROM agent_notes_fts WHERE note_id = ?", noteID); err != nil {
+		return err
+	}
+	_, err := tx.Exec("INSERT INTO agent_notes_fts (note_id, content) VALUES (?, ?)", noteID, content)
 	return err
 }
 
 func (r *NoteRepository) FindByID(_ context.Context, id annotation.NoteID) (*annotation.AgentNote, error) {
-	var (
-		noteID    string
-		meetingID string
-		author    string
-		content   string
-		createdAt time.Time
+	return r.findOne("id = ?", string(id))
+}
+
+func (r *NoteRepository) FindByIdempotencyKey(_ context.Context, key string) (*annotation.AgentNote, error) {
+	return r.findOne("idempotency_key = ?", key)
+}
+
+func (r *NoteRepository) findOne(where string, arg any) (*annotation.AgentNote, error) {
+	rows, err := r.db.Query(
+		"SELECT id, meeting_id, author, content, tags, created_at, idempotency_key FROM agent_notes WHERE "+where+" LIMIT 1",
+		arg,
 	)
-	err := r.db.QueryRow(
-		"SELECT id, meeting_id, author, content, created_at FROM agent_notes WHERE id = ?",
-		string(id),
-	).Scan(&noteID, &meetingID, &author, &content, &createdAt)
-	if err == sql.ErrNoRows {
-		return nil, annotation.ErrNoteNotFound
+	if err != nil {
+		return nil, err
 	}
+	notes, err := scanNotes(rows)
 	if err != nil {
 		return nil, err
 	}
-	return annotation.ReconstructAgentNote(
-		annotation.NoteID(noteID), meetingID, author, content, createdAt,
-	), nil
+	if len(notes) == 0 {
+		return nil, annotation.ErrNoteNotFound
+	}
+	return notes[0], nil
 }
 
 func (r *NoteRepository) ListByMeeting(_ context.Context, meetingID string) ([]*annotation.AgentNote, error) {
 	rows, err := r.db.Query(
-		"SELECT id, meeting_id, author, content, created_at FROM agent_notes WHERE meeting_id = ? ORDER BY created_at ASC",
+		"SELECT id, meeting_id, author, content, tags, created_at, idempotency_key FROM agent_notes WHERE meeting_id = ? ORDER BY created_at ASC",
 		meetingID,
 	)
 	if err != nil {
 		return nil, err
 	}
+	return scanNotes(rows)
+}
+
+func (r *NoteRepository) ListAll(_ context.Context) ([]*annotation.AgentNote, error) {
+	rows, err := r.db.Query(
+		"SELECT id, meeting_id, author, content, tags, created_at, idempotency_key FROM agent_notes ORDER BY meeting_id ASC, created_at ASC, id ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanNotes(rows)
+}
+
+// Search returns notes whose content matches every term in query, using the
+// FTS5 index when available and a LIKE scan otherwise.
+func (r *NoteRepository) Search(_ context.Context, query string, limit int) ([]*annotation.AgentNote, error) {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return []*annotation.AgentNote{}, nil
+	}
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if r.ftsEnabled() {
+		rows, err = r.db.Query(
+			`SELECT n.id, n.meeting_id, n.author, n.content, n.tags, n.created_at, n.idempotency_key
+			 FROM agent_notes_fts f JOIN agent_notes n ON n.id = f.note_id
+			 WHERE agent_notes_fts MATCH ? ORDER BY f.rank LIMIT ?`,
+			ftsQuery(terms), limit,
+		)
+	} else {
+		clauses := make([]string, len(terms))
+		args := make([]any, 0, len(terms)+1)
+		for i, term := range terms {
+			clauses[i] = `content LIKE ? ESCAPE '\'`
+			args = append(args, "%"+escapeLike(term)+"%")
+		}
+		args = append(args, limit)
+		rows, err = r.db.Query(
+			"SELECT id, meeting_id, author, content, tags, created_at, idempotency_key FROM agent_notes WHERE "+
+				strings.Join(clauses, " AND ")+" ORDER BY created_at DESC LIMIT ?",
+			args...,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scanNotes(rows)
+}
+
+func (r *NoteRepository) DeleteByMeeting(_ context.Context, meetingID string) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if r.ftsEnabled() {
+		if _, err := tx.Exec(
+			"DELETE FROM agent_notes_fts WHERE note_id IN (SELECT id FROM agent_notes WHERE meeting_id = ?)",
+			meetingID,
+		); err != nil {
+			return 0, err
+		}
+	}
+	result, err := tx.Exec("DELETE FROM agent_notes WHERE meeting_id = ?", meetingID)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// ftsEnabled reports whether InitSchema was able to create the FTS5 index.
+func (r *NoteRepository) ftsEnabled() bool {
+	r.ftsOnce.Do(func() {
+		var name string
+		err := r.db.QueryRow(
+			"SELECT name FROM sqlite_master WHERE type = 'table' AND name = 'agent_notes_fts'",
+		).Scan(&name)
+		r.fts = err == nil
+	})
+	return r.fts
+}
+
+// ftsQuery quotes each term so user input is matched literally
+// rather than parsed as FTS5 query syntax.
+func ftsQuery(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"`
+	}
+	return strings.Join(quoted, " ")
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func scanNotes(rows *sql.Rows) ([]*annotation.AgentNote, error) {
 	defer func() { _ = rows.Close() }()
 
 	var notes []*annotation.AgentNote
@@ -66,14 +287,18 @@ func (r *NoteRepository) ListByMeeting(_ context.Context, meetingID string) ([]*
 			mid       string
 			author    string
 			content   string
+			tags      string
 			createdAt time.Time
+			key       sql.NullString
 		)
-		if err := rows.Scan(&noteID, &mid, &author, &content, &createdAt); err != nil {
+		if err := rows.Scan(&noteID, &mid, &author, &content, &tags, &createdAt, &key); err != nil {
 			return nil, err
 		}
-		notes = append(notes, annotation.ReconstructAgentNote(
-			annotation.NoteID(noteID), mid, author, content, createdAt,
-		))
+		note := annotation.ReconstructAgentNote(
+			annotation.NoteID(noteID), mid, author, content, decodeTags(tags), createdAt,
+		)
+		note.SetIdempotencyKey(key.String)
+		notes = append(notes, note)
 	}
 	if notes == nil {
 		notes = []*annotation.AgentNote{}
@@ -82,7 +307,13 @@ func (r *NoteRepository) ListByMeeting(_ context.Context, meetingID string) ([]*
 }
 
 func (r *NoteRepository) Delete(_ context.Context, id annotation.NoteID) error {
-	result, err := r.db.Exec("DELETE FROM agent_notes WHERE id = ?", string(id))
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.Exec("DELETE FROM agent_notes WHERE id = ?", string(id))
 	if err != nil {
 		return err
 	}
@@ -93,7 +324,28 @@ func (r *NoteRepository) Delete(_ context.Context, id annotation.NoteID) error {
 	if affected == 0 {
 		return annotation.ErrNoteNotFound
 	}
-	return nil
+	if r.ftsEnabled() {
+		if _, err := tx.Exec("DELETE FROM agent_notes_fts WHERE note_id = ?", string(id)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// nullString stores an empty string as NULL, so unkeyed notes stay out of
+// the unique idempotency index.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// decodeTags parses the JSON tags column. Malformed values yield no tags
+// rather than failing the read.
+func decodeTags(raw string) []string {
+	var tags []string
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+		return nil
+	}
+	return tags
 }
 
 var _ annotation.NoteRepository = (*NoteRepository)(nil)
@@ -3,6 +3,7 @@ package localstore_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/felixgeelhaar/acai/internal/domain/annotation"
 	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
@@ -44,6 +45,34 @@ func TestNoteRepository_SaveAndFindByID(t *testing.T) {
 	}
 }
 
+func TestNoteRepository_SaveAndFindByID_Tags(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	note, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "observation")
+	note.SetTags([]string{"decision", "risk"})
+	if err := repo.Save(ctx, note); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, "n-1")
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	tags := found.Tags()
+	if len(tags) != 2 || tags[0] != "decision" || tags[1] != "risk" {
+		t.Errorf("got tags %v, want [decision risk]", tags)
+	}
+
+	listed, err := repo.ListByMeeting(ctx, "m-1")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(listed) != 1 || len(listed[0].Tags()) != 2 {
+		t.Errorf("expected listed note to carry 2 tags")
+	}
+}
+
 func TestNoteRepository_FindByID_NotFound(t *testing.T) {
 	repo := setupNoteRepo(t)
 	_, err := repo.FindByID(context.Background(), "nonexistent")
@@ -81,6 +110,37 @@ func TestNoteRepository_ListByMeeting(t *testing.T) {
 	}
 }
 
+func TestNoteRepository_ListAll(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	notes := []*annotation.AgentNote{
+		annotation.ReconstructAgentNote("n-3", "m-2", "claude", "other meeting", nil, base),
+		annotation.ReconstructAgentNote("n-2", "m-1", "gpt", "second", nil, base.Add(time.Minute)),
+		annotation.ReconstructAgentNote("n-1", "m-1", "claude", "first", nil, base),
+	}
+	for _, n := range notes {
+		if err := repo.Save(ctx, n); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	got, err := repo.ListAll(ctx)
+	if err != nil {
+		t.Fatalf("list all: %v", err)
+	}
+	want := []annotation.NoteID{"n-1", "n-2", "n-3"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d notes, want %d", len(got), len(want))
+	}
+	for i, id := range want {
+		if got[i].ID() != id {
+			t.Errorf("position %d: got %s, want %s", i, got[i].ID(), id)
+		}
+	}
+}
+
 func TestNoteRepository_ListByMeeting_Empty(t *testing.T) {
 	repo := setupNoteRepo(t)
 	notes, err := repo.ListByMeeting(context.Background(), "m-1")
@@ -111,6 +171,39 @@ func TestNoteRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestNoteRepository_DeleteByMeeting(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	for _, n := range []struct{ id, meeting string }{{"n-1", "m-1"}, {"n-2", "m-1"}, {"n-3", "m-2"}} {
+		note, _ := annotation.NewAgentNote(annotation.NoteID(n.id), n.meeting, "claude", "shared wording")
+		if err := repo.Save(ctx, note); err != nil {
+			t.Fatalf("save %s: %v", n.id, err)
+		}
+	}
+
+	deleted, err := repo.DeleteByMeeting(ctx, "m-1")
+	if err != nil {
+		t.Fatalf("delete by meeting: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("got %d deleted, want 2", deleted)
+	}
+
+	remaining, _ := repo.ListByMeeting(ctx, "m-1")
+	if len(remaining) != 0 {
+		t.Errorf("got %d notes left for m-1", len(remaining))
+	}
+	if notes, _ := repo.Search(ctx, "shared", 10); len(notes) != 1 || notes[0].ID() != "n-3" {
+		t.Errorf("search should only find n-3, got %d notes", len(notes))
+	}
+
+	deleted, err = repo.DeleteByMeeting(ctx, "m-1")
+	if err != nil || deleted != 0 {
+		t.Errorf("second delete: got %d, %v; want 0, nil", deleted, err)
+	}
+}
+
 func TestNoteRepository_Delete_NotFound(t *testing.T) {
 	repo := setupNoteRepo(t)
 	err := repo.Delete(context.Background(), "nonexistent")
@@ -141,3 +234,127 @@ func TestNoteRepository_SaveOverwrite(t *testing.T) {
 		t.Errorf("got content %q, want %q", found.Content(), "updated")
 	}
 }
+
+func TestNoteRepository_Search(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	for _, n := range []struct{ id, meeting, content string }{
+		{"n-1", "m-1", "Budget approved for Q3 launch"},
+		{"n-2", "m-2", "Launch date slipped to October"},
+		{"n-3", "m-2", "Hiring plan discussed"},
+	} {
+		note, _ := annotation.NewAgentNote(annotation.NoteID(n.id), n.meeting, "claude", n.content)
+		if err := repo.Save(ctx, note); err != nil {
+			t.Fatalf("save %s: %v", n.id, err)
+		}
+	}
+
+	notes, err := repo.Search(ctx, "launch", 10)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("got %d notes, want 2", len(notes))
+	}
+
+	notes, err = repo.Search(ctx, "launch budget", 10)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(notes) != 1 || notes[0].ID() != "n-1" || notes[0].MeetingID() != "m-1" {
+		t.Errorf("expected only n-1 from m-1, got %d notes", len(notes))
+	}
+
+	notes, err = repo.Search(ctx, "launch", 1)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(notes) != 1 {
+		t.Errorf("limit not applied: got %d notes", len(notes))
+	}
+}
+
+func TestNoteRepository_Search_ReflectsUpdatesAndDeletes(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	note, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "original wording")
+	_ = repo.Save(ctx, note)
+
+	updated, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "revised wording")
+	if err := repo.Save(ctx, updated); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	if notes, _ := repo.Search(ctx, "original", 10); len(notes) != 0 {
+		t.Errorf("stale content still matches: %d notes", len(notes))
+	}
+	if notes, _ := repo.Search(ctx, "revised", 10); len(notes) != 1 {
+		t.Errorf("updated content not found: %d notes", len(notes))
+	}
+
+	if err := repo.Delete(ctx, "n-1"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if notes, _ := repo.Search(ctx, "revised", 10); len(notes) != 0 {
+		t.Errorf("deleted note still matches: %d notes", len(notes))
+	}
+}
+
+func TestNoteRepository_Search_QuerySyntaxIsLiteral(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	note, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "owner alice")
+	_ = repo.Save(ctx, note)
+
+	// Operators from FTS5 and LIKE syntax must not cause errors or act as wildcards.
+	for _, q := range []string{`owner: "alice`, "al*", "a%e", "a_ice", "-owner"} {
+		notes, err := repo.Search(ctx, q, 10)
+		if err != nil {
+			t.Fatalf("search %q: %v", q, err)
+		}
+		if len(notes) != 0 && q != `owner: "alice` {
+			t.Errorf("search %q: treated as pattern, got %d notes", q, len(notes))
+		}
+	}
+}
+
+func TestNoteRepository_FindByIdempotencyKey(t *testing.T) {
+	repo := setupNoteRepo(t)
+	ctx := context.Background()
+
+	keyed, _ := annotation.NewAgentNote("n-1", "m-1", "claude", "keyed")
+	keyed.SetIdempotencyKey("retry-1")
+	if err := repo.Save(ctx, keyed); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	// Unkeyed notes must not collide in the unique index.
+	for _, id := range []annotation.NoteID{"n-2", "n-3"} {
+		note, _ := annotation.NewAgentNote(id, "m-1", "claude", "unkeyed")
+		if err := repo.Save(ctx, note); err != nil {
+			t.Fatalf("save %s: %v", id, err)
+		}
+	}
+
+	found, err := repo.FindByIdempotencyKey(ctx, "retry-1")
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if found.ID() != "n-1" || found.IdempotencyKey() != "retry-1" {
+		t.Errorf("got note %s with key %q", found.ID(), found.IdempotencyKey())
+	}
+	if _, err := repo.FindByIdempotencyKey(ctx, "unknown"); err != annotation.ErrNoteNotFound {
+		t.Errorf("got error %v, want %v", err, annotation.ErrNoteNotFound)
+	}
+
+	dup, _ := annotation.NewAgentNote("n-4", "m-1", "claude", "duplicate")
+	dup.SetIdempotencyKey("retry-1")
+	if err := repo.Save(ctx, dup); err == nil {
+		t.Error("expected a reused idempotency key to fail")
+	}
+	if found, err := repo.FindByIdempotencyKey(ctx, "retry-1"); err != nil || found.ID() != "n-1" {
+		t.Errorf("reused key replaced the original note: %v, %v", found, err)
+	}
+}
@@ -0,0 +1,47 @@
+package localstore
+
+import (
+	"database/sql"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Pragmas are SQLite settings applied to every connection Open makes.
+// Empty or zero fields keep SQLite's defaults. WAL with a busy timeout
+// lets the MCP server, webhook receiver, and CLI share a database:
+// readers proceed alongside a writer, and writers wait for each other
+// instead of failing.
+type Pragmas struct {
+	// JournalMode is the journal_mode, e.g. "WAL".
+	JournalMode string
+	// BusyTimeout is how long a connection waits on a locked database
+	// before failing with "database is locked". Zero keeps the driver's
+	// default.
+	BusyTimeout time.Duration
+	// Synchronous is the synchronous level, e.g. "NORMAL".
+	Synchronous string
+}
+
+// Open opens the SQLite database at path with p set on every pooled
+// connection. The sqlite3 driver must be registered by the caller.
+func Open(path string, p Pragmas) (*sql.DB, error) {
+	return sql.Open("sqlite3", dsn(path, p))
+}
+
+func dsn(path string, p Pragmas) string {
+	params := url.Values{}
+	if p.JournalMode != "" {
+		params.Set("_journal_mode", p.JournalMode)
+	}
+	if p.BusyTimeout > 0 {
+		params.Set("_busy_timeout", strconv.FormatInt(p.BusyTimeout.Milliseconds(), 10))
+	}
+	if p.Synchronous != "" {
+		params.Set("_synchronous", p.Synchronous)
+	}
+	if len(params) == 0 {
+		return path
+	}
+	return path + "?" + params.Encode()
+}
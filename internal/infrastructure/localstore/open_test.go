@@ -0,0 +1,100 @@
+package localstore_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+func TestOpen_AppliesPragmas(t *testing.T) {
+	db, err := localstore.Open(filepath.Join(t.TempDir(), "local.db"), localstore.Pragmas{
+		JournalMode: "WAL",
+		BusyTimeout: 2 * time.Second,
+		Synchronous: "NORMAL",
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	var mode string
+	var timeout, sync int
+	if err := db.QueryRow("PRAGMA journal_mode").Scan(&mode); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow("PRAGMA busy_timeout").Scan(&timeout); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow("PRAGMA synchronous").Scan(&sync); err != nil {
+		t.Fatal(err)
+	}
+	if mode != "wal" || timeout != 2000 || sync != 1 {
+		t.Errorf("got journal_mode=%s busy_timeout=%d synchronous=%d, want wal, 2000, 1 (NORMAL)", mode, timeout, sync)
+	}
+}
+
+func TestOpen_ConcurrentWritersDoNotLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "local.db")
+	pragmas := localstore.Pragmas{JournalMode: "WAL", BusyTimeout: 5 * time.Second, Synchronous: "NORMAL"}
+
+	// Two handles stand in for two processes, such as serve and a
+	// webhook receiver, writing to the same file.
+	var repos []*localstore.NoteRepository
+	for range 2 {
+		db, err := localstore.Open(path, pragmas)
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		t.Cleanup(func() { _ = db.Close() })
+		if err := localstore.InitSchema(db); err != nil {
+			t.Fatalf("init schema: %v", err)
+		}
+		repos = append(repos, localstore.NewNoteRepository(db))
+	}
+
+	const perWriter = 50
+	errs := make(chan error, 2*perWriter)
+	var wg sync.WaitGroup
+	for w, repo := range repos {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWriter {
+				note, err := annotation.NewAgentNote(annotation.NoteID(fmt.Sprintf("n-%d-%d", w, i)), "m-1", "agent", "content")
+				if err != nil {
+					errs <- err
+					return
+				}
+				if err := repo.Save(context.Background(), note); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() { wg.Wait(); close(done) }()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("concurrent writers did not finish")
+	}
+	close(errs)
+	for err := range errs {
+		t.Errorf("write failed: %v", err)
+	}
+
+	notes, err := repos[0].ListByMeeting(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(notes) != 2*perWriter {
+		t.Errorf("got %d notes, want %d", len(notes), 2*perWriter)
+	}
+}
@@ -0,0 +1,160 @@
+package localstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// OverlayRepository decorates a domain.Repository, applying the local
+// action item overrides (completion state, edited text) to every action
+// item it reads, so local writes are visible on the read paths.
+//
+// Overrides are applied in place: the inner repository must return fresh
+// aggregates on each call, as the Granola and cache repositories do.
+type OverlayRepository struct {
+	inner domain.Repository
+	db    *sql.DB
+}
+
+// NewOverlayRepository creates an overlay over inner that reads the
+// overrides saved by writes.
+func NewOverlayRepository(inner domain.Repository, writes *WriteRepository) *OverlayRepository {
+	return &OverlayRepository{inner: inner, db: writes.db}
+}
+
+func (r *OverlayRepository) FindByID(ctx context.Context, id domain.MeetingID) (*domain.Meeting, error) {
+	m, err := r.inner.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyToMeetings(ctx, []*domain.Meeting{m}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// List applies the overrides to whatever meetings were returned, including
+// partial results, and keeps the inner error.
+func (r *OverlayRepository) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	meetings, err := r.inner.List(ctx, filter)
+	if oerr := r.applyToMeetings(ctx, meetings); oerr != nil {
+		return nil, oerr
+	}
+	return meetings, err
+}
+
+func (r *OverlayRepository) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	return r.inner.GetTranscript(ctx, id)
+}
+
+func (r *OverlayRepository) SearchTranscripts(ctx context.Context, query string, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	meetings, err := r.inner.SearchTranscripts(ctx, query, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyToMeetings(ctx, meetings); err != nil {
+		return nil, err
+	}
+	return meetings, nil
+}
+
+func (r *OverlayRepository) GetActionItems(ctx context.Context, id domain.MeetingID) ([]*domain.ActionItem, error) {
+	items, err := r.inner.GetActionItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := r.overrides(ctx, []domain.MeetingID{id})
+	if err != nil {
+		return nil, err
+	}
+	applyOverrides(items, overrides)
+	return items, nil
+}
+
+func (r *OverlayRepository) Sync(ctx context.Context, since *time.Time) (*domain.SyncResult, error) {
+	return r.inner.Sync(ctx, since)
+}
+
+func (r *OverlayRepository) applyToMeetings(ctx context.Context, meetings []*domain.Meeting) error {
+	ids := make([]domain.MeetingID, 0, len(meetings))
+	for _, m := range meetings {
+		if m != nil && len(m.ActionItems()) > 0 {
+			ids = append(ids, m.ID())
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	overrides, err := r.overrides(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, m := range meetings {
+		if m != nil {
+			applyOverrides(m.ActionItems(), overrides)
+		}
+	}
+	return nil
+}
+
+// actionItemOverride is one row of action_item_overrides.
+type actionItemOverride struct {
+	text      sql.NullString
+	completed sql.NullInt64
+	updatedAt time.Time
+}
+
+// overrides loads the overrides of the given meetings, keyed by action
+// item ID.
+func (r *OverlayRepository) overrides(ctx context.Context, meetingIDs []domain.MeetingID) (map[domain.ActionItemID]actionItemOverride, error) {
+	args := make([]any, len(meetingIDs))
+	for i, id := range meetingIDs {
+		args[i] = string(id)
+	}
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT action_item_id, text, completed, updated_at FROM action_item_overrides WHERE meeting_id IN (?"+
+			strings.Repeat(", ?", len(meetingIDs)-1)+")",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make(map[domain.ActionItemID]actionItemOverride)
+	for rows.Next() {
+		var id string
+		var o actionItemOverride
+		if err := rows.Scan(&id, &o.text, &o.completed, &o.updatedAt); err != nil {
+			return nil, err
+		}
+		out[domain.ActionItemID(id)] = o
+	}
+	return out, rows.Err()
+}
+
+func applyOverrides(items []*domain.ActionItem, overrides map[domain.ActionItemID]actionItemOverride) {
+	for _, item := range items {
+		o, ok := overrides[item.ID()]
+		if !ok {
+			continue
+		}
+		if o.text.Valid && o.text.String != "" {
+			_ = item.UpdateText(o.text.String)
+		}
+		if o.completed.Valid {
+			if o.completed.Int64 == 1 {
+				item.Complete()
+			} else {
+				item.Uncomplete()
+			}
+		}
+		item.MarkModified(o.updatedAt)
+	}
+}
+
+var _ domain.Repository = (*OverlayRepository)(nil)
@@ -0,0 +1,90 @@
+package localstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+// upstreamRepo builds a fresh meeting with two open action items on every
+// read, like the Granola repository does.
+type upstreamRepo struct{}
+
+func (upstreamRepo) meeting() *domain.Meeting {
+	m, _ := domain.New("m-1", "Planning", time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC), domain.SourceZoom, nil)
+	for _, id := range []domain.ActionItemID{"ai-1", "ai-2"} {
+		item, _ := domain.NewActionItem(id, "m-1", "Alice", "Upstream "+string(id), nil)
+		m.AddActionItem(item)
+	}
+	return m
+}
+
+func (r upstreamRepo) FindByID(_ context.Context, _ domain.MeetingID) (*domain.Meeting, error) {
+	return r.meeting(), nil
+}
+func (r upstreamRepo) List(_ context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	return []*domain.Meeting{r.meeting()}, nil
+}
+func (upstreamRepo) GetTranscript(_ context.Context, _ domain.MeetingID) (*domain.Transcript, error) {
+	return nil, domain.ErrTranscriptNotReady
+}
+func (r upstreamRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	return []*domain.Meeting{r.meeting()}, nil
+}
+func (r upstreamRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
+	return r.meeting().ActionItems(), nil
+}
+func (upstreamRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{}, nil
+}
+
+func TestOverlayRepository_AppliesOverridesOnReRead(t *testing.T) {
+	writes := setupWriteRepo(t)
+	repo := localstore.NewOverlayRepository(upstreamRepo{}, writes)
+	ctx := context.Background()
+
+	items, err := repo.GetActionItems(ctx, "m-1")
+	if err != nil {
+		t.Fatalf("get action items: %v", err)
+	}
+	items[0].Complete()
+	if err := writes.SaveActionItemState(ctx, items[0]); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if err := items[1].UpdateText("Edited locally"); err != nil {
+		t.Fatal(err)
+	}
+	if err := writes.SaveActionItemState(ctx, items[1]); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	items, err = repo.GetActionItems(ctx, "m-1")
+	if err != nil {
+		t.Fatalf("get action items: %v", err)
+	}
+	if !items[0].IsCompleted() || items[0].ModifiedAt() == nil {
+		t.Error("ai-1 should read back completed, with its override time")
+	}
+	if items[1].IsCompleted() || items[1].Text() != "Edited locally" {
+		t.Errorf("ai-2: got completed %v, text %q, want open with the edited text", items[1].IsCompleted(), items[1].Text())
+	}
+
+	m, err := repo.FindByID(ctx, "m-1")
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if got := m.ActionItems(); !got[0].IsCompleted() || got[1].Text() != "Edited locally" {
+		t.Error("FindByID should apply the overrides to the meeting's action items")
+	}
+
+	meetings, err := repo.List(ctx, domain.ListFilter{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if !meetings[0].ActionItems()[0].IsCompleted() {
+		t.Error("List should apply the overrides")
+	}
+}
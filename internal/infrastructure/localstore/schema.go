@@ -3,17 +3,87 @@
 // decorator chain, writes go directly to local SQLite.
 package localstore
 
-import "database/sql"
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+)
 
-// InitSchema creates the local store tables if they don't exist.
+// columnMigration describes a column added to an existing table after its
+// initial release. Migrations are additive only, so older databases keep working.
+type columnMigration struct {
+	table      string
+	column     string
+	definition string
+}
+
+// columnMigrations are applied in order after the base schema is created.
+var columnMigrations = []columnMigration{
+	{table: "agent_notes", column: "tags", definition: "TEXT NOT NULL DEFAULT '[]'"},
+	{table: "agent_notes", column: "idempotency_key", definition: "TEXT"},
+	// Overrides written before this column existed have NULL and report
+	// updated_at as their creation time.
+	{table: "action_item_overrides", column: "created_at", definition: "DATETIME"},
+}
+
+// InitSchema creates the local store tables if they don't exist
+// and applies any pending additive column migrations.
 func InitSchema(db *sql.DB) error {
+	if err := createTables(db); err != nil {
+		return err
+	}
+	for _, m := range columnMigrations {
+		if err := addColumnIfMissing(db, m); err != nil {
+			return fmt.Errorf("migrate %s.%s: %w", m.table, m.column, err)
+		}
+	}
+	// The column may come from a migration, so its index is created after them.
+	if _, err := db.Exec(
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_agent_notes_idempotency_key ON agent_notes(idempotency_key) WHERE idempotency_key IS NOT NULL",
+	); err != nil {
+		return fmt.Errorf("create note idempotency index: %w", err)
+	}
+	return createNoteSearchIndex(db)
+}
+
+// createNoteSearchIndex builds the FTS5 index over note content. SQLite
+// builds without FTS5 are tolerated: note search falls back to a LIKE scan.
+func createNoteSearchIndex(db *sql.DB) error {
+	var exists int
+	if err := db.QueryRow(
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'agent_notes_fts'",
+	).Scan(&exists); err != nil {
+		return err
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	_, err := db.Exec("CREATE VIRTUAL TABLE agent_notes_fts USING fts5(note_id UNINDEXED, content)")
+	if err != nil {
+		if strings.Contains(err.Error(), "no such module") {
+			log.Printf("localstore: FTS5 unavailable, note search degraded to LIKE scan")
+			return nil
+		}
+		return fmt.Errorf("create note search index: %w", err)
+	}
+
+	// Backfill notes written before the index existed.
+	_, err = db.Exec("INSERT INTO agent_notes_fts (note_id, content) SELECT id, content FROM agent_notes")
+	return err
+}
+
+func createTables(db *sql.DB) error {
 	_, err := db.Exec(`
 		CREATE TABLE IF NOT EXISTS agent_notes (
 			id         TEXT PRIMARY KEY,
 			meeting_id TEXT NOT NULL,
 			author     TEXT NOT NULL,
 			content    TEXT NOT NULL,
-			created_at DATETIME NOT NULL
+			tags       TEXT NOT NULL DEFAULT '[]',
+			created_at DATETIME NOT NULL,
+			idempotency_key TEXT
 		);
 		CREATE INDEX IF NOT EXISTS idx_agent_notes_meeting ON agent_notes(meeting_id);
 
@@ -22,6 +92,7 @@ func InitSchema(db *sql.DB) error {
 			meeting_id     TEXT NOT NULL,
 			text           TEXT,
 			completed      INTEGER,
+			created_at     DATETIME,
 			updated_at     DATETIME NOT NULL
 		);
 		CREATE INDEX IF NOT EXISTS idx_action_item_overrides_meeting ON action_item_overrides(meeting_id);
@@ -36,6 +107,67 @@ func InitSchema(db *sql.DB) error {
 			attempts   INTEGER NOT NULL DEFAULT 0
 		);
 		CREATE INDEX IF NOT EXISTS idx_outbox_status ON outbox_entries(status);
+
+		CREATE TABLE IF NOT EXISTS audit_log (
+			id         INTEGER PRIMARY KEY AUTOINCREMENT,
+			operation  TEXT NOT NULL,
+			target_id  TEXT NOT NULL,
+			actor      TEXT NOT NULL,
+			details    TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS idx_audit_log_created ON audit_log(created_at);
+
+		CREATE TABLE IF NOT EXISTS speaker_aliases (
+			alias      TEXT PRIMARY KEY COLLATE NOCASE,
+			canonical  TEXT NOT NULL,
+			updated_at DATETIME NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS meeting_tags (
+			meeting_id TEXT NOT NULL,
+			tag        TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (meeting_id, tag)
+		);
+		CREATE INDEX IF NOT EXISTS idx_meeting_tags_tag ON meeting_tags(tag);
+
+		CREATE TABLE IF NOT EXISTS sync_state (
+			name      TEXT PRIMARY KEY,
+			synced_at DATETIME NOT NULL
+		);
 	`)
 	return err
 }
+
+func addColumnIfMissing(db *sql.DB, m columnMigration) error {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", m.table))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			cid       int
+			name      string
+			colType   string
+			notNull   int
+			dfltValue sql.NullString
+			pk        int
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &dfltValue, &pk); err != nil {
+			return err
+		}
+		if name == m.column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	_ = rows.Close()
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", m.table, m.column, m.definition))
+	return err
+}
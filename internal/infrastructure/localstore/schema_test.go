@@ -1,9 +1,12 @@
 package localstore_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
+	"time"
 
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -24,7 +27,7 @@ func TestInitSchema_CreatesAllTables(t *testing.T) {
 		t.Fatalf("init schema: %v", err)
 	}
 
-	tables := []string{"agent_notes", "action_item_overrides", "outbox_entries"}
+	tables := []string{"agent_notes", "action_item_overrides", "outbox_entries", "audit_log", "speaker_aliases", "meeting_tags", "sync_state"}
 	for _, table := range tables {
 		var name string
 		err := db.QueryRow(
@@ -45,3 +48,82 @@ func TestInitSchema_Idempotent(t *testing.T) {
 		t.Fatalf("second init should be idempotent: %v", err)
 	}
 }
+
+func TestInitSchema_MigratesAgentNotesTags(t *testing.T) {
+	db := openTestDB(t)
+	db.SetMaxOpenConns(1)
+
+	// Schema as shipped before note tags existed.
+	_, err := db.Exec(`CREATE TABLE agent_notes (
+		id         TEXT PRIMARY KEY,
+		meeting_id TEXT NOT NULL,
+		author     TEXT NOT NULL,
+		content    TEXT NOT NULL,
+		created_at DATETIME NOT NULL
+	)`)
+	if err != nil {
+		t.Fatalf("create legacy table: %v", err)
+	}
+	_, err = db.Exec(
+		"INSERT INTO agent_notes (id, meeting_id, author, content, created_at) VALUES ('n-1', 'm-1', 'claude', 'old', CURRENT_TIMESTAMP)",
+	)
+	if err != nil {
+		t.Fatalf("insert legacy row: %v", err)
+	}
+
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("second init schema: %v", err)
+	}
+
+	note, err := localstore.NewNoteRepository(db).FindByID(context.Background(), "n-1")
+	if err != nil {
+		t.Fatalf("find legacy note: %v", err)
+	}
+	if len(note.Tags()) != 0 {
+		t.Errorf("got tags %v, want none", note.Tags())
+	}
+}
+
+func TestInitSchema_MigratesOverrideCreatedAt(t *testing.T) {
+	db := openTestDB(t)
+	db.SetMaxOpenConns(1)
+
+	// Schema as shipped before overrides had a creation time.
+	_, err := db.Exec(`CREATE TABLE action_item_overrides (
+		action_item_id TEXT PRIMARY KEY,
+		meeting_id     TEXT NOT NULL,
+		text           TEXT,
+		completed      INTEGER,
+		updated_at     DATETIME NOT NULL
+	)`)
+	if err != nil {
+		t.Fatalf("create legacy table: %v", err)
+	}
+	legacyAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	if _, err := db.Exec(
+		"INSERT INTO action_item_overrides (action_item_id, meeting_id, text, completed, updated_at) VALUES ('ai-1', 'm-1', 'old', 1, ?)",
+		legacyAt,
+	); err != nil {
+		t.Fatalf("insert legacy row: %v", err)
+	}
+
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+
+	// The first save after the migration backfills created_at from updated_at.
+	item, _ := domain.NewActionItem("ai-1", "m-1", "", "new", nil)
+	if err := localstore.NewWriteRepository(db).SaveActionItemState(context.Background(), item); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	var createdAt time.Time
+	if err := db.QueryRow("SELECT created_at FROM action_item_overrides WHERE action_item_id = 'ai-1'").Scan(&createdAt); err != nil {
+		t.Fatalf("read created_at: %v", err)
+	}
+	if !createdAt.Equal(legacyAt) {
+		t.Errorf("got created_at %v, want %v", createdAt, legacyAt)
+	}
+}
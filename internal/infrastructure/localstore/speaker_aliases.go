@@ -0,0 +1,54 @@
+package localstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// SpeakerAliasStore implements domain.SpeakerAliasRepository using SQLite.
+// Aliases are unique regardless of case.
+type SpeakerAliasStore struct {
+	db *sql.DB
+}
+
+// NewSpeakerAliasStore creates a new SQLite-backed speaker alias store.
+func NewSpeakerAliasStore(db *sql.DB) *SpeakerAliasStore {
+	return &SpeakerAliasStore{db: db}
+}
+
+func (s *SpeakerAliasStore) SaveSpeakerAlias(_ context.Context, alias, canonical string) error {
+	_, err := s.db.Exec(
+		`INSERT INTO speaker_aliases (alias, canonical, updated_at) VALUES (?, ?, ?)
+		 ON CONFLICT(alias) DO UPDATE SET alias = excluded.alias, canonical = excluded.canonical, updated_at = excluded.updated_at`,
+		alias, canonical, time.Now().UTC(),
+	)
+	return err
+}
+
+func (s *SpeakerAliasStore) DeleteSpeakerAlias(_ context.Context, alias string) error {
+	_, err := s.db.Exec("DELETE FROM speaker_aliases WHERE alias = ?", alias)
+	return err
+}
+
+func (s *SpeakerAliasStore) ListSpeakerAliases(_ context.Context) (map[string]string, error) {
+	rows, err := s.db.Query("SELECT alias, canonical FROM speaker_aliases")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	aliases := make(map[string]string)
+	for rows.Next() {
+		var alias, canonical string
+		if err := rows.Scan(&alias, &canonical); err != nil {
+			return nil, err
+		}
+		aliases[alias] = canonical
+	}
+	return aliases, rows.Err()
+}
+
+var _ domain.SpeakerAliasRepository = (*SpeakerAliasStore)(nil)
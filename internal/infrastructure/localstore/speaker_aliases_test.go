@@ -0,0 +1,52 @@
+package localstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+func setupSpeakerAliasStore(t *testing.T) *localstore.SpeakerAliasStore {
+	t.Helper()
+	db := openTestDB(t)
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	return localstore.NewSpeakerAliasStore(db)
+}
+
+func TestSpeakerAliasStore_SaveListDelete(t *testing.T) {
+	store := setupSpeakerAliasStore(t)
+	ctx := context.Background()
+
+	if err := store.SaveSpeakerAlias(ctx, "Speaker 1", "Alice"); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if err := store.SaveSpeakerAlias(ctx, "bob s.", "Bob"); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	// Re-saving an alias in a different case replaces it.
+	if err := store.SaveSpeakerAlias(ctx, "SPEAKER 1", "Alice Smith"); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	aliases, err := store.ListSpeakerAliases(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(aliases) != 2 {
+		t.Fatalf("got %d aliases, want 2: %v", len(aliases), aliases)
+	}
+	if aliases["SPEAKER 1"] != "Alice Smith" {
+		t.Errorf("got %v, want SPEAKER 1 -> Alice Smith", aliases)
+	}
+
+	if err := store.DeleteSpeakerAlias(ctx, "Bob S."); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	aliases, _ = store.ListSpeakerAliases(ctx)
+	if _, ok := aliases["bob s."]; ok || len(aliases) != 1 {
+		t.Errorf("got %v after delete, want only SPEAKER 1", aliases)
+	}
+}
@@ -0,0 +1,47 @@
+package localstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// meetingsSyncState names the sync_state row of the meeting sync.
+const meetingsSyncState = "meetings"
+
+// SyncStateStore implements domain.SyncStateRepository using SQLite.
+type SyncStateStore struct {
+	db *sql.DB
+}
+
+// NewSyncStateStore creates a new SQLite-backed sync state store.
+func NewSyncStateStore(db *sql.DB) *SyncStateStore {
+	return &SyncStateStore{db: db}
+}
+
+func (s *SyncStateStore) LastSyncedAt(_ context.Context) (*time.Time, error) {
+	var t time.Time
+	err := s.db.QueryRow("SELECT synced_at FROM sync_state WHERE name = ?", meetingsSyncState).Scan(&t)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+func (s *SyncStateStore) SaveLastSyncedAt(_ context.Context, t time.Time) error {
+	_, err := s.db.Exec(
+		`INSERT INTO sync_state (name, synced_at) VALUES (?, ?)
+		 ON CONFLICT(name) DO UPDATE SET synced_at = excluded.synced_at`,
+		meetingsSyncState, t.UTC(),
+	)
+	return err
+}
+
+var _ domain.SyncStateRepository = (*SyncStateStore)(nil)
@@ -0,0 +1,42 @@
+package localstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+)
+
+func TestSyncStateStore_SaveAndLoad(t *testing.T) {
+	db := openTestDB(t)
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	store := localstore.NewSyncStateStore(db)
+	ctx := context.Background()
+
+	got, err := store.LastSyncedAt(ctx)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got != nil {
+		t.Errorf("got %v before any sync, want nil", got)
+	}
+
+	first := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	for _, mark := range []time.Time{first, second} {
+		if err := store.SaveLastSyncedAt(ctx, mark); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	got, err = store.LastSyncedAt(ctx)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got == nil || !got.Equal(second) {
+		t.Errorf("got %v, want %v", got, second)
+	}
+}
@@ -3,6 +3,7 @@ package localstore
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -19,16 +20,26 @@ func NewWriteRepository(db *sql.DB) *WriteRepository {
 	return &WriteRepository{db: db}
 }
 
+// SaveActionItemState upserts the item's override. updated_at is the
+// item's ModifiedAt, or now when unset; created_at keeps the time of the
+// first override.
 func (r *WriteRepository) SaveActionItemState(_ context.Context, item *domain.ActionItem) error {
 	var completed int
 	if item.IsCompleted() {
 		completed = 1
 	}
+	modified := time.Now().UTC()
+	if at := item.ModifiedAt(); at != nil {
+		modified = at.UTC()
+	}
 	_, err := r.db.Exec(
-		`INSERT OR REPLACE INTO action_item_overrides
-			(action_item_id, meeting_id, text, completed, updated_at)
-		VALUES (?, ?, ?, ?, ?)`,
-		string(item.ID()), string(item.MeetingID()), item.Text(), completed, time.Now().UTC(),
+		`INSERT INTO action_item_overrides
+			(action_item_id, meeting_id, text, completed, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(action_item_id) DO UPDATE SET meeting_id = excluded.meeting_id, text = excluded.text,
+			completed = excluded.completed, updated_at = excluded.updated_at,
+			created_at = COALESCE(action_item_overrides.created_at, action_item_overrides.updated_at)`,
+		string(item.ID()), string(item.MeetingID()), item.Text(), completed, modified, modified,
 	)
 	return err
 }
@@ -39,11 +50,12 @@ func (r *WriteRepository) GetLocalActionItemState(_ context.Context, id domain.A
 		meetingID    string
 		text         sql.NullString
 		completed    sql.NullInt64
+		updatedAt    time.Time
 	)
 	err := r.db.QueryRow(
-		"SELECT action_item_id, meeting_id, text, completed FROM action_item_overrides WHERE action_item_id = ?",
+		"SELECT action_item_id, meeting_id, text, completed, updated_at FROM action_item_overrides WHERE action_item_id = ?",
 		string(id),
-	).Scan(&actionItemID, &meetingID, &text, &completed)
+	).Scan(&actionItemID, &meetingID, &text, &completed, &updatedAt)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrMeetingNotFound
 	}
@@ -70,8 +82,97 @@ func (r *WriteRepository) GetLocalActionItemState(_ context.Context, id domain.A
 	if completed.Valid && completed.Int64 == 1 {
 		item.Complete()
 	}
+	item.MarkModified(updatedAt)
 
 	return item, nil
 }
 
+// ExportOverrides returns every action item override as an archive record,
+// ordered by meeting and action item.
+func (r *WriteRepository) ExportOverrides(_ context.Context) ([]ActionItemOverrideRecord, error) {
+	rows, err := r.db.Query(
+		"SELECT action_item_id, meeting_id, text, completed, created_at, updated_at FROM action_item_overrides ORDER BY meeting_id ASC, action_item_id ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	records := []ActionItemOverrideRecord{}
+	for rows.Next() {
+		var (
+			rec       ActionItemOverrideRecord
+			text      sql.NullString
+			completed sql.NullInt64
+			createdAt sql.NullTime
+		)
+		if err := rows.Scan(&rec.ActionItemID, &rec.MeetingID, &text, &completed, &createdAt, &rec.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if createdAt.Valid {
+			rec.CreatedAt = &createdAt.Time
+		}
+		if text.Valid {
+			rec.Text = &text.String
+		}
+		if completed.Valid {
+			done := completed.Int64 == 1
+			rec.Completed = &done
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+// ImportOverrides writes archived overrides in one transaction. Under
+// ConflictSkip an action item that already has an override keeps it;
+// under ConflictOverwrite it is replaced.
+func (r *WriteRepository) ImportOverrides(_ context.Context, records []ActionItemOverrideRecord, policy ConflictPolicy) (ImportCounts, error) {
+	var counts ImportCounts
+	tx, err := r.db.Begin()
+	if err != nil {
+		return counts, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	verb := "INSERT OR IGNORE"
+	if policy == ConflictOverwrite {
+		verb = "INSERT OR REPLACE"
+	}
+	for _, rec := range records {
+		var text sql.NullString
+		if rec.Text != nil {
+			text = sql.NullString{String: *rec.Text, Valid: true}
+		}
+		var completed sql.NullInt64
+		if rec.Completed != nil {
+			completed.Valid = true
+			if *rec.Completed {
+				completed.Int64 = 1
+			}
+		}
+		var createdAt sql.NullTime
+		if rec.CreatedAt != nil {
+			createdAt = sql.NullTime{Time: rec.CreatedAt.UTC(), Valid: true}
+		}
+		res, err := tx.Exec(
+			verb+" INTO action_item_overrides (action_item_id, meeting_id, text, completed, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+			rec.ActionItemID, rec.MeetingID, text, completed, createdAt, rec.UpdatedAt.UTC(),
+		)
+		if err != nil {
+			return counts, fmt.Errorf("action item override %s: %w", rec.ActionItemID, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return counts, err
+		}
+		if affected == 0 {
+			counts.Skipped++
+		} else {
+			counts.Imported++
+		}
+	}
+	return counts, tx.Commit()
+}
+
 var _ domain.WriteRepository = (*WriteRepository)(nil)
@@ -3,6 +3,7 @@ package localstore_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
@@ -96,3 +97,46 @@ func TestWriteRepository_SaveOverwrite(t *testing.T) {
 		t.Error("should be completed after update")
 	}
 }
+
+func TestWriteRepository_Timestamps(t *testing.T) {
+	db := openTestDB(t)
+	db.SetMaxOpenConns(1)
+	if err := localstore.InitSchema(db); err != nil {
+		t.Fatalf("init schema: %v", err)
+	}
+	repo := localstore.NewWriteRepository(db)
+	ctx := context.Background()
+
+	completedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	item.Complete()
+	item.MarkModified(completedAt)
+	if err := repo.SaveActionItemState(ctx, item); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	found, err := repo.GetLocalActionItemState(ctx, "ai-1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if at := found.ModifiedAt(); at == nil || !at.Equal(completedAt) {
+		t.Errorf("got modified at %v, want %v", at, completedAt)
+	}
+
+	editedAt := completedAt.Add(time.Hour)
+	_ = item.UpdateText("Write the report")
+	item.MarkModified(editedAt)
+	if err := repo.SaveActionItemState(ctx, item); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	var createdAt, updatedAt time.Time
+	if err := db.QueryRow(
+		"SELECT created_at, updated_at FROM action_item_overrides WHERE action_item_id = 'ai-1'",
+	).Scan(&createdAt, &updatedAt); err != nil {
+		t.Fatalf("read timestamps: %v", err)
+	}
+	if !createdAt.Equal(completedAt) || !updatedAt.Equal(editedAt) {
+		t.Errorf("got created %v updated %v, want %v and %v", createdAt, updatedAt, completedAt, editedAt)
+	}
+}
@@ -0,0 +1,166 @@
+// Package metrics collects operational counters and exposes them in the
+// Prometheus text exposition format for scraping over the HTTP transport.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry holds the process-wide counters. It is safe for concurrent use.
+// The observation methods are no-ops on a nil Registry, so decorators can
+// call them unconditionally when metrics are disabled.
+type Registry struct {
+	mu           sync.Mutex
+	toolCalls    map[toolCallKey]uint64
+	apiRequests  map[string]uint64
+	cacheHits    uint64
+	cacheMisses  uint64
+	breakerTrips uint64
+}
+
+type toolCallKey struct {
+	tool   string
+	status string
+}
+
+// NewRegistry creates an empty metrics registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		toolCalls:   make(map[toolCallKey]uint64),
+		apiRequests: make(map[string]uint64),
+	}
+}
+
+// ObserveToolCall counts one MCP tool invocation, labelled ok or error.
+func (r *Registry) ObserveToolCall(tool string, err error) {
+	if r == nil {
+		return
+	}
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.toolCalls[toolCallKey{tool: tool, status: status}]++
+}
+
+// ObserveAPIResponse counts one Granola API call by HTTP status class
+// (2xx, 4xx, ...). A zero status code records a transport error.
+func (r *Registry) ObserveAPIResponse(statusCode int) {
+	if r == nil {
+		return
+	}
+	class := "error"
+	if statusCode >= 100 && statusCode < 600 {
+		class = fmt.Sprintf("%dxx", statusCode/100)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.apiRequests[class]++
+}
+
+// CacheHit counts a read served from the local cache.
+func (r *Registry) CacheHit() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cacheHits++
+}
+
+// CacheMiss counts a read that fell through to the Granola API.
+func (r *Registry) CacheMiss() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cacheMisses++
+}
+
+// BreakerTripped counts a transition of the circuit breaker to open.
+func (r *Registry) BreakerTripped() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.breakerTrips++
+}
+
+// WriteTo renders all counters in the Prometheus text format.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	toolCalls := make(map[toolCallKey]uint64, len(r.toolCalls))
+	for k, v := range r.toolCalls {
+		toolCalls[k] = v
+	}
+	apiRequests := make(map[string]uint64, len(r.apiRequests))
+	for k, v := range r.apiRequests {
+		apiRequests[k] = v
+	}
+	cacheHits, cacheMisses, breakerTrips := r.cacheHits, r.cacheMisses, r.breakerTrips
+	r.mu.Unlock()
+
+	var buf bytes.Buffer
+
+	writeHeader(&buf, "acai_mcp_tool_calls_total", "MCP tool invocations by tool name and outcome.")
+	keys := make([]toolCallKey, 0, len(toolCalls))
+	for k := range toolCalls {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].tool != keys[j].tool {
+			return keys[i].tool < keys[j].tool
+		}
+		return keys[i].status < keys[j].status
+	})
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(&buf, "acai_mcp_tool_calls_total{tool=%s,status=%s} %d\n",
+			quoteLabel(k.tool), quoteLabel(k.status), toolCalls[k])
+	}
+
+	writeHeader(&buf, "acai_granola_api_requests_total", "Granola API requests by HTTP status class.")
+	classes := make([]string, 0, len(apiRequests))
+	for c := range apiRequests {
+		classes = append(classes, c)
+	}
+	sort.Strings(classes)
+	for _, c := range classes {
+		_, _ = fmt.Fprintf(&buf, "acai_granola_api_requests_total{status_class=%s} %d\n", quoteLabel(c), apiRequests[c])
+	}
+
+	writeHeader(&buf, "acai_cache_hits_total", "Meeting reads served from the local cache.")
+	_, _ = fmt.Fprintf(&buf, "acai_cache_hits_total %d\n", cacheHits)
+	writeHeader(&buf, "acai_cache_misses_total", "Meeting reads that missed the local cache.")
+	_, _ = fmt.Fprintf(&buf, "acai_cache_misses_total %d\n", cacheMisses)
+	writeHeader(&buf, "acai_circuit_breaker_trips_total", "Times the Granola API circuit breaker opened.")
+	_, _ = fmt.Fprintf(&buf, "acai_circuit_breaker_trips_total %d\n", breakerTrips)
+
+	return buf.WriteTo(w)
+}
+
+// Handler serves the registry at a scrape endpoint such as /metrics.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = r.WriteTo(w)
+	})
+}
+
+func writeHeader(w io.Writer, name, help string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+}
+
+// quoteLabel escapes a label value per the exposition format.
+func quoteLabel(v string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(v) + `"`
+}
@@ -0,0 +1,98 @@
+package metrics_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
+)
+
+func TestRegistry_WritesPrometheusText(t *testing.T) {
+	r := metrics.NewRegistry()
+	r.ObserveToolCall("list_meetings", nil)
+	r.ObserveToolCall("list_meetings", nil)
+	r.ObserveToolCall("get_meeting", errors.New("boom"))
+	r.ObserveAPIResponse(200)
+	r.ObserveAPIResponse(404)
+	r.ObserveAPIResponse(0)
+	r.CacheHit()
+	r.CacheMiss()
+	r.CacheMiss()
+	r.BreakerTripped()
+
+	var b strings.Builder
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"# TYPE acai_mcp_tool_calls_total counter",
+		`acai_mcp_tool_calls_total{tool="list_meetings",status="ok"} 2`,
+		`acai_mcp_tool_calls_total{tool="get_meeting",status="error"} 1`,
+		`acai_granola_api_requests_total{status_class="2xx"} 1`,
+		`acai_granola_api_requests_total{status_class="4xx"} 1`,
+		`acai_granola_api_requests_total{status_class="error"} 1`,
+		"acai_cache_hits_total 1",
+		"acai_cache_misses_total 2",
+		"acai_circuit_breaker_trips_total 1",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestRegistry_NilIsNoop(t *testing.T) {
+	var r *metrics.Registry
+	r.ObserveToolCall("list_meetings", nil)
+	r.ObserveAPIResponse(200)
+	r.CacheHit()
+	r.CacheMiss()
+	r.BreakerTripped()
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	r := metrics.NewRegistry()
+	r.CacheHit()
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("got content type %q", ct)
+	}
+	if !strings.Contains(rec.Body.String(), "acai_cache_hits_total 1") {
+		t.Errorf("unexpected body: %s", rec.Body.String())
+	}
+}
+
+func TestRegistry_Transport(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+
+	r := metrics.NewRegistry()
+	client := &http.Client{Transport: r.Transport(nil)}
+	for _, path := range []string{"/ok", "/missing"} {
+		resp, err := client.Get(upstream.URL + path)
+		if err != nil {
+			t.Fatalf("get %s: %v", path, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	var b strings.Builder
+	_, _ = r.WriteTo(&b)
+	if !strings.Contains(b.String(), `status_class="2xx"} 1`) || !strings.Contains(b.String(), `status_class="4xx"} 1`) {
+		t.Errorf("status classes not recorded:\n%s", b.String())
+	}
+}
@@ -0,0 +1,27 @@
+package metrics
+
+import "net/http"
+
+// Transport decorates an http.RoundTripper, counting every response by
+// status class in the registry. A nil next uses http.DefaultTransport.
+func (r *Registry) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &instrumentedTransport{next: next, registry: r}
+}
+
+type instrumentedTransport struct {
+	next     http.RoundTripper
+	registry *Registry
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.registry.ObserveAPIResponse(0)
+		return nil, err
+	}
+	t.registry.ObserveAPIResponse(resp.StatusCode)
+	return resp, nil
+}
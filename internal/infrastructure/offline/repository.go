@@ -0,0 +1,113 @@
+// Package offline provides repository decorators that cut off the Granola
+// API on demand. Stacked beneath the cache decorator, they let the server
+// answer from cached and local data only, for demos or unreliable networks.
+package offline
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/workspace"
+)
+
+// ErrOfflineUnavailable is returned for data that can only come from the API.
+var ErrOfflineUnavailable = errors.New("not available in offline mode")
+
+// Switch is the shared offline flag. The zero value is online.
+type Switch struct {
+	offline atomic.Bool
+}
+
+// SetOffline turns offline mode on or off.
+func (s *Switch) SetOffline(offline bool) { s.offline.Store(offline) }
+
+// Offline reports whether offline mode is active.
+func (s *Switch) Offline() bool { return s.offline.Load() }
+
+// Repository decorates a domain.Repository, refusing every call while
+// its switch is offline. A meeting lookup reports ErrMeetingNotFound so
+// callers treat it like any other cache miss.
+type Repository struct {
+	inner domain.Repository
+	sw    *Switch
+}
+
+// NewRepository wraps inner behind sw.
+func NewRepository(inner domain.Repository, sw *Switch) *Repository {
+	return &Repository{inner: inner, sw: sw}
+}
+
+func (r *Repository) FindByID(ctx context.Context, id domain.MeetingID) (*domain.Meeting, error) {
+	if r.sw.Offline() {
+		return nil, domain.ErrMeetingNotFound
+	}
+	return r.inner.FindByID(ctx, id)
+}
+
+func (r *Repository) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	if r.sw.Offline() {
+		return nil, ErrOfflineUnavailable
+	}
+	return r.inner.List(ctx, filter)
+}
+
+func (r *Repository) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	if r.sw.Offline() {
+		return nil, ErrOfflineUnavailable
+	}
+	return r.inner.GetTranscript(ctx, id)
+}
+
+func (r *Repository) SearchTranscripts(ctx context.Context, query string, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	if r.sw.Offline() {
+		return nil, ErrOfflineUnavailable
+	}
+	return r.inner.SearchTranscripts(ctx, query, filter)
+}
+
+func (r *Repository) GetActionItems(ctx context.Context, id domain.MeetingID) ([]*domain.ActionItem, error) {
+	if r.sw.Offline() {
+		return nil, ErrOfflineUnavailable
+	}
+	return r.inner.GetActionItems(ctx, id)
+}
+
+func (r *Repository) Sync(ctx context.Context, since *time.Time) (*domain.SyncResult, error) {
+	if r.sw.Offline() {
+		return nil, ErrOfflineUnavailable
+	}
+	return r.inner.Sync(ctx, since)
+}
+
+// WorkspaceRepository is the workspace counterpart of Repository.
+type WorkspaceRepository struct {
+	inner workspace.Repository
+	sw    *Switch
+}
+
+// NewWorkspaceRepository wraps inner behind sw.
+func NewWorkspaceRepository(inner workspace.Repository, sw *Switch) *WorkspaceRepository {
+	return &WorkspaceRepository{inner: inner, sw: sw}
+}
+
+func (r *WorkspaceRepository) List(ctx context.Context) ([]*workspace.Workspace, error) {
+	if r.sw.Offline() {
+		return nil, ErrOfflineUnavailable
+	}
+	return r.inner.List(ctx)
+}
+
+func (r *WorkspaceRepository) FindByID(ctx context.Context, id workspace.WorkspaceID) (*workspace.Workspace, error) {
+	if r.sw.Offline() {
+		return nil, workspace.ErrWorkspaceNotFound
+	}
+	return r.inner.FindByID(ctx, id)
+}
+
+var (
+	_ domain.Repository    = (*Repository)(nil)
+	_ workspace.Repository = (*WorkspaceRepository)(nil)
+)
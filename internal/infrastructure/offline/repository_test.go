@@ -0,0 +1,122 @@
+package offline_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/workspace"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/offline"
+)
+
+// countingRepo records how many calls reached it.
+type countingRepo struct {
+	calls int
+}
+
+func (r *countingRepo) FindByID(_ context.Context, id domain.MeetingID) (*domain.Meeting, error) {
+	r.calls++
+	return domain.New(id, "Standup", time.Now().UTC(), domain.SourceZoom, nil)
+}
+func (r *countingRepo) List(_ context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	r.calls++
+	return nil, nil
+}
+func (r *countingRepo) GetTranscript(_ context.Context, _ domain.MeetingID) (*domain.Transcript, error) {
+	r.calls++
+	return nil, nil
+}
+func (r *countingRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	r.calls++
+	return nil, nil
+}
+func (r *countingRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
+	r.calls++
+	return nil, nil
+}
+func (r *countingRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	r.calls++
+	return &domain.SyncResult{}, nil
+}
+
+type countingWorkspaceRepo struct {
+	calls int
+}
+
+func (r *countingWorkspaceRepo) List(_ context.Context) ([]*workspace.Workspace, error) {
+	r.calls++
+	return nil, nil
+}
+func (r *countingWorkspaceRepo) FindByID(_ context.Context, _ workspace.WorkspaceID) (*workspace.Workspace, error) {
+	r.calls++
+	return nil, nil
+}
+
+func TestRepository_OfflineRefusesCalls(t *testing.T) {
+	inner := &countingRepo{}
+	sw := &offline.Switch{}
+	sw.SetOffline(true)
+	repo := offline.NewRepository(inner, sw)
+	ctx := context.Background()
+
+	if _, err := repo.FindByID(ctx, "m-1"); !errors.Is(err, domain.ErrMeetingNotFound) {
+		t.Errorf("FindByID: got %v, want %v", err, domain.ErrMeetingNotFound)
+	}
+	if _, err := repo.List(ctx, domain.ListFilter{}); !errors.Is(err, offline.ErrOfflineUnavailable) {
+		t.Errorf("List: got %v, want %v", err, offline.ErrOfflineUnavailable)
+	}
+	if _, err := repo.GetTranscript(ctx, "m-1"); !errors.Is(err, offline.ErrOfflineUnavailable) {
+		t.Errorf("GetTranscript: got %v, want %v", err, offline.ErrOfflineUnavailable)
+	}
+	if _, err := repo.SearchTranscripts(ctx, "q", domain.ListFilter{}); !errors.Is(err, offline.ErrOfflineUnavailable) {
+		t.Errorf("SearchTranscripts: got %v, want %v", err, offline.ErrOfflineUnavailable)
+	}
+	if _, err := repo.GetActionItems(ctx, "m-1"); !errors.Is(err, offline.ErrOfflineUnavailable) {
+		t.Errorf("GetActionItems: got %v, want %v", err, offline.ErrOfflineUnavailable)
+	}
+	if _, err := repo.Sync(ctx, nil); !errors.Is(err, offline.ErrOfflineUnavailable) {
+		t.Errorf("Sync: got %v, want %v", err, offline.ErrOfflineUnavailable)
+	}
+	if inner.calls != 0 {
+		t.Errorf("inner repository called %d times while offline", inner.calls)
+	}
+}
+
+func TestRepository_OnlineDelegates(t *testing.T) {
+	inner := &countingRepo{}
+	repo := offline.NewRepository(inner, &offline.Switch{})
+
+	if _, err := repo.FindByID(context.Background(), "m-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := repo.Sync(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inner.calls != 2 {
+		t.Errorf("got %d inner calls, want 2", inner.calls)
+	}
+}
+
+func TestWorkspaceRepository_Offline(t *testing.T) {
+	inner := &countingWorkspaceRepo{}
+	sw := &offline.Switch{}
+	sw.SetOffline(true)
+	repo := offline.NewWorkspaceRepository(inner, sw)
+
+	if _, err := repo.List(context.Background()); !errors.Is(err, offline.ErrOfflineUnavailable) {
+		t.Errorf("List: got %v, want %v", err, offline.ErrOfflineUnavailable)
+	}
+	if _, err := repo.FindByID(context.Background(), "ws-1"); !errors.Is(err, workspace.ErrWorkspaceNotFound) {
+		t.Errorf("FindByID: got %v, want %v", err, workspace.ErrWorkspaceNotFound)
+	}
+
+	sw.SetOffline(false)
+	if _, err := repo.List(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inner.calls != 1 {
+		t.Errorf("got %d inner calls, want 1", inner.calls)
+	}
+}
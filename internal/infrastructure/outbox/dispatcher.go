@@ -12,31 +12,50 @@ import (
 var writeEventTypes = map[string]bool{
 	"note.added":            true,
 	"note.deleted":          true,
+	"notes.cleared":         true,
 	"action_item.completed": true,
 	"action_item.updated":   true,
 }
 
+// RetryPolicy controls how often a failed inner dispatch is retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of dispatch attempts, including the
+	// first. Values below 1 mean a single attempt.
+	MaxAttempts int
+	// Backoff is the delay before the first retry; it doubles after each.
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy retries twice, after 100ms and 200ms.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, Backoff: 100 * time.Millisecond}
+
 // Dispatcher decorates a domain.EventDispatcher, persisting write events
 // to an outbox table alongside dispatching them to MCP sessions.
 type Dispatcher struct {
 	inner domain.EventDispatcher
 	store Store
+	retry RetryPolicy
 }
 
-// NewDispatcher creates a new outbox dispatcher decorator.
+// NewDispatcher creates a new outbox dispatcher decorator using
+// DefaultRetryPolicy.
 func NewDispatcher(inner domain.EventDispatcher, store Store) *Dispatcher {
-	return &Dispatcher{inner: inner, store: store}
+	return &Dispatcher{inner: inner, store: store, retry: DefaultRetryPolicy}
 }
 
-// Dispatch forwards all events to the inner dispatcher, and additionally
-// persists write-related events to the outbox for future upstream sync.
-func (d *Dispatcher) Dispatch(ctx context.Context, events []domain.DomainEvent) error {
-	// Always dispatch to inner (MCP sessions) first
-	if err := d.inner.Dispatch(ctx, events); err != nil {
-		return err
-	}
+// SetRetryPolicy replaces the retry policy for failed inner dispatches.
+func (d *Dispatcher) SetRetryPolicy(p RetryPolicy) {
+	d.retry = p
+}
 
-	// Persist write events to outbox
+// Dispatch persists write-related events to the outbox for future upstream
+// sync, then forwards all events to the inner dispatcher. Once the inner
+// dispatch succeeds the entries are marked synced. A failed inner
+// dispatch is retried per the retry policy, recording each failure in the
+// entries' attempt count; once attempts run out the entries are marked
+// failed and drop out of ListPending.
+func (d *Dispatcher) Dispatch(ctx context.Context, events []domain.DomainEvent) error {
+	var ids []string
 	for _, event := range events {
 		if writeEventTypes[event.EventName()] {
 			entry := Entry{
@@ -48,9 +67,117 @@ func (d *Dispatcher) Dispatch(ctx context.Context, events []domain.DomainEvent)
 			if err := d.store.Append(entry); err != nil {
 				return fmt.Errorf("outbox append %s: %w", event.EventName(), err)
 			}
+			ids = append(ids, entry.ID)
+		}
+	}
+
+	dispatchErr, err := d.deliver(ctx, ids, events)
+	if err != nil {
+		return err
+	}
+	return dispatchErr
+}
+
+// Redeliver moves the failed entries, of eventType when it is non-empty,
+// back to pending and dispatches each one through the inner dispatcher
+// under the retry policy, marking it synced or failed again. Entries are
+// replayed with their stored event type, timestamp, and payload. Only the
+// entries listed up front are reset, so one that fails meanwhile stays
+// failed for the next redelivery. It returns how many were delivered and
+// how many failed again.
+func (d *Dispatcher) Redeliver(ctx context.Context, eventType string) (delivered, failed int, err error) {
+	listed, err := d.store.ListFailed()
+	if err != nil {
+		return 0, 0, fmt.Errorf("outbox list failed: %w", err)
+	}
+	var (
+		entries []Entry
+		ids     []string
+	)
+	for _, entry := range listed {
+		if eventType != "" && entry.EventType != eventType {
+			continue
+		}
+		entries = append(entries, entry)
+		ids = append(ids, entry.ID)
+	}
+	if _, err := d.store.ResetFailed(ids); err != nil {
+		return 0, 0, fmt.Errorf("outbox reset failed: %w", err)
+	}
+
+	for _, entry := range entries {
+		dispatchErr, err := d.deliver(ctx, []string{entry.ID}, []domain.DomainEvent{storedEvent{entry: entry}})
+		if err != nil {
+			return delivered, failed, err
+		}
+		if dispatchErr != nil {
+			failed++
+		} else {
+			delivered++
+		}
+	}
+	return delivered, failed, nil
+}
+
+// deliver dispatches events to the inner dispatcher under the retry
+// policy and records the outcome against the entries in ids. dispatchErr
+// is the last inner error when every attempt failed; err is an outbox
+// store error.
+func (d *Dispatcher) deliver(ctx context.Context, ids []string, events []domain.DomainEvent) (dispatchErr, err error) {
+	maxAttempts := max(d.retry.MaxAttempts, 1)
+	backoff := d.retry.Backoff
+	for attempt := 1; ; attempt++ {
+		dispatchErr = d.inner.Dispatch(ctx, events)
+		if dispatchErr == nil {
+			return nil, d.markSynced(ids)
+		}
+		if attempt == maxAttempts {
+			return dispatchErr, d.markFailed(ids)
+		}
+		for _, id := range ids {
+			if err := d.store.RecordAttempt(id); err != nil {
+				return dispatchErr, fmt.Errorf("outbox record attempt %s: %w", id, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return dispatchErr, d.markFailed(ids)
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// markSynced records the entries as delivered.
+func (d *Dispatcher) markSynced(ids []string) error {
+	for _, id := range ids {
+		if err := d.store.MarkSynced(id); err != nil {
+			return fmt.Errorf("outbox mark synced %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// markFailed moves the entries to the terminal failed state.
+func (d *Dispatcher) markFailed(ids []string) error {
+	for _, id := range ids {
+		if err := d.store.MarkFailed(id); err != nil {
+			return fmt.Errorf("outbox mark failed %s: %w", id, err)
 		}
 	}
 	return nil
 }
 
+// storedEvent replays a persisted outbox entry as a domain event.
+type storedEvent struct {
+	entry Entry
+}
+
+func (e storedEvent) EventName() string     { return e.entry.EventType }
+func (e storedEvent) OccurredAt() time.Time { return e.entry.CreatedAt }
+
+// Payload returns the event data as it was persisted.
+func (e storedEvent) Payload() []byte { return e.entry.Payload }
+
 var _ domain.EventDispatcher = (*Dispatcher)(nil)
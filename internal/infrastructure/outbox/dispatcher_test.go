@@ -2,6 +2,7 @@ package outbox_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -13,9 +14,11 @@ import (
 type mockInnerDispatcher struct {
 	dispatched []domain.DomainEvent
 	err        error
+	calls      int
 }
 
 func (m *mockInnerDispatcher) Dispatch(_ context.Context, events []domain.DomainEvent) error {
+	m.calls++
 	if m.err != nil {
 		return m.err
 	}
@@ -29,13 +32,39 @@ type mockOutboxStore struct {
 }
 
 func (m *mockOutboxStore) Append(entry outbox.Entry) error {
+	entry.Status = "pending"
 	m.entries = append(m.entries, entry)
 	return nil
 }
 
 func (m *mockOutboxStore) ListPending() ([]outbox.Entry, error) { return m.entries, nil }
-func (m *mockOutboxStore) MarkSynced(_ string) error            { return nil }
-func (m *mockOutboxStore) MarkFailed(_ string) error            { return nil }
+
+func (m *mockOutboxStore) MarkSynced(id string) error {
+	m.update(id, func(e *outbox.Entry) { e.Status = "synced" })
+	return nil
+}
+
+func (m *mockOutboxStore) RecordAttempt(id string) error {
+	m.update(id, func(e *outbox.Entry) { e.Attempts++ })
+	return nil
+}
+
+func (m *mockOutboxStore) MarkFailed(id string) error {
+	m.update(id, func(e *outbox.Entry) { e.Attempts++; e.Status = "failed" })
+	return nil
+}
+
+func (m *mockOutboxStore) ListFailed() ([]outbox.Entry, error) { return nil, nil }
+func (m *mockOutboxStore) ResetFailed(_ []string) (int, error) { return 0, nil }
+func (m *mockOutboxStore) Counts() (outbox.Counts, error)      { return outbox.Counts{}, nil }
+
+func (m *mockOutboxStore) update(id string, fn func(*outbox.Entry)) {
+	for i := range m.entries {
+		if m.entries[i].ID == id {
+			fn(&m.entries[i])
+		}
+	}
+}
 
 func TestOutboxDispatcher_PersistsWriteEvents(t *testing.T) {
 	inner := &mockInnerDispatcher{}
@@ -55,13 +84,16 @@ func TestOutboxDispatcher_PersistsWriteEvents(t *testing.T) {
 		t.Errorf("inner got %d events, want 1", len(inner.dispatched))
 	}
 
-	// Outbox should have persisted the write event
+	// Outbox should have persisted the write event, delivered
 	if len(store.entries) != 1 {
 		t.Fatalf("outbox got %d entries, want 1", len(store.entries))
 	}
 	if store.entries[0].EventType != "action_item.completed" {
 		t.Errorf("got event type %q", store.entries[0].EventType)
 	}
+	if store.entries[0].Status != "synced" {
+		t.Errorf("got status %q after a successful dispatch, want synced", store.entries[0].Status)
+	}
 }
 
 func TestOutboxDispatcher_SkipsNonWriteEvents(t *testing.T) {
@@ -113,22 +145,190 @@ func TestOutboxDispatcher_MixedEvents(t *testing.T) {
 	}
 }
 
-func TestOutboxDispatcher_InnerError_PropagatesWithoutOutbox(t *testing.T) {
+func TestOutboxDispatcher_InnerError_RetriesThenMarksFailed(t *testing.T) {
 	inner := &mockInnerDispatcher{err: context.DeadlineExceeded}
 	store := &mockOutboxStore{}
 	d := outbox.NewDispatcher(inner, store)
+	d.SetRetryPolicy(outbox.RetryPolicy{MaxAttempts: 3})
 
 	events := []domain.DomainEvent{
 		domain.NewActionItemCompletedEvent("m-1", "ai-1"),
 	}
 
 	err := d.Dispatch(context.Background(), events)
-	if err == nil {
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want inner error", err)
+	}
+	if inner.calls != 3 {
+		t.Errorf("inner called %d times, want 3", inner.calls)
+	}
+	if len(store.entries) != 1 {
+		t.Fatalf("outbox got %d entries, want 1", len(store.entries))
+	}
+	if got := store.entries[0]; got.Status != "failed" || got.Attempts != 3 {
+		t.Errorf("got status %q after %d attempts, want failed after 3", got.Status, got.Attempts)
+	}
+}
+
+func TestOutboxDispatcher_InnerRecovers(t *testing.T) {
+	inner := &flakyDispatcher{failures: 1}
+	store := &mockOutboxStore{}
+	d := outbox.NewDispatcher(inner, store)
+	d.SetRetryPolicy(outbox.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond})
+
+	events := []domain.DomainEvent{
+		domain.NewActionItemCompletedEvent("m-1", "ai-1"),
+	}
+
+	if err := d.Dispatch(context.Background(), events); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if got := store.entries[0]; got.Status != "synced" || got.Attempts != 1 {
+		t.Errorf("got status %q after %d attempts, want synced after 1", got.Status, got.Attempts)
+	}
+}
+
+func TestOutboxDispatcher_AlwaysFailing_SQLiteTerminalState(t *testing.T) {
+	inner := &mockInnerDispatcher{err: errors.New("session closed")}
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	d := outbox.NewDispatcher(inner, store)
+	d.SetRetryPolicy(outbox.RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond})
+
+	events := []domain.DomainEvent{
+		domain.NewActionItemCompletedEvent("m-1", "ai-1"),
+	}
+	if err := d.Dispatch(context.Background(), events); err == nil {
 		t.Fatal("expected error from inner dispatcher")
 	}
 
-	// Outbox should not be called when inner fails
-	if len(store.entries) != 0 {
-		t.Errorf("outbox got %d entries, want 0 (inner failed)", len(store.entries))
+	pending, err := store.ListPending()
+	if err != nil {
+		t.Fatalf("list pending: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("got %d pending, want 0 (exhausted entry should be terminal)", len(pending))
+	}
+}
+
+// flakyDispatcher fails its first calls, then succeeds.
+type flakyDispatcher struct {
+	failures int
+}
+
+func (f *flakyDispatcher) Dispatch(_ context.Context, _ []domain.DomainEvent) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("transient")
+	}
+	return nil
+}
+
+func TestOutboxDispatcher_Redeliver(t *testing.T) {
+	inner := &mockInnerDispatcher{err: errors.New("session closed")}
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	d := outbox.NewDispatcher(inner, store)
+	d.SetRetryPolicy(outbox.RetryPolicy{MaxAttempts: 1})
+
+	events := []domain.DomainEvent{
+		domain.NewActionItemCompletedEvent("m-1", "ai-1"),
+	}
+	if err := d.Dispatch(context.Background(), events); err == nil {
+		t.Fatal("expected error from inner dispatcher")
+	}
+	if err := d.Dispatch(context.Background(), []domain.DomainEvent{domain.NewActionItemUpdatedEvent("m-1", "ai-2", "x")}); err == nil {
+		t.Fatal("expected error from inner dispatcher")
+	}
+
+	inner.err = nil
+	delivered, failed, err := d.Redeliver(context.Background(), "action_item.completed")
+	if err != nil {
+		t.Fatalf("redeliver: %v", err)
+	}
+	if delivered != 1 || failed != 0 {
+		t.Errorf("got %d delivered, %d failed, want 1 and 0", delivered, failed)
+	}
+	if len(inner.dispatched) != 1 || inner.dispatched[0].EventName() != "action_item.completed" {
+		t.Fatalf("got dispatched %v, want the stored action_item.completed event", inner.dispatched)
+	}
+
+	counts, err := store.Counts()
+	if err != nil {
+		t.Fatalf("counts: %v", err)
+	}
+	if counts.Synced != 1 || counts.Failed != 1 || counts.Pending != 0 {
+		t.Errorf("got %+v, want the redelivered entry synced and the other still failed", counts)
+	}
+}
+
+// lateFailureStore fails another entry right after ListFailed, as a
+// concurrent dispatch could.
+type lateFailureStore struct {
+	*outbox.SQLiteStore
+	late outbox.Entry
+}
+
+func (s *lateFailureStore) ListFailed() ([]outbox.Entry, error) {
+	entries, err := s.SQLiteStore.ListFailed()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Append(s.late); err != nil {
+		return nil, err
+	}
+	return entries, s.MarkFailed(s.late.ID)
+}
+
+func TestOutboxDispatcher_Redeliver_ResetsOnlyListedEntries(t *testing.T) {
+	inner := &mockInnerDispatcher{err: errors.New("session closed")}
+	store := &lateFailureStore{
+		SQLiteStore: outbox.NewSQLiteStore(openTestDB(t)),
+		late:        outbox.Entry{ID: "late", EventType: "note.added", CreatedAt: time.Now().UTC()},
+	}
+	d := outbox.NewDispatcher(inner, store)
+	d.SetRetryPolicy(outbox.RetryPolicy{MaxAttempts: 1})
+	if err := d.Dispatch(context.Background(), []domain.DomainEvent{domain.NewActionItemCompletedEvent("m-1", "ai-1")}); err == nil {
+		t.Fatal("expected error from inner dispatcher")
+	}
+
+	inner.err = nil
+	delivered, _, err := d.Redeliver(context.Background(), "")
+	if err != nil {
+		t.Fatalf("redeliver: %v", err)
+	}
+	if delivered != 1 {
+		t.Errorf("got %d delivered, want 1", delivered)
+	}
+	counts, err := store.Counts()
+	if err != nil {
+		t.Fatalf("counts: %v", err)
+	}
+	if counts.Failed != 1 || counts.Pending != 0 {
+		t.Errorf("got %+v, want the late entry still failed rather than reset to pending", counts)
+	}
+}
+
+func TestOutboxDispatcher_Redeliver_FailsAgain(t *testing.T) {
+	inner := &mockInnerDispatcher{err: errors.New("session closed")}
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	d := outbox.NewDispatcher(inner, store)
+	d.SetRetryPolicy(outbox.RetryPolicy{MaxAttempts: 1})
+
+	if err := d.Dispatch(context.Background(), []domain.DomainEvent{domain.NewActionItemCompletedEvent("m-1", "ai-1")}); err == nil {
+		t.Fatal("expected error from inner dispatcher")
+	}
+
+	delivered, failed, err := d.Redeliver(context.Background(), "")
+	if err != nil {
+		t.Fatalf("redeliver: %v", err)
+	}
+	if delivered != 0 || failed != 1 {
+		t.Errorf("got %d delivered, %d failed, want 0 and 1", delivered, failed)
+	}
+	failedEntries, err := store.ListFailed()
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(failedEntries) != 1 {
+		t.Errorf("got %d failed entries, want 1", len(failedEntries))
 	}
 }
@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// DefaultMaxPending is the pending backlog above which HealthHandler
+// reports the outbox unhealthy when no threshold is set.
+const DefaultMaxPending = 1000
+
+// HealthHandler reports the outbox backlog as JSON. A pending count above
+// the threshold usually means deliveries are stuck, so it answers 503
+// with status "unhealthy"; failed entries are reported but do not affect
+// the status, since they wait for `outbox retry` by design.
+type HealthHandler struct {
+	store      Store
+	maxPending int
+}
+
+// NewHealthHandler creates a health handler for store; a maxPending of
+// zero or less uses DefaultMaxPending.
+func NewHealthHandler(store Store, maxPending int) *HealthHandler {
+	if maxPending <= 0 {
+		maxPending = DefaultMaxPending
+	}
+	return &HealthHandler{store: store, maxPending: maxPending}
+}
+
+type healthResponse struct {
+	Status     string `json:"status"`
+	Pending    int    `json:"pending"`
+	Failed     int    `json:"failed"`
+	Synced     int    `json:"synced"`
+	MaxPending int    `json:"max_pending"`
+}
+
+func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	counts, err := h.store.Counts()
+	if err != nil {
+		http.Error(w, "failed to count outbox entries", http.StatusInternalServerError)
+		return
+	}
+
+	resp := healthResponse{
+		Status:     "ok",
+		Pending:    counts.Pending,
+		Failed:     counts.Failed,
+		Synced:     counts.Synced,
+		MaxPending: h.maxPending,
+	}
+	code := http.StatusOK
+	if counts.Pending > h.maxPending {
+		resp.Status = "unhealthy"
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,81 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/outbox"
+)
+
+func TestHealthHandler_ReportsBacklog(t *testing.T) {
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	h := outbox.NewHealthHandler(store, 50)
+
+	get := func() (int, map[string]any) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/outbox", nil))
+		var body map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("decode %q: %v", rec.Body.String(), err)
+		}
+		return rec.Code, body
+	}
+
+	code, body := get()
+	if code != http.StatusOK || body["status"] != "ok" || body["pending"] != float64(0) {
+		t.Errorf("got %d %v for an empty outbox, want 200 ok", code, body)
+	}
+
+	now := time.Now().UTC()
+	for i := 0; i < 60; i++ {
+		if err := store.Append(outbox.Entry{ID: fmt.Sprintf("evt-%d", i), EventType: "note.added", CreatedAt: now}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	if err := store.MarkFailed("evt-0"); err != nil {
+		t.Fatalf("mark failed: %v", err)
+	}
+
+	code, body = get()
+	if code != http.StatusServiceUnavailable || body["status"] != "unhealthy" {
+		t.Errorf("got %d %v, want 503 unhealthy", code, body)
+	}
+	if body["pending"] != float64(59) || body["failed"] != float64(1) || body["max_pending"] != float64(50) {
+		t.Errorf("got %v, want 59 pending, 1 failed, max 50", body)
+	}
+}
+
+func TestHealthHandler_RejectsNonGet(t *testing.T) {
+	rec := httptest.NewRecorder()
+	outbox.NewHealthHandler(outbox.NewSQLiteStore(openTestDB(t)), 0).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/health/outbox", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("got %d, want 405", rec.Code)
+	}
+}
+
+func TestHealthHandler_DeliveredEventsDoNotCountAsBacklog(t *testing.T) {
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	d := outbox.NewDispatcher(&mockInnerDispatcher{}, store)
+	for i := 0; i < 20; i++ {
+		if err := d.Dispatch(context.Background(), []domain.DomainEvent{domain.NewActionItemCompletedEvent("m-1", "ai-1")}); err != nil {
+			t.Fatalf("dispatch: %v", err)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	outbox.NewHealthHandler(store, 10).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/outbox", nil))
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+	if rec.Code != http.StatusOK || body["pending"] != float64(0) || body["synced"] != float64(20) {
+		t.Errorf("got %d %v, want 200 with all 20 entries synced", rec.Code, body)
+	}
+}
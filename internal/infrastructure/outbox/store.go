@@ -6,6 +6,7 @@ package outbox
 import (
 	"database/sql"
 	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -20,12 +21,31 @@ type Entry struct {
 	Attempts  int
 }
 
+// Counts is how many outbox entries are in each state.
+type Counts struct {
+	Pending int
+	Failed  int
+	Synced  int
+}
+
 // Store is the interface for outbox persistence.
 type Store interface {
 	Append(entry Entry) error
 	ListPending() ([]Entry, error)
 	MarkSynced(id string) error
+	// RecordAttempt counts a failed delivery attempt; the entry stays pending.
+	RecordAttempt(id string) error
+	// MarkFailed counts the final failed attempt and moves the entry to the
+	// terminal failed state, excluding it from ListPending.
 	MarkFailed(id string) error
+	// ListFailed returns the entries in the failed state, oldest first.
+	ListFailed() ([]Entry, error)
+	// ResetFailed moves the entries in ids that are still failed back to
+	// pending with their attempt count cleared, so they are delivered
+	// again. It returns how many were reset.
+	ResetFailed(ids []string) (int, error)
+	// Counts returns how many entries are pending, failed, and synced.
+	Counts() (Counts, error)
 }
 
 // SQLiteStore implements Store using SQLite.
@@ -51,8 +71,17 @@ func (s *SQLiteStore) Append(entry Entry) error {
 }
 
 func (s *SQLiteStore) ListPending() ([]Entry, error) {
+	return s.listByStatus("pending")
+}
+
+func (s *SQLiteStore) ListFailed() ([]Entry, error) {
+	return s.listByStatus("failed")
+}
+
+func (s *SQLiteStore) listByStatus(status string) ([]Entry, error) {
 	rows, err := s.db.Query(
-		"SELECT id, event_type, payload, status, created_at, synced_at, attempts FROM outbox_entries WHERE status = 'pending' ORDER BY created_at ASC",
+		"SELECT id, event_type, payload, status, created_at, synced_at, attempts FROM outbox_entries WHERE status = ? ORDER BY created_at ASC",
+		status,
 	)
 	if err != nil {
 		return nil, err
@@ -86,6 +115,14 @@ func (s *SQLiteStore) MarkSynced(id string) error {
 	return err
 }
 
+func (s *SQLiteStore) RecordAttempt(id string) error {
+	_, err := s.db.Exec(
+		"UPDATE outbox_entries SET attempts = attempts + 1 WHERE id = ?",
+		id,
+	)
+	return err
+}
+
 func (s *SQLiteStore) MarkFailed(id string) error {
 	_, err := s.db.Exec(
 		"UPDATE outbox_entries SET status = 'failed', attempts = attempts + 1 WHERE id = ?",
@@ -94,6 +131,51 @@ func (s *SQLiteStore) MarkFailed(id string) error {
 	return err
 }
 
+func (s *SQLiteStore) ResetFailed(ids []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	res, err := s.db.Exec(
+		"UPDATE outbox_entries SET status = 'pending', attempts = 0 WHERE status = 'failed' AND id IN (?"+strings.Repeat(", ?", len(ids)-1)+")",
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (s *SQLiteStore) Counts() (Counts, error) {
+	rows, err := s.db.Query("SELECT status, COUNT(*) FROM outbox_entries GROUP BY status")
+	if err != nil {
+		return Counts{}, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var c Counts
+	for rows.Next() {
+		var status string
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return Counts{}, err
+		}
+		switch status {
+		case "pending":
+			c.Pending = n
+		case "failed":
+			c.Failed = n
+		case "synced":
+			c.Synced = n
+		}
+	}
+	return c, rows.Err()
+}
+
 // MarshalEventPayload is a helper to serialize event data to JSON.
 func MarshalEventPayload(v any) []byte {
 	data, _ := json.Marshal(v)
@@ -108,6 +108,37 @@ func TestSQLiteStore_MarkFailed(t *testing.T) {
 	}
 }
 
+func TestSQLiteStore_RecordAttempt(t *testing.T) {
+	store := outbox.NewSQLiteStore(openTestDB(t))
+
+	entry := outbox.Entry{
+		ID:        "evt-1",
+		EventType: "note.added",
+		Payload:   []byte(`{}`),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := store.Append(entry); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	for range 2 {
+		if err := store.RecordAttempt("evt-1"); err != nil {
+			t.Fatalf("record attempt: %v", err)
+		}
+	}
+
+	// Entries with recorded attempts stay pending
+	pending, err := store.ListPending()
+	if err != nil {
+		t.Fatalf("list pending: %v", err)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("got %d pending, want 1", len(pending))
+	}
+	if pending[0].Attempts != 2 {
+		t.Errorf("got %d attempts, want 2", pending[0].Attempts)
+	}
+}
+
 func TestSQLiteStore_ListPending_Empty(t *testing.T) {
 	store := outbox.NewSQLiteStore(openTestDB(t))
 
@@ -150,3 +181,67 @@ func TestMarshalEventPayload(t *testing.T) {
 		t.Errorf("got %q", string(data))
 	}
 }
+
+func TestSQLiteStore_ResetFailed(t *testing.T) {
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	now := time.Now().UTC()
+	for i, eventType := range []string{"note.added", "note.added", "action_item.completed"} {
+		id := fmt.Sprintf("evt-%d", i)
+		if err := store.Append(outbox.Entry{ID: id, EventType: eventType, CreatedAt: now.Add(time.Duration(i) * time.Second)}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+		if err := store.MarkFailed(id); err != nil {
+			t.Fatalf("mark failed: %v", err)
+		}
+	}
+
+	failed, err := store.ListFailed()
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(failed) != 3 || failed[0].ID != "evt-0" || failed[0].Attempts != 1 {
+		t.Fatalf("got %+v, want 3 failed entries oldest first", failed)
+	}
+
+	n, err := store.ResetFailed([]string{"evt-2"})
+	if err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("reset %d entries, want 1", n)
+	}
+	pending, _ := store.ListPending()
+	if len(pending) != 1 || pending[0].ID != "evt-2" || pending[0].Attempts != 0 {
+		t.Errorf("got pending %+v, want evt-2 with attempts cleared", pending)
+	}
+
+	if n, _ := store.ResetFailed([]string{"evt-0", "evt-1", "evt-2"}); n != 2 {
+		t.Errorf("reset %d entries, want 2", n)
+	}
+	if failed, _ := store.ListFailed(); len(failed) != 0 {
+		t.Errorf("got %d failed entries after reset, want 0", len(failed))
+	}
+}
+
+func TestSQLiteStore_Counts(t *testing.T) {
+	store := outbox.NewSQLiteStore(openTestDB(t))
+	for i := 0; i < 4; i++ {
+		if err := store.Append(outbox.Entry{ID: fmt.Sprintf("evt-%d", i), EventType: "note.added", CreatedAt: time.Now().UTC()}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	if err := store.MarkSynced("evt-0"); err != nil {
+		t.Fatalf("mark synced: %v", err)
+	}
+	if err := store.MarkFailed("evt-1"); err != nil {
+		t.Fatalf("mark failed: %v", err)
+	}
+
+	counts, err := store.Counts()
+	if err != nil {
+		t.Fatalf("counts: %v", err)
+	}
+	if want := (outbox.Counts{Pending: 2, Failed: 1, Synced: 1}); counts != want {
+		t.Errorf("got %+v, want %+v", counts, want)
+	}
+}
@@ -20,11 +20,15 @@ func NewEngine(result *LoadResult) *Engine {
 
 // CheckAccess evaluates whether a tool call is allowed.
 func (e *Engine) CheckAccess(tool string, ctx domainpolicy.MeetingContext) error {
-	effect := e.policy.Evaluate(tool, ctx)
-	if effect == domainpolicy.EffectDeny {
-		return domainpolicy.ErrAccessDenied
+	effect, rule := e.policy.Match(tool, ctx)
+	if effect != domainpolicy.EffectDeny {
+		return nil
 	}
-	return nil
+	denied := &domainpolicy.DeniedError{}
+	if rule != nil {
+		denied.Rule, denied.Message = rule.Name, rule.Message
+	}
+	return denied
 }
 
 // Redact applies redaction rules to content.
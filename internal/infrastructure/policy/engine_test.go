@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"errors"
 	"testing"
 
 	domainpolicy "github.com/felixgeelhaar/acai/internal/domain/policy"
@@ -35,9 +36,25 @@ func TestEngine_CheckAccess_Denied(t *testing.T) {
 	err := engine.CheckAccess("get_transcript", domainpolicy.MeetingContext{
 		Tags: []string{"confidential"},
 	})
-	if err != domainpolicy.ErrAccessDenied {
+	if !errors.Is(err, domainpolicy.ErrAccessDenied) {
 		t.Errorf("expected ErrAccessDenied, got %v", err)
 	}
+	var denied *domainpolicy.DeniedError
+	if !errors.As(err, &denied) || denied.Rule != "block-transcripts" {
+		t.Errorf("expected the denying rule to be named, got %v", err)
+	}
+}
+
+func TestEngine_CheckAccess_DefaultDenyHasNoRule(t *testing.T) {
+	engine := NewEngine(&LoadResult{
+		Policy: domainpolicy.Policy{DefaultEffect: domainpolicy.EffectDeny},
+	})
+
+	var denied *domainpolicy.DeniedError
+	err := engine.CheckAccess("get_meeting", domainpolicy.MeetingContext{})
+	if !errors.As(err, &denied) || denied.Rule != "" {
+		t.Errorf("got %v, want a default deny without a rule", err)
+	}
 }
 
 func TestEngine_CheckAccess_DeniedToolNotMatched(t *testing.T) {
@@ -135,7 +152,7 @@ redaction:
 	err = engine.CheckAccess("get_transcript", domainpolicy.MeetingContext{
 		Tags: []string{"confidential"},
 	})
-	if err != domainpolicy.ErrAccessDenied {
+	if !errors.Is(err, domainpolicy.ErrAccessDenied) {
 		t.Errorf("expected denied, got %v", err)
 	}
 
@@ -23,6 +23,7 @@ type yamlRule struct {
 	Effect     string         `yaml:"effect"`
 	Tools      []string       `yaml:"tools"`
 	Conditions yamlConditions `yaml:"conditions"`
+	Message    string         `yaml:"message"`
 }
 
 type yamlConditions struct {
@@ -45,6 +46,10 @@ type yamlRedactRule struct {
 type LoadResult struct {
 	Policy    domainpolicy.Policy
 	Redaction domainpolicy.RedactionConfig
+
+	// problems are values the loader tolerated, such as an unknown effect
+	// read as allow. Validate reports them.
+	problems []string
 }
 
 // LoadFromFile reads and parses a YAML policy file.
@@ -63,9 +68,12 @@ func LoadFromBytes(data []byte) (*LoadResult, error) {
 		return nil, fmt.Errorf("parse policy YAML: %w", err)
 	}
 
+	var problems []string
 	defaultEffect := domainpolicy.EffectAllow
 	if yp.DefaultEffect == "deny" {
 		defaultEffect = domainpolicy.EffectDeny
+	} else if !knownEffect(yp.DefaultEffect) {
+		problems = append(problems, fmt.Sprintf("unknown default_effect %q, treated as allow", yp.DefaultEffect))
 	}
 
 	rules := make([]domainpolicy.Rule, len(yp.Rules))
@@ -73,6 +81,8 @@ func LoadFromBytes(data []byte) (*LoadResult, error) {
 		effect := domainpolicy.EffectAllow
 		if yr.Effect == "deny" {
 			effect = domainpolicy.EffectDeny
+		} else if !knownEffect(yr.Effect) {
+			problems = append(problems, fmt.Sprintf("%s: unknown effect %q, treated as allow", ruleLabel(i, yr.Name), yr.Effect))
 		}
 		rules[i] = domainpolicy.Rule{
 			Name:   yr.Name,
@@ -81,6 +91,7 @@ func LoadFromBytes(data []byte) (*LoadResult, error) {
 			Conditions: domainpolicy.Conditions{
 				MeetingTags: yr.Conditions.MeetingTags,
 			},
+			Message: yr.Message,
 		}
 	}
 
@@ -103,5 +114,11 @@ func LoadFromBytes(data []byte) (*LoadResult, error) {
 			Enabled: yp.Redaction.Enabled,
 			Rules:   redactRules,
 		},
+		problems: problems,
 	}, nil
 }
+
+// knownEffect reports whether s is a valid effect; empty means allow.
+func knownEffect(s string) bool {
+	return s == "" || s == string(domainpolicy.EffectAllow) || s == string(domainpolicy.EffectDeny)
+}
@@ -20,6 +20,7 @@ rules:
     conditions:
       meeting_tags:
         - confidential
+    message: Confidential transcripts stay in Granola
 redaction:
   enabled: true
   rules:
@@ -56,6 +57,9 @@ redaction:
 	if len(rule.Conditions.MeetingTags) != 1 || rule.Conditions.MeetingTags[0] != "confidential" {
 		t.Errorf("conditions = %v", rule.Conditions)
 	}
+	if rule.Message != "Confidential transcripts stay in Granola" {
+		t.Errorf("rule message = %q", rule.Message)
+	}
 
 	if !result.Redaction.Enabled {
 		t.Error("redaction should be enabled")
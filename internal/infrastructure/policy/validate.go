@@ -0,0 +1,164 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+
+	domainpolicy "github.com/felixgeelhaar/acai/internal/domain/policy"
+)
+
+// Validate reports everything in the policy that will not behave as
+// written: values the loader tolerated, tool names outside knownTools,
+// rules an earlier rule overrides with the opposite effect, rules no call
+// can reach, and redaction rules the redactor would skip. A nil
+// knownTools skips the tool name check. No problems means the policy is
+// valid.
+func (r *LoadResult) Validate(knownTools []string) []string {
+	problems := append([]string(nil), r.problems...)
+
+	known := make(map[string]bool, len(knownTools))
+	for _, t := range knownTools {
+		known[t] = true
+	}
+	for j, rule := range r.Policy.Rules {
+		if len(known) > 0 {
+			for _, t := range rule.Tools {
+				if !known[t] {
+					problems = append(problems, fmt.Sprintf("%s: unknown tool %q", ruleLabel(j, rule.Name), t))
+				}
+			}
+		}
+		problems = append(problems, shadowProblems(r.Policy.Rules, j)...)
+	}
+
+	for i, rr := range r.Redaction.Rules {
+		if p := redactionProblem(rr); p != "" {
+			problems = append(problems, fmt.Sprintf("redaction rule #%d: %s", i+1, p))
+		}
+	}
+	return problems
+}
+
+// shadowProblems checks rule j against the rules before it. Evaluation is
+// first-match-wins, so an earlier rule that matches every call rule j
+// would, for some tool, decides that tool before rule j is reached.
+func shadowProblems(rules []domainpolicy.Rule, j int) []string {
+	rule := rules[j]
+	label := ruleLabel(j, rule.Name)
+
+	// decidedBy maps each of rule j's tools to the first earlier rule
+	// that matches all of its calls for that tool.
+	decidedBy := make(map[string]int)
+	for i := 0; i < j; i++ {
+		earlier := rules[i]
+		if !coversConditions(earlier.Conditions, rule.Conditions) {
+			continue
+		}
+		if len(earlier.Tools) == 0 {
+			return []string{fmt.Sprintf("%s is unreachable: %s matches every call it would", label, ruleLabel(i, earlier.Name))}
+		}
+		for _, t := range earlier.Tools {
+			if _, ok := decidedBy[t]; !ok && appliesTo(rule, t) {
+				decidedBy[t] = i
+			}
+		}
+	}
+	// A rule for all tools is a fallback: earlier rules deciding some
+	// tools differently is the usual way to carve out exceptions.
+	if len(rule.Tools) == 0 {
+		return nil
+	}
+
+	tools := uniqueTools(rule.Tools)
+	if len(decidedBy) == len(tools) {
+		return []string{fmt.Sprintf("%s is unreachable: earlier rules match every call it would", label)}
+	}
+	var problems []string
+	for _, t := range tools {
+		i, ok := decidedBy[t]
+		if ok && rules[i].Effect != rule.Effect {
+			problems = append(problems, fmt.Sprintf("%s %s %s, but %s %s it first",
+				label, effectVerb(rule.Effect), t, ruleLabel(i, rules[i].Name), effectVerb(rules[i].Effect)))
+		}
+	}
+	return problems
+}
+
+// coversConditions reports whether every meeting later's conditions match
+// is also matched by earlier's. Tag conditions match on any tag, so that
+// holds when earlier has no tags or includes all of later's.
+func coversConditions(earlier, later domainpolicy.Conditions) bool {
+	if len(earlier.MeetingTags) == 0 {
+		return true
+	}
+	if len(later.MeetingTags) == 0 {
+		return false
+	}
+	for _, tag := range later.MeetingTags {
+		if !contains(earlier.MeetingTags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func appliesTo(rule domainpolicy.Rule, tool string) bool {
+	return len(rule.Tools) == 0 || contains(rule.Tools, tool)
+}
+
+func redactionProblem(rr domainpolicy.RedactionRule) string {
+	switch rr.Type {
+	case domainpolicy.RedactionEmails, domainpolicy.RedactionSpeakers:
+		return ""
+	case domainpolicy.RedactionKeywords:
+		if len(rr.Keywords) == 0 {
+			return "keywords rule has no keywords"
+		}
+	case domainpolicy.RedactionPatterns:
+		if rr.Pattern == "" {
+			return "patterns rule has no pattern"
+		}
+		if _, err := regexp.Compile(rr.Pattern); err != nil {
+			return fmt.Sprintf("invalid pattern: %v", err)
+		}
+	default:
+		return fmt.Sprintf("unknown type %q", rr.Type)
+	}
+	return ""
+}
+
+// ruleLabel names a rule in messages, falling back to its position.
+func ruleLabel(i int, name string) string {
+	if name == "" {
+		return fmt.Sprintf("rule #%d", i+1)
+	}
+	return fmt.Sprintf("rule %q", name)
+}
+
+func effectVerb(e domainpolicy.Effect) string {
+	if e == domainpolicy.EffectDeny {
+		return "denies"
+	}
+	return "allows"
+}
+
+func uniqueTools(tools []string) []string {
+	seen := make(map[string]bool, len(tools))
+	unique := make([]string, 0, len(tools))
+	for _, t := range tools {
+		if !seen[t] {
+			seen[t] = true
+			unique = append(unique, t)
+		}
+	}
+	return unique
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
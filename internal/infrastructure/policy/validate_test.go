@@ -0,0 +1,138 @@
+package policy
+
+import (
+	"strings"
+	"testing"
+)
+
+var testTools = []string{"get_transcript", "delete_note", "add_note", "export_embeddings"}
+
+func TestLoadResult_Validate_ValidPolicy(t *testing.T) {
+	result, err := LoadFromBytes([]byte(`
+default_effect: deny
+rules:
+  - name: allow-reads
+    effect: allow
+    tools: [get_transcript]
+  - name: confidential
+    effect: deny
+    tools: [export_embeddings]
+    conditions:
+      meeting_tags: [confidential]
+  - name: exports
+    effect: allow
+    tools: [export_embeddings]
+  - name: fallback
+    effect: deny
+redaction:
+  enabled: true
+  rules:
+    - type: patterns
+      pattern: "\\d{3}-\\d{4}"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if problems := result.Validate(testTools); len(problems) != 0 {
+		t.Errorf("got problems %q, want none", problems)
+	}
+}
+
+func TestLoadResult_Validate_ReportsProblems(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"unknown tool", `
+rules:
+  - name: typo
+    effect: deny
+    tools: [delete_notes]
+`, `rule "typo": unknown tool "delete_notes"`},
+		{"unknown effect", `
+rules:
+  - effect: block
+    tools: [delete_note]
+`, `rule #1: unknown effect "block"`},
+		{"unknown default effect", `default_effect: reject`, `unknown default_effect "reject"`},
+		{"conflicting rules", `
+rules:
+  - name: no-deletes
+    effect: deny
+    tools: [delete_note]
+  - name: writes
+    effect: allow
+    tools: [add_note, delete_note]
+`, `rule "writes" allows delete_note, but rule "no-deletes" denies it first`},
+		{"after a catch-all", `
+rules:
+  - name: everything
+    effect: deny
+  - name: reads
+    effect: allow
+    tools: [get_transcript]
+`, `rule "reads" is unreachable: rule "everything" matches every call it would`},
+		{"covered by earlier rules", `
+rules:
+  - name: a
+    effect: allow
+    tools: [add_note]
+  - name: b
+    effect: allow
+    tools: [delete_note]
+    conditions:
+      meeting_tags: [team, private]
+  - name: c
+    effect: allow
+    tools: [add_note, delete_note]
+    conditions:
+      meeting_tags: [private]
+`, `rule "c" is unreachable: earlier rules match every call it would`},
+		{"bad redaction pattern", `
+redaction:
+  rules:
+    - type: patterns
+      pattern: "("
+`, "redaction rule #1: invalid pattern"},
+		{"unknown redaction type", `
+redaction:
+  rules:
+    - type: phones
+`, `redaction rule #1: unknown type "phones"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := LoadFromBytes([]byte(tt.yaml))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			problems := result.Validate(testTools)
+			if len(problems) != 1 || !strings.Contains(problems[0], tt.want) {
+				t.Errorf("got problems %q, want one containing %q", problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadResult_Validate_NarrowerTagsDoNotShadow(t *testing.T) {
+	result, err := LoadFromBytes([]byte(`
+rules:
+  - name: private-only
+    effect: deny
+    tools: [delete_note]
+    conditions:
+      meeting_tags: [private]
+  - name: team-or-private
+    effect: allow
+    tools: [delete_note]
+    conditions:
+      meeting_tags: [team, private]
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if problems := result.Validate(nil); len(problems) != 0 {
+		t.Errorf("got problems %q, want none", problems)
+	}
+}
@@ -6,9 +6,14 @@ package resilience
 
 import (
 	"context"
+	"errors"
+	"net"
+	"sync"
+	"syscall"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
 	"github.com/felixgeelhaar/fortify/circuitbreaker"
 	"github.com/felixgeelhaar/fortify/ratelimit"
 	"github.com/felixgeelhaar/fortify/retry"
@@ -17,7 +22,12 @@ import (
 
 // Config defines the resilience configuration.
 type Config struct {
-	Timeout          time.Duration
+	Timeout time.Duration
+	// ListTimeout applies to List and FindByID; zero falls back to Timeout.
+	ListTimeout time.Duration
+	// TranscriptTimeout applies to GetTranscript; zero falls back to Timeout.
+	TranscriptTimeout time.Duration
+
 	MaxRetries       int
 	RetryDelay       time.Duration
 	RetryMaxDelay    time.Duration
@@ -54,15 +64,68 @@ type ResilientRepository struct {
 	cb    circuitbreaker.CircuitBreaker[any]
 	rt    retry.Retry[any]
 	rl    ratelimit.RateLimiter
+	// rlStore is the limiter's bucket store, kept for headroom reporting.
+	rlStore *ratelimit.MemoryStore
+
+	mu                  sync.Mutex
+	consecutiveFailures uint32
+	lastTrippedAt       time.Time
+	metrics             *metrics.Registry
+	// requestTimes holds request start times within the last rate interval.
+	requestTimes []time.Time
+}
+
+// BreakerState is a point-in-time snapshot of the circuit breaker.
+type BreakerState struct {
+	// State is "closed", "half-open", or "open".
+	State               string
+	ConsecutiveFailures uint32
+	// LastTrippedAt is the zero time if the breaker has never opened.
+	LastTrippedAt time.Time
+}
+
+// RateLimitState is a point-in-time snapshot of the outgoing rate limiter.
+type RateLimitState struct {
+	// Tokens is how many requests could be sent right now without waiting.
+	Tokens float64
+	// Burst is the bucket capacity; Rate requests are refilled per Interval.
+	Burst    int
+	Rate     int
+	Interval time.Duration
+	// RecentRequests is how many requests started within the last Interval.
+	RecentRequests int
 }
 
+// Headroom returns the bucket fill level, from 0 (exhausted) to 1 (full).
+func (s RateLimitState) Headroom() float64 {
+	if s.Burst <= 0 {
+		return 0
+	}
+	return s.Tokens / float64(s.Burst)
+}
+
+// rateLimitKey is the single bucket all Granola API calls share.
+const rateLimitKey = "granola-api"
+
 // NewResilientRepository creates a resilient repository decorator.
 func NewResilientRepository(inner domain.Repository, cfg Config) *ResilientRepository {
+	r := &ResilientRepository{inner: inner, cfg: cfg}
+
 	cb := circuitbreaker.New[any](circuitbreaker.Config{
 		MaxRequests: cfg.SuccessThreshold,
 		Timeout:     cfg.HalfOpenTimeout,
 		ReadyToTrip: func(counts circuitbreaker.Counts) bool {
-			return counts.ConsecutiveFailures >= cfg.FailureThreshold
+			trip := counts.ConsecutiveFailures >= cfg.FailureThreshold
+			if trip {
+				r.markTripped()
+			}
+			return trip
+		},
+		OnStateChange: func(from, to circuitbreaker.State) {
+			// A failed half-open probe reopens the breaker without consulting ReadyToTrip.
+			if from == circuitbreaker.StateHalfOpen && to == circuitbreaker.StateOpen {
+				r.markTripped()
+			}
 		},
 	})
 
@@ -72,25 +135,114 @@ func NewResilientRepository(inner domain.Repository, cfg Config) *ResilientRepos
 		MaxDelay:      cfg.RetryMaxDelay,
 		BackoffPolicy: retry.BackoffExponential,
 		Jitter:        true,
+		IsRetryable:   IsRetryable,
 	})
 
 	tm := timeout.New[any](timeout.Config{
 		DefaultTimeout: cfg.Timeout,
 	})
 
-	rl := ratelimit.New(&ratelimit.Config{
+	rlConfig := &ratelimit.Config{
 		Rate:     cfg.RateLimit,
 		Burst:    cfg.RateBurst,
 		Interval: cfg.RateInterval,
-	})
+		Store:    ratelimit.NewMemoryStore(),
+	}
+	rl := ratelimit.New(rlConfig)
+	// New fills in defaults; keep the effective values for reporting.
+	r.cfg.RateLimit = rlConfig.Rate
+	r.cfg.RateBurst = rlConfig.Burst
+	r.cfg.RateInterval = rlConfig.Interval
+	r.rlStore = rlConfig.Store.(*ratelimit.MemoryStore)
+
+	r.cb = cb
+	r.rt = rt
+	r.tm = tm
+	r.rl = rl
+	return r
+}
 
-	return &ResilientRepository{
-		inner: inner,
-		cfg:   cfg,
-		cb:    cb,
-		rt:    rt,
-		tm:    tm,
-		rl:    rl,
+// State reports the current circuit breaker state for diagnostics.
+func (r *ResilientRepository) State() BreakerState {
+	// Read the breaker before taking r.mu: ReadyToTrip runs under the
+	// breaker's lock and acquires r.mu, so the reverse order could deadlock.
+	state := r.cb.State().String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return BreakerState{
+		State:               state,
+		ConsecutiveFailures: r.consecutiveFailures,
+		LastTrippedAt:       r.lastTrippedAt,
+	}
+}
+
+// RateLimit reports how close outgoing requests are to the rate limit.
+func (r *ResilientRepository) RateLimit() RateLimitState {
+	now := time.Now()
+	st := RateLimitState{
+		Tokens:   float64(r.cfg.RateBurst),
+		Burst:    r.cfg.RateBurst,
+		Rate:     r.cfg.RateLimit,
+		Interval: r.cfg.RateInterval,
+	}
+
+	// The store holds the level as of the last request; refill it to now
+	// the same way the limiter does.
+	if bucket, err := r.rlStore.Get(context.Background(), rateLimitKey); err == nil && bucket != nil {
+		elapsed := now.Sub(bucket.LastRefill)
+		refill := float64(elapsed) / float64(r.cfg.RateInterval) * float64(r.cfg.RateLimit)
+		st.Tokens = min(bucket.Tokens+max(refill, 0), float64(r.cfg.RateBurst))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pruneRequestTimes(now)
+	st.RecentRequests = len(r.requestTimes)
+	return st
+}
+
+// recordRequest notes a request start for RecentRequests.
+func (r *ResilientRepository) recordRequest() {
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pruneRequestTimes(now)
+	r.requestTimes = append(r.requestTimes, now)
+}
+
+// pruneRequestTimes drops request times older than one rate interval.
+// Callers must hold r.mu.
+func (r *ResilientRepository) pruneRequestTimes(now time.Time) {
+	cutoff := now.Add(-r.cfg.RateInterval)
+	i := 0
+	for i < len(r.requestTimes) && !r.requestTimes[i].After(cutoff) {
+		i++
+	}
+	r.requestTimes = r.requestTimes[i:]
+}
+
+// SetMetrics records circuit breaker trips in the given registry.
+func (r *ResilientRepository) SetMetrics(m *metrics.Registry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.metrics = m
+}
+
+func (r *ResilientRepository) markTripped() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastTrippedAt = time.Now().UTC()
+	r.metrics.BreakerTripped()
+}
+
+func (r *ResilientRepository) recordOutcome(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.consecutiveFailures++
+	} else {
+		r.consecutiveFailures = 0
 	}
 }
 
@@ -99,21 +251,46 @@ func (r *ResilientRepository) Close() error {
 	return r.rl.Close()
 }
 
+// IsRetryable reports whether err is transient: a rate limit, an
+// unavailable meeting source (5xx), a per-attempt timeout, a network
+// timeout, or a refused or reset connection. Everything else, including
+// not-found, access-denied, a cancelled caller, and network errors such
+// as an unknown host, would fail the same way again and is returned
+// without retrying.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, domain.ErrRateLimited) ||
+		errors.Is(err, domain.ErrSourceUnavailable) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // execute runs the given function through the full resilience stack:
-// rate limit → timeout → circuit breaker → retry → operation
-func (r *ResilientRepository) execute(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
-	if err := r.rl.Wait(ctx, "granola-api"); err != nil {
+// rate limit → timeout → circuit breaker → retry → operation.
+// A zero opTimeout uses the global Timeout.
+func (r *ResilientRepository) execute(ctx context.Context, opTimeout time.Duration, fn func(context.Context) (any, error)) (any, error) {
+	if err := r.rl.Wait(ctx, rateLimitKey); err != nil {
 		return nil, err
 	}
-	return r.tm.Execute(ctx, 0, func(ctx context.Context) (any, error) {
+	r.recordRequest()
+	return r.tm.Execute(ctx, opTimeout, func(ctx context.Context) (any, error) {
 		return r.cb.Execute(ctx, func(ctx context.Context) (any, error) {
-			return r.rt.Do(ctx, fn)
+			result, err := r.rt.Do(ctx, fn)
+			r.recordOutcome(err)
+			return result, err
 		})
 	})
 }
 
 func (r *ResilientRepository) FindByID(ctx context.Context, id domain.MeetingID) (*domain.Meeting, error) {
-	result, err := r.execute(ctx, func(ctx context.Context) (any, error) {
+	result, err := r.execute(ctx, r.cfg.ListTimeout, func(ctx context.Context) (any, error) {
 		return r.inner.FindByID(ctx, id)
 	})
 	if err != nil {
@@ -123,9 +300,23 @@ func (r *ResilientRepository) FindByID(ctx context.Context, id domain.MeetingID)
 }
 
 func (r *ResilientRepository) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
-	result, err := r.execute(ctx, func(ctx context.Context) (any, error) {
-		return r.inner.List(ctx, filter)
+	var (
+		partial    []*domain.Meeting
+		partialErr error
+	)
+	result, err := r.execute(ctx, r.cfg.ListTimeout, func(ctx context.Context) (any, error) {
+		meetings, err := r.inner.List(ctx, filter)
+		if errors.Is(err, domain.ErrPartialResults) {
+			// The timeout layer discards results once the context is
+			// done, so keep the partial list aside.
+			partial, partialErr = meetings, err
+			return meetings, nil
+		}
+		return meetings, err
 	})
+	if partialErr != nil {
+		return partial, partialErr
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +324,7 @@ func (r *ResilientRepository) List(ctx context.Context, filter domain.ListFilter
 }
 
 func (r *ResilientRepository) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
-	result, err := r.execute(ctx, func(ctx context.Context) (any, error) {
+	result, err := r.execute(ctx, r.cfg.TranscriptTimeout, func(ctx context.Context) (any, error) {
 		return r.inner.GetTranscript(ctx, id)
 	})
 	if err != nil {
@@ -143,7 +334,7 @@ func (r *ResilientRepository) GetTranscript(ctx context.Context, id domain.Meeti
 }
 
 func (r *ResilientRepository) SearchTranscripts(ctx context.Context, query string, filter domain.ListFilter) ([]*domain.Meeting, error) {
-	result, err := r.execute(ctx, func(ctx context.Context) (any, error) {
+	result, err := r.execute(ctx, 0, func(ctx context.Context) (any, error) {
 		return r.inner.SearchTranscripts(ctx, query, filter)
 	})
 	if err != nil {
@@ -153,7 +344,7 @@ func (r *ResilientRepository) SearchTranscripts(ctx context.Context, query strin
 }
 
 func (r *ResilientRepository) GetActionItems(ctx context.Context, id domain.MeetingID) ([]*domain.ActionItem, error) {
-	result, err := r.execute(ctx, func(ctx context.Context) (any, error) {
+	result, err := r.execute(ctx, 0, func(ctx context.Context) (any, error) {
 		return r.inner.GetActionItems(ctx, id)
 	})
 	if err != nil {
@@ -162,12 +353,12 @@ func (r *ResilientRepository) GetActionItems(ctx context.Context, id domain.Meet
 	return result.([]*domain.ActionItem), nil
 }
 
-func (r *ResilientRepository) Sync(ctx context.Context, since *time.Time) ([]domain.DomainEvent, error) {
-	result, err := r.execute(ctx, func(ctx context.Context) (any, error) {
+func (r *ResilientRepository) Sync(ctx context.Context, since *time.Time) (*domain.SyncResult, error) {
+	result, err := r.execute(ctx, 0, func(ctx context.Context) (any, error) {
 		return r.inner.Sync(ctx, since)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.([]domain.DomainEvent), nil
+	return result.(*domain.SyncResult), nil
 }
@@ -2,10 +2,21 @@ package resilience_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/granola"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
 	"github.com/felixgeelhaar/acai/internal/infrastructure/resilience"
 )
 
@@ -49,10 +60,10 @@ func (s *stubRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*dom
 	return nil, nil
 }
 
-func (s *stubRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
+func (s *stubRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
 	s.syncCalled = true
 	s.callCount++
-	return nil, nil
+	return &domain.SyncResult{}, nil
 }
 
 func TestResilientRepository_DelegatesToInner(t *testing.T) {
@@ -146,3 +157,254 @@ func TestResilientRepository_CancelledContext(t *testing.T) {
 		t.Error("expected error for cancelled context")
 	}
 }
+
+func TestResilientRepository_State_ClosedInitially(t *testing.T) {
+	repo := resilience.NewResilientRepository(&stubRepo{}, resilience.DefaultConfig())
+	defer func() { _ = repo.Close() }()
+
+	st := repo.State()
+	if st.State != "closed" {
+		t.Errorf("got state %q, want closed", st.State)
+	}
+	if st.ConsecutiveFailures != 0 {
+		t.Errorf("got %d failures, want 0", st.ConsecutiveFailures)
+	}
+	if !st.LastTrippedAt.IsZero() {
+		t.Errorf("expected zero last tripped time, got %v", st.LastTrippedAt)
+	}
+}
+
+func TestResilientRepository_State_ReportsOpenAfterFailures(t *testing.T) {
+	inner := &stubRepo{}
+	cfg := resilience.DefaultConfig()
+	cfg.MaxRetries = 1
+	cfg.RetryDelay = time.Millisecond
+	cfg.RetryMaxDelay = time.Millisecond
+	cfg.FailureThreshold = 2
+	repo := resilience.NewResilientRepository(inner, cfg)
+	defer func() { _ = repo.Close() }()
+
+	before := time.Now().UTC()
+	for i := 0; i < 2; i++ {
+		_, _ = repo.FindByID(context.Background(), "m-1")
+	}
+
+	st := repo.State()
+	if st.State != "open" {
+		t.Fatalf("got state %q, want open", st.State)
+	}
+	if st.ConsecutiveFailures != 2 {
+		t.Errorf("got %d failures, want 2", st.ConsecutiveFailures)
+	}
+	if st.LastTrippedAt.Before(before) {
+		t.Errorf("last tripped %v should be after %v", st.LastTrippedAt, before)
+	}
+
+	// Calls are rejected while open and do not reach the inner repository.
+	calls := inner.callCount
+	if _, err := repo.FindByID(context.Background(), "m-1"); err == nil {
+		t.Error("expected error while breaker is open")
+	}
+	if inner.callCount != calls {
+		t.Errorf("inner called %d times while open", inner.callCount-calls)
+	}
+}
+
+// slowRepo blocks each call for delay or until the context is done.
+type slowRepo struct {
+	stubRepo
+	delay time.Duration
+}
+
+func (s *slowRepo) wait(ctx context.Context) error {
+	select {
+	case <-time.After(s.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *slowRepo) List(ctx context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	return nil, s.wait(ctx)
+}
+
+func (s *slowRepo) GetTranscript(ctx context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	if err := s.wait(ctx); err != nil {
+		return nil, err
+	}
+	t := domain.NewTranscript(id, nil)
+	return &t, nil
+}
+
+func TestResilientRepository_PerOperationTimeouts(t *testing.T) {
+	inner := &slowRepo{delay: 100 * time.Millisecond}
+	cfg := resilience.DefaultConfig()
+	cfg.Timeout = time.Second
+	cfg.ListTimeout = 20 * time.Millisecond
+	cfg.MaxRetries = 1
+	repo := resilience.NewResilientRepository(inner, cfg)
+	defer func() { _ = repo.Close() }()
+
+	if _, err := repo.List(context.Background(), domain.ListFilter{}); err == nil {
+		t.Error("expected list to exceed its 20ms timeout")
+	}
+
+	// The transcript fetch falls back to the 1s global timeout.
+	transcript, err := repo.GetTranscript(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("slow transcript fetch aborted: %v", err)
+	}
+	if transcript.MeetingID() != "m-1" {
+		t.Errorf("got meeting id %q", transcript.MeetingID())
+	}
+}
+
+// partialRepo returns one meeting, then waits out the context and
+// reports the list as partial, like the paged granola repository.
+type partialRepo struct {
+	stubRepo
+}
+
+func (p *partialRepo) List(ctx context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	mtg, _ := domain.New("m-1", "Standup", time.Now().UTC(), domain.SourceZoom, nil)
+	<-ctx.Done()
+	return []*domain.Meeting{mtg}, fmt.Errorf("%w: %w", domain.ErrPartialResults, ctx.Err())
+}
+
+func TestResilientRepository_ListKeepsPartialResults(t *testing.T) {
+	repo := resilience.NewResilientRepository(&partialRepo{}, resilience.DefaultConfig())
+	defer func() { _ = repo.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	meetings, err := repo.List(ctx, domain.ListFilter{})
+	if !errors.Is(err, domain.ErrPartialResults) {
+		t.Fatalf("got %v, want %v", err, domain.ErrPartialResults)
+	}
+	if len(meetings) != 1 {
+		t.Errorf("got %d meetings, want the 1 fetched before the deadline", len(meetings))
+	}
+}
+
+func TestResilientRepository_RecordsBreakerTrips(t *testing.T) {
+	inner := &stubRepo{}
+	cfg := resilience.DefaultConfig()
+	cfg.MaxRetries = 1
+	cfg.RetryDelay = time.Millisecond
+	cfg.RetryMaxDelay = time.Millisecond
+	cfg.FailureThreshold = 2
+	repo := resilience.NewResilientRepository(inner, cfg)
+	defer func() { _ = repo.Close() }()
+	registry := metrics.NewRegistry()
+	repo.SetMetrics(registry)
+
+	for i := 0; i < 2; i++ {
+		_, _ = repo.FindByID(context.Background(), "m-1")
+	}
+
+	var b strings.Builder
+	_, _ = registry.WriteTo(&b)
+	if !strings.Contains(b.String(), "acai_circuit_breaker_trips_total 1") {
+		t.Errorf("expected one trip:\n%s", b.String())
+	}
+}
+
+func TestResilientRepository_RateLimit_HeadroomDropsAsTokensDrain(t *testing.T) {
+	cfg := resilience.DefaultConfig()
+	cfg.RateLimit = 1
+	cfg.RateBurst = 4
+	cfg.RateInterval = time.Hour
+	repo := resilience.NewResilientRepository(&stubRepo{}, cfg)
+	defer func() { _ = repo.Close() }()
+
+	st := repo.RateLimit()
+	if st.Headroom() != 1 || st.RecentRequests != 0 {
+		t.Fatalf("got headroom %.2f with %d requests, want a full idle bucket", st.Headroom(), st.RecentRequests)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := repo.List(context.Background(), domain.ListFilter{}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	}
+
+	st = repo.RateLimit()
+	if st.Tokens < 0.99 || st.Tokens > 1.01 {
+		t.Errorf("got %.3f tokens, want ~1 of 4 left", st.Tokens)
+	}
+	if st.Headroom() > 0.26 {
+		t.Errorf("got headroom %.3f, want ~0.25", st.Headroom())
+	}
+	if st.RecentRequests != 3 {
+		t.Errorf("got %d recent requests, want 3", st.RecentRequests)
+	}
+	if st.Burst != 4 || st.Rate != 1 || st.Interval != time.Hour {
+		t.Errorf("got limits %+v", st)
+	}
+}
+
+func TestResilientRepository_RetriesOnlyTransientStatuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		wantErr  error
+		wantHits int32
+	}{
+		{"404 fails immediately", http.StatusNotFound, domain.ErrMeetingNotFound, 1},
+		{"401 fails immediately", http.StatusUnauthorized, domain.ErrAccessDenied, 1},
+		{"503 is retried", http.StatusServiceUnavailable, domain.ErrSourceUnavailable, 3},
+		{"429 is retried", http.StatusTooManyRequests, domain.ErrRateLimited, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			cfg := resilience.DefaultConfig()
+			cfg.MaxRetries = 3
+			cfg.RetryDelay = time.Millisecond
+			cfg.RetryMaxDelay = time.Millisecond
+			inner := granola.NewRepository(granola.NewClient(server.URL, server.Client(), "token"))
+			repo := resilience.NewResilientRepository(inner, cfg)
+			defer func() { _ = repo.Close() }()
+
+			_, err := repo.FindByID(context.Background(), "m-1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := hits.Load(); got != tt.wantHits {
+				t.Errorf("got %d requests, want %d", got, tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{domain.ErrRateLimited, true},
+		{fmt.Errorf("%w: status 502", domain.ErrSourceUnavailable), true},
+		{context.DeadlineExceeded, true},
+		{&net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, true},
+		{&url.Error{Op: "Get", URL: "https://api.granola.ai", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, true},
+		{&net.DNSError{Err: "i/o timeout", IsTimeout: true}, true},
+		{&net.DNSError{Err: "no such host", IsNotFound: true}, false},
+		{&url.Error{Op: "Get", URL: "https://api.granola.ai", Err: context.Canceled}, false},
+		{domain.ErrMeetingNotFound, false},
+		{domain.ErrAccessDenied, false},
+		{context.Canceled, false},
+		{errors.New("decoding response: unexpected EOF"), false},
+	}
+	for _, tt := range tests {
+		if got := resilience.IsRetryable(tt.err); got != tt.want {
+			t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
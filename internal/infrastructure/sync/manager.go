@@ -82,6 +82,10 @@ func (m *Manager) tick(ctx context.Context) {
 		return
 	}
 
+	for _, syncErr := range out.Errors {
+		log.Printf("sync manager: skipped %v", syncErr)
+	}
+
 	now := time.Now().UTC()
 	m.mu.Lock()
 	m.lastSyncTime = &now
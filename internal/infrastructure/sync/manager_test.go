@@ -33,9 +33,12 @@ func (m *mockRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListF
 func (m *mockRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
 	return nil, nil
 }
-func (m *mockRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
+func (m *mockRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
 	m.calls++
-	return m.events, m.err
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.SyncResult{Events: m.events}, nil
 }
 
 // mockDispatcher tracks dispatched events.
@@ -192,9 +195,9 @@ func (m *captureSinceRepo) SearchTranscripts(_ context.Context, _ string, _ doma
 func (m *captureSinceRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
 	return nil, nil
 }
-func (m *captureSinceRepo) Sync(_ context.Context, since *time.Time) ([]domain.DomainEvent, error) {
+func (m *captureSinceRepo) Sync(_ context.Context, since *time.Time) (*domain.SyncResult, error) {
 	m.sinceTimes = append(m.sinceTimes, since)
-	return nil, nil
+	return &domain.SyncResult{}, nil
 }
 
 func TestSyncManager_ZeroEvents_NoDispatch(t *testing.T) {
@@ -0,0 +1,32 @@
+// Package tracing carries a per-request correlation ID through the context
+// so a single tool invocation can be followed across logs and API calls.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// CorrelationHeader is the HTTP header used to forward the correlation ID.
+const CorrelationHeader = "X-Correlation-Id"
+
+type correlationKey struct{}
+
+// NewCorrelationID returns a random 16-character hex identifier.
+func NewCorrelationID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// WithCorrelationID attaches id to ctx.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID on ctx, or "" if none.
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationKey{}).(string)
+	return id
+}
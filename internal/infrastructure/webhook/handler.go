@@ -1,33 +1,103 @@
 package webhook
 
 import (
+	"bytes"
+	"context"
 	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
 	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 )
 
+// RetryPolicy bounds the retries of a webhook-triggered sync.
+type RetryPolicy struct {
+	MaxAttempts  int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// DefaultRetryPolicy rides out short Granola outages without retrying forever.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: time.Second,
+		MaxDelay:     30 * time.Second,
+	}
+}
+
+// DefaultTimestampTolerance is how far a signed request's timestamp may
+// drift from the local clock before it is rejected as a possible replay.
+const DefaultTimestampTolerance = 5 * time.Minute
+
+// CacheInvalidator drops cached data for a meeting.
+// Implemented by cache.CachedRepository.
+type CacheInvalidator interface {
+	Invalidate(ctx context.Context, id domain.MeetingID) error
+}
+
 // Handler receives Granola webhook events and triggers sync + event dispatch.
+// Syncs run in the background so the webhook is acknowledged promptly.
 type Handler struct {
-	syncUC     *meetingapp.SyncMeetings
-	dispatcher domain.EventDispatcher
-	secret     string
+	syncUC      *meetingapp.SyncMeetings
+	dispatcher  domain.EventDispatcher
+	invalidator CacheInvalidator
+	secret      string
+	tolerance   time.Duration
+	retry       RetryPolicy
+	baseCtx     context.Context
+	wg          sync.WaitGroup
 }
 
 // NewHandler creates a new webhook handler.
-// If secret is empty, signature validation is skipped.
+// If secret is empty, signature and timestamp validation are skipped.
 func NewHandler(syncUC *meetingapp.SyncMeetings, dispatcher domain.EventDispatcher, secret string) *Handler {
 	return &Handler{
 		syncUC:     syncUC,
 		dispatcher: dispatcher,
 		secret:     secret,
+		tolerance:  DefaultTimestampTolerance,
+		retry:      DefaultRetryPolicy(),
+		baseCtx:    context.Background(),
+	}
+}
+
+// SetRetryPolicy overrides the retry policy for subsequent syncs.
+func (h *Handler) SetRetryPolicy(p RetryPolicy) {
+	h.retry = p
+}
+
+// SetInvalidator sets the cache that meeting.deleted events evict from.
+func (h *Handler) SetInvalidator(inv CacheInvalidator) {
+	h.invalidator = inv
+}
+
+// SetTimestampTolerance overrides the accepted clock drift for signed
+// requests. A non-positive value restores DefaultTimestampTolerance.
+func (h *Handler) SetTimestampTolerance(d time.Duration) {
+	if d <= 0 {
+		d = DefaultTimestampTolerance
 	}
+	h.tolerance = d
+}
+
+// SetBaseContext sets the context background syncs run under, typically
+// the server's lifetime: once it ends, pending retries are abandoned.
+// Call it before the handler starts serving.
+func (h *Handler) SetBaseContext(ctx context.Context) {
+	h.baseCtx = ctx
+}
+
+// Wait blocks until all in-flight background syncs have finished.
+func (h *Handler) Wait() {
+	h.wg.Wait()
 }
 
 // ServeHTTP handles incoming webhook requests.
@@ -45,47 +115,191 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer func() { _ = r.Body.Close() }()
 
 	if h.secret != "" {
+		timestamp := r.Header.Get("X-Granola-Timestamp")
 		sig := r.Header.Get("X-Granola-Signature")
-		if !h.validSignature(body, sig) {
+		if !h.validSignature(timestamp, body, sig) {
 			http.Error(w, "invalid signature", http.StatusUnauthorized)
 			return
 		}
+		if !h.freshTimestamp(timestamp, time.Now()) {
+			http.Error(w, "timestamp outside tolerance", http.StatusUnauthorized)
+			return
+		}
 	}
 
-	var payload GranolaWebhookPayload
-	if err := json.Unmarshal(body, &payload); err != nil {
+	payloads, batch, err := decodePayloads(body)
+	if err != nil {
 		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
 		return
 	}
 
-	switch payload.Event {
-	case "meeting.created", "transcript.ready":
-		h.handleSync(r, payload)
-	default:
-		// Unknown event types are accepted but not processed
+	errs := h.process(r.Context(), payloads)
+	if !batch {
+		if errs[0] != nil {
+			http.Error(w, errs[0].Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	results := make([]EventResult, len(payloads))
+	failed := false
+	for i, p := range payloads {
+		results[i] = EventResult{Index: i, Event: p.Event, Status: "accepted"}
+		if errs[i] != nil {
+			results[i].Status = "rejected"
+			results[i].Error = errs[i].Error()
+			failed = true
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if failed {
+		w.WriteHeader(http.StatusMultiStatus)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = json.NewEncoder(w).Encode(BatchResponse{Results: results})
+}
+
+// decodePayloads accepts a single event object or a JSON array of events,
+// told apart by the first non-whitespace byte. batch reports an array.
+func decodePayloads(body []byte) (payloads []GranolaWebhookPayload, batch bool, err error) {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &payloads); err != nil {
+			return nil, true, err
+		}
+		if len(payloads) == 0 {
+			return nil, true, errors.New("empty event batch")
+		}
+		return payloads, true, nil
+	}
+
+	var payload GranolaWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, false, err
+	}
+	return []GranolaWebhookPayload{payload}, false, nil
+}
+
+// process handles events in delivery order and returns one error per
+// event, nil when it was accepted. Deletes run before the response;
+// syncs run afterwards, one at a time in a single background goroutine.
+func (h *Handler) process(ctx context.Context, payloads []GranolaWebhookPayload) []error {
+	errs := make([]error, len(payloads))
+	var syncs []GranolaWebhookPayload
+	for i, payload := range payloads {
+		switch payload.Event {
+		case "meeting.created", "transcript.ready":
+			syncs = append(syncs, payload)
+		case "meeting.deleted":
+			if payload.MeetingID == "" {
+				errs[i] = errors.New("missing meeting_id")
+				continue
+			}
+			h.handleDelete(ctx, domain.MeetingID(payload.MeetingID))
+		default:
+			// Unknown event types are accepted but not processed
+		}
 	}
 
-	w.WriteHeader(http.StatusOK)
+	if len(syncs) > 0 {
+		h.wg.Add(1)
+		go func() {
+			defer h.wg.Done()
+			// The request context ends once we respond, so sync under the
+			// base context instead.
+			for _, payload := range syncs {
+				h.handleSync(h.baseCtx, payload)
+			}
+		}()
+	}
+	return errs
 }
 
-func (h *Handler) handleSync(r *http.Request, payload GranolaWebhookPayload) {
+func (h *Handler) handleSync(ctx context.Context, payload GranolaWebhookPayload) {
 	since := payload.Timestamp
-	out, err := h.syncUC.Execute(r.Context(), meetingapp.SyncMeetingsInput{Since: &since})
-	if err != nil {
-		log.Printf("webhook: sync failed for %s: %v", payload.Event, err)
-		return
+	attempts := h.retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := h.retry.InitialDelay
+
+	var (
+		out *meetingapp.SyncMeetingsOutput
+		err error
+	)
+	for attempt := 1; attempt <= attempts; attempt++ {
+		out, err = h.syncUC.Execute(ctx, meetingapp.SyncMeetingsInput{Since: &since})
+		if err == nil {
+			break
+		}
+		if attempt == attempts {
+			log.Printf("webhook: sync failed for %s after %d attempts: %v", payload.Event, attempts, err)
+			return
+		}
+		log.Printf("webhook: sync attempt %d/%d failed for %s, retrying in %s: %v",
+			attempt, attempts, payload.Event, delay, err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Printf("webhook: sync for %s abandoned: %v", payload.Event, ctx.Err())
+			return
+		case <-timer.C:
+		}
+		delay *= 2
+		if h.retry.MaxDelay > 0 && delay > h.retry.MaxDelay {
+			delay = h.retry.MaxDelay
+		}
+	}
+
+	for _, syncErr := range out.Errors {
+		log.Printf("webhook: sync skipped %v", syncErr)
 	}
 
 	if len(out.Events) > 0 && h.dispatcher != nil {
-		if err := h.dispatcher.Dispatch(r.Context(), out.Events); err != nil {
+		if err := h.dispatcher.Dispatch(ctx, out.Events); err != nil {
 			log.Printf("webhook: dispatch failed: %v", err)
 		}
 	}
 }
 
-func (h *Handler) validSignature(body []byte, signature string) bool {
-	mac := hmac.New(sha256.New, []byte(h.secret))
-	_, _ = mac.Write(body)
-	expected := hex.EncodeToString(mac.Sum(nil))
+// handleDelete evicts a deleted meeting from the cache and announces it.
+// It is quick, so unlike syncs it runs before the webhook is acknowledged.
+func (h *Handler) handleDelete(ctx context.Context, id domain.MeetingID) {
+	if h.invalidator != nil {
+		if err := h.invalidator.Invalidate(ctx, id); err != nil {
+			log.Printf("webhook: cache invalidation failed for %s: %v", id, err)
+		}
+	}
+	if h.dispatcher != nil {
+		event := domain.NewMeetingDeletedEvent(id)
+		if err := h.dispatcher.Dispatch(ctx, []domain.DomainEvent{event}); err != nil {
+			log.Printf("webhook: dispatch failed: %v", err)
+		}
+	}
+}
+
+// validSignature checks the HMAC over timestamp + "." + body, which binds
+// the timestamp to the payload so it cannot be swapped for a fresh one.
+func (h *Handler) validSignature(timestamp string, body []byte, signature string) bool {
+	expected := sign(h.secret, timestamp, body)
 	return hmac.Equal([]byte(expected), []byte(signature))
 }
+
+// freshTimestamp reports whether timestamp (Unix seconds) is within the
+// tolerance window of now, in either direction.
+func (h *Handler) freshTimestamp(timestamp string, now time.Time) bool {
+	secs, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	drift := now.Sub(time.Unix(secs, 0))
+	if drift < 0 {
+		drift = -drift
+	}
+	return drift <= h.tolerance
+}
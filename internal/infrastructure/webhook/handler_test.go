@@ -4,22 +4,29 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"database/sql"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/cache"
 	"github.com/felixgeelhaar/acai/internal/infrastructure/webhook"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 type mockRepo struct {
-	events []domain.DomainEvent
-	err    error
-	calls  int
+	events   []domain.DomainEvent
+	err      error
+	calls    int
+	failures int // number of initial Sync calls that fail transiently
 }
 
 func (m *mockRepo) FindByID(_ context.Context, _ domain.MeetingID) (*domain.Meeting, error) {
@@ -37,9 +44,15 @@ func (m *mockRepo) SearchTranscripts(_ context.Context, _ string, _ domain.ListF
 func (m *mockRepo) GetActionItems(_ context.Context, _ domain.MeetingID) ([]*domain.ActionItem, error) {
 	return nil, nil
 }
-func (m *mockRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
+func (m *mockRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
 	m.calls++
-	return m.events, m.err
+	if m.calls <= m.failures {
+		return nil, errors.New("granola unavailable")
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.SyncResult{Events: m.events}, nil
 }
 
 type mockDispatcher struct {
@@ -51,12 +64,17 @@ func (m *mockDispatcher) Dispatch(_ context.Context, events []domain.DomainEvent
 	return nil
 }
 
-func signBody(secret string, body []byte) string {
+func signBody(secret, timestamp string, body []byte) string {
 	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp + "."))
 	_, _ = mac.Write(body)
 	return hex.EncodeToString(mac.Sum(nil))
 }
 
+func nowTimestamp() string {
+	return strconv.FormatInt(time.Now().Unix(), 10)
+}
+
 func TestHandler_ValidPayload_Returns200(t *testing.T) {
 	repo := &mockRepo{}
 	d := &mockDispatcher{}
@@ -116,6 +134,7 @@ func TestHandler_MeetingCreated_TriggersSyncAndDispatches(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	h.ServeHTTP(w, req)
+	h.Wait()
 
 	if w.Code != http.StatusOK {
 		t.Errorf("expected 200, got %d", w.Code)
@@ -139,6 +158,7 @@ func TestHandler_TranscriptReady_TriggersSyncAndDispatches(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	h.ServeHTTP(w, req)
+	h.Wait()
 
 	if w.Code != http.StatusOK {
 		t.Errorf("expected 200, got %d", w.Code)
@@ -161,6 +181,7 @@ func TestHandler_UnknownEvent_Returns200_NoOp(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	h.ServeHTTP(w, req)
+	h.Wait()
 
 	if w.Code != http.StatusOK {
 		t.Errorf("expected 200, got %d", w.Code)
@@ -209,9 +230,11 @@ func TestHandler_ValidSignature_Returns200(t *testing.T) {
 	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), d, secret)
 
 	body := []byte(`{"event":"meeting.created","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`)
-	sig := signBody(secret, body)
+	ts := nowTimestamp()
+	sig := signBody(secret, ts, body)
 
 	req := httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(string(body)))
+	req.Header.Set("X-Granola-Timestamp", ts)
 	req.Header.Set("X-Granola-Signature", sig)
 	w := httptest.NewRecorder()
 
@@ -221,3 +244,258 @@ func TestHandler_ValidSignature_Returns200(t *testing.T) {
 		t.Errorf("expected 200 with valid signature, got %d", w.Code)
 	}
 }
+
+func TestHandler_ExpiredTimestamp_Returns401(t *testing.T) {
+	secret := "test-secret"
+	repo := &mockRepo{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), &mockDispatcher{}, secret)
+
+	body := []byte(`{"event":"meeting.created","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`)
+	ts := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(string(body)))
+	req.Header.Set("X-Granola-Timestamp", ts)
+	req.Header.Set("X-Granola-Signature", signBody(secret, ts, body))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for expired timestamp, got %d", w.Code)
+	}
+}
+
+func TestHandler_CustomTolerance_AcceptsOlderTimestamp(t *testing.T) {
+	secret := "test-secret"
+	repo := &mockRepo{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), &mockDispatcher{}, secret)
+	h.SetTimestampTolerance(time.Hour)
+
+	body := []byte(`{"event":"unknown","timestamp":"2026-01-01T00:00:00Z"}`)
+	ts := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(string(body)))
+	req.Header.Set("X-Granola-Timestamp", ts)
+	req.Header.Set("X-Granola-Signature", signBody(secret, ts, body))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200 within custom tolerance, got %d", w.Code)
+	}
+}
+
+func TestHandler_TamperedTimestamp_Returns401(t *testing.T) {
+	secret := "test-secret"
+	repo := &mockRepo{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), &mockDispatcher{}, secret)
+
+	body := []byte(`{"event":"meeting.created","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`)
+	// A replayed request signed long ago, with its timestamp swapped for a fresh one.
+	oldTS := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	sig := signBody(secret, oldTS, body)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(string(body)))
+	req.Header.Set("X-Granola-Timestamp", nowTimestamp())
+	req.Header.Set("X-Granola-Signature", sig)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for tampered timestamp, got %d", w.Code)
+	}
+}
+
+func TestHandler_SyncFailure_RetriesUntilSuccess(t *testing.T) {
+	event := domain.NewMeetingCreatedEvent("m-1", "Test", time.Now().UTC())
+	repo := &mockRepo{events: []domain.DomainEvent{event}, failures: 1}
+	d := &mockDispatcher{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), d, "")
+	h.SetRetryPolicy(webhook.RetryPolicy{MaxAttempts: 3, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	body := `{"event":"meeting.created","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+	h.Wait()
+
+	if repo.calls != 2 {
+		t.Errorf("expected 2 sync calls, got %d", repo.calls)
+	}
+	if len(d.dispatched) != 1 {
+		t.Errorf("expected 1 dispatched event after retry, got %d", len(d.dispatched))
+	}
+}
+
+func TestHandler_SyncFailure_GivesUpAfterMaxAttempts(t *testing.T) {
+	repo := &mockRepo{failures: 10}
+	d := &mockDispatcher{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), d, "")
+	h.SetRetryPolicy(webhook.RetryPolicy{MaxAttempts: 3, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	body := `{"event":"transcript.ready","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+	h.Wait()
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+	if repo.calls != 3 {
+		t.Errorf("expected 3 sync calls, got %d", repo.calls)
+	}
+	if len(d.dispatched) != 0 {
+		t.Errorf("expected no dispatch, got %d", len(d.dispatched))
+	}
+}
+
+// findRepo serves one meeting and counts lookups, so tests can tell a
+// cache hit from a miss.
+type findRepo struct {
+	mockRepo
+	finds int
+}
+
+func (m *findRepo) FindByID(_ context.Context, id domain.MeetingID) (*domain.Meeting, error) {
+	m.finds++
+	return domain.New(id, "Standup", time.Now().UTC(), domain.SourceZoom, nil)
+}
+
+func TestHandler_MeetingDeleted_InvalidatesCache(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	inner := &findRepo{}
+	cached, err := cache.NewCachedRepository(inner, db, time.Hour)
+	if err != nil {
+		t.Fatalf("new cached repo: %v", err)
+	}
+	if _, err := cached.FindByID(context.Background(), "m-1"); err != nil {
+		t.Fatalf("prime cache: %v", err)
+	}
+
+	d := &mockDispatcher{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(&mockRepo{}), d, "")
+	h.SetInvalidator(cached)
+
+	body := `{"event":"meeting.deleted","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", w.Code)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM cache_entries WHERE key = ?", "meeting:m-1").Scan(&count); err != nil {
+		t.Fatalf("query cache: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("cache entry for m-1 still present")
+	}
+	if _, err := cached.FindByID(context.Background(), "m-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inner.finds != 2 {
+		t.Errorf("got %d inner lookups, want 2 (entry evicted)", inner.finds)
+	}
+
+	if len(d.dispatched) != 1 || d.dispatched[0].EventName() != "meeting.deleted" {
+		t.Fatalf("got events %v, want one meeting.deleted", d.dispatched)
+	}
+	if ev, ok := d.dispatched[0].(domain.MeetingDeleted); !ok || ev.MeetingID() != "m-1" {
+		t.Errorf("got %+v, want meeting.deleted for m-1", d.dispatched[0])
+	}
+}
+
+func TestHandler_MeetingDeleted_MissingMeetingID(t *testing.T) {
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(&mockRepo{}), &mockDispatcher{}, "")
+
+	body := `{"event":"meeting.deleted","timestamp":"2026-01-01T00:00:00Z"}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, want 400", w.Code)
+	}
+}
+
+func TestHandler_Batch_ProcessesEachEvent(t *testing.T) {
+	repo := &mockRepo{events: []domain.DomainEvent{domain.NewMeetingCreatedEvent("m-1", "Test", time.Now().UTC())}}
+	d := &mockDispatcher{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), d, "")
+
+	body := ` [
+		{"event":"meeting.created","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"},
+		{"event":"transcript.ready","meeting_id":"m-2","timestamp":"2026-01-01T00:00:00Z"}
+	]`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body)))
+	h.Wait()
+
+	if w.Code != http.StatusOK {
+		t.Errorf("got status %d, want 200", w.Code)
+	}
+	if repo.calls != 2 {
+		t.Errorf("got %d sync calls, want 2", repo.calls)
+	}
+	if len(d.dispatched) != 2 {
+		t.Errorf("got %d dispatched events, want 2", len(d.dispatched))
+	}
+}
+
+func TestHandler_Batch_PartialFailure(t *testing.T) {
+	d := &mockDispatcher{}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(&mockRepo{}), d, "")
+
+	body := `[{"event":"meeting.deleted","meeting_id":"m-1"},{"event":"meeting.deleted"}]`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body)))
+	h.Wait()
+
+	if w.Code != http.StatusMultiStatus {
+		t.Fatalf("got status %d, want 207", w.Code)
+	}
+	var resp webhook.BatchResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(resp.Results) != 2 || resp.Results[0].Status != "accepted" || resp.Results[1].Status != "rejected" {
+		t.Errorf("got results %+v", resp.Results)
+	}
+	if len(d.dispatched) != 1 {
+		t.Errorf("got %d dispatched events, want 1", len(d.dispatched))
+	}
+}
+
+func TestHandler_BaseContextCancelled_AbandonsRetries(t *testing.T) {
+	repo := &mockRepo{failures: 10}
+	h := webhook.NewHandler(meetingapp.NewSyncMeetings(repo), &mockDispatcher{}, "")
+	h.SetRetryPolicy(webhook.RetryPolicy{MaxAttempts: 5, InitialDelay: time.Hour, MaxDelay: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	h.SetBaseContext(ctx)
+
+	body := `{"event":"meeting.created","meeting_id":"m-1","timestamp":"2026-01-01T00:00:00Z"}`
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/webhook/granola", strings.NewReader(body)))
+	cancel()
+
+	done := make(chan struct{})
+	go func() { h.Wait(); close(done) }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Wait did not return after the base context was cancelled")
+	}
+	if repo.calls != 1 {
+		t.Errorf("expected 1 sync call before the retry was abandoned, got %d", repo.calls)
+	}
+}
@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+)
+
+// DefaultOutboundTimeout bounds each outbound delivery so a slow receiver
+// cannot back up the delivery queue indefinitely.
+const DefaultOutboundTimeout = 10 * time.Second
+
+// DefaultOutboundQueueSize is how many deliveries may wait for the
+// receiver; events raised while the queue is full are dropped and logged.
+const DefaultOutboundQueueSize = 256
+
+// OutboundPayload is the JSON body POSTed for each forwarded event.
+type OutboundPayload struct {
+	Event        string    `json:"event"`
+	MeetingID    string    `json:"meeting_id"`
+	NoteID       string    `json:"note_id,omitempty"`
+	Author       string    `json:"author,omitempty"`
+	ActionItemID string    `json:"action_item_id,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// OutboundDispatcher decorates a domain.EventDispatcher, POSTing note and
+// action item events to an external URL after forwarding them. Requests
+// are signed the way the Handler verifies inbound ones: an
+// X-Granola-Timestamp header with Unix seconds and an X-Granola-Signature
+// header with the hex HMAC-SHA256 of timestamp + "." + body.
+//
+// Delivery is asynchronous and best-effort: events are queued and POSTed
+// in order by a background worker, so a slow receiver never delays the
+// write that raised them, and failures are logged, not returned, so a
+// down receiver neither fails the write nor makes the outbox re-dispatch
+// the events to MCP sessions. Close drains the queue.
+type OutboundDispatcher struct {
+	inner  domain.EventDispatcher
+	url    string
+	secret string
+	client *http.Client
+
+	mu     sync.Mutex
+	closed bool
+	queue  chan OutboundPayload
+	done   chan struct{}
+}
+
+// NewOutboundDispatcher creates an outbound webhook decorator that delivers
+// to url. If secret is empty, requests are sent unsigned.
+func NewOutboundDispatcher(inner domain.EventDispatcher, url, secret string) *OutboundDispatcher {
+	d := &OutboundDispatcher{
+		inner:  inner,
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: DefaultOutboundTimeout},
+		queue:  make(chan OutboundPayload, DefaultOutboundQueueSize),
+		done:   make(chan struct{}),
+	}
+	go d.run()
+	return d
+}
+
+// SetHTTPClient replaces the client used for deliveries.
+func (d *OutboundDispatcher) SetHTTPClient(client *http.Client) {
+	d.client = client
+}
+
+// Dispatch forwards the batch to the inner dispatcher, then queues each
+// note.added, note.deleted, and action_item.completed event for delivery.
+// It does not wait for the receiver.
+func (d *OutboundDispatcher) Dispatch(ctx context.Context, events []domain.DomainEvent) error {
+	if err := d.inner.Dispatch(ctx, events); err != nil {
+		return err
+	}
+	for _, event := range events {
+		if payload, ok := outboundPayload(event); ok {
+			d.enqueue(payload)
+		}
+	}
+	return nil
+}
+
+// Close stops accepting events and waits for the queued deliveries to
+// finish.
+func (d *OutboundDispatcher) Close() {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		close(d.queue)
+	}
+	d.mu.Unlock()
+	<-d.done
+}
+
+func (d *OutboundDispatcher) enqueue(payload OutboundPayload) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		log.Printf("outbound webhook %s for meeting %s: dispatcher closed, dropped", payload.Event, payload.MeetingID)
+		return
+	}
+	select {
+	case d.queue <- payload:
+	default:
+		log.Printf("outbound webhook %s for meeting %s: queue full, dropped", payload.Event, payload.MeetingID)
+	}
+}
+
+// run delivers queued payloads one at a time, in order. The write that
+// raised an event has returned by now, so deliveries are bound only by
+// the client timeout.
+func (d *OutboundDispatcher) run() {
+	defer close(d.done)
+	for payload := range d.queue {
+		if err := d.deliver(context.Background(), payload); err != nil {
+			log.Printf("outbound webhook %s for meeting %s: %v", payload.Event, payload.MeetingID, err)
+		}
+	}
+}
+
+func (d *OutboundDispatcher) deliver(ctx context.Context, payload OutboundPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if d.secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("X-Granola-Timestamp", timestamp)
+		req.Header.Set("X-Granola-Signature", sign(d.secret, timestamp, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver returned %s", resp.Status)
+	}
+	return nil
+}
+
+// outboundPayload maps the events external systems are notified of; ok is
+// false for every other event.
+func outboundPayload(event domain.DomainEvent) (OutboundPayload, bool) {
+	payload := OutboundPayload{Event: event.EventName(), Timestamp: event.OccurredAt()}
+	switch e := event.(type) {
+	case annotation.NoteAdded:
+		payload.MeetingID = e.MeetingID()
+		payload.NoteID = e.NoteID()
+		payload.Author = e.Author()
+	case annotation.NoteDeleted:
+		payload.MeetingID = e.MeetingID()
+		payload.NoteID = e.NoteID()
+	case domain.ActionItemCompleted:
+		payload.MeetingID = string(e.MeetingID())
+		payload.ActionItemID = string(e.ActionItemID())
+	default:
+		return OutboundPayload{}, false
+	}
+	return payload, true
+}
+
+// sign returns the hex HMAC-SHA256 of timestamp + "." + body under secret.
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp + "."))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ domain.EventDispatcher = (*OutboundDispatcher)(nil)
@@ -0,0 +1,158 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/webhook"
+)
+
+type capturedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// captureServer records every request it receives and answers with status.
+func captureServer(t *testing.T, status int) (*httptest.Server, func() []capturedRequest) {
+	t.Helper()
+	var (
+		mu       sync.Mutex
+		captured []capturedRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		captured = append(captured, capturedRequest{header: r.Header.Clone(), body: body})
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []capturedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]capturedRequest(nil), captured...)
+	}
+}
+
+func TestOutboundDispatcher_PostsSignedPayloads(t *testing.T) {
+	srv, captured := captureServer(t, http.StatusOK)
+	inner := &mockDispatcher{}
+	d := webhook.NewOutboundDispatcher(inner, srv.URL, "out-secret")
+
+	events := []domain.DomainEvent{
+		annotation.NewNoteAddedEvent("n-1", "m-1", "claude"),
+		domain.NewActionItemUpdatedEvent("m-1", "ai-2", "Send notes"),
+		annotation.NewNoteDeletedEvent("n-2", "m-1"),
+		domain.NewActionItemCompletedEvent("m-1", "ai-1"),
+	}
+	if err := d.Dispatch(context.Background(), events); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inner.dispatched) != 4 {
+		t.Errorf("inner got %d events, want all 4", len(inner.dispatched))
+	}
+
+	d.Close()
+	reqs := captured()
+	if len(reqs) != 3 {
+		t.Fatalf("got %d deliveries, want 3", len(reqs))
+	}
+	want := []webhook.OutboundPayload{
+		{Event: "note.added", MeetingID: "m-1", NoteID: "n-1", Author: "claude"},
+		{Event: "note.deleted", MeetingID: "m-1", NoteID: "n-2"},
+		{Event: "action_item.completed", MeetingID: "m-1", ActionItemID: "ai-1"},
+	}
+	for i, req := range reqs {
+		timestamp := req.header.Get("X-Granola-Timestamp")
+		if got := req.header.Get("X-Granola-Signature"); got != signBody("out-secret", timestamp, req.body) {
+			t.Errorf("delivery %d: signature %q does not verify", i, got)
+		}
+		if ct := req.header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("delivery %d: content type %q", i, ct)
+		}
+
+		var got webhook.OutboundPayload
+		if err := json.Unmarshal(req.body, &got); err != nil {
+			t.Fatalf("delivery %d: %v", i, err)
+		}
+		if got.Timestamp.IsZero() {
+			t.Errorf("delivery %d: missing timestamp", i)
+		}
+		got.Timestamp = want[i].Timestamp
+		if got != want[i] {
+			t.Errorf("delivery %d: got %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func TestOutboundDispatcher_UnsignedWithoutSecret(t *testing.T) {
+	srv, captured := captureServer(t, http.StatusOK)
+	d := webhook.NewOutboundDispatcher(&mockDispatcher{}, srv.URL, "")
+
+	if err := d.Dispatch(context.Background(), []domain.DomainEvent{
+		annotation.NewNoteAddedEvent("n-1", "m-1", "claude"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d.Close()
+	reqs := captured()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(reqs))
+	}
+	if sig := reqs[0].header.Get("X-Granola-Signature"); sig != "" {
+		t.Errorf("got signature %q, want none", sig)
+	}
+}
+
+func TestOutboundDispatcher_ReceiverFailureDoesNotFailDispatch(t *testing.T) {
+	srv, captured := captureServer(t, http.StatusInternalServerError)
+	inner := &mockDispatcher{}
+	d := webhook.NewOutboundDispatcher(inner, srv.URL, "out-secret")
+
+	err := d.Dispatch(context.Background(), []domain.DomainEvent{
+		annotation.NewNoteAddedEvent("n-1", "m-1", "claude"),
+	})
+	if err != nil {
+		t.Fatalf("got %v, want delivery failures to be swallowed", err)
+	}
+	d.Close()
+	if len(captured()) != 1 || len(inner.dispatched) != 1 {
+		t.Errorf("got %d deliveries and %d inner events, want 1 each", len(captured()), len(inner.dispatched))
+	}
+}
+
+func TestOutboundDispatcher_DoesNotWaitForReceiver(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	d := webhook.NewOutboundDispatcher(&mockDispatcher{}, srv.URL, "")
+
+	done := make(chan error, 1)
+	go func() {
+		done <- d.Dispatch(context.Background(), []domain.DomainEvent{
+			annotation.NewNoteAddedEvent("n-1", "m-1", "claude"),
+		})
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Dispatch waited for the receiver")
+	}
+
+	close(release)
+	d.Close()
+}
@@ -9,3 +9,18 @@ type GranolaWebhookPayload struct {
 	MeetingID string    `json:"meeting_id"`
 	Timestamp time.Time `json:"timestamp"`
 }
+
+// BatchResponse is the body returned for a batch delivery, reporting the
+// outcome of each event in order.
+type BatchResponse struct {
+	Results []EventResult `json:"results"`
+}
+
+// EventResult is the outcome of one event of a batch: "accepted" or
+// "rejected" with the reason in Error.
+type EventResult struct {
+	Index  int    `json:"index"`
+	Event  string `json:"event"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
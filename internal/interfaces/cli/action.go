@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"sort"
 
 	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
@@ -16,6 +17,7 @@ func newActionCmd(deps *Dependencies) *cobra.Command {
 
 	cmd.AddCommand(
 		newActionCompleteCmd(deps),
+		newActionCompleteAllCmd(deps),
 		newActionUpdateCmd(deps),
 	)
 	return cmd
@@ -43,6 +45,39 @@ func newActionCompleteCmd(deps *Dependencies) *cobra.Command {
 	}
 }
 
+func newActionCompleteAllCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "complete-all <meeting_id>",
+		Short: "Mark every open action item in a meeting as completed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.CompleteActionItems == nil {
+				return fmt.Errorf("action item functionality not configured")
+			}
+			out, err := deps.CompleteActionItems.Execute(cmd.Context(), meetingapp.CompleteActionItemsInput{
+				MeetingID: domain.MeetingID(args[0]),
+				AllOpen:   true,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to complete action items: %w", err)
+			}
+			for _, item := range out.Items {
+				_, _ = fmt.Fprintf(deps.Out, "Action item %s completed (text: %s)\n", item.ID(), item.Text())
+			}
+			failed := make([]domain.ActionItemID, 0, len(out.Errors))
+			for id := range out.Errors {
+				failed = append(failed, id)
+			}
+			sort.Slice(failed, func(i, j int) bool { return failed[i] < failed[j] })
+			for _, id := range failed {
+				_, _ = fmt.Fprintf(deps.Out, "Action item %s failed: %v\n", id, out.Errors[id])
+			}
+			_, _ = fmt.Fprintf(deps.Out, "%d action item(s) completed, %d failed\n", len(out.Items), len(out.Errors))
+			return nil
+		},
+	}
+}
+
 func newActionUpdateCmd(deps *Dependencies) *cobra.Command {
 	return &cobra.Command{
 		Use:   "update <meeting_id> <action_item_id> <text>",
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	auditapp "github.com/felixgeelhaar/acai/internal/application/audit"
+	"github.com/spf13/cobra"
+)
+
+// auditActor attributes audited writes made through CLI commands.
+const auditActor = "cli"
+
+func newAuditCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Review the audit log of local write operations",
+	}
+
+	cmd.AddCommand(newAuditListCmd(deps))
+	return cmd
+}
+
+func newAuditListCmd(deps *Dependencies) *cobra.Command {
+	var (
+		since     string
+		operation string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audited note and action item changes, oldest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.ListAuditEntries == nil {
+				return fmt.Errorf("audit log not configured")
+			}
+			input := auditapp.ListEntriesInput{Operation: operation}
+			var err error
+			if input.Since, err = parseDateFlag(deps, "since", since); err != nil {
+				return err
+			}
+
+			out, err := deps.ListAuditEntries.Execute(cmd.Context(), input)
+			if err != nil {
+				return fmt.Errorf("failed to list audit entries: %w", err)
+			}
+
+			switch flagFormat {
+			case "json":
+				return printJSON(deps, out.Entries)
+			default:
+				w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "TIME\tOPERATION\tTARGET\tACTOR\tDETAILS")
+				for _, e := range out.Entries {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+						displayTime(deps, e.Timestamp).Format("2006-01-02 15:04"), e.Operation, e.TargetID, e.Actor, e.Details)
+				}
+				return w.Flush()
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "", "Only entries on or after this date (RFC3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&operation, "operation", "", "Only this operation (add_note, delete_note, clear_notes, complete_action_item, update_action_item)")
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"text/tabwriter"
 
 	authapp "github.com/felixgeelhaar/acai/internal/application/auth"
 	domain "github.com/felixgeelhaar/acai/internal/domain/auth"
@@ -16,6 +17,8 @@ func newAuthCmd(deps *Dependencies) *cobra.Command {
 
 	cmd.AddCommand(newAuthLoginCmd(deps))
 	cmd.AddCommand(newAuthStatusCmd(deps))
+	cmd.AddCommand(newAuthRefreshCmd(deps))
+	cmd.AddCommand(newAuthListCmd(deps))
 
 	return cmd
 }
@@ -39,7 +42,8 @@ func newAuthLoginCmd(deps *Dependencies) *cobra.Command {
 				return fmt.Errorf("login failed: %w", err)
 			}
 
-			_, _ = fmt.Fprintf(deps.Out, "Authenticated successfully (workspace: %s)\n", out.Credential.Workspace())
+			_, _ = fmt.Fprintf(deps.Out, "Authenticated successfully (workspace: %s, profile: %s)\n",
+				out.Credential.Workspace(), domain.ProfileFromContext(cmd.Context()))
 			return nil
 		},
 	}
@@ -59,14 +63,126 @@ func newAuthStatusCmd(deps *Dependencies) *cobra.Command {
 				return err
 			}
 
+			if flagFormat == "json" {
+				return printJSON(deps, toStatusResult(cmd, out))
+			}
 			if !out.Authenticated {
-				_, _ = fmt.Fprintln(deps.Out, "Not authenticated. Run 'acai auth login' to authenticate.")
+				_, _ = fmt.Fprintln(deps.Out, notAuthenticatedMessage)
 				return nil
 			}
 
-			_, _ = fmt.Fprintf(deps.Out, "Authenticated (workspace: %s, method: %s)\n",
-				out.Credential.Workspace(), out.Credential.Method())
+			as := ""
+			if !out.Identity.IsZero() {
+				as = " as " + out.Identity.String()
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Authenticated%s (workspace: %s, method: %s)\n",
+				as, out.Credential.Workspace(), out.Credential.Method())
+			return nil
+		},
+	}
+}
+
+const notAuthenticatedMessage = "Not authenticated. Run 'acai auth login' to authenticate."
+
+// statusResult is the JSON form of auth status and whoami.
+type statusResult struct {
+	Authenticated bool   `json:"authenticated"`
+	Profile       string `json:"profile"`
+	Workspace     string `json:"workspace,omitempty"`
+	Method        string `json:"method,omitempty"`
+	AccountID     string `json:"account_id,omitempty"`
+	Email         string `json:"email,omitempty"`
+	Name          string `json:"name,omitempty"`
+}
+
+func toStatusResult(cmd *cobra.Command, out *authapp.CheckStatusOutput) statusResult {
+	r := statusResult{
+		Authenticated: out.Authenticated,
+		Profile:       domain.ProfileFromContext(cmd.Context()),
+	}
+	if !out.Authenticated {
+		return r
+	}
+	r.Workspace = out.Credential.Workspace()
+	r.Method = string(out.Credential.Method())
+	r.AccountID = out.Identity.AccountID()
+	r.Email = out.Identity.Email()
+	r.Name = out.Identity.Name()
+	return r
+}
+
+func newAuthRefreshCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refresh",
+		Short: "Refresh the OAuth access token using the stored refresh token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.RefreshToken == nil {
+				return fmt.Errorf("token refresh not configured")
+			}
+			out, err := deps.RefreshToken.Execute(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("refresh failed: %w", err)
+			}
+
+			expires := "unknown"
+			if at := out.Credential.Token().ExpiresAt(); !at.IsZero() {
+				expires = displayTime(deps, at).Format("2006-01-02 15:04")
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Token refreshed (workspace: %s, expires: %s)\n", out.Credential.Workspace(), expires)
 			return nil
 		},
 	}
 }
+
+type profileResult struct {
+	Name      string `json:"name"`
+	Workspace string `json:"workspace"`
+	Method    string `json:"method"`
+	Valid     bool   `json:"valid"`
+}
+
+func newAuthListCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List saved credential profiles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.ListProfiles == nil {
+				return fmt.Errorf("profile listing not configured")
+			}
+			out, err := deps.ListProfiles.Execute(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list profiles: %w", err)
+			}
+
+			results := make([]profileResult, len(out.Profiles))
+			for i, p := range out.Profiles {
+				results[i] = profileResult{
+					Name:      p.Name(),
+					Workspace: p.Credential().Workspace(),
+					Method:    string(p.Credential().Method()),
+					Valid:     p.Credential().IsValid(),
+				}
+			}
+
+			switch flagFormat {
+			case "json":
+				return printJSON(deps, results)
+			default:
+				if len(results) == 0 {
+					_, _ = fmt.Fprintln(deps.Out, "No saved profiles. Run 'acai auth login' to authenticate.")
+					return nil
+				}
+				w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "PROFILE\tWORKSPACE\tMETHOD\tSTATUS")
+				for _, r := range results {
+					status := "valid"
+					if !r.Valid {
+						status = "expired"
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Name, r.Workspace, r.Method, status)
+				}
+				return w.Flush()
+			}
+		},
+	}
+}
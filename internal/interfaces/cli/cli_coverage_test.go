@@ -2,9 +2,27 @@ package cli_test
 
 import (
 	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	auditapp "github.com/felixgeelhaar/acai/internal/application/audit"
+	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/events"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/outbox"
 	"github.com/felixgeelhaar/acai/internal/interfaces/cli"
 )
 
@@ -48,6 +66,30 @@ func TestListMeetingsCmd_WithSource(t *testing.T) {
 	}
 }
 
+func TestListMeetingsCmd_Count(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListMeetings = meetingapp.NewListMeetings(&pickRepo{})
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"list", "meetings", "--count", "--limit", "1", "--format", "table"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); output != "2\n" {
+		t.Errorf("got %q, want the count of both meetings", output)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"list", "meetings", "--count", "--format", "json"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, `"count": 2`) {
+		t.Errorf("got %q, want a JSON count", output)
+	}
+}
+
 func TestSyncCmd_WithSinceDate(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -84,6 +126,49 @@ func TestSyncCmd_InvalidSince(t *testing.T) {
 	}
 }
 
+// mockSyncState is an in-memory sync high-water mark.
+type mockSyncState struct {
+	mark *time.Time
+}
+
+func (m *mockSyncState) LastSyncedAt(context.Context) (*time.Time, error) { return m.mark, nil }
+
+func (m *mockSyncState) SaveLastSyncedAt(_ context.Context, t time.Time) error {
+	m.mark = &t
+	return nil
+}
+
+func TestSyncCmd_ResumesFromLastSync(t *testing.T) {
+	deps := testDeps(t)
+	mark := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	deps.SyncMeetings.SetSyncState(&mockSyncState{mark: &mark})
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"sync"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "Resuming from last sync at 2025-03-01 12:00") {
+		t.Errorf("expected resume notice, got: %q", output)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"sync", "--full"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); strings.Contains(output, "Resuming") {
+		t.Errorf("--full should not resume, got: %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"sync", "--full", "--since", "2025-01-01"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error combining --full and --since")
+	}
+}
+
 func TestExportMeetingCmd_JSONFormat(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -167,6 +252,52 @@ func TestNoteListCmd_JSONFormat(t *testing.T) {
 	}
 }
 
+func TestNoteSearchCmd(t *testing.T) {
+	deps := testDeps(t)
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"note", "add", "m-1", "Budget approved"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("add note: %v", err)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"note", "search", "Budget", "--format", "table"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "MEETING") || !strings.Contains(output, "m-1") {
+		t.Errorf("expected matching note with its meeting, got: %q", output)
+	}
+}
+
+func TestNoteDeleteAllCmd(t *testing.T) {
+	deps := testDeps(t)
+
+	for _, text := range []string{"first", "second"} {
+		root := cli.NewRootCmd(deps)
+		root.SetArgs([]string{"note", "add", "m-1", text})
+		if err := root.Execute(); err != nil {
+			t.Fatalf("add note: %v", err)
+		}
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"note", "delete-all", "m-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "Deleted 2 note(s)") {
+		t.Errorf("expected deleted count, got: %q", output)
+	}
+}
+
 func TestNoteDeleteCmd_NotFound(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -206,6 +337,229 @@ func TestActionCompleteCmd_MissingArgs(t *testing.T) {
 	}
 }
 
+func TestActionCompleteAllCmd(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"action", "complete-all", "m-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "Action item ai-1 completed") {
+		t.Errorf("expected ai-1 completed, got: %q", output)
+	}
+	if !strings.Contains(output, "completed, 0 failed") {
+		t.Errorf("expected summary line, got: %q", output)
+	}
+}
+
+// mockAuditRepo is an in-memory audit log.
+type mockAuditRepo struct {
+	entries []audit.Entry
+}
+
+func (m *mockAuditRepo) Record(_ context.Context, entry audit.Entry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *mockAuditRepo) List(_ context.Context, filter audit.Filter) ([]audit.Entry, error) {
+	var out []audit.Entry
+	for _, e := range m.entries {
+		if filter.Operation == "" || e.Operation == filter.Operation {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func TestAuditListCmd_ShowsCLIWrites(t *testing.T) {
+	deps := testDeps(t)
+	auditRepo := &mockAuditRepo{}
+	deps.CompleteActionItem.SetAuditLogger(auditRepo)
+	deps.ListAuditEntries = auditapp.NewListEntries(auditRepo)
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"action", "complete", "m-1", "ai-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"audit", "list", "--operation", "complete_action_item"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "OPERATION") {
+		t.Errorf("expected table headers, got: %q", output)
+	}
+	if !strings.Contains(output, "complete_action_item") || !strings.Contains(output, "ai-1") || !strings.Contains(output, "cli") {
+		t.Errorf("expected the completion attributed to cli, got: %q", output)
+	}
+}
+
+func TestAuditListCmd_InvalidFilters(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListAuditEntries = auditapp.NewListEntries(&mockAuditRepo{})
+
+	for _, args := range [][]string{
+		{"audit", "list", "--operation", "drop_table"},
+		{"audit", "list", "--since", "last week"},
+	} {
+		root := cli.NewRootCmd(deps)
+		root.SetArgs(args)
+		if err := root.Execute(); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
+
+// mockOutboxStore is an in-memory outbox.
+type mockOutboxStore struct {
+	entries []outbox.Entry
+}
+
+func (m *mockOutboxStore) ListPending() ([]outbox.Entry, error) { return m.byStatus("pending"), nil }
+func (m *mockOutboxStore) ListFailed() ([]outbox.Entry, error)  { return m.byStatus("failed"), nil }
+
+// Redeliver delivers every matching failed entry.
+func (m *mockOutboxStore) Redeliver(_ context.Context, eventType string) (int, int, error) {
+	n := 0
+	for i, e := range m.entries {
+		if e.Status == "failed" && (eventType == "" || e.EventType == eventType) {
+			m.entries[i].Status = "synced"
+			n++
+		}
+	}
+	return n, 0, nil
+}
+
+func (m *mockOutboxStore) byStatus(status string) []outbox.Entry {
+	var out []outbox.Entry
+	for _, e := range m.entries {
+		if e.Status == status {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func TestOutboxCmds(t *testing.T) {
+	deps := testDeps(t)
+	now := time.Now().UTC()
+	store := &mockOutboxStore{entries: []outbox.Entry{
+		{ID: "evt-1", EventType: "note.added", Status: "pending", CreatedAt: now},
+		{ID: "evt-2", EventType: "note.added", Status: "failed", Attempts: 3, CreatedAt: now},
+		{ID: "evt-3", EventType: "action_item.completed", Status: "failed", Attempts: 3, CreatedAt: now},
+	}}
+	deps.Outbox = store
+	deps.OutboxRedeliver = store
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"outbox", "list", "--format", "table"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "ATTEMPTS") || !strings.Contains(output, "evt-1") || !strings.Contains(output, "evt-3") {
+		t.Errorf("expected pending and failed entries, got: %q", output)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"outbox", "retry", "--event-type", "note.added"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "Redelivered 1 failed entries; 0 failed again") {
+		t.Errorf("got %q", output)
+	}
+	if failed := store.byStatus("failed"); len(failed) != 1 || failed[0].ID != "evt-3" {
+		t.Errorf("got failed %+v, want only evt-3", failed)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"outbox", "list", "--status", "failed", "--format", "json"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output = deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, `"evt-3"`) || strings.Contains(output, `"evt-1"`) {
+		t.Errorf("expected only failed entries, got: %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"outbox", "list", "--status", "synced"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
+
+// mockSpeakerAliases is an in-memory speaker alias store.
+type mockSpeakerAliases map[string]string
+
+func (m mockSpeakerAliases) SaveSpeakerAlias(_ context.Context, alias, canonical string) error {
+	m[alias] = canonical
+	return nil
+}
+
+func (m mockSpeakerAliases) DeleteSpeakerAlias(_ context.Context, alias string) error {
+	delete(m, alias)
+	return nil
+}
+
+func (m mockSpeakerAliases) ListSpeakerAliases(context.Context) (map[string]string, error) {
+	return m, nil
+}
+
+func TestSpeakersCmds(t *testing.T) {
+	deps := testDeps(t)
+	store := mockSpeakerAliases{}
+	deps.SetSpeakerAlias = meetingapp.NewSetSpeakerAlias(store)
+	deps.RemoveSpeakerAlias = meetingapp.NewRemoveSpeakerAlias(store)
+	deps.ListSpeakerAliases = meetingapp.NewListSpeakerAliases(store)
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"speakers", "alias", "Speaker 1", "Alice"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store["Speaker 1"] != "Alice" {
+		t.Errorf("got aliases %v, want Speaker 1 -> Alice", store)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"speakers", "list", "--format", "table"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "CANONICAL") || !strings.Contains(output, "Speaker 1") {
+		t.Errorf("got %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"speakers", "alias", "alice", "Alice"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error aliasing a name to itself")
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"speakers", "unalias", "Speaker 1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store) != 0 {
+		t.Errorf("got aliases %v after unalias, want none", store)
+	}
+}
+
 func TestActionUpdateCmd(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -246,3 +600,464 @@ func TestAuthLoginCmd_APIToken(t *testing.T) {
 		t.Errorf("expected success message, got: %q", output)
 	}
 }
+
+// mockMeetingTags is an in-memory meeting tag store.
+type mockMeetingTags map[domain.MeetingID][]string
+
+func (m mockMeetingTags) AddTag(_ context.Context, id domain.MeetingID, tag string) error {
+	if !slices.Contains(m[id], tag) {
+		m[id] = append(m[id], tag)
+	}
+	return nil
+}
+
+func (m mockMeetingTags) RemoveTag(_ context.Context, id domain.MeetingID, tag string) error {
+	m[id] = slices.DeleteFunc(m[id], func(t string) bool { return t == tag })
+	return nil
+}
+
+func (m mockMeetingTags) TagsOf(_ context.Context, id domain.MeetingID) ([]string, error) {
+	return append([]string{}, m[id]...), nil
+}
+
+func (m mockMeetingTags) ListTags(_ context.Context) (map[domain.MeetingID][]string, error) {
+	return m, nil
+}
+
+func TestMeetingTagCmds(t *testing.T) {
+	deps := testDeps(t)
+	store := mockMeetingTags{}
+	deps.TagMeeting = meetingapp.NewTagMeeting(store)
+	deps.UntagMeeting = meetingapp.NewUntagMeeting(store)
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"meeting", "tag", "m-1", "Planning", "--format", "table"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "planning") {
+		t.Errorf("got %q", output)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"meeting", "untag", "m-1", "planning"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "no tags") {
+		t.Errorf("got %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"meeting", "tag", "m-1", " "})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error for a blank tag")
+	}
+}
+
+func TestExportEmbeddingsCmd_OutputFile(t *testing.T) {
+	deps := testDeps(t)
+	deps.ExportEmbeddings = embeddingapp.NewExportEmbeddings(&missingMeetingRepo{}, nil)
+	path := filepath.Join(t.TempDir(), "chunks.jsonl")
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"export", "embeddings", "--meetings", "m-1,missing", "--output", path})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "chunks exported to "+path) || !strings.Contains(output, "# skipped missing:") {
+		t.Errorf("got %q", output)
+	}
+	if strings.Contains(output, `"meeting_id"`) {
+		t.Errorf("chunks should go to the file, not stdout: %q", output)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("output file: %v", err)
+	}
+}
+
+func TestPolicyCmds(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "policy.yaml")
+	if err := os.WriteFile(valid, []byte(`
+rules:
+  - name: no-confidential-deletes
+    effect: deny
+    tools: [delete_note]
+    conditions:
+      meeting_tags: [confidential]
+    message: Confidential notes are kept
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(invalid, []byte(`
+rules:
+  - name: all
+    effect: deny
+  - name: typo
+    effect: allow
+    tools: [delete_notes]
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"policy", "validate", valid})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "is valid: 1 rules") {
+		t.Errorf("got %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"policy", "validate", invalid})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown tool "delete_notes"`) || !strings.Contains(err.Error(), "unreachable") {
+		t.Errorf("got %v, want unknown tool and unreachable rule", err)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	deps.PolicyFile = valid
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"policy", "test", "--tool", "delete_note"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "delete_note would be allowed by the default effect") {
+		t.Errorf("got %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"policy", "test", "--tool", "delete_note", "--tags", "team, confidential"})
+	err = root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `rule "no-confidential-deletes"`) || !strings.Contains(err.Error(), "Confidential notes are kept") {
+		t.Errorf("got %v, want denial by no-confidential-deletes", err)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"policy", "test", "--tool", "drop_tables"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error for unknown tool")
+	}
+}
+
+// mockArchiver records the archive and policy it was last asked to import.
+type mockArchiver struct {
+	archive  *localstore.Archive
+	imported *localstore.Archive
+	policy   localstore.ConflictPolicy
+}
+
+func (m *mockArchiver) Export(_ context.Context) (*localstore.Archive, error) { return m.archive, nil }
+
+func (m *mockArchiver) Import(_ context.Context, archive *localstore.Archive, policy localstore.ConflictPolicy) (*localstore.ImportResult, error) {
+	m.imported, m.policy = archive, policy
+	return &localstore.ImportResult{
+		Notes:         localstore.ImportCounts{Imported: len(archive.Notes)},
+		OutboxEntries: localstore.ImportCounts{Skipped: len(archive.OutboxEntries)},
+	}, nil
+}
+
+func TestExportImportDBCmds(t *testing.T) {
+	deps := testDeps(t)
+	archiver := &mockArchiver{archive: &localstore.Archive{
+		Version:       localstore.ArchiveVersion,
+		Notes:         []localstore.NoteRecord{{ID: "n-1", MeetingID: "m-1", Author: "claude", Content: "hi"}},
+		OutboxEntries: []localstore.OutboxRecord{{ID: "evt-1", EventType: "note.added", Status: "pending"}},
+	}}
+	deps.LocalArchive = archiver
+	path := filepath.Join(t.TempDir(), "backup.json")
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"export-db", "--output", path})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("export-db: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "Exported 1 notes, 0 action item overrides, 1 outbox entries, 0 meeting tags, and 0 speaker aliases") {
+		t.Errorf("got %q", output)
+	}
+	deps.Out.(*bytes.Buffer).Reset()
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"import-db", "--input", path, "--on-conflict", "overwrite"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("import-db: %v", err)
+	}
+	if archiver.policy != localstore.ConflictOverwrite {
+		t.Errorf("got policy %q, want overwrite", archiver.policy)
+	}
+	if archiver.imported == nil || len(archiver.imported.Notes) != 1 || archiver.imported.Notes[0].Content != "hi" {
+		t.Errorf("archive did not survive the file round trip: %+v", archiver.imported)
+	}
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "Notes: 1 imported, 0 skipped") || !strings.Contains(output, "Outbox entries: 0 imported, 1 skipped") {
+		t.Errorf("got %q", output)
+	}
+
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"import-db", "--input", path, "--on-conflict", "merge"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error for unknown conflict policy")
+	}
+}
+
+func TestServeCmd_BothTransports(t *testing.T) {
+	// Keep stdin open so the stdio transport stays up until shutdown.
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	origStdin := os.Stdin
+	os.Stdin = stdinR
+	t.Cleanup(func() {
+		os.Stdin = origStdin
+		_ = stdinW.Close()
+		_ = stdinR.Close()
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	deps := testDeps(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"serve", "--transport", "both", "--port", strconv.Itoa(port)})
+	done := make(chan error, 1)
+	go func() { done <- root.ExecuteContext(ctx) }()
+
+	healthURL := fmt.Sprintf("http://127.0.0.1:%d/health", port)
+	var healthy bool
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		resp, err := http.Get(healthURL)
+		if err != nil {
+			continue
+		}
+		_ = resp.Body.Close()
+		healthy = resp.StatusCode == http.StatusOK
+		break
+	}
+	if !healthy {
+		t.Fatal("health endpoint did not respond while stdio was served")
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("serve returned while stdio was still open: %v", err)
+	default:
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not stop in time")
+	}
+	if _, err := http.Get(healthURL); err == nil {
+		t.Error("HTTP server should be shut down with stdio")
+	}
+}
+
+func TestServeCmd_CustomWebhookPath(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	deps := testDeps(t)
+	deps.WebhookPath = "/ingress/acai/webhook"
+	deps.WebhookHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"serve", "--transport", "http", "--port", strconv.Itoa(port)})
+	done := make(chan error, 1)
+	go func() { done <- root.ExecuteContext(ctx) }()
+
+	base := fmt.Sprintf("http://127.0.0.1:%d", port)
+	status := 0
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		resp, err := http.Post(base+"/ingress/acai/webhook", "application/json", strings.NewReader("{}"))
+		if err != nil {
+			continue
+		}
+		_ = resp.Body.Close()
+		status = resp.StatusCode
+		break
+	}
+	if status != http.StatusAccepted {
+		t.Fatalf("got status %d on the custom path, want %d", status, http.StatusAccepted)
+	}
+
+	resp, err := http.Post(base+cli.DefaultWebhookPath, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("got status %d on the default path, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not stop in time")
+	}
+}
+
+// sinceRecordingRepo records the since each Sync receives.
+type sinceRecordingRepo struct {
+	mockMeetingRepo
+	since *time.Time
+}
+
+func (m *sinceRecordingRepo) Sync(_ context.Context, since *time.Time) (*domain.SyncResult, error) {
+	m.since = since
+	return &domain.SyncResult{}, nil
+}
+
+func TestSyncCmd_SinceDateFormats(t *testing.T) {
+	tests := []struct {
+		name     string
+		since    string
+		timezone string
+		want     time.Time
+	}{
+		{"RFC3339", "2025-01-01T09:00:00-05:00", "Asia/Tokyo", time.Date(2025, 1, 1, 14, 0, 0, 0, time.UTC)},
+		{"date only in UTC", "2025-01-01", "", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"date only in display zone", "2025-01-01", "Asia/Tokyo", time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &sinceRecordingRepo{}
+			deps := testDeps(t)
+			deps.SyncMeetings = meetingapp.NewSyncMeetings(repo)
+			deps.Timezone = tt.timezone
+
+			root := cli.NewRootCmd(deps)
+			root.SetArgs([]string{"sync", "--since", tt.since})
+			if err := root.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if repo.since == nil || !repo.since.Equal(tt.want) {
+				t.Errorf("got since %v, want %s", repo.since, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventsTailCmd_PrintsStreamedEvents(t *testing.T) {
+	b := events.NewBroadcaster()
+	srv := httptest.NewServer(events.NewStreamHandler(b))
+	defer srv.Close()
+
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"events", "tail", "--url", srv.URL, "--count", "1", "--format", "table"})
+	done := make(chan error, 1)
+	go func() { done <- root.Execute() }()
+
+	// Events published before tail subscribes are not replayed, so keep
+	// publishing until it has printed one.
+	timeout := time.After(5 * time.Second)
+	for {
+		b.Publish(domain.NewMeetingDeletedEvent("m-1"))
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			output := deps.Out.(*bytes.Buffer).String()
+			if !strings.Contains(output, "meeting.deleted") || !strings.Contains(output, "m-1") {
+				t.Errorf("expected the deleted event, got: %q", output)
+			}
+			return
+		case <-timeout:
+			t.Fatal("events tail did not print an event in time")
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+}
+
+func TestEventsTailCmd_RejectsErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"events", "tail", "--url", srv.URL})
+	if err := root.Execute(); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("got error %v, want a 404 status error", err)
+	}
+}
+
+// backgroundWebhook records how serve binds and drains it.
+type backgroundWebhook struct {
+	http.HandlerFunc
+	ctx    context.Context
+	waited bool
+}
+
+func (b *backgroundWebhook) SetBaseContext(ctx context.Context) { b.ctx = ctx }
+func (b *backgroundWebhook) Wait()                              { b.waited = b.ctx != nil && b.ctx.Err() != nil }
+
+func TestServeCmd_WebhookBoundToServerLifetime(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	hook := &backgroundWebhook{HandlerFunc: func(w http.ResponseWriter, r *http.Request) {}}
+	deps := testDeps(t)
+	deps.WebhookHandler = hook
+	ctx, cancel := context.WithCancel(context.Background())
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"serve", "--transport", "http", "--port", strconv.Itoa(port)})
+	done := make(chan error, 1)
+	go func() { done <- root.ExecuteContext(ctx) }()
+
+	healthURL := fmt.Sprintf("http://127.0.0.1:%d/health", port)
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		if resp, err := http.Get(healthURL); err == nil {
+			_ = resp.Body.Close()
+			break
+		}
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not stop in time")
+	}
+	if hook.ctx == nil {
+		t.Fatal("webhook handler was not given the server context")
+	}
+	if !hook.waited {
+		t.Error("serve should wait for webhook work after cancelling its context")
+	}
+}
@@ -3,6 +3,8 @@ package cli_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -17,6 +19,7 @@ import (
 	domainauth "github.com/felixgeelhaar/acai/internal/domain/auth"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 	"github.com/felixgeelhaar/acai/internal/domain/workspace"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/resilience"
 	"github.com/felixgeelhaar/acai/internal/interfaces/cli"
 	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
 )
@@ -25,7 +28,7 @@ func TestRootCmd_HasExpectedSubcommands(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
 
-	expected := []string{"auth", "sync", "list", "export", "serve", "workspace", "note", "action", "version"}
+	expected := []string{"auth", "sync", "list", "export", "serve", "workspace", "note", "action", "doctor", "version"}
 	for _, name := range expected {
 		found := false
 		for _, cmd := range root.Commands() {
@@ -57,6 +60,198 @@ func TestVersionCmd(t *testing.T) {
 	}
 }
 
+type fakeBreaker struct {
+	state resilience.BreakerState
+}
+
+func (f *fakeBreaker) State() resilience.BreakerState { return f.state }
+
+func TestDoctorCmd_ReportsBreakerState(t *testing.T) {
+	deps := testDeps(t)
+	deps.Breaker = &fakeBreaker{state: resilience.BreakerState{
+		State:               "open",
+		ConsecutiveFailures: 5,
+		LastTrippedAt:       time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}}
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"doctor"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	for _, want := range []string{"not authenticated", "reachable", "open (consecutive failures: 5)", "2026-01-02T03:04:05Z"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got: %q", want, output)
+		}
+	}
+}
+
+type failingListRepo struct {
+	mockMeetingRepo
+}
+
+func (f *failingListRepo) List(_ context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestDoctorCmd_ProbesAPIPastCache(t *testing.T) {
+	deps := testDeps(t)
+	deps.APIProbe = meetingapp.NewListMeetings(&failingListRepo{})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"doctor"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "unreachable") || !strings.Contains(output, "connection refused") {
+		t.Errorf("expected the failed probe in output, got: %q", output)
+	}
+}
+
+type fakeRateLimiter struct {
+	state resilience.RateLimitState
+}
+
+func (f *fakeRateLimiter) RateLimit() resilience.RateLimitState { return f.state }
+
+func TestDoctorCmd_ReportsRateLimitHeadroom(t *testing.T) {
+	deps := testDeps(t)
+	deps.RateLimiter = &fakeRateLimiter{state: resilience.RateLimitState{
+		Tokens:         25,
+		Burst:          200,
+		Rate:           100,
+		Interval:       time.Minute,
+		RecentRequests: 180,
+	}}
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"doctor"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	for _, want := range []string{"12% headroom", "25.0 of 200 tokens", "limit 100 per 1m0s", "180 in the last 1m0s"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got: %q", want, output)
+		}
+	}
+}
+
+type fakeRawFetcher struct {
+	document   json.RawMessage
+	transcript json.RawMessage
+}
+
+func (f *fakeRawFetcher) GetDocumentRaw(_ context.Context, _ string) (json.RawMessage, error) {
+	return f.document, nil
+}
+
+func (f *fakeRawFetcher) GetTranscriptRaw(_ context.Context, _ string) (json.RawMessage, error) {
+	return f.transcript, nil
+}
+
+func TestDebugFetchCmds_PrintRawResponse(t *testing.T) {
+	raw := `{"id":"m-1","unmapped_field":{"nested":true}}`
+	deps := testDeps(t)
+	deps.RawFetcher = &fakeRawFetcher{document: json.RawMessage(raw), transcript: json.RawMessage(`{"utterances":[]}`)}
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"debug", "fetch-document", "m-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := deps.Out.(*bytes.Buffer).String(); got != raw+"\n" {
+		t.Errorf("got %q, want raw body %q", got, raw)
+	}
+
+	deps.Out.(*bytes.Buffer).Reset()
+	root.SetArgs([]string{"debug", "fetch-transcript", "m-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := deps.Out.(*bytes.Buffer).String(); !strings.Contains(got, `"utterances"`) {
+		t.Errorf("unexpected transcript output: %q", got)
+	}
+}
+
+func TestDebugCmd_HiddenFromHelp(t *testing.T) {
+	root := cli.NewRootCmd(testDeps(t))
+	for _, cmd := range root.Commands() {
+		if cmd.Name() == "debug" && !cmd.Hidden {
+			t.Error("debug command should be hidden")
+		}
+	}
+}
+
+func TestAuthRefreshCmd_NoRefreshToken(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"auth", "refresh"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "no refresh token") {
+		t.Errorf("expected no refresh token error, got: %v", err)
+	}
+}
+
+type mockProfileRepo struct{}
+
+func (m *mockProfileRepo) ListProfiles(_ context.Context) ([]domainauth.Profile, error) {
+	token := domainauth.NewToken("t", "", time.Now().Add(time.Hour).UTC())
+	return []domainauth.Profile{
+		domainauth.NewProfile("default", domainauth.NewCredential(domainauth.AuthOAuth, token, "main-ws")),
+		domainauth.NewProfile("work", domainauth.NewCredential(domainauth.AuthAPIToken, token, "work-ws")),
+	}, nil
+}
+
+func TestAuthListCmd(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListProfiles = authapp.NewListProfiles(&mockProfileRepo{})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"auth", "list"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	for _, want := range []string{"PROFILE", "default", "main-ws", "work", "work-ws", "api_token"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got: %q", want, output)
+		}
+	}
+}
+
+func TestAuthLoginCmd_WithProfile(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"auth", "login", "--profile", "work"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "profile: work") {
+		t.Errorf("expected profile in output, got: %q", output)
+	}
+}
+
+func TestRootCmd_InvalidProfile(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"auth", "status", "--profile", "../etc"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error for invalid profile name")
+	}
+}
+
 func TestAuthLoginCmd(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -82,6 +277,109 @@ func TestAuthStatusCmd(t *testing.T) {
 	}
 }
 
+// authenticatedService reports a stored credential for alice.
+type authenticatedService struct {
+	mockAuthService
+	identity domainauth.Identity
+}
+
+func (m *authenticatedService) Status(_ context.Context) (*domainauth.Credential, error) {
+	token := domainauth.NewToken("test", "", time.Now().Add(1*time.Hour).UTC())
+	return domainauth.NewCredential(domainauth.AuthOAuth, token, "test-ws").WithIdentity(m.identity), nil
+}
+
+func TestAuthStatusCmd_ShowsIdentity(t *testing.T) {
+	deps := testDeps(t)
+	deps.CheckStatus = authapp.NewCheckStatus(&authenticatedService{
+		identity: domainauth.NewIdentity("u-1", "alice@example.com", "Alice"),
+	})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"auth", "status"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "Authenticated as alice@example.com (workspace: test-ws") {
+		t.Errorf("got output %q", output)
+	}
+}
+
+func TestWhoamiCmd(t *testing.T) {
+	deps := testDeps(t)
+	deps.CheckStatus = authapp.NewCheckStatus(&authenticatedService{
+		identity: domainauth.NewIdentity("u-1", "alice@example.com", "Alice"),
+	})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"whoami"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	for _, want := range []string{"alice@example.com", "Alice", "u-1", "test-ws", "default"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got: %q", want, output)
+		}
+	}
+}
+
+func TestWhoamiCmd_JSON(t *testing.T) {
+	deps := testDeps(t)
+	deps.CheckStatus = authapp.NewCheckStatus(&authenticatedService{
+		identity: domainauth.NewIdentity("u-1", "alice@example.com", ""),
+	})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"whoami", "--format", "json"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(deps.Out.(*bytes.Buffer).Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if got["authenticated"] != true || got["email"] != "alice@example.com" || got["account_id"] != "u-1" {
+		t.Errorf("got %v", got)
+	}
+	if _, ok := got["name"]; ok {
+		t.Error("empty name should be omitted")
+	}
+}
+
+func TestWhoamiCmd_UnknownIdentity(t *testing.T) {
+	deps := testDeps(t)
+	deps.CheckStatus = authapp.NewCheckStatus(&authenticatedService{})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"whoami"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "account is unknown") {
+		t.Errorf("got output %q", output)
+	}
+}
+
+func TestWhoamiCmd_NotAuthenticated(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"whoami", "--format", "json"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, `"authenticated": false`) {
+		t.Errorf("got output %q", output)
+	}
+}
+
 func TestSyncCmd(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -97,6 +395,148 @@ func TestSyncCmd(t *testing.T) {
 	}
 }
 
+type partialSyncRepo struct {
+	mockMeetingRepo
+}
+
+func (m *partialSyncRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{
+		Events: []domain.DomainEvent{domain.NewMeetingCreatedEvent("m-1", "Good", time.Now().UTC())},
+		Errors: []domain.SyncError{{MeetingID: "m-bad", Err: domain.ErrInvalidTitle}},
+	}, nil
+}
+
+func TestSyncCmd_ReportsSkipped(t *testing.T) {
+	deps := testDeps(t)
+	deps.SyncMeetings = meetingapp.NewSyncMeetings(&partialSyncRepo{})
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"sync"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "Synced 1 meeting event(s) (1 skipped due to errors)") {
+		t.Errorf("unexpected output: %q", output)
+	}
+	if strings.Contains(output, "m-bad") {
+		t.Errorf("skipped IDs should only be listed with --verbose: %q", output)
+	}
+
+	deps.Out.(*bytes.Buffer).Reset()
+	root = cli.NewRootCmd(deps)
+	root.SetArgs([]string{"sync", "--verbose"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "skipped m-bad") {
+		t.Errorf("expected skipped ID with --verbose, got: %q", output)
+	}
+}
+
+// pickRepo lists two meetings so --pick has something to offer.
+type pickRepo struct {
+	mockMeetingRepo
+}
+
+func (m *pickRepo) List(_ context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+	base := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	newer, _ := domain.New("m-new", "Standup", base.Add(24*time.Hour), domain.SourceZoom, nil)
+	older, _ := domain.New("m-old", "Planning", base, domain.SourceZoom, nil)
+	return []*domain.Meeting{older, newer}, nil
+}
+
+func TestNoteAddCmd_PickSelectsMeeting(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListMeetings = meetingapp.NewListMeetings(&pickRepo{})
+	deps.IsTerminal = func() bool { return true }
+	deps.In = strings.NewReader("2\n")
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"note", "add", "--pick", "Follow up"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	// Newest first, so choice 2 is the older meeting.
+	for _, want := range []string{" 1) 2026-01-06 09:00  Standup  (m-new)", "Select a meeting [1-2]:", "added to meeting m-old"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got: %q", want, output)
+		}
+	}
+}
+
+func TestPick_RequiresTerminal(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListMeetings = meetingapp.NewListMeetings(&pickRepo{})
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"export", "meeting", "--pick"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "interactive terminal") {
+		t.Errorf("got error %v, want interactive terminal error", err)
+	}
+}
+
+func TestPick_InvalidSelection(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListMeetings = meetingapp.NewListMeetings(&pickRepo{})
+	deps.IsTerminal = func() bool { return true }
+	deps.In = strings.NewReader("7\n")
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"note", "delete-all", "--pick"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `invalid selection "7"`) {
+		t.Errorf("got error %v, want invalid selection", err)
+	}
+}
+
+type fakeOfflineSwitch struct {
+	offline bool
+}
+
+func (s *fakeOfflineSwitch) SetOffline(offline bool) { s.offline = offline }
+func (s *fakeOfflineSwitch) Offline() bool          { return s.offline }
+
+func TestRootCmd_OfflineFlag(t *testing.T) {
+	deps := testDeps(t)
+	sw := &fakeOfflineSwitch{}
+	deps.Offline = sw
+	root := cli.NewRootCmd(deps)
+	stderr := new(bytes.Buffer)
+	root.SetErr(stderr)
+
+	root.SetArgs([]string{"list", "meetings", "--offline"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !sw.offline {
+		t.Error("--offline should switch the repository offline")
+	}
+	if !strings.Contains(stderr.String(), "Offline mode active") {
+		t.Errorf("expected offline notice on stderr, got: %q", stderr.String())
+	}
+}
+
+func TestRootCmd_OfflineFromConfigAnnounced(t *testing.T) {
+	deps := testDeps(t)
+	deps.Offline = &fakeOfflineSwitch{offline: true}
+	root := cli.NewRootCmd(deps)
+	stderr := new(bytes.Buffer)
+	root.SetErr(stderr)
+
+	root.SetArgs([]string{"list", "meetings"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "Offline mode active") {
+		t.Errorf("expected offline notice on stderr, got: %q", stderr.String())
+	}
+}
+
 func TestExportMeetingCmd(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -112,6 +552,38 @@ func TestExportMeetingCmd(t *testing.T) {
 	}
 }
 
+// transcriptMeetingRepo serves a transcript with repeated speakers.
+type transcriptMeetingRepo struct {
+	mockMeetingRepo
+}
+
+func (m *transcriptMeetingRepo) GetTranscript(_ context.Context, id domain.MeetingID) (*domain.Transcript, error) {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	t := domain.NewTranscript(id, []domain.Utterance{
+		domain.NewUtterance("Alice", "So", start, 0.9),
+		domain.NewUtterance("Alice", "it slips.", start.Add(2*time.Second), 0.9),
+		domain.NewUtterance("Bob", "Okay.", start.Add(4*time.Second), 0.9),
+	})
+	return &t, nil
+}
+
+func TestExportTranscriptCmd_MergeSpeakerTurns(t *testing.T) {
+	deps := testDeps(t)
+	deps.ExportTranscript = exportapp.NewExportTranscript(&transcriptMeetingRepo{})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "transcript", "m-1", "--merge-speaker-turns"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	want := "[10:00:00-10:00:02] Alice: So it slips.\n[10:00:04] Bob: Okay.\n"
+	if output != want {
+		t.Errorf("got %q, want %q", output, want)
+	}
+}
+
 func TestExportMeetingCmd_MissingArg(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -123,6 +595,106 @@ func TestExportMeetingCmd_MissingArg(t *testing.T) {
 	}
 }
 
+// missingMeetingRepo reports "missing" as not found.
+type missingMeetingRepo struct {
+	mockMeetingRepo
+}
+
+func (r *missingMeetingRepo) FindByID(ctx context.Context, id domain.MeetingID) (*domain.Meeting, error) {
+	if id == "missing" {
+		return nil, domain.ErrMeetingNotFound
+	}
+	return r.mockMeetingRepo.FindByID(ctx, id)
+}
+
+func TestExportEmbeddingsCmd_ReportsSkippedMeetings(t *testing.T) {
+	deps := testDeps(t)
+	deps.ExportEmbeddings = embeddingapp.NewExportEmbeddings(&missingMeetingRepo{}, nil)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "embeddings", "--meetings", "m-1,missing", "--concurrency", "2"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "# skipped missing:") {
+		t.Errorf("expected skipped meeting in output, got: %q", output)
+	}
+}
+
+func TestExportNotesCmd_Markdown(t *testing.T) {
+	deps := testDeps(t)
+	note, _ := annotation.NewAgentNote("n-1", "m-1", "agent", "Follow up on pricing")
+	deps.ExportNotes = exportapp.NewExportNotes(&mockNoteRepo{notes: []*annotation.AgentNote{note}})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "notes", "m-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "## Meeting m-1") || !strings.Contains(output, "Follow up on pricing") {
+		t.Errorf("expected markdown notes, got: %q", output)
+	}
+}
+
+func TestExportNotesCmd_AllJSON(t *testing.T) {
+	deps := testDeps(t)
+	n1, _ := annotation.NewAgentNote("n-1", "m-2", "agent", "Second meeting")
+	n2, _ := annotation.NewAgentNote("n-2", "m-1", "agent", "First meeting")
+	deps.ExportNotes = exportapp.NewExportNotes(&mockNoteRepo{notes: []*annotation.AgentNote{n1, n2}})
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "notes", "--all", "--format", "json"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	first := strings.Index(output, `"meeting_id": "m-1"`)
+	second := strings.Index(output, `"meeting_id": "m-2"`)
+	if first < 0 || second < 0 || first > second {
+		t.Errorf("expected both meetings in ID order, got: %q", output)
+	}
+}
+
+func TestExportNotesCmd_AllRejectsMeetingID(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "notes", "--all", "m-1"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error when combining --all with a meeting ID")
+	}
+}
+
+func TestExportCalendarCmd(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "calendar", "--since", "2020-01-01"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.HasPrefix(output, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(output, "END:VCALENDAR\r\n") {
+		t.Errorf("expected iCalendar output, got: %q", output)
+	}
+}
+
+func TestExportCalendarCmd_InvalidDate(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+
+	root.SetArgs([]string{"export", "calendar", "--until", "next week"})
+	if err := root.Execute(); err == nil {
+		t.Error("expected error for invalid --until date")
+	}
+}
+
 func TestWorkspaceListCmd(t *testing.T) {
 	deps := testDeps(t)
 	root := cli.NewRootCmd(deps)
@@ -174,6 +746,9 @@ func (m *mockAuthService) Status(_ context.Context) (*domainauth.Credential, err
 	return nil, domainauth.ErrNotAuthenticated
 }
 func (m *mockAuthService) Logout(_ context.Context) error { return nil }
+func (m *mockAuthService) Refresh(_ context.Context) (*domainauth.Credential, error) {
+	return nil, domainauth.ErrNoRefreshToken
+}
 
 type mockMeetingRepo struct{}
 
@@ -195,8 +770,8 @@ func (m *mockMeetingRepo) GetActionItems(_ context.Context, _ domain.MeetingID)
 	ai, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Review PR", nil)
 	return []*domain.ActionItem{ai}, nil
 }
-func (m *mockMeetingRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
-	return []domain.DomainEvent{}, nil
+func (m *mockMeetingRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{Events: []domain.DomainEvent{}}, nil
 }
 
 type mockWorkspaceRepo struct {
@@ -232,6 +807,14 @@ func (m *mockNoteRepo) FindByID(_ context.Context, id annotation.NoteID) (*annot
 	}
 	return nil, annotation.ErrNoteNotFound
 }
+func (m *mockNoteRepo) FindByIdempotencyKey(_ context.Context, key string) (*annotation.AgentNote, error) {
+	for _, n := range m.notes {
+		if n.IdempotencyKey() == key {
+			return n, nil
+		}
+	}
+	return nil, annotation.ErrNoteNotFound
+}
 func (m *mockNoteRepo) ListByMeeting(_ context.Context, meetingID string) ([]*annotation.AgentNote, error) {
 	var result []*annotation.AgentNote
 	for _, n := range m.notes {
@@ -241,6 +824,9 @@ func (m *mockNoteRepo) ListByMeeting(_ context.Context, meetingID string) ([]*an
 	}
 	return result, nil
 }
+func (m *mockNoteRepo) ListAll(_ context.Context) ([]*annotation.AgentNote, error) {
+	return m.notes, nil
+}
 func (m *mockNoteRepo) Delete(_ context.Context, id annotation.NoteID) error {
 	for i, n := range m.notes {
 		if n.ID() == id {
@@ -250,6 +836,29 @@ func (m *mockNoteRepo) Delete(_ context.Context, id annotation.NoteID) error {
 	}
 	return annotation.ErrNoteNotFound
 }
+func (m *mockNoteRepo) DeleteByMeeting(_ context.Context, meetingID string) (int, error) {
+	kept := m.notes[:0]
+	for _, n := range m.notes {
+		if n.MeetingID() != meetingID {
+			kept = append(kept, n)
+		}
+	}
+	deleted := len(m.notes) - len(kept)
+	m.notes = kept
+	return deleted, nil
+}
+func (m *mockNoteRepo) Search(_ context.Context, query string, limit int) ([]*annotation.AgentNote, error) {
+	var result []*annotation.AgentNote
+	for _, n := range m.notes {
+		if strings.Contains(n.Content(), query) {
+			result = append(result, n)
+		}
+	}
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
 
 type mockWriteRepo struct{}
 
@@ -276,20 +885,28 @@ func testDeps(t *testing.T) *cli.Dependencies {
 
 	return &cli.Dependencies{
 		ListMeetings:      meetingapp.NewListMeetings(repo),
+		APIProbe:          meetingapp.NewListMeetings(repo),
 		GetMeeting:        meetingapp.NewGetMeeting(repo),
 		GetTranscript:     meetingapp.NewGetTranscript(repo),
 		SearchTranscripts: meetingapp.NewSearchTranscripts(repo),
 		GetActionItems:    meetingapp.NewGetActionItems(repo),
 		SyncMeetings:      meetingapp.NewSyncMeetings(repo),
 		ExportMeeting:     exportapp.NewExportMeeting(repo),
+		ExportTranscript:  exportapp.NewExportTranscript(repo),
+		ExportCalendar:    exportapp.NewExportCalendar(repo),
+		ExportNotes:       exportapp.NewExportNotes(noteRepo),
 		Login:             authapp.NewLogin(authSvc),
 		CheckStatus:       authapp.NewCheckStatus(authSvc),
+		RefreshToken:      authapp.NewRefreshToken(authSvc, nil),
 		ListWorkspaces:    workspaceapp.NewListWorkspaces(wsRepo),
 		GetWorkspace:      workspaceapp.NewGetWorkspace(wsRepo),
 		AddNote:           annotationapp.NewAddNote(noteRepo, repo, dispatcher),
 		ListNotes:         annotationapp.NewListNotes(noteRepo),
+		SearchNotes:       annotationapp.NewSearchNotes(noteRepo),
 		DeleteNote:        annotationapp.NewDeleteNote(noteRepo, dispatcher),
+		DeleteMeetingNotes: annotationapp.NewDeleteNotesByMeeting(noteRepo, dispatcher),
 		CompleteActionItem: meetingapp.NewCompleteActionItem(repo, writeRepo, dispatcher),
+		CompleteActionItems: meetingapp.NewCompleteActionItems(repo, meetingapp.NewCompleteActionItem(repo, writeRepo, dispatcher)),
 		UpdateActionItem:   meetingapp.NewUpdateActionItem(repo, writeRepo, dispatcher),
 		ExportEmbeddings:   embeddingapp.NewExportEmbeddings(repo, noteRepo),
 		MCPServer: mcpiface.NewServer("acai", "test", mcpiface.ServerOptions{
@@ -311,3 +928,46 @@ func testDeps(t *testing.T) *cli.Dependencies {
 		Out: buf,
 	}
 }
+
+func TestListMeetingsCmd_Timezone(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListMeetings = meetingapp.NewListMeetings(&pickRepo{})
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"list", "meetings", "--timezone", "America/New_York"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := deps.Out.(*bytes.Buffer).String()
+	if !strings.Contains(output, "2026-01-06 04:00") {
+		t.Errorf("expected 09:00 UTC shown as 04:00 New York time, got: %q", output)
+	}
+}
+
+func TestListMeetingsCmd_ConfiguredTimezone(t *testing.T) {
+	deps := testDeps(t)
+	deps.ListMeetings = meetingapp.NewListMeetings(&pickRepo{})
+	deps.Timezone = "Asia/Tokyo"
+
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"list", "meetings"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output := deps.Out.(*bytes.Buffer).String(); !strings.Contains(output, "2026-01-06 18:00") {
+		t.Errorf("expected configured Tokyo time, got: %q", output)
+	}
+}
+
+func TestRootCmd_InvalidTimezone(t *testing.T) {
+	deps := testDeps(t)
+	root := cli.NewRootCmd(deps)
+	root.SetArgs([]string{"list", "meetings", "--timezone", "Mars/Olympus"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `invalid timezone "Mars/Olympus"`) {
+		t.Errorf("got error %v, want invalid timezone error", err)
+	}
+}
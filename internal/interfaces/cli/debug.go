@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// RawFetcher returns Granola API responses exactly as received, without
+// domain mapping. Implemented by granola.Client.
+type RawFetcher interface {
+	GetDocumentRaw(ctx context.Context, id string) (json.RawMessage, error)
+	GetTranscriptRaw(ctx context.Context, meetingID string) (json.RawMessage, error)
+}
+
+var errRawFetcherUnavailable = errors.New("raw API access is not configured")
+
+func newDebugCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "debug",
+		Short:  "Diagnostics for the Granola API integration",
+		Long:   "Fetch raw Granola API responses, bypassing domain mapping, to tell upstream gaps from mapping bugs.",
+		Hidden: true,
+	}
+
+	cmd.AddCommand(newDebugFetchDocumentCmd(deps))
+	cmd.AddCommand(newDebugFetchTranscriptCmd(deps))
+	return cmd
+}
+
+func newDebugFetchDocumentCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fetch-document <id>",
+		Short: "Print the raw get-document response",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.RawFetcher == nil {
+				return errRawFetcherUnavailable
+			}
+			raw, err := deps.RawFetcher.GetDocumentRaw(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to fetch document: %w", err)
+			}
+			_, err = fmt.Fprintln(deps.Out, string(raw))
+			return err
+		},
+	}
+}
+
+func newDebugFetchTranscriptCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fetch-transcript <meeting_id>",
+		Short: "Print the raw transcript response",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.RawFetcher == nil {
+				return errRawFetcherUnavailable
+			}
+			raw, err := deps.RawFetcher.GetTranscriptRaw(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to fetch transcript: %w", err)
+			}
+			_, err = fmt.Fprintln(deps.Out, string(raw))
+			return err
+		},
+	}
+}
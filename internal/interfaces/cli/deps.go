@@ -5,8 +5,10 @@ package cli
 import (
 	"io"
 	"net/http"
+	"time"
 
 	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
+	auditapp "github.com/felixgeelhaar/acai/internal/application/audit"
 	authapp "github.com/felixgeelhaar/acai/internal/application/auth"
 	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
 	exportapp "github.com/felixgeelhaar/acai/internal/application/export"
@@ -27,22 +29,90 @@ type Dependencies struct {
 	GetActionItems    *meetingapp.GetActionItems
 	SyncMeetings      *meetingapp.SyncMeetings
 	ExportMeeting     *exportapp.ExportMeeting
+	ExportTranscript  *exportapp.ExportTranscript
+	ExportCalendar    *exportapp.ExportCalendar
+	ExportNotes       *exportapp.ExportNotes
 	Login             *authapp.Login
 	CheckStatus       *authapp.CheckStatus
+	RefreshToken      *authapp.RefreshToken
+	ListProfiles      *authapp.ListProfiles
+	TokenSetter       authapp.TokenSetter
 	ListWorkspaces    *workspaceapp.ListWorkspaces
 	GetWorkspace      *workspaceapp.GetWorkspace
 	EventDispatcher   domain.EventDispatcher
 	WebhookHandler    http.Handler
+	MetricsHandler    http.Handler
+	EventStream       http.Handler
+	OutboxHealth      http.Handler
 	MCPServer         *mcpiface.Server
+	Breaker           BreakerInspector
+	RateLimiter       RateLimitInspector
+	RawFetcher        RawFetcher
+	Offline           OfflineSwitch
 	Out               io.Writer
 
+	// APIProbe lists meetings straight from the API, past the cache, so
+	// doctor cannot report a warm cache as a reachable API.
+	APIProbe *meetingapp.ListMeetings
+
+	// Timezone is the configured default display zone (IANA name).
+	// Location is resolved from --timezone or Timezone before each
+	// command runs; nil means UTC.
+	Timezone string
+	Location *time.Location
+
+	// RedactEmails is the default for the --redact-emails export flags.
+	RedactEmails bool
+
+	// PolicyFile is the configured policy path that `policy test` loads
+	// when --file is not given.
+	PolicyFile string
+
+	// WebhookPath is where serve mounts WebhookHandler; empty uses
+	// DefaultWebhookPath.
+	WebhookPath string
+
+	// Interactive prompts (--pick). In defaults to os.Stdin;
+	// IsTerminal optionally overrides terminal detection on Out.
+	In         io.Reader
+	IsTerminal func() bool
+
 	// Write use cases (Phase 3)
-	AddNote            *annotationapp.AddNote
-	ListNotes          *annotationapp.ListNotes
-	DeleteNote         *annotationapp.DeleteNote
-	CompleteActionItem *meetingapp.CompleteActionItem
-	UpdateActionItem   *meetingapp.UpdateActionItem
+	AddNote             *annotationapp.AddNote
+	ListNotes           *annotationapp.ListNotes
+	SearchNotes         *annotationapp.SearchNotes
+	DeleteNote          *annotationapp.DeleteNote
+	DeleteMeetingNotes  *annotationapp.DeleteNotesByMeeting
+	CompleteActionItem  *meetingapp.CompleteActionItem
+	CompleteActionItems *meetingapp.CompleteActionItems
+	UpdateActionItem    *meetingapp.UpdateActionItem
+
+	// Audit log of the write use cases above
+	ListAuditEntries *auditapp.ListEntries
+
+	// Outbox of write events, for inspecting and retrying deliveries
+	Outbox          OutboxStore
+	OutboxRedeliver OutboxRedeliverer
+
+	// Export and import of the local database for moving it between machines
+	LocalArchive LocalArchiver
+
+	// Speaker aliases applied to transcripts and speaker statistics
+	SetSpeakerAlias    *meetingapp.SetSpeakerAlias
+	RemoveSpeakerAlias *meetingapp.RemoveSpeakerAlias
+	ListSpeakerAliases *meetingapp.ListSpeakerAliases
+
+	// Local meeting tags
+	TagMeeting   *meetingapp.TagMeeting
+	UntagMeeting *meetingapp.UntagMeeting
 
 	// Embedding export (Phase 3)
 	ExportEmbeddings *embeddingapp.ExportEmbeddings
 }
+
+// OfflineSwitch toggles offline mode, in which only cached and local data
+// is served. Implemented by offline.Switch.
+type OfflineSwitch interface {
+	SetOffline(offline bool)
+	Offline() bool
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/resilience"
+	"github.com/spf13/cobra"
+)
+
+// BreakerInspector reports the state of the Granola API circuit breaker.
+// Implemented by resilience.ResilientRepository.
+type BreakerInspector interface {
+	State() resilience.BreakerState
+}
+
+// RateLimitInspector reports headroom under the outgoing API rate limit.
+// Implemented by resilience.ResilientRepository.
+type RateLimitInspector interface {
+	RateLimit() resilience.RateLimitState
+}
+
+type rateLimitReport struct {
+	Tokens         float64 `json:"tokens"`
+	Burst          int     `json:"burst"`
+	Rate           int     `json:"rate"`
+	Interval       string  `json:"interval"`
+	RecentRequests int     `json:"recent_requests"`
+	Headroom       float64 `json:"headroom"`
+}
+
+type doctorReport struct {
+	Authenticated       bool   `json:"authenticated"`
+	Workspace           string `json:"workspace,omitempty"`
+	APIReachable        bool   `json:"api_reachable"`
+	APIError            string `json:"api_error,omitempty"`
+	BreakerState        string `json:"breaker_state,omitempty"`
+	ConsecutiveFailures uint32 `json:"consecutive_failures"`
+	LastTrippedAt       string `json:"last_tripped_at,omitempty"`
+
+	RateLimit *rateLimitReport `json:"rate_limit,omitempty"`
+}
+
+func newDoctorCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose connectivity to Granola",
+		Long:  "Check authentication, probe the Granola API, and report the circuit breaker and rate limiter state.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var report doctorReport
+
+			if deps.CheckStatus != nil {
+				if out, err := deps.CheckStatus.Execute(cmd.Context()); err == nil && out.Authenticated {
+					report.Authenticated = true
+					report.Workspace = out.Credential.Workspace()
+				}
+			}
+
+			if deps.APIProbe != nil {
+				_, err := deps.APIProbe.Execute(cmd.Context(), meetingapp.ListMeetingsInput{Limit: 1})
+				report.APIReachable = err == nil
+				if err != nil {
+					report.APIError = err.Error()
+				}
+			}
+
+			if deps.Breaker != nil {
+				st := deps.Breaker.State()
+				report.BreakerState = st.State
+				report.ConsecutiveFailures = st.ConsecutiveFailures
+				if !st.LastTrippedAt.IsZero() {
+					report.LastTrippedAt = st.LastTrippedAt.Format(time.RFC3339)
+				}
+			}
+
+			if deps.RateLimiter != nil {
+				st := deps.RateLimiter.RateLimit()
+				report.RateLimit = &rateLimitReport{
+					Tokens:         st.Tokens,
+					Burst:          st.Burst,
+					Rate:           st.Rate,
+					Interval:       st.Interval.String(),
+					RecentRequests: st.RecentRequests,
+					Headroom:       st.Headroom(),
+				}
+			}
+
+			if flagFormat == "json" {
+				return printJSON(deps, report)
+			}
+
+			w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+			if report.Authenticated {
+				_, _ = fmt.Fprintf(w, "Authentication:\tok (workspace: %s)\n", report.Workspace)
+			} else {
+				_, _ = fmt.Fprintln(w, "Authentication:\tnot authenticated (run 'acai auth login')")
+			}
+			if report.APIReachable {
+				_, _ = fmt.Fprintln(w, "Granola API:\treachable")
+			} else {
+				_, _ = fmt.Fprintf(w, "Granola API:\tunreachable (%s)\n", report.APIError)
+			}
+			if deps.Breaker != nil {
+				_, _ = fmt.Fprintf(w, "Circuit breaker:\t%s (consecutive failures: %d)\n",
+					report.BreakerState, report.ConsecutiveFailures)
+				lastTripped := report.LastTrippedAt
+				if lastTripped == "" {
+					lastTripped = "never"
+				}
+				_, _ = fmt.Fprintf(w, "Last tripped:\t%s\n", lastTripped)
+			}
+			if rl := report.RateLimit; rl != nil {
+				_, _ = fmt.Fprintf(w, "Rate limit:\t%.0f%% headroom (%.1f of %d tokens; limit %d per %s)\n",
+					rl.Headroom*100, rl.Tokens, rl.Burst, rl.Rate, rl.Interval)
+				_, _ = fmt.Fprintf(w, "Recent requests:\t%d in the last %s\n", rl.RecentRequests, rl.Interval)
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/events"
+	"github.com/spf13/cobra"
+)
+
+// DefaultEventsURL is the stream `acai serve --transport http` exposes on
+// its default port.
+const DefaultEventsURL = "http://localhost:8080/events"
+
+func newEventsCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Watch domain events dispatched by a running server",
+	}
+
+	cmd.AddCommand(newEventsTailCmd(deps))
+	return cmd
+}
+
+func newEventsTailCmd(deps *Dependencies) *cobra.Command {
+	var (
+		url   string
+		count int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Stream events live from a server running with --transport http or both",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return fmt.Errorf("invalid --url: %w", err)
+			}
+			req.Header.Set("Accept", "text/event-stream")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("failed to connect to %s: %w", url, err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("event stream %s returned %s", url, resp.Status)
+			}
+
+			seen := 0
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+				if !ok {
+					continue
+				}
+				if err := printStreamEvent(deps, data); err != nil {
+					return err
+				}
+				seen++
+				if count > 0 && seen >= count {
+					return nil
+				}
+			}
+			if err := scanner.Err(); err != nil && ctx.Err() == nil {
+				return fmt.Errorf("event stream interrupted: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&url, "url", DefaultEventsURL, "Event stream URL of the running server")
+	cmd.Flags().IntVar(&count, "count", 0, "Exit after this many events (0 streams until interrupted)")
+	return cmd
+}
+
+// printStreamEvent writes one event: the raw JSON line with --format json,
+// otherwise a time, event name, and meeting ID row.
+func printStreamEvent(deps *Dependencies, data string) error {
+	if flagFormat == "json" {
+		_, _ = fmt.Fprintln(deps.Out, data)
+		return nil
+	}
+	var e events.StreamEvent
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		return fmt.Errorf("invalid event %q: %w", data, err)
+	}
+	_, _ = fmt.Fprintf(deps.Out, "%s  %-24s %s\n",
+		displayTime(deps, e.OccurredAt).Format("2006-01-02 15:04:05"), e.Event, e.MeetingID)
+	return nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
@@ -18,16 +19,23 @@ func newExportCmd(deps *Dependencies) *cobra.Command {
 
 	cmd.AddCommand(
 		newExportMeetingCmd(deps),
+		newExportTranscriptCmd(deps),
+		newExportCalendarCmd(deps),
 		newExportEmbeddingsCmd(deps),
+		newExportNotesCmd(deps),
 	)
 	return cmd
 }
 
 func newExportEmbeddingsCmd(deps *Dependencies) *cobra.Command {
 	var (
-		meetings  string
-		strategy  string
-		maxTokens int
+		meetings    string
+		strategy    string
+		maxTokens   int
+		overlap     int
+		concurrency int
+		output      string
+		redact      bool
 	)
 
 	cmd := &cobra.Command{
@@ -47,18 +55,39 @@ func newExportEmbeddingsCmd(deps *Dependencies) *cobra.Command {
 				meetingIDs[i] = domain.MeetingID(strings.TrimSpace(id))
 			}
 
-			out, err := deps.ExportEmbeddings.Execute(cmd.Context(), embeddingapp.ExportEmbeddingsInput{
-				MeetingIDs: meetingIDs,
-				Strategy:   strategy,
-				MaxTokens:  maxTokens,
-				Format:     "jsonl",
-			})
+			input := embeddingapp.ExportEmbeddingsInput{
+				MeetingIDs:  meetingIDs,
+				Strategy:    strategy,
+				MaxTokens:   maxTokens,
+				Overlap:     overlap,
+				Format:      "jsonl",
+				Concurrency: concurrency,
+
+				RedactEmails: redact,
+			}
+
+			if output != "" {
+				out, err := exportEmbeddingsToFile(cmd, deps, input, output)
+				if err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(deps.Out, "# %d chunks exported to %s\n", out.ChunkCount, output)
+				for _, e := range out.Errors {
+					_, _ = fmt.Fprintf(deps.Out, "# skipped %s: %v\n", e.MeetingID, e.Err)
+				}
+				return nil
+			}
+
+			out, err := deps.ExportEmbeddings.Execute(cmd.Context(), input)
 			if err != nil {
 				return fmt.Errorf("export failed: %w", err)
 			}
 
 			_, _ = fmt.Fprintln(deps.Out, out.Content)
 			_, _ = fmt.Fprintf(deps.Out, "# %d chunks exported\n", out.ChunkCount)
+			for _, e := range out.Errors {
+				_, _ = fmt.Fprintf(deps.Out, "# skipped %s: %v\n", e.MeetingID, e.Err)
+			}
 			return nil
 		},
 	}
@@ -66,23 +95,180 @@ func newExportEmbeddingsCmd(deps *Dependencies) *cobra.Command {
 	cmd.Flags().StringVar(&meetings, "meetings", "", "Comma-separated meeting IDs")
 	cmd.Flags().StringVar(&strategy, "strategy", "speaker_turn", "Chunking strategy: speaker_turn, time_window, token_limit")
 	cmd.Flags().IntVar(&maxTokens, "max-tokens", 256, "Max tokens per chunk (for token_limit strategy)")
+	cmd.Flags().IntVar(&overlap, "overlap", 0, "Utterances repeated between consecutive chunks (for time_window and token_limit strategies)")
+	cmd.Flags().IntVar(&concurrency, "concurrency", embeddingapp.DefaultFetchConcurrency, "Meetings fetched in parallel")
+	cmd.Flags().StringVar(&output, "output", "", "Stream the JSONL to this file instead of stdout")
+	cmd.Flags().BoolVar(&redact, "redact-emails", deps.RedactEmails, "Mask participant emails in chunk content")
+	return cmd
+}
+
+// exportEmbeddingsToFile streams the export into path, so large exports
+// are never held in memory.
+func exportEmbeddingsToFile(cmd *cobra.Command, deps *Dependencies, input embeddingapp.ExportEmbeddingsInput, path string) (*embeddingapp.ExportEmbeddingsOutput, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	out, err := deps.ExportEmbeddings.ExecuteStream(cmd.Context(), input, f)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("export failed: %w", err)
+	}
+	return out, nil
+}
+
+func newExportNotesCmd(deps *Dependencies) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "notes [meeting_id]",
+		Short: "Export agent notes as markdown or JSON",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if all {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.ExportNotes == nil {
+				return fmt.Errorf("note export functionality not configured")
+			}
+
+			format := exportapp.Format(flagFormat)
+			if flagFormat == "table" {
+				format = exportapp.FormatMarkdown
+			}
+
+			input := exportapp.ExportNotesInput{All: all, Format: format, Location: deps.Location}
+			if !all {
+				input.MeetingID = args[0]
+			}
+			out, err := deps.ExportNotes.Execute(cmd.Context(), input)
+			if err != nil {
+				return fmt.Errorf("export failed: %w", err)
+			}
+
+			_, _ = fmt.Fprintln(deps.Out, out.Content)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Export notes for every meeting, grouped by meeting")
+	return cmd
+}
+
+func newExportCalendarCmd(deps *Dependencies) *cobra.Command {
+	var (
+		since string
+		until string
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "calendar",
+		Short: "Export meetings as an iCalendar (.ics) file",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if deps.ExportCalendar == nil {
+				return fmt.Errorf("calendar export functionality not configured")
+			}
+
+			input := exportapp.ExportCalendarInput{Limit: limit}
+			var err error
+			if input.Since, err = parseDateFlag(deps, "since", since); err != nil {
+				return err
+			}
+			if input.Until, err = parseDateFlag(deps, "until", until); err != nil {
+				return err
+			}
+
+			out, err := deps.ExportCalendar.Execute(cmd.Context(), input)
+			if err != nil {
+				return fmt.Errorf("export failed: %w", err)
+			}
+
+			_, _ = fmt.Fprint(deps.Out, out.Content)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "", "Only meetings on or after this date (RFC3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&until, "until", "", "Only meetings before this date (RFC3339 or YYYY-MM-DD)")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of meetings to export")
+	return cmd
+}
+
+func newExportTranscriptCmd(deps *Dependencies) *cobra.Command {
+	var (
+		pick  bool
+		merge bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "transcript [id]",
+		Short: "Export a meeting transcript",
+		Args:  pickArgs(&pick, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			args, err := withPickedMeeting(cmd, deps, pick, args)
+			if err != nil {
+				return err
+			}
+
+			format := exportapp.Format(flagFormat)
+			if flagFormat == "table" {
+				format = exportapp.FormatText
+			}
+
+			out, err := deps.ExportTranscript.Execute(cmd.Context(), exportapp.ExportTranscriptInput{
+				MeetingID:         domain.MeetingID(args[0]),
+				Format:            format,
+				Location:          deps.Location,
+				MergeSpeakerTurns: merge,
+			})
+			if err != nil {
+				return fmt.Errorf("export failed: %w", err)
+			}
+
+			_, _ = fmt.Fprint(deps.Out, out.Content)
+			return nil
+		},
+	}
+
+	addPickFlag(cmd, &pick)
+	cmd.Flags().BoolVar(&merge, "merge-speaker-turns", false, "Combine consecutive utterances by the same speaker into one segment")
 	return cmd
 }
 
 func newExportMeetingCmd(deps *Dependencies) *cobra.Command {
-	return &cobra.Command{
+	var (
+		pick     bool
+		openOnly bool
+		redact   bool
+	)
+
+	cmd := &cobra.Command{
 		Use:   "meeting [id]",
 		Short: "Export a meeting",
-		Args:  cobra.ExactArgs(1),
+		Args:  pickArgs(&pick, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			args, err := withPickedMeeting(cmd, deps, pick, args)
+			if err != nil {
+				return err
+			}
+
 			format := exportapp.Format(flagFormat)
 			if flagFormat == "table" {
 				format = exportapp.FormatMarkdown
 			}
 
 			out, err := deps.ExportMeeting.Execute(cmd.Context(), exportapp.ExportMeetingInput{
-				MeetingID: domain.MeetingID(args[0]),
-				Format:    format,
+				MeetingID:    domain.MeetingID(args[0]),
+				Format:       format,
+				Location:     deps.Location,
+				OpenOnly:     openOnly,
+				RedactEmails: redact,
 			})
 			if err != nil {
 				return fmt.Errorf("export failed: %w", err)
@@ -92,4 +278,9 @@ func newExportMeetingCmd(deps *Dependencies) *cobra.Command {
 			return nil
 		},
 	}
+
+	addPickFlag(cmd, &pick)
+	cmd.Flags().BoolVar(&openOnly, "open-only", false, "Leave completed action items out of the export")
+	cmd.Flags().BoolVar(&redact, "redact-emails", deps.RedactEmails, "Mask participant emails as a***@example.com")
+	return cmd
 }
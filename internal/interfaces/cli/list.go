@@ -25,6 +25,9 @@ func newListMeetingsCmd(deps *Dependencies) *cobra.Command {
 		limit  int
 		offset int
 		source string
+		sortBy string
+		tags   []string
+		count  bool
 	)
 
 	cmd := &cobra.Command{
@@ -32,8 +35,11 @@ func newListMeetingsCmd(deps *Dependencies) *cobra.Command {
 		Short: "List meetings",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			input := meetingapp.ListMeetingsInput{
-				Limit:  limit,
-				Offset: offset,
+				Limit:     limit,
+				Offset:    offset,
+				SortBy:    meetingapp.SortOrder(sortBy),
+				Tags:      tags,
+				CountOnly: count,
 			}
 			if source != "" {
 				input.Source = &source
@@ -43,6 +49,17 @@ func newListMeetingsCmd(deps *Dependencies) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to list meetings: %w", err)
 			}
+			if out.Partial {
+				_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "Warning: timed out before all meetings were fetched; the list is incomplete.")
+			}
+
+			if count {
+				if flagFormat == "json" {
+					return printJSON(deps, map[string]int{"count": out.Total})
+				}
+				_, _ = fmt.Fprintln(deps.Out, out.Total)
+				return nil
+			}
 
 			switch flagFormat {
 			case "json":
@@ -56,6 +73,9 @@ func newListMeetingsCmd(deps *Dependencies) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 20, "Max results")
 	cmd.Flags().IntVar(&offset, "offset", 0, "Pagination offset")
 	cmd.Flags().StringVar(&source, "source", "", "Filter by source (zoom, google_meet, teams)")
+	cmd.Flags().StringVar(&sortBy, "sort", "date_desc", "Sort order (date_desc, date_asc, title)")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "Only meetings with this local tag (repeatable; all must match)")
+	cmd.Flags().BoolVar(&count, "count", false, "Print only the number of matching meetings (ignores --limit and --offset)")
 
 	return cmd
 }
@@ -65,7 +85,7 @@ func printMeetingsTable(deps *Dependencies, meetings []*domain.Meeting) error {
 	_, _ = fmt.Fprintln(w, "ID\tTITLE\tDATE\tSOURCE")
 	for _, m := range meetings {
 		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
-			m.ID(), m.Title(), m.Datetime().Format("2006-01-02 15:04"), m.Source())
+			m.ID(), m.Title(), displayTime(deps, m.Datetime()).Format("2006-01-02 15:04"), m.Source())
 	}
 	return w.Flush()
 }
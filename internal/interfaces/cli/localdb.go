@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/localstore"
+	"github.com/spf13/cobra"
+)
+
+// LocalArchiver moves the local database's notes, action item overrides,
+// outbox entries, meeting tags, and speaker aliases in and out of a
+// portable archive.
+// Implemented by localstore.Archiver.
+type LocalArchiver interface {
+	Export(ctx context.Context) (*localstore.Archive, error)
+	Import(ctx context.Context, archive *localstore.Archive, policy localstore.ConflictPolicy) (*localstore.ImportResult, error)
+}
+
+func newExportDBCmd(deps *Dependencies) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export-db",
+		Short: "Export agent notes, action item overrides, outbox entries, meeting tags, and speaker aliases as a JSON archive",
+		Long:  "Write the local data that cannot be re-synced from Granola to a single JSON document, e.g. to move it to another machine with import-db. Without --output the archive goes to stdout.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.LocalArchive == nil {
+				return fmt.Errorf("local store not configured")
+			}
+
+			archive, err := deps.LocalArchive.Export(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("export failed: %w", err)
+			}
+			data, err := json.MarshalIndent(archive, "", "  ")
+			if err != nil {
+				return err
+			}
+			data = append(data, '\n')
+
+			if output == "" {
+				_, err = deps.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(output, data, 0o600); err != nil {
+				return fmt.Errorf("failed to write archive: %w", err)
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Exported %d notes, %d action item overrides, %d outbox entries, %d meeting tags, and %d speaker aliases to %s\n",
+				len(archive.Notes), len(archive.ActionItemOverrides), len(archive.OutboxEntries),
+				len(archive.MeetingTags), len(archive.SpeakerAliases), output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&output, "output", "", "Write the archive to this file instead of stdout")
+	return cmd
+}
+
+func newImportDBCmd(deps *Dependencies) *cobra.Command {
+	var (
+		input      string
+		onConflict string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import-db",
+		Short: "Import an archive written by export-db into the local database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.LocalArchive == nil {
+				return fmt.Errorf("local store not configured")
+			}
+			if input == "" {
+				return fmt.Errorf("--input is required")
+			}
+			policy := localstore.ConflictPolicy(onConflict)
+			if policy != localstore.ConflictSkip && policy != localstore.ConflictOverwrite {
+				return fmt.Errorf("invalid --on-conflict %q: must be skip or overwrite", onConflict)
+			}
+
+			data, err := os.ReadFile(input)
+			if err != nil {
+				return fmt.Errorf("failed to read archive: %w", err)
+			}
+			var archive localstore.Archive
+			if err := json.Unmarshal(data, &archive); err != nil {
+				return fmt.Errorf("invalid archive %s: %w", input, err)
+			}
+
+			result, err := deps.LocalArchive.Import(cmd.Context(), &archive, policy)
+			if err != nil {
+				return fmt.Errorf("import failed: %w", err)
+			}
+
+			if flagFormat == "json" {
+				return printJSON(deps, result)
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Notes: %d imported, %d skipped\n", result.Notes.Imported, result.Notes.Skipped)
+			_, _ = fmt.Fprintf(deps.Out, "Action item overrides: %d imported, %d skipped\n",
+				result.ActionItemOverrides.Imported, result.ActionItemOverrides.Skipped)
+			_, _ = fmt.Fprintf(deps.Out, "Outbox entries: %d imported, %d skipped\n",
+				result.OutboxEntries.Imported, result.OutboxEntries.Skipped)
+			_, _ = fmt.Fprintf(deps.Out, "Meeting tags: %d imported, %d skipped\n",
+				result.MeetingTags.Imported, result.MeetingTags.Skipped)
+			_, _ = fmt.Fprintf(deps.Out, "Speaker aliases: %d imported, %d skipped\n",
+				result.SpeakerAliases.Imported, result.SpeakerAliases.Skipped)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&input, "input", "", "Archive file to import")
+	cmd.Flags().StringVar(&onConflict, "on-conflict", string(localstore.ConflictSkip), "What to do with records already in the database: skip or overwrite")
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/spf13/cobra"
+)
+
+func newMeetingCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "meeting",
+		Short: "Organize meetings with local tags",
+	}
+
+	cmd.AddCommand(newMeetingTagCmd(deps))
+	cmd.AddCommand(newMeetingUntagCmd(deps))
+	return cmd
+}
+
+func newMeetingTagCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tag <meeting_id> <tag>",
+		Short: "Tag a meeting, e.g. 1:1 or planning",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.TagMeeting == nil {
+				return fmt.Errorf("meeting tags not configured")
+			}
+
+			out, err := deps.TagMeeting.Execute(cmd.Context(), meetingapp.TagMeetingInput{
+				ID:  domain.MeetingID(args[0]),
+				Tag: args[1],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to tag meeting: %w", err)
+			}
+			return printMeetingTags(deps, args[0], out.Tags)
+		},
+	}
+}
+
+func newMeetingUntagCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "untag <meeting_id> <tag>",
+		Short: "Remove a tag from a meeting",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.UntagMeeting == nil {
+				return fmt.Errorf("meeting tags not configured")
+			}
+
+			out, err := deps.UntagMeeting.Execute(cmd.Context(), meetingapp.TagMeetingInput{
+				ID:  domain.MeetingID(args[0]),
+				Tag: args[1],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to untag meeting: %w", err)
+			}
+			return printMeetingTags(deps, args[0], out.Tags)
+		},
+	}
+}
+
+func printMeetingTags(deps *Dependencies, id string, tags []string) error {
+	if flagFormat == "json" {
+		return printJSON(deps, struct {
+			MeetingID string   `json:"meeting_id"`
+			Tags      []string `json:"tags"`
+		}{id, tags})
+	}
+	if len(tags) == 0 {
+		_, _ = fmt.Fprintf(deps.Out, "Meeting %s has no tags.\n", id)
+		return nil
+	}
+	_, _ = fmt.Fprintf(deps.Out, "Meeting %s tags: %s\n", id, strings.Join(tags, ", "))
+	return nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
 	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
@@ -17,26 +18,37 @@ func newNoteCmd(deps *Dependencies) *cobra.Command {
 	cmd.AddCommand(
 		newNoteAddCmd(deps),
 		newNoteListCmd(deps),
+		newNoteSearchCmd(deps),
 		newNoteDeleteCmd(deps),
+		newNoteDeleteAllCmd(deps),
 	)
 	return cmd
 }
 
 func newNoteAddCmd(deps *Dependencies) *cobra.Command {
-	var author string
+	var (
+		author string
+		tags   []string
+		pick   bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "add <meeting_id> <text>",
 		Short: "Add an agent note to a meeting",
-		Args:  cobra.ExactArgs(2),
+		Args:  pickArgs(&pick, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if deps.AddNote == nil {
 				return fmt.Errorf("note functionality not configured")
 			}
+			args, err := withPickedMeeting(cmd, deps, pick, args)
+			if err != nil {
+				return err
+			}
 			out, err := deps.AddNote.Execute(cmd.Context(), annotationapp.AddNoteInput{
 				MeetingID: args[0],
 				Author:    author,
 				Content:   args[1],
+				Tags:      tags,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to add note: %w", err)
@@ -47,20 +59,32 @@ func newNoteAddCmd(deps *Dependencies) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&author, "author", "cli", "Note author")
+	cmd.Flags().StringSliceVar(&tags, "tags", nil, "Comma-separated note tags")
+	addPickFlag(cmd, &pick)
 	return cmd
 }
 
 func newNoteListCmd(deps *Dependencies) *cobra.Command {
-	return &cobra.Command{
+	var (
+		tags []string
+		pick bool
+	)
+
+	cmd := &cobra.Command{
 		Use:   "list <meeting_id>",
 		Short: "List agent notes for a meeting",
-		Args:  cobra.ExactArgs(1),
+		Args:  pickArgs(&pick, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if deps.ListNotes == nil {
 				return fmt.Errorf("note functionality not configured")
 			}
+			args, err := withPickedMeeting(cmd, deps, pick, args)
+			if err != nil {
+				return err
+			}
 			out, err := deps.ListNotes.Execute(cmd.Context(), annotationapp.ListNotesInput{
 				MeetingID: args[0],
+				Tags:      tags,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to list notes: %w", err)
@@ -71,15 +95,57 @@ func newNoteListCmd(deps *Dependencies) *cobra.Command {
 				return printJSON(deps, out.Notes)
 			default:
 				w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
-				_, _ = fmt.Fprintln(w, "ID\tAUTHOR\tCONTENT\tCREATED")
+				_, _ = fmt.Fprintln(w, "ID\tAUTHOR\tCONTENT\tTAGS\tCREATED")
+				for _, n := range out.Notes {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+						n.ID(), n.Author(), n.Content(), strings.Join(n.Tags(), ","), displayTime(deps, n.CreatedAt()).Format("2006-01-02 15:04"))
+				}
+				return w.Flush()
+			}
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&tags, "tags", nil, "Only show notes carrying all of these comma-separated tags")
+	addPickFlag(cmd, &pick)
+	return cmd
+}
+
+func newNoteSearchCmd(deps *Dependencies) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Full-text search across agent notes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.SearchNotes == nil {
+				return fmt.Errorf("note functionality not configured")
+			}
+			out, err := deps.SearchNotes.Execute(cmd.Context(), annotationapp.SearchNotesInput{
+				Query: args[0],
+				Limit: limit,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to search notes: %w", err)
+			}
+
+			switch flagFormat {
+			case "json":
+				return printJSON(deps, out.Notes)
+			default:
+				w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "ID\tMEETING\tAUTHOR\tCONTENT\tCREATED")
 				for _, n := range out.Notes {
-					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
-						n.ID(), n.Author(), n.Content(), n.CreatedAt().Format("2006-01-02 15:04"))
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+						n.ID(), n.MeetingID(), n.Author(), n.Content(), displayTime(deps, n.CreatedAt()).Format("2006-01-02 15:04"))
 				}
 				return w.Flush()
 			}
 		},
 	}
+
+	cmd.Flags().IntVar(&limit, "limit", annotationapp.DefaultSearchLimit, "Maximum number of notes to return")
+	return cmd
 }
 
 func newNoteDeleteCmd(deps *Dependencies) *cobra.Command {
@@ -102,3 +168,34 @@ func newNoteDeleteCmd(deps *Dependencies) *cobra.Command {
 		},
 	}
 }
+
+func newNoteDeleteAllCmd(deps *Dependencies) *cobra.Command {
+	var pick bool
+
+	cmd := &cobra.Command{
+		Use:   "delete-all <meeting_id>",
+		Short: "Delete all agent notes for a meeting",
+		Args:  pickArgs(&pick, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.DeleteMeetingNotes == nil {
+				return fmt.Errorf("note functionality not configured")
+			}
+			args, err := withPickedMeeting(cmd, deps, pick, args)
+			if err != nil {
+				return err
+			}
+			out, err := deps.DeleteMeetingNotes.Execute(cmd.Context(), annotationapp.DeleteNotesByMeetingInput{
+				MeetingID: args[0],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete notes: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(deps.Out, "Deleted %d note(s) from meeting %s\n", out.Deleted, args[0])
+			return nil
+		},
+	}
+
+	addPickFlag(cmd, &pick)
+	return cmd
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/outbox"
+	"github.com/spf13/cobra"
+)
+
+// OutboxStore lists the outbox of write events.
+// Implemented by outbox.SQLiteStore.
+type OutboxStore interface {
+	ListPending() ([]outbox.Entry, error)
+	ListFailed() ([]outbox.Entry, error)
+}
+
+// OutboxRedeliverer dispatches failed outbox entries again.
+// Implemented by outbox.Dispatcher.
+type OutboxRedeliverer interface {
+	Redeliver(ctx context.Context, eventType string) (delivered, failed int, err error)
+}
+
+func newOutboxCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbox",
+		Short: "Inspect and retry undelivered write events",
+	}
+
+	cmd.AddCommand(newOutboxListCmd(deps))
+	cmd.AddCommand(newOutboxRetryCmd(deps))
+	return cmd
+}
+
+func newOutboxListCmd(deps *Dependencies) *cobra.Command {
+	var status string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List pending and failed outbox entries with their attempt counts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.Outbox == nil {
+				return fmt.Errorf("outbox not configured")
+			}
+
+			if status != "" && status != "pending" && status != "failed" {
+				return fmt.Errorf("invalid --status %q: must be pending or failed", status)
+			}
+
+			var entries []outbox.Entry
+			if status == "" || status == "pending" {
+				pending, err := deps.Outbox.ListPending()
+				if err != nil {
+					return fmt.Errorf("failed to list pending entries: %w", err)
+				}
+				entries = append(entries, pending...)
+			}
+			if status == "" || status == "failed" {
+				failed, err := deps.Outbox.ListFailed()
+				if err != nil {
+					return fmt.Errorf("failed to list failed entries: %w", err)
+				}
+				entries = append(entries, failed...)
+			}
+
+			switch flagFormat {
+			case "json":
+				return printJSON(deps, toOutboxEntryJSON(deps, entries))
+			default:
+				w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "ID\tEVENT\tSTATUS\tATTEMPTS\tCREATED")
+				for _, e := range entries {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+						e.ID, e.EventType, e.Status, e.Attempts, displayTime(deps, e.CreatedAt).Format("2006-01-02 15:04"))
+				}
+				return w.Flush()
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "Only entries in this state (pending or failed)")
+	return cmd
+}
+
+func newOutboxRetryCmd(deps *Dependencies) *cobra.Command {
+	var eventType string
+
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Dispatch failed outbox entries again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.OutboxRedeliver == nil {
+				return fmt.Errorf("outbox not configured")
+			}
+
+			delivered, failed, err := deps.OutboxRedeliver.Redeliver(cmd.Context(), eventType)
+			if err != nil {
+				return fmt.Errorf("failed to redeliver outbox entries: %w", err)
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Redelivered %d failed entries; %d failed again.\n", delivered, failed)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&eventType, "event-type", "", "Only entries of this event type, e.g. note.added")
+	return cmd
+}
+
+type outboxEntryJSON struct {
+	ID        string `json:"id"`
+	EventType string `json:"event_type"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	CreatedAt string `json:"created_at"`
+}
+
+func toOutboxEntryJSON(deps *Dependencies, entries []outbox.Entry) []outboxEntryJSON {
+	out := make([]outboxEntryJSON, len(entries))
+	for i, e := range entries {
+		out[i] = outboxEntryJSON{
+			ID:        e.ID,
+			EventType: e.EventType,
+			Status:    e.Status,
+			Attempts:  e.Attempts,
+			CreatedAt: displayTime(deps, e.CreatedAt).Format(time.RFC3339),
+		}
+	}
+	return out
+}
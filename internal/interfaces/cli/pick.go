@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/spf13/cobra"
+)
+
+// pickListSize is how many recent meetings --pick offers.
+const pickListSize = 10
+
+var (
+	errPickNotInteractive = errors.New("--pick requires an interactive terminal")
+	errNoMeetingsToPick   = errors.New("no meetings to pick from")
+)
+
+// addPickFlag registers --pick on a command whose first argument is a meeting ID.
+func addPickFlag(cmd *cobra.Command, pick *bool) {
+	cmd.Flags().BoolVar(pick, "pick", false, "Choose the meeting interactively from recent meetings")
+}
+
+// pickArgs validates n positional arguments, or n-1 when --pick supplies
+// the leading meeting ID.
+func pickArgs(pick *bool, n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if *pick {
+			return cobra.ExactArgs(n-1)(cmd, args)
+		}
+		return cobra.ExactArgs(n)(cmd, args)
+	}
+}
+
+// withPickedMeeting prepends an interactively chosen meeting ID to args
+// when pick is set; otherwise args are returned unchanged.
+func withPickedMeeting(cmd *cobra.Command, deps *Dependencies, pick bool, args []string) ([]string, error) {
+	if !pick {
+		return args, nil
+	}
+	id, err := pickMeeting(cmd, deps)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{id}, args...), nil
+}
+
+// pickMeeting lists recent meetings, numbered, and reads the user's choice.
+// It refuses to run without a terminal so scripted use fails instead of hanging.
+func pickMeeting(cmd *cobra.Command, deps *Dependencies) (string, error) {
+	if !isTerminal(deps) {
+		return "", errPickNotInteractive
+	}
+	if deps.ListMeetings == nil {
+		return "", fmt.Errorf("meeting listing not configured")
+	}
+
+	out, err := deps.ListMeetings.Execute(cmd.Context(), meetingapp.ListMeetingsInput{Limit: pickListSize})
+	if err != nil {
+		return "", fmt.Errorf("failed to list meetings: %w", err)
+	}
+	if len(out.Meetings) == 0 {
+		return "", errNoMeetingsToPick
+	}
+
+	for i, m := range out.Meetings {
+		_, _ = fmt.Fprintf(deps.Out, "%2d) %s  %s  (%s)\n",
+			i+1, displayTime(deps, m.Datetime()).Format("2006-01-02 15:04"), m.Title(), m.ID())
+	}
+	_, _ = fmt.Fprintf(deps.Out, "Select a meeting [1-%d]: ", len(out.Meetings))
+
+	in := deps.In
+	if in == nil {
+		in = os.Stdin
+	}
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read selection: %w", err)
+	}
+	choice := strings.TrimSpace(line)
+	n, err := strconv.Atoi(choice)
+	if err != nil || n < 1 || n > len(out.Meetings) {
+		return "", fmt.Errorf("invalid selection %q", choice)
+	}
+	return string(out.Meetings[n-1].ID()), nil
+}
+
+// isTerminal reports whether deps.Out is an interactive terminal.
+func isTerminal(deps *Dependencies) bool {
+	if deps.IsTerminal != nil {
+		return deps.IsTerminal()
+	}
+	f, ok := deps.Out.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	domainpolicy "github.com/felixgeelhaar/acai/internal/domain/policy"
+	infraPolicy "github.com/felixgeelhaar/acai/internal/infrastructure/policy"
+	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
+	"github.com/spf13/cobra"
+)
+
+func newPolicyCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Check agent policy files before deploying them",
+	}
+
+	cmd.AddCommand(newPolicyValidateCmd(deps))
+	cmd.AddCommand(newPolicyTestCmd(deps))
+	return cmd
+}
+
+func newPolicyValidateCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate <path>",
+		Short: "Report syntax errors, unknown tools, and conflicting or unreachable rules in a policy file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := infraPolicy.LoadFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid policy: %w", err)
+			}
+			if problems := result.Validate(mcpiface.ToolNames()); len(problems) > 0 {
+				return fmt.Errorf("invalid policy %s:\n  - %s", args[0], strings.Join(problems, "\n  - "))
+			}
+
+			_, _ = fmt.Fprintf(deps.Out, "%s is valid: %d rules, default %s\n",
+				args[0], len(result.Policy.Rules), result.Policy.DefaultEffect)
+			return nil
+		},
+	}
+}
+
+func newPolicyTestCmd(deps *Dependencies) *cobra.Command {
+	var (
+		file string
+		tool string
+		tags string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "Show whether a tool call would be allowed under a policy",
+		Long:  "Evaluate a tool call against the policy file, optionally for a meeting with the given tags. Exits non-zero when the call would be denied.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if tool == "" {
+				return fmt.Errorf("--tool is required")
+			}
+			if !containsTool(mcpiface.ToolNames(), tool) {
+				return fmt.Errorf("unknown tool %q", tool)
+			}
+			if file == "" {
+				file = deps.PolicyFile
+			}
+			if file == "" {
+				return fmt.Errorf("no policy file: pass --file or set ACAI_POLICY_FILE")
+			}
+
+			result, err := infraPolicy.LoadFromFile(file)
+			if err != nil {
+				return fmt.Errorf("invalid policy: %w", err)
+			}
+
+			ctx := domainpolicy.MeetingContext{}
+			if tags != "" {
+				for _, tag := range strings.Split(tags, ",") {
+					ctx.Tags = append(ctx.Tags, strings.TrimSpace(tag))
+				}
+			}
+			effect, rule := result.Policy.Match(tool, ctx)
+			if effect == domainpolicy.EffectDeny {
+				return fmt.Errorf("%s would be denied: %w", tool, infraPolicy.NewEngine(result).CheckAccess(tool, ctx))
+			}
+
+			decidedBy := "the default effect"
+			if rule != nil {
+				decidedBy = fmt.Sprintf("rule %q", rule.Name)
+			}
+			_, _ = fmt.Fprintf(deps.Out, "%s would be allowed by %s\n", tool, decidedBy)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "Policy file to load (default: the configured ACAI_POLICY_FILE)")
+	cmd.Flags().StringVar(&tool, "tool", "", "Tool name to evaluate, e.g. delete_note")
+	cmd.Flags().StringVar(&tags, "tags", "", "Comma-separated tags of the meeting the call targets")
+	return cmd
+}
+
+func containsTool(tools []string, tool string) bool {
+	for _, t := range tools {
+		if t == tool {
+			return true
+		}
+	}
+	return false
+}
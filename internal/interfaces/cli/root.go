@@ -1,12 +1,20 @@
 package cli
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
+	domainauth "github.com/felixgeelhaar/acai/internal/domain/auth"
 	"github.com/spf13/cobra"
 )
 
 var (
-	flagFormat  string
-	flagVerbose bool
+	flagFormat   string
+	flagVerbose  bool
+	flagProfile  string
+	flagOffline  bool
+	flagTimezone string
 )
 
 func NewRootCmd(deps *Dependencies) *cobra.Command {
@@ -15,22 +23,114 @@ func NewRootCmd(deps *Dependencies) *cobra.Command {
 		Short: "Granola meeting intelligence for the MCP ecosystem",
 		Long:  "A CLI and MCP server that exposes Granola meeting data as structured, queryable MCP resources.",
 		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SetContext(audit.WithActor(cmd.Context(), auditActor))
+			activateOffline(cmd, deps)
+			if err := activateTimezone(deps); err != nil {
+				return err
+			}
+			return activateProfile(cmd, deps)
+		},
 	}
 
 	root.PersistentFlags().StringVar(&flagFormat, "format", "table", "Output format: table, json, md")
 	root.PersistentFlags().BoolVar(&flagVerbose, "verbose", false, "Enable debug logging")
+	root.PersistentFlags().StringVar(&flagProfile, "profile", domainauth.DefaultProfile, "Credential profile to use")
+	root.PersistentFlags().BoolVar(&flagOffline, "offline", false, "Serve only cached and local data; never call the Granola API")
+	root.PersistentFlags().StringVar(&flagTimezone, "timezone", "", "IANA time zone for displayed dates, e.g. America/New_York (default UTC)")
 
 	root.AddCommand(
 		newAuthCmd(deps),
+		newWhoamiCmd(deps),
 		newSyncCmd(deps),
 		newListCmd(deps),
+		newMeetingCmd(deps),
 		newExportCmd(deps),
 		newServeCmd(deps),
 		newWorkspaceCmd(deps),
 		newNoteCmd(deps),
 		newActionCmd(deps),
+		newAuditCmd(deps),
+		newOutboxCmd(deps),
+		newEventsCmd(deps),
+		newExportDBCmd(deps),
+		newImportDBCmd(deps),
+		newSpeakersCmd(deps),
+		newPolicyCmd(deps),
+		newDoctorCmd(deps),
+		newDebugCmd(deps),
 		newVersionCmd(),
 	)
 
 	return root
 }
+
+// activateOffline applies --offline and announces offline mode, whether it
+// came from the flag or from configuration.
+func activateOffline(cmd *cobra.Command, deps *Dependencies) {
+	if deps.Offline == nil {
+		return
+	}
+	if flagOffline {
+		deps.Offline.SetOffline(true)
+	}
+	if deps.Offline.Offline() {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "Offline mode active: serving cached and local data only; Granola API calls are disabled.")
+	}
+}
+
+// activateTimezone resolves --timezone, falling back to the configured
+// default, and applies it to CLI output and the MCP server.
+func activateTimezone(deps *Dependencies) error {
+	name := flagTimezone
+	if name == "" {
+		name = deps.Timezone
+	}
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %q: want an IANA name such as America/New_York", name)
+	}
+	deps.Location = loc
+	if deps.MCPServer != nil {
+		deps.MCPServer.SetLocation(loc)
+	}
+	return nil
+}
+
+// displayTime converts t to the display zone for table output.
+func displayTime(deps *Dependencies, t time.Time) time.Time {
+	if deps.Location == nil {
+		return t.UTC()
+	}
+	return t.In(deps.Location)
+}
+
+// activateProfile scopes the command context to the selected credential
+// profile and, for non-default profiles, points the API client at its token
+// and pins token refreshes to the profile, since serve request contexts
+// do not carry it.
+func activateProfile(cmd *cobra.Command, deps *Dependencies) error {
+	if flagProfile == domainauth.DefaultProfile {
+		return nil
+	}
+	if err := domainauth.ValidateProfileName(flagProfile); err != nil {
+		return err
+	}
+	ctx := domainauth.WithProfile(cmd.Context(), flagProfile)
+	cmd.SetContext(ctx)
+	if deps.RefreshToken != nil {
+		deps.RefreshToken.SetProfile(flagProfile)
+	}
+
+	if deps.TokenSetter != nil && deps.CheckStatus != nil {
+		token := ""
+		if out, err := deps.CheckStatus.Execute(ctx); err == nil && out.Authenticated {
+			token = out.Credential.Token().AccessToken()
+		}
+		deps.TokenSetter.SetToken(token)
+	}
+	return nil
+}
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
 	"github.com/spf13/cobra"
 )
 
@@ -24,7 +27,10 @@ func newServeCmd(deps *Dependencies) *cobra.Command {
 			}
 
 			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
-			defer cancel()
+			defer func() {
+				cancel()
+				waitForWebhooks(deps)
+			}()
 
 			switch transport {
 			case "http":
@@ -32,11 +38,7 @@ func newServeCmd(deps *Dependencies) *cobra.Command {
 				_, _ = fmt.Fprintf(deps.Out, "Starting %s v%s MCP server (http on %s)...\n",
 					deps.MCPServer.Name(), deps.MCPServer.Version(), addr)
 
-				err := deps.MCPServer.ServeHTTP(ctx, addr, func(mux *http.ServeMux) {
-					if deps.WebhookHandler != nil {
-						mux.Handle("/webhook/granola", deps.WebhookHandler)
-					}
-				})
+				err := deps.MCPServer.ServeHTTP(ctx, addr, httpRoutes(ctx, deps))
 				if err != nil {
 					if ctx.Err() != nil {
 						_, _ = fmt.Fprintln(os.Stderr, "MCP server stopped.")
@@ -46,6 +48,19 @@ func newServeCmd(deps *Dependencies) *cobra.Command {
 				}
 				return nil
 
+			case "both":
+				addr := fmt.Sprintf(":%d", port)
+				_, _ = fmt.Fprintf(deps.Out, "Starting %s v%s MCP server (stdio, and http on %s)...\n",
+					deps.MCPServer.Name(), deps.MCPServer.Version(), addr)
+
+				if err := serveBoth(ctx, deps, addr); err != nil {
+					return fmt.Errorf("MCP server error: %w", err)
+				}
+				if ctx.Err() != nil {
+					_, _ = fmt.Fprintln(os.Stderr, "MCP server stopped.")
+				}
+				return nil
+
 			default: // stdio
 				_, _ = fmt.Fprintf(deps.Out, "Starting %s v%s MCP server (stdio)...\n",
 					deps.MCPServer.Name(), deps.MCPServer.Version())
@@ -62,8 +77,97 @@ func newServeCmd(deps *Dependencies) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&transport, "transport", "stdio", "Transport: stdio or http")
-	cmd.Flags().IntVar(&port, "port", 8080, "HTTP port (when transport=http)")
+	cmd.Flags().StringVar(&transport, "transport", "stdio", "Transport: stdio, http, or both")
+	cmd.Flags().IntVar(&port, "port", 8080, "HTTP port (when transport=http or both)")
 
 	return cmd
 }
+
+// DefaultWebhookPath is where serve mounts the webhook handler when
+// Dependencies.WebhookPath is empty.
+const DefaultWebhookPath = "/webhook/granola"
+
+// backgroundWebhook is implemented by webhook handlers that keep working
+// after they respond, such as webhook.Handler with its background syncs.
+type backgroundWebhook interface {
+	SetBaseContext(ctx context.Context)
+	Wait()
+}
+
+// waitForWebhooks blocks until the webhook handler's background work,
+// already cancelled by the server's shutdown, has finished.
+func waitForWebhooks(deps *Dependencies) {
+	if bg, ok := deps.WebhookHandler.(backgroundWebhook); ok {
+		bg.Wait()
+	}
+}
+
+// httpRoutes mounts the webhook, metrics, event stream, and outbox health
+// handlers next to the MCP routes. Streams are closed when ctx ends, since the HTTP
+// server's shutdown waits for open requests rather than cancelling them;
+// background webhook work is bound to ctx for the same reason.
+func httpRoutes(ctx context.Context, deps *Dependencies) func(mux *http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		if deps.WebhookHandler != nil {
+			path := deps.WebhookPath
+			if path == "" {
+				path = DefaultWebhookPath
+			}
+			if bg, ok := deps.WebhookHandler.(backgroundWebhook); ok {
+				bg.SetBaseContext(ctx)
+			}
+			mux.Handle(path, deps.WebhookHandler)
+		}
+		if deps.MetricsHandler != nil {
+			mux.Handle("/metrics", deps.MetricsHandler)
+		}
+		if deps.EventStream != nil {
+			mux.Handle("/events", mcpiface.StreamingHandler(streamUntil(ctx, deps.EventStream)))
+		}
+		if deps.OutboxHealth != nil {
+			mux.Handle("/health/outbox", deps.OutboxHealth)
+		}
+	}
+}
+
+// streamUntil cancels each request's context once ctx ends, so
+// long-lived responses finish when the server stops.
+func streamUntil(ctx context.Context, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqCtx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stop := context.AfterFunc(ctx, cancel)
+		defer stop()
+		h.ServeHTTP(w, r.WithContext(reqCtx))
+	})
+}
+
+// serveBoth serves MCP over stdio and HTTP under one shutdown context:
+// when either transport stops, because of a signal, the stdio client
+// disconnecting, or an error, the other is shut down too. It returns the
+// first error that was not caused by the shutdown.
+func serveBoth(ctx context.Context, deps *Dependencies, addr string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	httpErr := make(chan error, 1)
+	stdioErr := make(chan error, 1)
+	go func() { httpErr <- deps.MCPServer.ServeHTTP(ctx, addr, httpRoutes(ctx, deps)) }()
+	go func() { stdioErr <- deps.MCPServer.ServeStdio(ctx) }()
+
+	var first error
+	select {
+	case first = <-httpErr:
+		cancel()
+		<-stdioErr
+	case first = <-stdioErr:
+		cancel()
+		if err := <-httpErr; first == nil || errors.Is(first, context.Canceled) {
+			first = err
+		}
+	}
+	if errors.Is(first, context.Canceled) {
+		return nil
+	}
+	return first
+}
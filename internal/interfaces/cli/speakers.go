@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/spf13/cobra"
+)
+
+func newSpeakersCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "speakers",
+		Short: "Manage speaker name aliases",
+	}
+
+	cmd.AddCommand(newSpeakersAliasCmd(deps))
+	cmd.AddCommand(newSpeakersUnaliasCmd(deps))
+	cmd.AddCommand(newSpeakersListCmd(deps))
+	return cmd
+}
+
+func newSpeakersAliasCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "alias <from> <to>",
+		Short: "Report the transcript speaker <from> as <to> in transcripts and statistics",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.SetSpeakerAlias == nil {
+				return fmt.Errorf("speaker aliases not configured")
+			}
+
+			err := deps.SetSpeakerAlias.Execute(cmd.Context(), meetingapp.SetSpeakerAliasInput{
+				Alias:     args[0],
+				Canonical: args[1],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set speaker alias: %w", err)
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Speaker %q is now reported as %q.\n", args[0], args[1])
+			return nil
+		},
+	}
+}
+
+func newSpeakersUnaliasCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unalias <from>",
+		Short: "Remove a speaker alias",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.RemoveSpeakerAlias == nil {
+				return fmt.Errorf("speaker aliases not configured")
+			}
+
+			err := deps.RemoveSpeakerAlias.Execute(cmd.Context(), meetingapp.RemoveSpeakerAliasInput{Alias: args[0]})
+			if err != nil {
+				return fmt.Errorf("failed to remove speaker alias: %w", err)
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Removed alias %q.\n", args[0])
+			return nil
+		},
+	}
+}
+
+func newSpeakersListCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List speaker aliases",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deps.ListSpeakerAliases == nil {
+				return fmt.Errorf("speaker aliases not configured")
+			}
+
+			out, err := deps.ListSpeakerAliases.Execute(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list speaker aliases: %w", err)
+			}
+
+			switch flagFormat {
+			case "json":
+				return printJSON(deps, toSpeakerAliasJSON(out.Aliases))
+			default:
+				w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "ALIAS\tCANONICAL")
+				for _, a := range out.Aliases {
+					_, _ = fmt.Fprintf(w, "%s\t%s\n", a.Alias, a.Canonical)
+				}
+				return w.Flush()
+			}
+		},
+	}
+}
+
+type speakerAliasJSON struct {
+	Alias     string `json:"alias"`
+	Canonical string `json:"canonical"`
+}
+
+func toSpeakerAliasJSON(aliases []meetingapp.SpeakerAlias) []speakerAliasJSON {
+	out := make([]speakerAliasJSON, len(aliases))
+	for i, a := range aliases {
+		out[i] = speakerAliasJSON{Alias: a.Alias, Canonical: a.Canonical}
+	}
+	return out
+}
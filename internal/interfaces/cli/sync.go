@@ -5,33 +5,38 @@ import (
 	"time"
 
 	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/interfaces/datefilter"
 	"github.com/spf13/cobra"
 )
 
 func newSyncCmd(deps *Dependencies) *cobra.Command {
-	var since string
+	var (
+		since string
+		full  bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "sync",
 		Short: "Sync meetings from Granola",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			input := meetingapp.SyncMeetingsInput{}
-
-			if since != "" {
-				t, err := time.Parse(time.RFC3339, since)
-				if err != nil {
-					t, err = time.Parse("2006-01-02", since)
-					if err != nil {
-						return fmt.Errorf("invalid --since date: %w", err)
-					}
-				}
-				input.Since = &t
+			if full && since != "" {
+				return fmt.Errorf("--full and --since cannot be combined")
+			}
+			input := meetingapp.SyncMeetingsInput{Full: full}
+
+			t, err := parseDateFlag(deps, "since", since)
+			if err != nil {
+				return err
 			}
+			input.Since = t
 
 			out, err := deps.SyncMeetings.Execute(cmd.Context(), input)
 			if err != nil {
 				return fmt.Errorf("sync failed: %w", err)
 			}
+			if out.Resumed {
+				_, _ = fmt.Fprintf(deps.Out, "Resuming from last sync at %s\n", displayTime(deps, *out.Since).Format("2006-01-02 15:04"))
+			}
 
 			if deps.EventDispatcher != nil && len(out.Events) > 0 {
 				if dispErr := deps.EventDispatcher.Dispatch(cmd.Context(), out.Events); dispErr != nil {
@@ -39,12 +44,35 @@ func newSyncCmd(deps *Dependencies) *cobra.Command {
 				}
 			}
 
-			_, _ = fmt.Fprintf(deps.Out, "Synced %d meeting event(s)\n", len(out.Events))
+			if len(out.Errors) == 0 {
+				_, _ = fmt.Fprintf(deps.Out, "Synced %d meeting event(s)\n", len(out.Events))
+				return nil
+			}
+			_, _ = fmt.Fprintf(deps.Out, "Synced %d meeting event(s) (%d skipped due to errors)\n", len(out.Events), len(out.Errors))
+			if flagVerbose {
+				for _, syncErr := range out.Errors {
+					_, _ = fmt.Fprintf(deps.Out, "  skipped %s: %v\n", syncErr.MeetingID, syncErr.Err)
+				}
+			}
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVar(&since, "since", "", "Sync meetings since date (RFC3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&since, "since", "", "Sync meetings since date (RFC3339 or YYYY-MM-DD); defaults to the last successful sync")
+	cmd.Flags().BoolVar(&full, "full", false, "Re-sync every meeting, ignoring the last successful sync")
 
 	return cmd
 }
+
+// parseDateFlag parses an RFC3339 or YYYY-MM-DD flag value; a date-only
+// value is midnight in the display zone. An empty value yields nil.
+func parseDateFlag(deps *Dependencies, name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := datefilter.Parse(value, deps.Location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s date: %w", name, err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func newWhoamiCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "whoami",
+		Short: "Print the account the current profile is authenticated as",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := deps.CheckStatus.Execute(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			r := toStatusResult(cmd, out)
+			if flagFormat == "json" {
+				return printJSON(deps, r)
+			}
+			if !r.Authenticated {
+				_, _ = fmt.Fprintln(deps.Out, notAuthenticatedMessage)
+				return nil
+			}
+			if out.Identity.IsZero() {
+				_, _ = fmt.Fprintf(deps.Out, "Authenticated (workspace: %s), but the account is unknown. Run 'acai auth login' again to record it.\n",
+					r.Workspace)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(deps.Out, 0, 0, 2, ' ', 0)
+			for _, row := range [][2]string{
+				{"Email", r.Email},
+				{"Name", r.Name},
+				{"Account ID", r.AccountID},
+				{"Workspace", r.Workspace},
+				{"Method", r.Method},
+				{"Profile", r.Profile},
+			} {
+				if row[1] != "" {
+					_, _ = fmt.Fprintf(w, "%s:\t%s\n", row[0], row[1])
+				}
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,31 @@
+// Package datefilter parses the since/until values that MCP tools and CLI
+// commands accept, so every entry point takes the same formats.
+package datefilter
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateOnly is the layout of a date without a time of day.
+const DateOnly = "2006-01-02"
+
+// ErrInvalidDate is returned for a value in neither accepted format.
+var ErrInvalidDate = errors.New("invalid date")
+
+// Parse accepts an RFC3339 timestamp or a YYYY-MM-DD date. A date-only
+// value means midnight in loc, the configured display zone; nil loc
+// means UTC. RFC3339 values carry their own offset and ignore loc.
+func Parse(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	if t, err := time.ParseInLocation(DateOnly, value, loc); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w %q: want RFC3339 or YYYY-MM-DD", ErrInvalidDate, value)
+}
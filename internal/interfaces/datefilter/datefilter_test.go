@@ -0,0 +1,47 @@
+package datefilter_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/acai/internal/interfaces/datefilter"
+)
+
+func TestParse(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		loc   *time.Location
+		want  time.Time
+	}{
+		{"RFC3339 UTC", "2026-01-01T09:30:00Z", nil, time.Date(2026, 1, 1, 9, 30, 0, 0, time.UTC)},
+		{"RFC3339 offset ignores loc", "2026-01-01T09:30:00-05:00", berlin, time.Date(2026, 1, 1, 14, 30, 0, 0, time.UTC)},
+		{"date only defaults to UTC", "2026-01-01", nil, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"date only in zone", "2026-01-01", berlin, time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := datefilter.Parse(tt.value, tt.loc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, value := range []string{"", "last week", "2026-13-01", "01/02/2026", "2026-01-01 09:30"} {
+		if _, err := datefilter.Parse(value, nil); !errors.Is(err, datefilter.ErrInvalidDate) {
+			t.Errorf("Parse(%q): got %v, want %v", value, err, datefilter.ErrInvalidDate)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package mcp_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/granola"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/tracing"
+	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
+)
+
+func TestServer_HandleToolJSON_CorrelationIDReachesAPIAndError(t *testing.T) {
+	var received string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(tracing.CorrelationHeader)
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer api.Close()
+
+	repo := granola.NewRepository(granola.NewClient(api.URL, api.Client(), "test-token"))
+	opts, _, _ := testDeps(newMockRepo())
+	opts.GetMeeting = meetingapp.NewGetMeeting(repo)
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	_, err := srv.HandleToolJSON(context.Background(), "get_meeting", json.RawMessage(`{"id":"m-1"}`))
+	if err == nil {
+		t.Fatal("expected error from failing API")
+	}
+	if received == "" {
+		t.Fatalf("API did not receive %s header", tracing.CorrelationHeader)
+	}
+	if !strings.Contains(err.Error(), "correlation_id: "+received) {
+		t.Errorf("error %q does not carry correlation ID %q", err, received)
+	}
+}
+
+func TestServer_HandleToolJSON_ReusesContextCorrelationID(t *testing.T) {
+	srv := newTestServer(newMockRepo())
+	ctx := tracing.WithCorrelationID(context.Background(), "req-42")
+
+	_, err := srv.HandleToolJSON(ctx, "get_meeting", json.RawMessage(`{"id":"missing"}`))
+	if err == nil {
+		t.Fatal("expected error for missing meeting")
+	}
+	if !strings.Contains(err.Error(), "correlation_id: req-42") {
+		t.Errorf("error %q does not carry caller's correlation ID", err)
+	}
+}
@@ -0,0 +1,149 @@
+package mcp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
+	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	workspaceapp "github.com/felixgeelhaar/acai/internal/application/workspace"
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	domainauth "github.com/felixgeelhaar/acai/internal/domain/auth"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/policy"
+	"github.com/felixgeelhaar/acai/internal/domain/workspace"
+	"github.com/felixgeelhaar/acai/internal/interfaces/datefilter"
+)
+
+// ErrorCode is a stable, machine-readable classification of a tool error.
+// Clients should branch on it rather than on error messages.
+type ErrorCode string
+
+const (
+	CodeNotFound      ErrorCode = "NOT_FOUND"
+	CodeInvalidInput  ErrorCode = "INVALID_INPUT"
+	CodeUnauthorized  ErrorCode = "UNAUTHORIZED"
+	CodeRateLimited   ErrorCode = "RATE_LIMITED"
+	CodeUpstreamError ErrorCode = "UPSTREAM_ERROR"
+	CodePolicyDenied  ErrorCode = "POLICY_DENIED"
+)
+
+var (
+	// ErrInvalidInput is returned when tool arguments cannot be decoded.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrUnknownTool is returned for a tool name the server does not know.
+	ErrUnknownTool = errors.New("unknown tool")
+)
+
+// ToolError is the structured error returned by HandleToolJSON. It wraps
+// the underlying error, so errors.Is still matches domain sentinels.
+type ToolError struct {
+	Code          ErrorCode `json:"code"`
+	Message       string    `json:"message"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	// Rule names the policy rule that denied a POLICY_DENIED call; empty
+	// when the policy's default effect denied it.
+	Rule string `json:"rule,omitempty"`
+
+	err error
+}
+
+func (e *ToolError) Error() string {
+	if e.CorrelationID == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s (correlation_id: %s)", e.Message, e.CorrelationID)
+}
+
+func (e *ToolError) Unwrap() error { return e.err }
+
+// Payload returns the error as JSON ({code, message, correlation_id, rule}).
+func (e *ToolError) Payload() json.RawMessage {
+	data, _ := json.Marshal(e)
+	return data
+}
+
+func newToolError(err error, correlationID string) *ToolError {
+	te := &ToolError{
+		Code:          ErrorCodeOf(err),
+		Message:       err.Error(),
+		CorrelationID: correlationID,
+		err:           err,
+	}
+	var denied *policy.DeniedError
+	if errors.As(err, &denied) {
+		te.Rule = denied.Rule
+	}
+	return te
+}
+
+// errorCodes maps sentinel errors to their codes, checked in order.
+var errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{domain.ErrMeetingNotFound, CodeNotFound},
+	{domain.ErrActionItemNotFound, CodeNotFound},
+	{domain.ErrTranscriptNotReady, CodeNotFound},
+	{annotation.ErrNoteNotFound, CodeNotFound},
+	{workspace.ErrWorkspaceNotFound, CodeNotFound},
+	{ErrUnknownTool, CodeNotFound},
+
+	{ErrInvalidInput, CodeInvalidInput},
+	{domain.ErrInvalidMeetingID, CodeInvalidInput},
+	{domain.ErrInvalidTitle, CodeInvalidInput},
+	{domain.ErrInvalidDatetime, CodeInvalidInput},
+	{domain.ErrInvalidActionItemID, CodeInvalidInput},
+	{domain.ErrInvalidActionItemText, CodeInvalidInput},
+	{domain.ErrInvalidFilter, CodeInvalidInput},
+	{annotation.ErrInvalidNoteID, CodeInvalidInput},
+	{annotation.ErrInvalidMeetingID, CodeInvalidInput},
+	{annotation.ErrInvalidNoteContent, CodeInvalidInput},
+	{annotation.ErrInvalidAuthor, CodeInvalidInput},
+	{annotation.ErrNoteTooLong, CodeInvalidInput},
+	{workspace.ErrInvalidWorkspaceID, CodeInvalidInput},
+	{domain.ErrInvalidTag, CodeInvalidInput},
+	{meetingapp.ErrEmptyQuery, CodeInvalidInput},
+	{meetingapp.ErrInvalidSortOrder, CodeInvalidInput},
+	{meetingapp.ErrInvalidCompletionFilter, CodeInvalidInput},
+	{meetingapp.ErrInvalidPagination, CodeInvalidInput},
+	{meetingapp.ErrNoActionItemIDs, CodeInvalidInput},
+	{meetingapp.ErrInvalidGroupBy, CodeInvalidInput},
+	{meetingapp.ErrInvalidGranularity, CodeInvalidInput},
+	{datefilter.ErrInvalidDate, CodeInvalidInput},
+	{meetingapp.ErrTagsUnavailable, CodeInvalidInput},
+	{annotationapp.ErrEmptyQuery, CodeInvalidInput},
+	{embeddingapp.ErrNoMeetings, CodeInvalidInput},
+	{embeddingapp.ErrInvalidStrategy, CodeInvalidInput},
+	{embeddingapp.ErrInvalidOverlap, CodeInvalidInput},
+	{workspaceapp.ErrInvalidPagination, CodeInvalidInput},
+
+	{domain.ErrAccessDenied, CodeUnauthorized},
+	{domainauth.ErrNotAuthenticated, CodeUnauthorized},
+	{domainauth.ErrTokenExpired, CodeUnauthorized},
+	{domainauth.ErrInvalidToken, CodeUnauthorized},
+	{domainauth.ErrNoRefreshToken, CodeUnauthorized},
+	{ErrToolDisabled, CodeUnauthorized},
+
+	{domain.ErrRateLimited, CodeRateLimited},
+
+	{policy.ErrAccessDenied, CodePolicyDenied},
+}
+
+// ErrorCodeOf classifies err. Errors that match no known sentinel come
+// from the Granola API or the resilience layer and are UPSTREAM_ERROR.
+func ErrorCodeOf(err error) ErrorCode {
+	for _, m := range errorCodes {
+		if errors.Is(err, m.err) {
+			return m.code
+		}
+	}
+	var parseErr *time.ParseError
+	if errors.As(err, &parseErr) {
+		return CodeInvalidInput
+	}
+	return CodeUpstreamError
+}
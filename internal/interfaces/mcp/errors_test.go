@@ -0,0 +1,134 @@
+package mcp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
+	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
+	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
+	workspaceapp "github.com/felixgeelhaar/acai/internal/application/workspace"
+	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	domainauth "github.com/felixgeelhaar/acai/internal/domain/auth"
+	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
+	"github.com/felixgeelhaar/acai/internal/domain/policy"
+	"github.com/felixgeelhaar/acai/internal/domain/workspace"
+	"github.com/felixgeelhaar/acai/internal/interfaces/datefilter"
+	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	_, parseErr := time.Parse(time.RFC3339, "yesterday")
+
+	tests := []struct {
+		err  error
+		want mcpiface.ErrorCode
+	}{
+		{domain.ErrMeetingNotFound, mcpiface.CodeNotFound},
+		{domain.ErrActionItemNotFound, mcpiface.CodeNotFound},
+		{domain.ErrTranscriptNotReady, mcpiface.CodeNotFound},
+		{annotation.ErrNoteNotFound, mcpiface.CodeNotFound},
+		{workspace.ErrWorkspaceNotFound, mcpiface.CodeNotFound},
+		{mcpiface.ErrUnknownTool, mcpiface.CodeNotFound},
+
+		{mcpiface.ErrInvalidInput, mcpiface.CodeInvalidInput},
+		{domain.ErrInvalidMeetingID, mcpiface.CodeInvalidInput},
+		{domain.ErrInvalidTitle, mcpiface.CodeInvalidInput},
+		{domain.ErrInvalidDatetime, mcpiface.CodeInvalidInput},
+		{domain.ErrInvalidActionItemID, mcpiface.CodeInvalidInput},
+		{domain.ErrInvalidActionItemText, mcpiface.CodeInvalidInput},
+		{domain.ErrInvalidFilter, mcpiface.CodeInvalidInput},
+		{annotation.ErrInvalidNoteID, mcpiface.CodeInvalidInput},
+		{annotation.ErrInvalidMeetingID, mcpiface.CodeInvalidInput},
+		{annotation.ErrInvalidNoteContent, mcpiface.CodeInvalidInput},
+		{annotation.ErrInvalidAuthor, mcpiface.CodeInvalidInput},
+		{workspace.ErrInvalidWorkspaceID, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrEmptyQuery, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrInvalidSortOrder, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrInvalidCompletionFilter, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrInvalidPagination, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrNoActionItemIDs, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrInvalidGroupBy, mcpiface.CodeInvalidInput},
+		{meetingapp.ErrInvalidGranularity, mcpiface.CodeInvalidInput},
+		{datefilter.ErrInvalidDate, mcpiface.CodeInvalidInput},
+		{annotationapp.ErrEmptyQuery, mcpiface.CodeInvalidInput},
+		{embeddingapp.ErrNoMeetings, mcpiface.CodeInvalidInput},
+		{embeddingapp.ErrInvalidStrategy, mcpiface.CodeInvalidInput},
+		{workspaceapp.ErrInvalidPagination, mcpiface.CodeInvalidInput},
+		{fmt.Errorf("invalid 'since' date: %w", parseErr), mcpiface.CodeInvalidInput},
+
+		{domain.ErrAccessDenied, mcpiface.CodeUnauthorized},
+		{domainauth.ErrNotAuthenticated, mcpiface.CodeUnauthorized},
+		{domainauth.ErrTokenExpired, mcpiface.CodeUnauthorized},
+		{domainauth.ErrInvalidToken, mcpiface.CodeUnauthorized},
+		{domainauth.ErrNoRefreshToken, mcpiface.CodeUnauthorized},
+		{mcpiface.ErrToolDisabled, mcpiface.CodeUnauthorized},
+
+		{domain.ErrRateLimited, mcpiface.CodeRateLimited},
+
+		{policy.ErrAccessDenied, mcpiface.CodePolicyDenied},
+
+		{errors.New("api error (status 502): bad gateway"), mcpiface.CodeUpstreamError},
+		{context.DeadlineExceeded, mcpiface.CodeUpstreamError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			if got := mcpiface.ErrorCodeOf(fmt.Errorf("wrapped: %w", tt.err)); got != tt.want {
+				t.Errorf("ErrorCodeOf(%v) = %s, want %s", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_HandleToolJSON_StructuredError(t *testing.T) {
+	srv := newTestServer(newMockRepo())
+
+	_, err := srv.HandleToolJSON(context.Background(), "get_meeting", json.RawMessage(`{"id":"missing"}`))
+
+	var toolErr *mcpiface.ToolError
+	if !errors.As(err, &toolErr) {
+		t.Fatalf("got %T, want *ToolError", err)
+	}
+	if !errors.Is(err, domain.ErrMeetingNotFound) {
+		t.Error("errors.Is should still match the domain sentinel")
+	}
+
+	var payload struct {
+		Code          string `json:"code"`
+		Message       string `json:"message"`
+		CorrelationID string `json:"correlation_id"`
+	}
+	if err := json.Unmarshal(toolErr.Payload(), &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if payload.Code != "NOT_FOUND" || payload.Message != domain.ErrMeetingNotFound.Error() || payload.CorrelationID == "" {
+		t.Errorf("got payload %+v", payload)
+	}
+}
+
+func TestServer_HandleToolJSON_InvalidInputCode(t *testing.T) {
+	srv := newTestServer(newMockRepo())
+
+	for tool, input := range map[string]string{
+		"get_meeting":   `{"id":`,
+		"list_meetings": `{"since":"not-a-date"}`,
+		"no_such_tool":  `{}`,
+	} {
+		_, err := srv.HandleToolJSON(context.Background(), tool, json.RawMessage(input))
+		var toolErr *mcpiface.ToolError
+		if !errors.As(err, &toolErr) {
+			t.Fatalf("%s: got %T, want *ToolError", tool, err)
+		}
+		want := mcpiface.CodeInvalidInput
+		if tool == "no_such_tool" {
+			want = mcpiface.CodeNotFound
+		}
+		if toolErr.Code != want {
+			t.Errorf("%s: got code %s, want %s", tool, toolErr.Code, want)
+		}
+	}
+}
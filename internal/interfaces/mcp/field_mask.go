@@ -0,0 +1,74 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// meetingDetailFields are the top-level JSON keys of MeetingDetailResult
+// a get_meeting field mask may select.
+var meetingDetailFields = jsonFieldNames(reflect.TypeOf(MeetingDetailResult{}))
+
+// jsonFieldNames lists the JSON keys of a struct type, flattening embedded
+// structs the way encoding/json does. Fields without a tag are skipped.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			for name := range jsonFieldNames(f.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" || name == "warnings" {
+			continue
+		}
+		names[name] = true
+	}
+	return names
+}
+
+// applyFieldMask restricts r to the requested fields. Unknown names are
+// ignored and reported in r.Warnings; an empty mask keeps every field.
+func (r *MeetingDetailResult) applyFieldMask(fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	r.fields = []string{}
+	for _, f := range fields {
+		if !meetingDetailFields[f] {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("unknown field %q ignored", f))
+			continue
+		}
+		r.fields = append(r.fields, f)
+	}
+}
+
+// MarshalJSON encodes only the masked fields, plus warnings, when a field
+// mask was applied.
+func (r MeetingDetailResult) MarshalJSON() ([]byte, error) {
+	type plain MeetingDetailResult
+	data, err := json.Marshal(plain(r))
+	if err != nil || r.fields == nil {
+		return data, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	masked := make(map[string]json.RawMessage, len(r.fields)+1)
+	for _, f := range r.fields {
+		if v, ok := all[f]; ok {
+			masked[f] = v
+		}
+	}
+	if v, ok := all["warnings"]; ok {
+		masked["warnings"] = v
+	}
+	return json.Marshal(masked)
+}
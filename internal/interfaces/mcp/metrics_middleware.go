@@ -0,0 +1,42 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
+)
+
+// ToolHandler executes an MCP tool by name with JSON input and output.
+// Implemented by Server and the middlewares that wrap it.
+type ToolHandler interface {
+	HandleToolJSON(ctx context.Context, tool string, rawInput json.RawMessage) (json.RawMessage, error)
+}
+
+// MetricsMiddleware wraps a ToolHandler and counts every tool invocation
+// by name and outcome.
+type MetricsMiddleware struct {
+	inner   ToolHandler
+	metrics *metrics.Registry
+}
+
+// NewMetricsMiddleware creates a middleware that records tool calls in the registry.
+func NewMetricsMiddleware(inner ToolHandler, registry *metrics.Registry) *MetricsMiddleware {
+	return &MetricsMiddleware{
+		inner:   inner,
+		metrics: registry,
+	}
+}
+
+// HandleToolJSON delegates to the inner handler and records the outcome.
+func (mm *MetricsMiddleware) HandleToolJSON(ctx context.Context, tool string, rawInput json.RawMessage) (json.RawMessage, error) {
+	result, err := mm.inner.HandleToolJSON(ctx, tool, rawInput)
+	mm.metrics.ObserveToolCall(tool, err)
+	return result, err
+}
+
+var (
+	_ ToolHandler = (*Server)(nil)
+	_ ToolHandler = (*PolicyMiddleware)(nil)
+	_ ToolHandler = (*MetricsMiddleware)(nil)
+)
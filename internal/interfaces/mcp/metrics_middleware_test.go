@@ -0,0 +1,33 @@
+package mcp_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/acai/internal/infrastructure/metrics"
+	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
+)
+
+func TestMetricsMiddleware_CountsToolCalls(t *testing.T) {
+	srv := newTestServer(newMockRepo())
+	registry := metrics.NewRegistry()
+	mw := mcpiface.NewMetricsMiddleware(srv, registry)
+
+	if _, err := mw.HandleToolJSON(context.Background(), "list_meetings", []byte(`{}`)); err != nil {
+		t.Fatalf("list_meetings: %v", err)
+	}
+	if _, err := mw.HandleToolJSON(context.Background(), "get_meeting", []byte(`{"id":"missing"}`)); err == nil {
+		t.Fatal("expected error for missing meeting")
+	}
+
+	var b strings.Builder
+	_, _ = registry.WriteTo(&b)
+	out := b.String()
+	if !strings.Contains(out, `acai_mcp_tool_calls_total{tool="list_meetings",status="ok"} 1`) {
+		t.Errorf("list_meetings call not counted:\n%s", out)
+	}
+	if !strings.Contains(out, `acai_mcp_tool_calls_total{tool="get_meeting",status="error"} 1`) {
+		t.Errorf("get_meeting error not counted:\n%s", out)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	domainpolicy "github.com/felixgeelhaar/acai/internal/domain/policy"
 	policy "github.com/felixgeelhaar/acai/internal/infrastructure/policy"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/tracing"
 )
 
 // PolicyMiddleware wraps an MCP Server and enforces access control and redaction policies.
@@ -26,13 +27,18 @@ func NewPolicyMiddleware(inner *Server, engine *policy.Engine) *PolicyMiddleware
 }
 
 // HandleToolJSON checks ACL, delegates to inner server, and applies redaction.
+// A denied call fails with a POLICY_DENIED *ToolError naming the rule.
 func (pm *PolicyMiddleware) HandleToolJSON(ctx context.Context, tool string, rawInput json.RawMessage) (json.RawMessage, error) {
 	// Extract meeting context from input for ACL check
 	meetingCtx := pm.extractMeetingContext(rawInput)
 
 	// Check access control
 	if err := pm.engine.CheckAccess(tool, meetingCtx); err != nil {
-		return nil, fmt.Errorf("%s: %w", tool, err)
+		id := tracing.CorrelationIDFromContext(ctx)
+		if id == "" {
+			id = tracing.NewCorrelationID()
+		}
+		return nil, newToolError(fmt.Errorf("%s: %w", tool, err), id)
 	}
 
 	// Delegate to inner server
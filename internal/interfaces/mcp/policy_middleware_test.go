@@ -3,9 +3,12 @@ package mcp_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/felixgeelhaar/mcp-go/protocol"
+
 	domainpolicy "github.com/felixgeelhaar/acai/internal/domain/policy"
 	policy "github.com/felixgeelhaar/acai/internal/infrastructure/policy"
 	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
@@ -26,12 +29,12 @@ func TestPolicyMiddleware_AllowedTool(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	var results []mcpiface.MeetingResult
-	if err := json.Unmarshal(raw, &results); err != nil {
+	var result mcpiface.ListMeetingsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
 		t.Fatalf("unmarshal error: %v", err)
 	}
-	if len(results) != 1 {
-		t.Errorf("got %d results, want 1", len(results))
+	if len(result.Meetings) != 1 {
+		t.Errorf("got %d results, want 1", len(result.Meetings))
 	}
 }
 
@@ -335,3 +338,64 @@ redaction:
 		t.Errorf("public meeting should not be denied: %v", err)
 	}
 }
+
+func TestPolicyMiddleware_ServedToolCalls(t *testing.T) {
+	result, err := policy.LoadFromBytes([]byte(`
+default_effect: allow
+rules:
+  - name: no-note-deletion
+    effect: deny
+    tools: [delete_note]
+    message: Notes are append-only
+`))
+	if err != nil {
+		t.Fatalf("load policy: %v", err)
+	}
+
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	srv := newTestServer(repo)
+	srv.SetToolHandler(mcpiface.NewPolicyMiddleware(srv, policy.NewEngine(result)))
+
+	nextCalled := false
+	handle := srv.ToolCallMiddleware()(func(context.Context, *protocol.Request) (*protocol.Response, error) {
+		nextCalled = true
+		return nil, nil
+	})
+	call := func(tool, args string) (*protocol.Response, error) {
+		params, _ := json.Marshal(map[string]any{"name": tool, "arguments": json.RawMessage(args)})
+		return handle(context.Background(), &protocol.Request{
+			JSONRPC: "2.0",
+			ID:      json.RawMessage(`1`),
+			Method:  protocol.MethodToolsCall,
+			Params:  params,
+		})
+	}
+
+	_, err = call("delete_note", `{"note_id":"n-1"}`)
+	var pErr *protocol.Error
+	if !errors.As(err, &pErr) {
+		t.Fatalf("got %v, want a protocol error", err)
+	}
+	toolErr, ok := pErr.Data.(*mcpiface.ToolError)
+	if !ok {
+		t.Fatalf("got error data %T, want *ToolError", pErr.Data)
+	}
+	if toolErr.Code != mcpiface.CodePolicyDenied || toolErr.Rule != "no-note-deletion" {
+		t.Errorf("got code %s rule %q, want POLICY_DENIED by no-note-deletion", toolErr.Code, toolErr.Rule)
+	}
+	if !strings.Contains(toolErr.Message, "Notes are append-only") {
+		t.Errorf("message %q should carry the rule's message", toolErr.Message)
+	}
+
+	resp, err := call("list_meetings", `{}`)
+	if err != nil {
+		t.Fatalf("allowed tool: unexpected error: %v", err)
+	}
+	if data, _ := json.Marshal(resp.Result); !strings.Contains(string(data), "Sprint Planning") {
+		t.Errorf("got %s, want the meeting list", data)
+	}
+	if nextCalled {
+		t.Error("registered tools should not fall through to the default handler")
+	}
+}
@@ -6,8 +6,11 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	mcpfw "github.com/felixgeelhaar/mcp-go"
@@ -17,8 +20,12 @@ import (
 	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
 	workspaceapp "github.com/felixgeelhaar/acai/internal/application/workspace"
 	"github.com/felixgeelhaar/acai/internal/domain/annotation"
+	"github.com/felixgeelhaar/acai/internal/domain/audit"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 	"github.com/felixgeelhaar/acai/internal/domain/workspace"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/events"
+	"github.com/felixgeelhaar/acai/internal/infrastructure/tracing"
+	"github.com/felixgeelhaar/acai/internal/interfaces/datefilter"
 )
 
 // ServerOptions groups all use cases passed to NewServer.
@@ -27,20 +34,206 @@ type ServerOptions struct {
 	GetMeeting        *meetingapp.GetMeeting
 	GetTranscript     *meetingapp.GetTranscript
 	SearchTranscripts *meetingapp.SearchTranscripts
+	SearchMeetings    *meetingapp.SearchMeetings
 	GetActionItems    *meetingapp.GetActionItems
 	GetMeetingStats   *meetingapp.GetMeetingStats
+	ExtractKeywords   *meetingapp.ExtractKeywords
+	CompareMeetings   *meetingapp.CompareMeetings
 	ListWorkspaces    *workspaceapp.ListWorkspaces
 	GetWorkspace      *workspaceapp.GetWorkspace
 
+	// OverdueActionItems scans a date range for past-due open action items.
+	OverdueActionItems *meetingapp.OverdueActionItems
+	// ActionItemDigest rolls up a date range's action items per owner.
+	ActionItemDigest *meetingapp.ActionItemDigest
+	// FindConflicts detects overlapping meetings in a date range.
+	FindConflicts *meetingapp.FindConflicts
+	// MeetingTimeline counts meetings per day, week, or month.
+	MeetingTimeline *meetingapp.MeetingTimeline
+	// GetActionItem fetches one action item by ID.
+	GetActionItem *meetingapp.GetActionItem
+
 	// Write use cases (Phase 3)
-	AddNote            *annotationapp.AddNote
-	ListNotes          *annotationapp.ListNotes
-	DeleteNote         *annotationapp.DeleteNote
-	CompleteActionItem *meetingapp.CompleteActionItem
-	UpdateActionItem   *meetingapp.UpdateActionItem
+	AddNote             *annotationapp.AddNote
+	ListNotes           *annotationapp.ListNotes
+	SearchNotes         *annotationapp.SearchNotes
+	DeleteNote          *annotationapp.DeleteNote
+	DeleteMeetingNotes  *annotationapp.DeleteNotesByMeeting
+	CompleteActionItem  *meetingapp.CompleteActionItem
+	CompleteActionItems *meetingapp.CompleteActionItems
+	UpdateActionItem    *meetingapp.UpdateActionItem
+	TagMeeting          *meetingapp.TagMeeting
+	UntagMeeting        *meetingapp.UntagMeeting
 
 	// Embedding export (Phase 3)
 	ExportEmbeddings *embeddingapp.ExportEmbeddings
+
+	// RecentEvents backs the events://recent resource. Optional.
+	RecentEvents *events.RecentEvents
+
+	// DisabledTools lists tool names that must not be registered,
+	// even when their use case is wired (e.g., read-only deployments).
+	DisabledTools []string
+
+	// ToolOverrides tailors individual tools' registration, keyed by tool name.
+	ToolOverrides map[string]ToolOverride
+
+	// Location is the zone timestamps are rendered in; nil means UTC.
+	Location *time.Location
+
+	// HTTP bounds the HTTP transport; zero fields use DefaultHTTPLimits.
+	HTTP HTTPLimits
+
+	// Limits bounds how many items one list call returns; zero fields use
+	// DefaultListLimits.
+	Limits ListLimits
+
+	// RedactExportEmails masks participant emails in export_embeddings
+	// output even when a call does not ask for it.
+	RedactExportEmails bool
+}
+
+// HTTPLimits are the timeouts, header cap, and concurrency cap of the
+// HTTP transport.
+type HTTPLimits struct {
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	MaxHeaderBytes    int
+	// MaxInFlight caps concurrently served requests; further requests get
+	// 503 Service Unavailable. /health is not counted.
+	MaxInFlight int
+	// MaxStreams caps concurrently open streaming responses, the routes
+	// wrapped in StreamingHandler. They are counted apart from MaxInFlight,
+	// so idle streams cannot starve the other routes.
+	MaxStreams int
+}
+
+// DefaultHTTPLimits keeps slow or stalled clients from holding
+// connections open indefinitely.
+var DefaultHTTPLimits = HTTPLimits{
+	ReadHeaderTimeout: 10 * time.Second,
+	ReadTimeout:       30 * time.Second,
+	WriteTimeout:      60 * time.Second,
+	IdleTimeout:       120 * time.Second,
+	MaxHeaderBytes:    1 << 20,
+	MaxInFlight:       100,
+	MaxStreams:        20,
+}
+
+// withDefaults fills zero fields from DefaultHTTPLimits.
+func (l HTTPLimits) withDefaults() HTTPLimits {
+	if l.ReadHeaderTimeout <= 0 {
+		l.ReadHeaderTimeout = DefaultHTTPLimits.ReadHeaderTimeout
+	}
+	if l.ReadTimeout <= 0 {
+		l.ReadTimeout = DefaultHTTPLimits.ReadTimeout
+	}
+	if l.WriteTimeout <= 0 {
+		l.WriteTimeout = DefaultHTTPLimits.WriteTimeout
+	}
+	if l.IdleTimeout <= 0 {
+		l.IdleTimeout = DefaultHTTPLimits.IdleTimeout
+	}
+	if l.MaxHeaderBytes <= 0 {
+		l.MaxHeaderBytes = DefaultHTTPLimits.MaxHeaderBytes
+	}
+	if l.MaxInFlight <= 0 {
+		l.MaxInFlight = DefaultHTTPLimits.MaxInFlight
+	}
+	if l.MaxStreams <= 0 {
+		l.MaxStreams = DefaultHTTPLimits.MaxStreams
+	}
+	return l
+}
+
+// ListLimits bound the page size of list_meetings and search_transcripts
+// and the meetings per export_embeddings call. Default applies when the
+// client gives no limit; larger requests are clamped to Max.
+type ListLimits struct {
+	Default int
+	Max     int
+}
+
+// DefaultListLimits keeps a single call from requesting a huge page.
+var DefaultListLimits = ListLimits{Default: 20, Max: 200}
+
+// withDefaults fills zero fields from DefaultListLimits and keeps Default
+// within Max.
+func (l ListLimits) withDefaults() ListLimits {
+	if l.Max <= 0 {
+		l.Max = DefaultListLimits.Max
+	}
+	if l.Default <= 0 {
+		l.Default = DefaultListLimits.Default
+	}
+	if l.Default > l.Max {
+		l.Default = l.Max
+	}
+	return l
+}
+
+// clamp returns the limit to use for a requested one, and whether the
+// request exceeded Max.
+func (l ListLimits) clamp(requested *int) (int, bool) {
+	if requested == nil || *requested <= 0 {
+		return l.Default, false
+	}
+	if *requested > l.Max {
+		return l.Max, true
+	}
+	return *requested, false
+}
+
+// ToolOverride replaces a tool's default catalog entry.
+type ToolOverride struct {
+	// Description replaces the built-in description when non-empty.
+	Description string
+	// DisableUIResource stops the tool advertising its UI resource.
+	DisableUIResource bool
+}
+
+// ErrToolDisabled is returned when a tool is turned off by configuration.
+var ErrToolDisabled = errors.New("tool disabled by configuration")
+
+// toolNames lists every tool the server knows how to register.
+// Used to validate the disabled tools configuration.
+var toolNames = []string{
+	"list_meetings",
+	"recent_meetings",
+	"count_meetings",
+	"get_meeting",
+	"get_transcript",
+	"search_transcripts",
+	"search_meetings",
+	"get_action_items",
+	"get_action_item",
+	"overdue_action_items",
+	"action_item_digest",
+	"find_conflicts",
+	"meeting_stats",
+	"meeting_timeline",
+	"extract_keywords",
+	"compare_meetings",
+	"list_workspaces",
+	"add_note",
+	"list_notes",
+	"search_notes",
+	"delete_note",
+	"delete_meeting_notes",
+	"complete_action_item",
+	"complete_action_items",
+	"update_action_item",
+	"tag_meeting",
+	"untag_meeting",
+	"export_embeddings",
+}
+
+// ToolNames returns the names of every tool the server knows, for
+// checking configuration that refers to tools.
+func ToolNames() []string {
+	return append([]string(nil), toolNames...)
 }
 
 // Server wraps the mcp-go server and exposes Granola meeting data
@@ -52,21 +245,47 @@ type Server struct {
 	getMeeting        *meetingapp.GetMeeting
 	getTranscript     *meetingapp.GetTranscript
 	searchTranscripts *meetingapp.SearchTranscripts
+	searchMeetings    *meetingapp.SearchMeetings
 	getActionItems    *meetingapp.GetActionItems
 	getMeetingStats   *meetingapp.GetMeetingStats
+	extractKeywords   *meetingapp.ExtractKeywords
+	compareMeetings   *meetingapp.CompareMeetings
 	listWorkspaces    *workspaceapp.ListWorkspaces
 	getWorkspace      *workspaceapp.GetWorkspace
 
+	overdueActionItems *meetingapp.OverdueActionItems
+	actionItemDigest   *meetingapp.ActionItemDigest
+	findConflicts      *meetingapp.FindConflicts
+	meetingTimeline    *meetingapp.MeetingTimeline
+	getActionItem      *meetingapp.GetActionItem
+
 	// Write use cases (Phase 3)
-	addNote            *annotationapp.AddNote
-	listNotes          *annotationapp.ListNotes
-	deleteNote         *annotationapp.DeleteNote
-	completeActionItem *meetingapp.CompleteActionItem
-	updateActionItem   *meetingapp.UpdateActionItem
+	addNote             *annotationapp.AddNote
+	listNotes           *annotationapp.ListNotes
+	searchNotes         *annotationapp.SearchNotes
+	deleteNote          *annotationapp.DeleteNote
+	deleteMeetingNotes  *annotationapp.DeleteNotesByMeeting
+	completeActionItem  *meetingapp.CompleteActionItem
+	completeActionItems *meetingapp.CompleteActionItems
+	updateActionItem    *meetingapp.UpdateActionItem
+	tagMeeting          *meetingapp.TagMeeting
+	untagMeeting        *meetingapp.UntagMeeting
 
 	// Embedding export (Phase 3)
 	exportEmbeddings *embeddingapp.ExportEmbeddings
 
+	recentEvents *events.RecentEvents
+
+	// toolHandler, when set, serves tools/call; see SetToolHandler.
+	toolHandler ToolHandler
+
+	disabledTools map[string]bool
+	toolOverrides map[string]ToolOverride
+	location      *time.Location
+	httpLimits    HTTPLimits
+	limits        ListLimits
+	redactEmails  bool
+
 	name    string
 	version string
 }
@@ -74,22 +293,42 @@ type Server struct {
 // NewServer creates a new MCP server wired to application use cases.
 func NewServer(name, version string, opts ServerOptions) *Server {
 	s := &Server{
-		name:               name,
-		version:            version,
-		listMeetings:       opts.ListMeetings,
-		getMeeting:         opts.GetMeeting,
-		getTranscript:      opts.GetTranscript,
-		searchTranscripts:  opts.SearchTranscripts,
-		getActionItems:     opts.GetActionItems,
-		getMeetingStats:    opts.GetMeetingStats,
-		listWorkspaces:     opts.ListWorkspaces,
-		getWorkspace:       opts.GetWorkspace,
-		addNote:            opts.AddNote,
-		listNotes:          opts.ListNotes,
-		deleteNote:         opts.DeleteNote,
-		completeActionItem: opts.CompleteActionItem,
-		updateActionItem:   opts.UpdateActionItem,
-		exportEmbeddings:   opts.ExportEmbeddings,
+		name:                name,
+		version:             version,
+		listMeetings:        opts.ListMeetings,
+		getMeeting:          opts.GetMeeting,
+		getTranscript:       opts.GetTranscript,
+		searchTranscripts:   opts.SearchTranscripts,
+		searchMeetings:      opts.SearchMeetings,
+		getActionItems:      opts.GetActionItems,
+		overdueActionItems:  opts.OverdueActionItems,
+		actionItemDigest:    opts.ActionItemDigest,
+		findConflicts:       opts.FindConflicts,
+		meetingTimeline:     opts.MeetingTimeline,
+		getActionItem:       opts.GetActionItem,
+		getMeetingStats:     opts.GetMeetingStats,
+		extractKeywords:     opts.ExtractKeywords,
+		compareMeetings:     opts.CompareMeetings,
+		listWorkspaces:      opts.ListWorkspaces,
+		getWorkspace:        opts.GetWorkspace,
+		addNote:             opts.AddNote,
+		listNotes:           opts.ListNotes,
+		searchNotes:         opts.SearchNotes,
+		deleteNote:          opts.DeleteNote,
+		deleteMeetingNotes:  opts.DeleteMeetingNotes,
+		completeActionItem:  opts.CompleteActionItem,
+		completeActionItems: opts.CompleteActionItems,
+		updateActionItem:    opts.UpdateActionItem,
+		tagMeeting:          opts.TagMeeting,
+		untagMeeting:        opts.UntagMeeting,
+		exportEmbeddings:    opts.ExportEmbeddings,
+		recentEvents:        opts.RecentEvents,
+		disabledTools:       buildDisabledTools(opts.DisabledTools),
+		toolOverrides:       buildToolOverrides(opts.ToolOverrides),
+		location:            opts.Location,
+		httpLimits:          opts.HTTP.withDefaults(),
+		limits:              opts.Limits.withDefaults(),
+		redactEmails:        opts.RedactExportEmails,
 	}
 
 	srv := mcpfw.NewServer(mcpfw.ServerInfo{
@@ -99,42 +338,102 @@ func NewServer(name, version string, opts ServerOptions) *Server {
 
 	s.registerTools(srv)
 	s.registerResources(srv)
+	s.registerPrompts(srv)
 
 	s.inner = srv
 	return s
 }
 
+// SetLocation changes the zone timestamps are rendered in; nil means UTC.
+// Call it before the server starts handling requests.
+func (s *Server) SetLocation(loc *time.Location) {
+	s.location = loc
+}
+
+// buildDisabledTools converts the configured list into a lookup set,
+// logging each disabled tool and warning about names the server doesn't know.
+func buildDisabledTools(names []string) map[string]bool {
+	known := make(map[string]bool, len(toolNames))
+	for _, n := range toolNames {
+		known[n] = true
+	}
+
+	disabled := make(map[string]bool, len(names))
+	for _, n := range names {
+		if !known[n] {
+			log.Printf("mcp: warning: unknown tool %q in disabled tools list", n)
+			continue
+		}
+		disabled[n] = true
+		log.Printf("mcp: tool %q disabled by configuration", n)
+	}
+	return disabled
+}
+
+// buildToolOverrides drops overrides for tools the server doesn't know,
+// warning about each so typos don't go unnoticed.
+func buildToolOverrides(overrides map[string]ToolOverride) map[string]ToolOverride {
+	known := make(map[string]bool, len(toolNames))
+	for _, n := range toolNames {
+		known[n] = true
+	}
+
+	result := make(map[string]ToolOverride, len(overrides))
+	for name, o := range overrides {
+		if !known[name] {
+			log.Printf("mcp: warning: unknown tool %q in tool overrides", name)
+			continue
+		}
+		result[name] = o
+	}
+	return result
+}
+
+// toolDescription returns the configured description for the named tool,
+// falling back to def.
+func (s *Server) toolDescription(name, def string) string {
+	if d := s.toolOverrides[name].Description; d != "" {
+		return d
+	}
+	return def
+}
+
+// uiResourceEnabled reports whether the named tool should advertise its UI resource.
+func (s *Server) uiResourceEnabled(name string) bool {
+	return !s.toolOverrides[name].DisableUIResource
+}
+
+// toolEnabled reports whether the named tool may be registered and invoked.
+func (s *Server) toolEnabled(name string) bool {
+	return !s.disabledTools[name]
+}
+
 func (s *Server) Name() string    { return s.name }
 func (s *Server) Version() string { return s.version }
 
 // Inner returns the underlying mcp-go server for transport integration.
+// Serve it with ServeOptions so tool calls go through the tool handler.
 func (s *Server) Inner() *mcpfw.Server { return s.inner }
 
+// ServeOptions returns the options every MCP transport is served with:
+// when SetToolHandler was called, the middleware routing tools/call
+// through that handler, so policy and metrics apply on any transport.
+func (s *Server) ServeOptions() []mcpfw.ServeOption {
+	if s.toolHandler == nil {
+		return nil
+	}
+	return []mcpfw.ServeOption{mcpfw.WithMiddleware(s.ToolCallMiddleware())}
+}
+
 // ServeStdio starts the MCP server on stdio transport.
 func (s *Server) ServeStdio(ctx context.Context) error {
-	return mcpfw.ServeStdio(ctx, s.inner)
+	return mcpfw.ServeStdio(ctx, s.inner, s.ServeOptions()...)
 }
 
 // ServeHTTP starts the MCP server on HTTP+SSE transport.
 // extraRoutes allows mounting additional HTTP handlers (e.g., webhook, health).
 func (s *Server) ServeHTTP(ctx context.Context, addr string, extraRoutes func(mux *http.ServeMux)) error {
-	mux := http.NewServeMux()
-
-	// Health check endpoint
-	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		_, _ = fmt.Fprintf(w, `{"status":"ok","server":"%s","version":"%s"}`, s.name, s.version)
-	})
-
-	if extraRoutes != nil {
-		extraRoutes(mux)
-	}
-
-	srv := &http.Server{
-		Addr:    addr,
-		Handler: mux,
-	}
+	srv := s.HTTPServer(addr, s.HTTPHandler(extraRoutes))
 
 	errCh := make(chan error, 1)
 	go func() {
@@ -154,69 +453,244 @@ func (s *Server) ServeHTTP(ctx context.Context, addr string, extraRoutes func(mu
 	}
 }
 
+// HTTPHandler builds the routes ServeHTTP serves: /health, plus the
+// routes extraRoutes mounts behind the MaxInFlight limit. Routes mounted
+// as a StreamingHandler are held to MaxStreams instead.
+func (s *Server) HTTPHandler(extraRoutes func(mux *http.ServeMux)) http.Handler {
+	mux := http.NewServeMux()
+
+	// Health check endpoint
+	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"status":"ok","server":"%s","version":"%s"}`, s.name, s.version)
+	})
+
+	routes := http.NewServeMux()
+	if extraRoutes != nil {
+		extraRoutes(routes)
+	}
+	requests := limitInFlight(routes, s.httpLimits.MaxInFlight)
+	streams := limitInFlight(routes, s.httpLimits.MaxStreams)
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h, _ := routes.Handler(r); isStreaming(h) {
+			streams.ServeHTTP(w, r)
+			return
+		}
+		requests.ServeHTTP(w, r)
+	}))
+	return mux
+}
+
+// streamingHandler marks a route whose responses stay open, such as
+// server-sent events.
+type streamingHandler struct {
+	http.Handler
+}
+
+// StreamingHandler marks h as serving long-lived responses, so
+// HTTPHandler counts it against MaxStreams rather than MaxInFlight.
+func StreamingHandler(h http.Handler) http.Handler {
+	return streamingHandler{h}
+}
+
+func isStreaming(h http.Handler) bool {
+	_, ok := h.(streamingHandler)
+	return ok
+}
+
+// limitInFlight serves at most max requests at once and answers the rest
+// with 503 and Retry-After rather than queueing them.
+func limitInFlight(next http.Handler, max int) http.Handler {
+	slots := make(chan struct{}, max)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+			next.ServeHTTP(w, r)
+		default:
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "too many concurrent requests", http.StatusServiceUnavailable)
+		}
+	})
+}
+
+// HTTPServer builds the http.Server ServeHTTP runs, applying the
+// configured HTTPLimits.
+func (s *Server) HTTPServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: s.httpLimits.ReadHeaderTimeout,
+		ReadTimeout:       s.httpLimits.ReadTimeout,
+		WriteTimeout:      s.httpLimits.WriteTimeout,
+		IdleTimeout:       s.httpLimits.IdleTimeout,
+		MaxHeaderBytes:    s.httpLimits.MaxHeaderBytes,
+	}
+}
+
 // --- Tool registration ---
 
 func (s *Server) registerTools(srv *mcpfw.Server) {
-	srv.Tool("list_meetings").
-		Description("Search and filter Granola meetings").
-		Handler(s.HandleListMeetings)
+	if s.toolEnabled("list_meetings") {
+		srv.Tool("list_meetings").
+			Description(s.toolDescription("list_meetings", "Search and filter Granola meetings; sort_by is date_desc (default), date_asc, or title")).
+			Handler(s.HandleListMeetings)
+	}
+
+	if s.toolEnabled("recent_meetings") {
+		srv.Tool("recent_meetings").
+			Description(s.toolDescription("recent_meetings", "List meetings from the last `days` days (default 7), newest first, up to limit (default 20)")).
+			Handler(s.HandleRecentMeetings)
+	}
+
+	if s.toolEnabled("count_meetings") {
+		srv.Tool("count_meetings").
+			Description(s.toolDescription("count_meetings", "Count the meetings matching the list_meetings filters (since and until as RFC3339 or YYYY-MM-DD, source, participant, query, tags) without returning them")).
+			Handler(s.HandleCountMeetings)
+	}
+
+	if s.toolEnabled("get_meeting") {
+		srv.Tool("get_meeting").
+			Description(s.toolDescription("get_meeting", "Get full details for a specific meeting; set include_transcript to embed the transcript and fields to return only those top-level keys")).
+			Handler(s.HandleGetMeeting)
+	}
+
+	if s.toolEnabled("get_transcript") {
+		srv.Tool("get_transcript").
+			Description(s.toolDescription("get_transcript", "Get the transcript for a meeting. Use offset and limit to page through long transcripts by utterance; set confidence_histogram for the distribution of confidence scores, and merge_speaker_turns to combine consecutive utterances by the same speaker into segments with start and end times. Utterances are sorted by timestamp unless sort is false")).
+			Handler(s.HandleGetTranscript)
+	}
+
+	if s.toolEnabled("search_transcripts") {
+		srv.Tool("search_transcripts").
+			Description(s.toolDescription("search_transcripts", "Full-text search across all meeting transcripts; set include_snippets to return matching utterances with context")).
+			Handler(s.HandleSearchTranscripts)
+	}
+
+	if s.toolEnabled("search_meetings") {
+		srv.Tool("search_meetings").
+			Description(s.toolDescription("search_meetings", "Relevance-ranked search over meeting titles and transcripts; each result has a match_reason")).
+			Handler(s.HandleSearchMeetings)
+	}
+
+	if s.toolEnabled("get_action_items") {
+		srv.Tool("get_action_items").
+			Description(s.toolDescription("get_action_items", "Get action items from a meeting; filter by owner substring or completed (all, open, done)")).
+			Handler(s.HandleGetActionItems)
+	}
+
+	if s.getActionItem != nil && s.toolEnabled("get_action_item") {
+		srv.Tool("get_action_item").
+			Description(s.toolDescription("get_action_item", "Get one action item of a meeting by its ID, e.g. an ID returned by another tool")).
+			Handler(s.HandleGetActionItem)
+	}
+
+	if s.overdueActionItems != nil && s.toolEnabled("overdue_action_items") {
+		srv.Tool("overdue_action_items").
+			Description(s.toolDescription("overdue_action_items", "List open action items past their due date, grouped by meeting with days_overdue, most overdue first; filter by owner substring and meeting since/until (RFC3339 or YYYY-MM-DD)")).
+			Handler(s.HandleOverdueActionItems)
+	}
 
-	srv.Tool("get_meeting").
-		Description("Get full details for a specific meeting").
-		Handler(s.HandleGetMeeting)
+	if s.actionItemDigest != nil && s.toolEnabled("action_item_digest") {
+		srv.Tool("action_item_digest").
+			Description(s.toolDescription("action_item_digest", "Summarize action items per owner for meetings in since/until (RFC3339 or YYYY-MM-DD): open, overdue, and completed counts plus open items due within upcoming_days (default 7), soonest first; filter by owner substring")).
+			Handler(s.HandleActionItemDigest)
+	}
+
+	if s.findConflicts != nil && s.toolEnabled("find_conflicts") {
+		srv.Tool("find_conflicts").
+			Description(s.toolDescription("find_conflicts", "Find double-booked meetings: pairs whose time ranges overlap, with overlap_seconds. End times come from the transcript span, or assumed_duration_minutes (default 30) without one; filter by since/until (RFC3339 or YYYY-MM-DD)")).
+			Handler(s.HandleFindConflicts)
+	}
 
-	srv.Tool("get_transcript").
-		Description("Get the transcript for a meeting").
-		Handler(s.HandleGetTranscript)
+	if s.meetingTimeline != nil && s.toolEnabled("meeting_timeline") {
+		srv.Tool("meeting_timeline").
+			Description(s.toolDescription("meeting_timeline", "Count meetings per period for a quick trend view: [{period, count}] oldest first, period being the first day (weeks start Monday); granularity day, week (default), or month; filter by since/until (RFC3339 or YYYY-MM-DD)")).
+			Handler(s.HandleMeetingTimeline)
+	}
 
-	srv.Tool("search_transcripts").
-		Description("Full-text search across all meeting transcripts").
-		Handler(s.HandleSearchTranscripts)
+	if s.toolEnabled("meeting_stats") {
+		tool := srv.Tool("meeting_stats").
+			Description(s.toolDescription("meeting_stats", "Get aggregated meeting statistics with visual dashboard. Set group_by to workspace for per-workspace rollups"))
+		if s.uiResourceEnabled("meeting_stats") {
+			tool.UIResource("ui://meeting-stats")
+		}
+		tool.Handler(s.HandleMeetingStats)
+	}
 
-	srv.Tool("get_action_items").
-		Description("Get action items from a meeting").
-		Handler(s.HandleGetActionItems)
+	if s.extractKeywords != nil && s.toolEnabled("extract_keywords") {
+		srv.Tool("extract_keywords").
+			Description(s.toolDescription("extract_keywords", "Most frequent transcript terms for a meeting, stopwords removed, as a quick topic overview")).
+			Handler(s.HandleExtractKeywords)
+	}
 
-	srv.Tool("meeting_stats").
-		Description("Get aggregated meeting statistics with visual dashboard").
-		UIResource("ui://meeting-stats").
-		Handler(s.HandleMeetingStats)
+	if s.compareMeetings != nil && s.toolEnabled("compare_meetings") {
+		srv.Tool("compare_meetings").
+			Description(s.toolDescription("compare_meetings", "Compare two meetings, e.g. instances of a recurring meeting: participant changes, action item counts, summary presence, and durations")).
+			Handler(s.HandleCompareMeetings)
+	}
 
-	if s.listWorkspaces != nil {
+	if s.listWorkspaces != nil && s.toolEnabled("list_workspaces") {
 		srv.Tool("list_workspaces").
-			Description("List all Granola workspaces").
+			Description(s.toolDescription("list_workspaces", "List Granola workspaces, optionally paginated with limit and offset")).
 			Handler(s.HandleListWorkspaces)
 	}
 
 	// Write tools (Phase 3)
-	if s.addNote != nil {
+	if s.addNote != nil && s.toolEnabled("add_note") {
 		srv.Tool("add_note").
-			Description("Add an agent note to a meeting").
+			Description(s.toolDescription("add_note", "Add an agent note to a meeting, optionally tagged; pass idempotency_key so a retried call returns the note it already created")).
 			Handler(s.HandleAddNote)
 	}
-	if s.listNotes != nil {
+	if s.listNotes != nil && s.toolEnabled("list_notes") {
 		srv.Tool("list_notes").
-			Description("List agent notes for a meeting").
+			Description(s.toolDescription("list_notes", "List agent notes for a meeting, optionally filtered to notes carrying all given tags")).
 			Handler(s.HandleListNotes)
 	}
-	if s.deleteNote != nil {
+	if s.searchNotes != nil && s.toolEnabled("search_notes") {
+		srv.Tool("search_notes").
+			Description(s.toolDescription("search_notes", "Full-text search across agent notes from all meetings")).
+			Handler(s.HandleSearchNotes)
+	}
+	if s.deleteNote != nil && s.toolEnabled("delete_note") {
 		srv.Tool("delete_note").
-			Description("Delete an agent note").
+			Description(s.toolDescription("delete_note", "Delete an agent note")).
 			Handler(s.HandleDeleteNote)
 	}
-	if s.completeActionItem != nil {
+	if s.deleteMeetingNotes != nil && s.toolEnabled("delete_meeting_notes") {
+		srv.Tool("delete_meeting_notes").
+			Description(s.toolDescription("delete_meeting_notes", "Delete all agent notes for a meeting and return how many were removed")).
+			Handler(s.HandleDeleteMeetingNotes)
+	}
+	if s.completeActionItem != nil && s.toolEnabled("complete_action_item") {
 		srv.Tool("complete_action_item").
-			Description("Mark an action item as completed").
+			Description(s.toolDescription("complete_action_item", "Mark an action item as completed")).
 			Handler(s.HandleCompleteActionItem)
 	}
-	if s.updateActionItem != nil {
+	if s.completeActionItems != nil && s.toolEnabled("complete_action_items") {
+		srv.Tool("complete_action_items").
+			Description(s.toolDescription("complete_action_items", "Mark several action items of a meeting as completed; failures are reported per ID")).
+			Handler(s.HandleCompleteActionItems)
+	}
+	if s.updateActionItem != nil && s.toolEnabled("update_action_item") {
 		srv.Tool("update_action_item").
-			Description("Update an action item's text").
+			Description(s.toolDescription("update_action_item", "Update an action item's text")).
 			Handler(s.HandleUpdateActionItem)
 	}
-	if s.exportEmbeddings != nil {
+	if s.tagMeeting != nil && s.toolEnabled("tag_meeting") {
+		srv.Tool("tag_meeting").
+			Description(s.toolDescription("tag_meeting", "Add a local tag (e.g. \"1:1\", \"planning\") to a meeting and return its tags")).
+			Handler(s.HandleTagMeeting)
+	}
+	if s.untagMeeting != nil && s.toolEnabled("untag_meeting") {
+		srv.Tool("untag_meeting").
+			Description(s.toolDescription("untag_meeting", "Remove a local tag from a meeting and return its remaining tags")).
+			Handler(s.HandleUntagMeeting)
+	}
+	if s.exportEmbeddings != nil && s.toolEnabled("export_embeddings") {
 		srv.Tool("export_embeddings").
-			Description("Export meeting content as chunks for embedding generation (JSONL format)").
+			Description(s.toolDescription("export_embeddings", "Export meeting content as chunks for embedding generation (JSONL format)")).
 			Handler(s.HandleExportEmbeddings)
 	}
 }
@@ -236,7 +710,7 @@ func (s *Server) registerResources(srv *mcpfw.Server) {
 			if err != nil {
 				return nil, err
 			}
-			result := toMeetingDetailResult(out.Meeting)
+			result := s.toMeetingDetailResult(out.Meeting)
 			data, _ := json.Marshal(result)
 			return &mcpfw.ResourceContent{
 				URI:      uri,
@@ -269,7 +743,7 @@ func (s *Server) registerResources(srv *mcpfw.Server) {
 			if err != nil {
 				return nil, err
 			}
-			result := toTranscriptResult(out.Transcript)
+			result := s.toTranscriptPageResult(out)
 			data, _ := json.Marshal(result)
 			return &mcpfw.ResourceContent{
 				URI:      uri,
@@ -293,7 +767,27 @@ func (s *Server) registerResources(srv *mcpfw.Server) {
 				}
 				results := make([]NoteResult, len(out.Notes))
 				for i, n := range out.Notes {
-					results[i] = toNoteResult(n)
+					results[i] = s.toNoteResult(n)
+				}
+				data, _ := json.Marshal(results)
+				return &mcpfw.ResourceContent{
+					URI:      uri,
+					MimeType: "application/json",
+					Text:     string(data),
+				}, nil
+			})
+	}
+
+	if s.recentEvents != nil {
+		srv.Resource("events://recent").
+			Name("Recent Events").
+			Description("Most recently dispatched domain events, oldest first").
+			MimeType("application/json").
+			Handler(func(_ context.Context, uri string, _ map[string]string) (*mcpfw.ResourceContent, error) {
+				recent := s.recentEvents.Recent()
+				results := make([]EventResult, len(recent))
+				for i, e := range recent {
+					results[i] = s.toEventResult(e)
 				}
 				data, _ := json.Marshal(results)
 				return &mcpfw.ResourceContent{
@@ -328,6 +822,94 @@ func (s *Server) registerResources(srv *mcpfw.Server) {
 	}
 }
 
+// --- Prompt registration ---
+
+func (s *Server) registerPrompts(srv *mcpfw.Server) {
+	if s.getMeeting != nil && s.getTranscript != nil {
+		srv.Prompt("summarize_meeting").
+			Description("Summarize a meeting from its details and transcript: key points, decisions, action items, and open questions").
+			Argument("meeting_id", "The meeting to summarize", true).
+			Handler(func(ctx context.Context, args map[string]string) (*mcpfw.PromptResult, error) {
+				return s.HandleSummarizeMeetingPrompt(ctx, args["meeting_id"])
+			})
+	}
+}
+
+// summarizeInstructions is the first message of summarize_meeting. MCP
+// prompts have no system role, so the instructions are a user message
+// ahead of the meeting content.
+const summarizeInstructions = `You are summarizing a meeting for someone who did not attend.
+Using only the meeting content that follows, write:
+
+1. A two or three sentence overview.
+2. Key discussion points, as bullets.
+3. Decisions made, each with who made it when the transcript says.
+4. Action items with their owners, including any the transcript mentions that are not yet listed.
+5. Open questions or unresolved topics.
+
+Be concise and do not invent details the content does not support.`
+
+// HandleSummarizeMeetingPrompt renders the summarize_meeting prompt. A
+// meeting without a transcript yet is summarized from its details alone.
+func (s *Server) HandleSummarizeMeetingPrompt(ctx context.Context, meetingID string) (*mcpfw.PromptResult, error) {
+	out, err := s.getMeeting.Execute(ctx, meetingapp.GetMeetingInput{ID: domain.MeetingID(meetingID)})
+	if err != nil {
+		return nil, err
+	}
+	m := out.Meeting
+
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "# %s\n\n", m.Title())
+	_, _ = fmt.Fprintf(&b, "Date: %s\nSource: %s\n", s.formatTime(m.Datetime()), m.Source())
+	if len(m.Participants()) > 0 {
+		b.WriteString("\n## Participants\n\n")
+		for _, p := range m.Participants() {
+			if p.Email() != "" {
+				_, _ = fmt.Fprintf(&b, "- %s <%s> (%s)\n", p.Name(), p.Email(), p.Role())
+			} else {
+				_, _ = fmt.Fprintf(&b, "- %s (%s)\n", p.Name(), p.Role())
+			}
+		}
+	}
+	if sum := m.Summary(); sum != nil {
+		_, _ = fmt.Fprintf(&b, "\n## Existing summary\n\n%s\n", sum.Content())
+	}
+	if items := m.ActionItems(); len(items) > 0 {
+		b.WriteString("\n## Action items\n\n")
+		for _, item := range items {
+			status := "[ ]"
+			if item.IsCompleted() {
+				status = "[x]"
+			}
+			_, _ = fmt.Fprintf(&b, "- %s %s (owner: %s)\n", status, item.Text(), item.Owner())
+		}
+	}
+
+	b.WriteString("\n## Transcript\n\n")
+	transcript, err := s.getTranscript.Execute(ctx, meetingapp.GetTranscriptInput{MeetingID: m.ID()})
+	switch {
+	case errors.Is(err, domain.ErrTranscriptNotReady):
+		b.WriteString("(No transcript is available for this meeting yet.)\n")
+	case err != nil:
+		return nil, err
+	default:
+		for _, u := range transcript.Transcript.Utterances() {
+			_, _ = fmt.Fprintf(&b, "%s: %s\n", u.Speaker(), u.Text())
+		}
+		if transcript.Truncated {
+			_, _ = fmt.Fprintf(&b, "\n(Transcript truncated; %d later utterances omitted.)\n", transcript.Omitted)
+		}
+	}
+
+	return &mcpfw.PromptResult{
+		Description: "Summarize " + m.Title(),
+		Messages: []mcpfw.PromptMessage{
+			{Role: "user", Content: mcpfw.TextContent{Type: "text", Text: summarizeInstructions}},
+			{Role: "user", Content: mcpfw.TextContent{Type: "text", Text: b.String()}},
+		},
+	}, nil
+}
+
 // --- Tool Input Types ---
 
 type ListMeetingsToolInput struct {
@@ -338,14 +920,48 @@ type ListMeetingsToolInput struct {
 	Query       *string `json:"query,omitempty"`
 	Limit       *int    `json:"limit,omitempty"`
 	Offset      *int    `json:"offset,omitempty"`
+	SortBy      *string `json:"sort_by,omitempty"`
+	// Tags keeps only meetings carrying every given local tag.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// CountMeetingsToolInput takes the filters of ListMeetingsToolInput;
+// paging and sorting do not apply to a count.
+type CountMeetingsToolInput struct {
+	Since       *string  `json:"since,omitempty"`
+	Until       *string  `json:"until,omitempty"`
+	Source      *string  `json:"source,omitempty"`
+	Participant *string  `json:"participant,omitempty"`
+	Query       *string  `json:"query,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type RecentMeetingsToolInput struct {
+	// Days reaches back from now; default 7.
+	Days  *int `json:"days,omitempty"`
+	Limit *int `json:"limit,omitempty"`
 }
 
 type GetMeetingToolInput struct {
-	ID string `json:"id"`
+	ID                string `json:"id"`
+	IncludeTranscript bool   `json:"include_transcript,omitempty"`
+	// Fields limits the result to these top-level keys; empty returns all.
+	Fields []string `json:"fields,omitempty"`
 }
 
 type GetTranscriptToolInput struct {
 	MeetingID string `json:"meeting_id"`
+	// Offset and Limit page through utterances; omit Limit for the full transcript.
+	Offset *int `json:"offset,omitempty"`
+	Limit  *int `json:"limit,omitempty"`
+	// ConfidenceHistogram adds confidence_histogram to the result.
+	ConfidenceHistogram bool `json:"confidence_histogram,omitempty"`
+	// MergeSpeakerTurns returns the page as speaker segments instead of
+	// utterances.
+	MergeSpeakerTurns bool `json:"merge_speaker_turns,omitempty"`
+	// Sort orders utterances by timestamp (default true); false keeps the
+	// order Granola returned them in.
+	Sort *bool `json:"sort,omitempty"`
 }
 
 type SearchTranscriptsToolInput struct {
@@ -353,18 +969,82 @@ type SearchTranscriptsToolInput struct {
 	Since *string `json:"since,omitempty"`
 	Until *string `json:"until,omitempty"`
 	Limit *int    `json:"limit,omitempty"`
+	// IncludeSnippets returns the matching utterances of each meeting,
+	// with ContextWindow utterances (default 1) on either side.
+	IncludeSnippets bool `json:"include_snippets,omitempty"`
+	ContextWindow   *int `json:"context_window,omitempty"`
+}
+
+type SearchMeetingsToolInput struct {
+	Query string  `json:"query"`
+	Since *string `json:"since,omitempty"`
+	Until *string `json:"until,omitempty"`
+	Limit *int    `json:"limit,omitempty"`
 }
 
 type GetActionItemsToolInput struct {
-	MeetingID string `json:"meeting_id"`
+	MeetingID string  `json:"meeting_id"`
+	Owner     *string `json:"owner,omitempty"`
+	Completed *string `json:"completed,omitempty"`
 }
 
-type MeetingStatsToolInput struct {
+type GetActionItemToolInput struct {
+	MeetingID    string `json:"meeting_id"`
+	ActionItemID string `json:"action_item_id"`
+}
+
+type OverdueActionItemsToolInput struct {
+	Owner *string `json:"owner,omitempty"`
+	Since *string `json:"since,omitempty"`
+	Until *string `json:"until,omitempty"`
+}
+
+type ActionItemDigestToolInput struct {
+	Owner *string `json:"owner,omitempty"`
+	Since *string `json:"since,omitempty"`
+	Until *string `json:"until,omitempty"`
+	// UpcomingDays is how many days ahead a due date counts as upcoming;
+	// defaults to 7.
+	UpcomingDays *int `json:"upcoming_days,omitempty"`
+}
+
+type FindConflictsToolInput struct {
 	Since *string `json:"since,omitempty"`
 	Until *string `json:"until,omitempty"`
+	// AssumedDurationMinutes is the length of meetings without a
+	// transcript; defaults to 30.
+	AssumedDurationMinutes *int `json:"assumed_duration_minutes,omitempty"`
+}
+
+type MeetingTimelineToolInput struct {
+	Since       *string `json:"since,omitempty"`
+	Until       *string `json:"until,omitempty"`
+	Granularity string  `json:"granularity,omitempty"`
+}
+
+type ExtractKeywordsToolInput struct {
+	MeetingID string `json:"meeting_id"`
+	Limit     *int   `json:"limit,omitempty"`
+	MinLength *int   `json:"min_length,omitempty"`
+	// Language picks the stopword pack: en (default), de, or fr.
+	Language string `json:"language,omitempty"`
+}
+
+type CompareMeetingsToolInput struct {
+	IDA string `json:"id_a"`
+	IDB string `json:"id_b"`
+}
+
+type MeetingStatsToolInput struct {
+	Since   *string `json:"since,omitempty"`
+	Until   *string `json:"until,omitempty"`
+	GroupBy *string `json:"group_by,omitempty"`
 }
 
 type ListWorkspacesToolInput struct {
+	// Limit and Offset page through the workspaces; no limit returns all.
+	Limit  *int `json:"limit,omitempty"`
+	Offset *int `json:"offset,omitempty"`
 }
 
 // --- Tool Output Types ---
@@ -375,6 +1055,55 @@ type MeetingResult struct {
 	Datetime     string              `json:"datetime"`
 	Source       string              `json:"source"`
 	Participants []ParticipantResult `json:"participants"`
+	// Tags are the meeting's local tags, set by list_meetings and
+	// get_meeting.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ListMeetingsResult is the list_meetings response. Partial is set when
+// the request deadline cut the fetch short and Meetings is incomplete.
+type ListMeetingsResult struct {
+	Meetings []MeetingResult `json:"meetings"`
+	Partial  bool            `json:"partial,omitempty"`
+	// LimitClamped reports that the requested limit exceeded the maximum
+	// and at most Limit meetings were returned.
+	LimitClamped bool `json:"limit_clamped,omitempty"`
+	Limit        int  `json:"limit,omitempty"`
+}
+
+type CountMeetingsResult struct {
+	Count   int  `json:"count"`
+	Partial bool `json:"partial,omitempty"`
+}
+
+// SearchMeetingResult is a meeting matched by search_meetings.
+// MatchReason is "title", "transcript", or "both".
+type SearchMeetingResult struct {
+	MeetingResult
+	MatchReason string `json:"match_reason"`
+}
+
+// SearchTranscriptsResult is the search_transcripts response.
+type SearchTranscriptsResult struct {
+	Meetings []TranscriptSearchResult `json:"meetings"`
+	// LimitClamped reports that the requested limit exceeded the maximum
+	// and at most Limit meetings were returned.
+	LimitClamped bool `json:"limit_clamped,omitempty"`
+	Limit        int  `json:"limit,omitempty"`
+}
+
+// TranscriptSearchResult is a meeting matched by search_transcripts.
+// Snippets is only populated when include_snippets is set.
+type TranscriptSearchResult struct {
+	MeetingResult
+	Snippets []SnippetResult `json:"snippets,omitempty"`
+}
+
+// SnippetResult is a matching utterance with surrounding context;
+// Utterances[MatchIndex] is the match.
+type SnippetResult struct {
+	Utterances []UtteranceResult `json:"utterances"`
+	MatchIndex int               `json:"match_index"`
 }
 
 type ParticipantResult struct {
@@ -387,6 +1116,11 @@ type MeetingDetailResult struct {
 	MeetingResult
 	Summary     *SummaryResult     `json:"summary,omitempty"`
 	ActionItems []ActionItemResult `json:"action_items,omitempty"`
+	Transcript  *TranscriptResult  `json:"transcript,omitempty"`
+	// Warnings reports field mask names that were ignored.
+	Warnings []string `json:"warnings,omitempty"`
+
+	fields []string
 }
 
 type SummaryResult struct {
@@ -395,8 +1129,25 @@ type SummaryResult struct {
 }
 
 type TranscriptResult struct {
-	MeetingID  string            `json:"meeting_id"`
-	Utterances []UtteranceResult `json:"utterances"`
+	MeetingID       string            `json:"meeting_id"`
+	Utterances      []UtteranceResult `json:"utterances"`
+	TotalUtterances int               `json:"total_utterances"`
+	HasMore         bool              `json:"has_more"`
+	Truncated       bool              `json:"truncated,omitempty"`
+	Note            string            `json:"note,omitempty"`
+	// ConfidenceHistogram covers the whole transcript when requested.
+	ConfidenceHistogram *meetingapp.ConfidenceHistogram `json:"confidence_histogram,omitempty"`
+	// Segments replace Utterances when speaker turns are merged.
+	Segments []SpeakerSegmentResult `json:"segments,omitempty"`
+}
+
+// SpeakerSegmentResult is a run of consecutive utterances by one speaker.
+type SpeakerSegmentResult struct {
+	Speaker    string `json:"speaker"`
+	Text       string `json:"text"`
+	Start      string `json:"start,omitempty"`
+	End        string `json:"end,omitempty"`
+	Utterances int    `json:"utterances"`
 }
 
 type UtteranceResult struct {
@@ -404,6 +1155,8 @@ type UtteranceResult struct {
 	Text       string  `json:"text"`
 	Timestamp  string  `json:"timestamp"`
 	Confidence float64 `json:"confidence"`
+	// TimestampEstimated marks a timestamp filled in because Granola sent none.
+	TimestampEstimated bool `json:"timestamp_estimated,omitempty"`
 }
 
 type ActionItemResult struct {
@@ -412,6 +1165,138 @@ type ActionItemResult struct {
 	Text      string  `json:"text"`
 	DueDate   *string `json:"due_date,omitempty"`
 	Completed bool    `json:"completed"`
+	// ModifiedAt is when a local override last changed the item.
+	ModifiedAt *string `json:"modified_at,omitempty"`
+}
+
+// OverdueActionItemsResult lists the meetings with overdue action items,
+// ordered by their most overdue item.
+type OverdueActionItemsResult struct {
+	Meetings []OverdueMeetingResult `json:"meetings"`
+	// Errors holds the error message for each meeting whose action items
+	// could not be read.
+	Errors map[string]string `json:"errors,omitempty"`
+
+	// ScanLimited reports that the meeting scan cap was hit; Note suggests
+	// narrowing the date range.
+	ScanLimited bool   `json:"scan_limited,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// OverdueMeetingResult is a meeting with overdue action items, most
+// overdue first.
+type OverdueMeetingResult struct {
+	MeetingID string                    `json:"meeting_id"`
+	Title     string                    `json:"title"`
+	Datetime  string                    `json:"datetime"`
+	Items     []OverdueActionItemResult `json:"items"`
+}
+
+type OverdueActionItemResult struct {
+	ActionItemResult
+	DaysOverdue int `json:"days_overdue"`
+}
+
+// ActionItemDigestResult is a per-owner rollup of action items; owners
+// are sorted by name with unassigned items (an empty owner) last.
+type ActionItemDigestResult struct {
+	Owners []OwnerDigestResult `json:"owners"`
+	// Errors holds the error message for each meeting whose action items
+	// could not be read.
+	Errors map[string]string `json:"errors,omitempty"`
+
+	// ScanLimited reports that the meeting scan cap was hit; Note suggests
+	// narrowing the date range.
+	ScanLimited bool   `json:"scan_limited,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+type OwnerDigestResult struct {
+	Owner     string             `json:"owner"`
+	Open      int                `json:"open"`
+	Overdue   int                `json:"overdue"`
+	Completed int                `json:"completed"`
+	Upcoming  []DigestItemResult `json:"upcoming"`
+}
+
+type DigestItemResult struct {
+	ActionItemResult
+	MeetingID    string `json:"meeting_id"`
+	MeetingTitle string `json:"meeting_title"`
+}
+
+// FindConflictsResult lists overlapping meeting pairs by start time.
+type FindConflictsResult struct {
+	Conflicts []MeetingConflictResult `json:"conflicts"`
+
+	// ScanLimited reports that the meeting scan cap was hit; Note suggests
+	// narrowing the date range.
+	ScanLimited bool   `json:"scan_limited,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// MeetingConflictResult is a pair of overlapping meetings; meeting_a
+// starts no later than meeting_b.
+type MeetingConflictResult struct {
+	MeetingA       ConflictMeetingResult `json:"meeting_a"`
+	MeetingB       ConflictMeetingResult `json:"meeting_b"`
+	OverlapSeconds float64               `json:"overlap_seconds"`
+}
+
+type ConflictMeetingResult struct {
+	MeetingID string `json:"meeting_id"`
+	Title     string `json:"title"`
+	Datetime  string `json:"datetime"`
+}
+
+// MeetingTimelineResult holds the meeting counts per period, oldest first.
+type MeetingTimelineResult struct {
+	Periods []TimelinePeriodResult `json:"periods"`
+
+	// ScanLimited reports that the meeting scan cap was hit; Note suggests
+	// narrowing the date range.
+	ScanLimited bool   `json:"scan_limited,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+type TimelinePeriodResult struct {
+	Period string `json:"period"`
+	Count  int    `json:"count"`
+}
+
+type KeywordResult struct {
+	Term  string `json:"term"`
+	Count int    `json:"count"`
+}
+
+// ExtractKeywordsResult holds the top terms. Warning is set when the
+// language had no stopword pack.
+type ExtractKeywordsResult struct {
+	Keywords []KeywordResult `json:"keywords"`
+	Warning  string          `json:"warning,omitempty"`
+}
+
+// MeetingComparisonResult contrasts meeting B against meeting A.
+// Deltas are B minus A.
+type MeetingComparisonResult struct {
+	A                    MeetingComparisonSide `json:"a"`
+	B                    MeetingComparisonSide `json:"b"`
+	ParticipantsAdded    []ParticipantResult   `json:"participants_added"`
+	ParticipantsRemoved  []ParticipantResult   `json:"participants_removed"`
+	ActionItemDelta      int                   `json:"action_item_delta"`
+	DurationDeltaSeconds float64               `json:"duration_delta_seconds"`
+}
+
+// MeetingComparisonSide describes one meeting of a comparison. Duration
+// spans the transcript and is zero without one.
+type MeetingComparisonSide struct {
+	ID              string  `json:"id"`
+	Title           string  `json:"title"`
+	Datetime        string  `json:"datetime"`
+	ActionItems     int     `json:"action_items"`
+	OpenActionItems int     `json:"open_action_items"`
+	HasSummary      bool    `json:"has_summary"`
+	DurationSeconds float64 `json:"duration_seconds"`
 }
 
 type MeetingStatsResult struct {
@@ -425,6 +1310,13 @@ type MeetingStatsResult struct {
 	DayOfWeekHeatmap     []meetingapp.HeatmapEntry           `json:"day_of_week_heatmap"`
 	SpeakerTalkTime      []meetingapp.SpeakerEntry           `json:"speaker_talk_time"`
 	SummaryCoverage      meetingapp.SummaryCoverageStats     `json:"summary_coverage"`
+	PeakInsights         *meetingapp.PeakInsights            `json:"peak_insights,omitempty"`
+	ByWorkspace          map[string]*MeetingStatsResult      `json:"by_workspace,omitempty"`
+
+	// ScanLimited reports that the meeting scan cap was hit; Note suggests
+	// narrowing the date range.
+	ScanLimited bool   `json:"scan_limited,omitempty"`
+	Note        string `json:"note,omitempty"`
 }
 
 type WorkspaceResult struct {
@@ -433,141 +1325,584 @@ type WorkspaceResult struct {
 	Slug string `json:"slug"`
 }
 
+// ListWorkspacesResult is a page of workspaces. Total counts all of them.
+type ListWorkspacesResult struct {
+	Workspaces []WorkspaceResult `json:"workspaces"`
+	Total      int               `json:"total"`
+}
+
 // --- Tool Handlers ---
 
-func (s *Server) HandleListMeetings(ctx context.Context, input ListMeetingsToolInput) ([]MeetingResult, error) {
+func (s *Server) HandleListMeetings(ctx context.Context, input ListMeetingsToolInput) (*ListMeetingsResult, error) {
 	appInput := meetingapp.ListMeetingsInput{
 		Source:      input.Source,
 		Participant: input.Participant,
 		Query:       input.Query,
+		Tags:        input.Tags,
 	}
 
 	if input.Since != nil {
-		t, err := time.Parse(time.RFC3339, *input.Since)
+		t, err := s.parseDate(*input.Since)
 		if err != nil {
 			return nil, fmt.Errorf("invalid 'since' date: %w", err)
 		}
 		appInput.Since = &t
 	}
 	if input.Until != nil {
-		t, err := time.Parse(time.RFC3339, *input.Until)
+		t, err := s.parseDate(*input.Until)
 		if err != nil {
 			return nil, fmt.Errorf("invalid 'until' date: %w", err)
 		}
 		appInput.Until = &t
 	}
-	if input.Limit != nil {
-		appInput.Limit = *input.Limit
-	} else {
-		appInput.Limit = 20
-	}
+	limit, clamped := s.limits.clamp(input.Limit)
+	appInput.Limit = limit
 	if input.Offset != nil {
 		appInput.Offset = *input.Offset
 	}
+	if input.SortBy != nil {
+		appInput.SortBy = meetingapp.SortOrder(*input.SortBy)
+	}
 
 	out, err := s.listMeetings.Execute(ctx, appInput)
 	if err != nil {
 		return nil, err
 	}
 
-	results := make([]MeetingResult, len(out.Meetings))
+	result := &ListMeetingsResult{
+		Meetings: make([]MeetingResult, len(out.Meetings)),
+		Partial:  out.Partial,
+	}
+	if clamped {
+		result.LimitClamped = true
+		result.Limit = limit
+	}
 	for i, m := range out.Meetings {
-		results[i] = toMeetingResult(m)
+		result.Meetings[i] = s.toMeetingResult(m)
+		result.Meetings[i].Tags = out.Tags[m.ID()]
 	}
-	return results, nil
+	return result, nil
+}
+
+func (s *Server) HandleCountMeetings(ctx context.Context, input CountMeetingsToolInput) (*CountMeetingsResult, error) {
+	appInput := meetingapp.ListMeetingsInput{
+		Source:      input.Source,
+		Participant: input.Participant,
+		Query:       input.Query,
+		Tags:        input.Tags,
+		CountOnly:   true,
+	}
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+	if input.Until != nil {
+		t, err := s.parseDate(*input.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'until' date: %w", err)
+		}
+		appInput.Until = &t
+	}
+
+	out, err := s.listMeetings.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+	return &CountMeetingsResult{Count: out.Total, Partial: out.Partial}, nil
+}
+
+// Defaults of recent_meetings.
+const (
+	defaultRecentDays  = 7
+	defaultRecentLimit = 20
+)
+
+// HandleRecentMeetings lists meetings since now minus the given days, so
+// clients need no date arithmetic of their own.
+func (s *Server) HandleRecentMeetings(ctx context.Context, input RecentMeetingsToolInput) (*ListMeetingsResult, error) {
+	days, limit := defaultRecentDays, defaultRecentLimit
+	if input.Days != nil {
+		days = *input.Days
+	}
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+	if days <= 0 {
+		return nil, fmt.Errorf("%w: days must be positive, got %d", ErrInvalidInput, days)
+	}
+
+	since := time.Now().UTC().Add(-time.Duration(days) * 24 * time.Hour).Format(time.RFC3339)
+	return s.HandleListMeetings(ctx, ListMeetingsToolInput{Since: &since, Limit: &limit})
 }
 
 func (s *Server) HandleGetMeeting(ctx context.Context, input GetMeetingToolInput) (*MeetingDetailResult, error) {
 	out, err := s.getMeeting.Execute(ctx, meetingapp.GetMeetingInput{
-		ID: domain.MeetingID(input.ID),
+		ID:                domain.MeetingID(input.ID),
+		IncludeTranscript: input.IncludeTranscript,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	result := toMeetingDetailResult(out.Meeting)
+	result := s.toMeetingDetailResult(out.Meeting)
+	result.Tags = out.Tags
+	if out.Transcript != nil {
+		transcript := s.toTranscriptResult(out.Transcript)
+		result.Transcript = &transcript
+	}
+	result.applyFieldMask(input.Fields)
 	return &result, nil
 }
 
 func (s *Server) HandleGetTranscript(ctx context.Context, input GetTranscriptToolInput) (*TranscriptResult, error) {
-	out, err := s.getTranscript.Execute(ctx, meetingapp.GetTranscriptInput{
-		MeetingID: domain.MeetingID(input.MeetingID),
-	})
+	appInput := meetingapp.GetTranscriptInput{
+		MeetingID:           domain.MeetingID(input.MeetingID),
+		ConfidenceHistogram: input.ConfidenceHistogram,
+		KeepSourceOrder:     input.Sort != nil && !*input.Sort,
+	}
+	if input.Offset != nil {
+		appInput.Offset = *input.Offset
+	}
+	if input.Limit != nil {
+		appInput.Limit = *input.Limit
+	}
+
+	out, err := s.getTranscript.Execute(ctx, appInput)
 	if err != nil {
 		return nil, err
 	}
 
-	result := toTranscriptResult(out.Transcript)
+	result := s.toTranscriptPageResult(out)
+	if input.MergeSpeakerTurns {
+		result.Segments = s.toSpeakerSegmentResults(out.Transcript.SpeakerSegments())
+		result.Utterances = []UtteranceResult{}
+	}
 	return &result, nil
 }
 
-func (s *Server) HandleSearchTranscripts(ctx context.Context, input SearchTranscriptsToolInput) ([]MeetingResult, error) {
+// toTranscriptPageResult adds the pagination and truncation metadata of a
+// GetTranscript call to the transcript result.
+func (s *Server) toTranscriptPageResult(out *meetingapp.GetTranscriptOutput) TranscriptResult {
+	result := s.toTranscriptResult(out.Transcript)
+	result.TotalUtterances = out.TotalUtterances
+	result.HasMore = out.HasMore
+	if out.Truncated {
+		result.Truncated = true
+		result.Note = fmt.Sprintf("response capped at %d utterances; %d omitted, page through them with offset and limit",
+			len(out.Transcript.Utterances()), out.Omitted)
+	}
+	if h := out.ConfidenceHistogram; h != nil {
+		result.ConfidenceHistogram = h
+		if !h.HasScores {
+			result.Note = strings.TrimPrefix(result.Note+"; no confidence scores in this transcript", "; ")
+		}
+	}
+	return result
+}
+
+func (s *Server) HandleSearchTranscripts(ctx context.Context, input SearchTranscriptsToolInput) (*SearchTranscriptsResult, error) {
 	appInput := meetingapp.SearchTranscriptsInput{
+		Query:           input.Query,
+		IncludeSnippets: input.IncludeSnippets,
+		ContextWindow:   1,
+	}
+	limit, clamped := s.limits.clamp(input.Limit)
+	appInput.Limit = limit
+	if input.ContextWindow != nil {
+		appInput.ContextWindow = *input.ContextWindow
+	}
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+
+	out, err := s.searchTranscripts.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchTranscriptsResult{Meetings: make([]TranscriptSearchResult, len(out.Meetings))}
+	if clamped {
+		result.LimitClamped = true
+		result.Limit = limit
+	}
+	for i, m := range out.Meetings {
+		result.Meetings[i] = TranscriptSearchResult{MeetingResult: s.toMeetingResult(m)}
+		for _, snip := range out.Snippets[m.ID()] {
+			result.Meetings[i].Snippets = append(result.Meetings[i].Snippets, s.toSnippetResult(snip))
+		}
+	}
+	return result, nil
+}
+
+func (s *Server) toSnippetResult(snip meetingapp.TranscriptSnippet) SnippetResult {
+	utterances := make([]UtteranceResult, len(snip.Utterances))
+	for i, u := range snip.Utterances {
+		utterances[i] = s.toUtteranceResult(u)
+	}
+	return SnippetResult{Utterances: utterances, MatchIndex: snip.MatchIndex}
+}
+
+func (s *Server) HandleSearchMeetings(ctx context.Context, input SearchMeetingsToolInput) ([]SearchMeetingResult, error) {
+	appInput := meetingapp.SearchMeetingsInput{
 		Query: input.Query,
+		Limit: 20,
+	}
+	if input.Limit != nil {
+		appInput.Limit = *input.Limit
+	}
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+	if input.Until != nil {
+		t, err := s.parseDate(*input.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'until' date: %w", err)
+		}
+		appInput.Until = &t
+	}
+
+	out, err := s.searchMeetings.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchMeetingResult, len(out.Results))
+	for i, r := range out.Results {
+		results[i] = SearchMeetingResult{
+			MeetingResult: s.toMeetingResult(r.Meeting),
+			MatchReason:   string(r.MatchReason),
+		}
+	}
+	return results, nil
+}
+
+func (s *Server) HandleGetActionItems(ctx context.Context, input GetActionItemsToolInput) ([]ActionItemResult, error) {
+	appInput := meetingapp.GetActionItemsInput{
+		MeetingID: domain.MeetingID(input.MeetingID),
+	}
+	if input.Owner != nil {
+		appInput.Owner = *input.Owner
+	}
+	if input.Completed != nil {
+		appInput.Completed = meetingapp.CompletionFilter(*input.Completed)
+	}
+
+	out, err := s.getActionItems.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ActionItemResult, len(out.Items))
+	for i, item := range out.Items {
+		results[i] = s.toActionItemResult(item)
+	}
+	return results, nil
+}
+
+func (s *Server) HandleGetActionItem(ctx context.Context, input GetActionItemToolInput) (*ActionItemResult, error) {
+	out, err := s.getActionItem.Execute(ctx, meetingapp.GetActionItemInput{
+		MeetingID:    domain.MeetingID(input.MeetingID),
+		ActionItemID: domain.ActionItemID(input.ActionItemID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.toActionItemResult(out.Item)
+	return &result, nil
+}
+
+func (s *Server) HandleOverdueActionItems(ctx context.Context, input OverdueActionItemsToolInput) (*OverdueActionItemsResult, error) {
+	var appInput meetingapp.OverdueActionItemsInput
+	if input.Owner != nil {
+		appInput.Owner = *input.Owner
+	}
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+	if input.Until != nil {
+		t, err := s.parseDate(*input.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'until' date: %w", err)
+		}
+		appInput.Until = &t
+	}
+
+	out, err := s.overdueActionItems.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &OverdueActionItemsResult{Meetings: make([]OverdueMeetingResult, len(out.Meetings))}
+	for i, om := range out.Meetings {
+		items := make([]OverdueActionItemResult, len(om.Items))
+		for j, item := range om.Items {
+			items[j] = OverdueActionItemResult{
+				ActionItemResult: s.toActionItemResult(item.Item),
+				DaysOverdue:      item.DaysOverdue,
+			}
+		}
+		result.Meetings[i] = OverdueMeetingResult{
+			MeetingID: string(om.Meeting.ID()),
+			Title:     om.Meeting.Title(),
+			Datetime:  s.formatTime(om.Meeting.Datetime()),
+			Items:     items,
+		}
+	}
+	result.Errors = meetingErrorMessages(out.Errors)
+	if out.ScanLimited {
+		result.ScanLimited = true
+		result.Note = scanLimitNote(out.ScanLimit)
+	}
+	return result, nil
+}
+
+func (s *Server) HandleActionItemDigest(ctx context.Context, input ActionItemDigestToolInput) (*ActionItemDigestResult, error) {
+	var appInput meetingapp.ActionItemDigestInput
+	if input.Owner != nil {
+		appInput.Owner = *input.Owner
+	}
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+	if input.Until != nil {
+		t, err := s.parseDate(*input.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'until' date: %w", err)
+		}
+		appInput.Until = &t
+	}
+	if input.UpcomingDays != nil {
+		appInput.UpcomingWithin = time.Duration(*input.UpcomingDays) * 24 * time.Hour
+	}
+
+	out, err := s.actionItemDigest.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ActionItemDigestResult{Owners: make([]OwnerDigestResult, len(out.Owners))}
+	for i, od := range out.Owners {
+		upcoming := make([]DigestItemResult, len(od.Upcoming))
+		for j, item := range od.Upcoming {
+			upcoming[j] = DigestItemResult{
+				ActionItemResult: s.toActionItemResult(item.Item),
+				MeetingID:        string(item.Meeting.ID()),
+				MeetingTitle:     item.Meeting.Title(),
+			}
+		}
+		result.Owners[i] = OwnerDigestResult{
+			Owner:     od.Owner,
+			Open:      od.Open,
+			Overdue:   od.Overdue,
+			Completed: od.Completed,
+			Upcoming:  upcoming,
+		}
+	}
+	result.Errors = meetingErrorMessages(out.Errors)
+	if out.ScanLimited {
+		result.ScanLimited = true
+		result.Note = scanLimitNote(out.ScanLimit)
+	}
+	return result, nil
+}
+
+func (s *Server) HandleFindConflicts(ctx context.Context, input FindConflictsToolInput) (*FindConflictsResult, error) {
+	var appInput meetingapp.FindConflictsInput
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+	if input.Until != nil {
+		t, err := s.parseDate(*input.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'until' date: %w", err)
+		}
+		appInput.Until = &t
+	}
+	if input.AssumedDurationMinutes != nil {
+		appInput.AssumedDuration = time.Duration(*input.AssumedDurationMinutes) * time.Minute
+	}
+
+	out, err := s.findConflicts.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FindConflictsResult{Conflicts: make([]MeetingConflictResult, len(out.Conflicts))}
+	for i, c := range out.Conflicts {
+		result.Conflicts[i] = MeetingConflictResult{
+			MeetingA:       s.toConflictMeetingResult(c.A),
+			MeetingB:       s.toConflictMeetingResult(c.B),
+			OverlapSeconds: c.Overlap.Seconds(),
+		}
+	}
+	if out.ScanLimited {
+		result.ScanLimited = true
+		result.Note = scanLimitNote(out.ScanLimit)
+	}
+	return result, nil
+}
+
+func (s *Server) toConflictMeetingResult(m *domain.Meeting) ConflictMeetingResult {
+	return ConflictMeetingResult{
+		MeetingID: string(m.ID()),
+		Title:     m.Title(),
+		Datetime:  s.formatTime(m.Datetime()),
+	}
+}
+
+func (s *Server) HandleMeetingTimeline(ctx context.Context, input MeetingTimelineToolInput) (*MeetingTimelineResult, error) {
+	appInput := meetingapp.MeetingTimelineInput{Granularity: input.Granularity}
+	if input.Since != nil {
+		t, err := s.parseDate(*input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' date: %w", err)
+		}
+		appInput.Since = &t
+	}
+	if input.Until != nil {
+		t, err := s.parseDate(*input.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'until' date: %w", err)
+		}
+		appInput.Until = &t
+	}
+
+	out, err := s.meetingTimeline.Execute(ctx, appInput)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MeetingTimelineResult{Periods: make([]TimelinePeriodResult, len(out.Periods))}
+	for i, p := range out.Periods {
+		result.Periods[i] = TimelinePeriodResult{Period: p.Period, Count: p.Count}
+	}
+	if out.ScanLimited {
+		result.ScanLimited = true
+		result.Note = scanLimitNote(out.ScanLimit)
+	}
+	return result, nil
+}
+
+// meetingErrorMessages renders per-meeting failures keyed by meeting ID,
+// or nil when there are none.
+func meetingErrorMessages(errs map[domain.MeetingID]error) map[string]string {
+	if len(errs) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(errs))
+	for id, err := range errs {
+		out[string(id)] = err.Error()
+	}
+	return out
+}
+
+// scanLimitNote explains a result cut short by the meeting scan cap.
+func scanLimitNote(limit int) string {
+	return fmt.Sprintf("results cover only %d meetings, the scan limit; narrow since and until for complete results", limit)
+}
+
+func (s *Server) HandleExtractKeywords(ctx context.Context, input ExtractKeywordsToolInput) (*ExtractKeywordsResult, error) {
+	appInput := meetingapp.ExtractKeywordsInput{
+		MeetingID: domain.MeetingID(input.MeetingID),
+		Language:  input.Language,
 	}
 	if input.Limit != nil {
 		appInput.Limit = *input.Limit
 	}
-	if input.Since != nil {
-		t, err := time.Parse(time.RFC3339, *input.Since)
-		if err != nil {
-			return nil, fmt.Errorf("invalid 'since' date: %w", err)
-		}
-		appInput.Since = &t
+	if input.MinLength != nil {
+		appInput.MinLength = *input.MinLength
 	}
 
-	out, err := s.searchTranscripts.Execute(ctx, appInput)
+	out, err := s.extractKeywords.Execute(ctx, appInput)
 	if err != nil {
 		return nil, err
 	}
 
-	results := make([]MeetingResult, len(out.Meetings))
-	for i, m := range out.Meetings {
-		results[i] = toMeetingResult(m)
+	result := &ExtractKeywordsResult{
+		Keywords: make([]KeywordResult, len(out.Keywords)),
+		Warning:  out.Warning,
 	}
-	return results, nil
+	for i, k := range out.Keywords {
+		result.Keywords[i] = KeywordResult{Term: k.Term, Count: k.Count}
+	}
+	return result, nil
 }
 
-func (s *Server) HandleGetActionItems(ctx context.Context, input GetActionItemsToolInput) ([]ActionItemResult, error) {
-	out, err := s.getActionItems.Execute(ctx, meetingapp.GetActionItemsInput{
-		MeetingID: domain.MeetingID(input.MeetingID),
+func (s *Server) HandleCompareMeetings(ctx context.Context, input CompareMeetingsToolInput) (*MeetingComparisonResult, error) {
+	out, err := s.compareMeetings.Execute(ctx, meetingapp.CompareMeetingsInput{
+		IDA: domain.MeetingID(input.IDA),
+		IDB: domain.MeetingID(input.IDB),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	results := make([]ActionItemResult, len(out.Items))
-	for i, item := range out.Items {
-		results[i] = toActionItemResult(item)
-	}
-	return results, nil
+	return &MeetingComparisonResult{
+		A:                    s.toComparisonSide(out.A),
+		B:                    s.toComparisonSide(out.B),
+		ParticipantsAdded:    toParticipantResults(out.ParticipantsAdded),
+		ParticipantsRemoved:  toParticipantResults(out.ParticipantsRemoved),
+		ActionItemDelta:      out.B.ActionItems - out.A.ActionItems,
+		DurationDeltaSeconds: (out.B.Duration - out.A.Duration).Seconds(),
+	}, nil
 }
 
 func (s *Server) HandleMeetingStats(ctx context.Context, input MeetingStatsToolInput) (*MeetingStatsResult, error) {
 	appInput := meetingapp.GetMeetingStatsInput{}
 
 	if input.Since != nil {
-		t, err := time.Parse(time.RFC3339, *input.Since)
+		t, err := s.parseDate(*input.Since)
 		if err != nil {
 			return nil, fmt.Errorf("invalid 'since' date: %w", err)
 		}
 		appInput.Since = &t
 	}
 	if input.Until != nil {
-		t, err := time.Parse(time.RFC3339, *input.Until)
+		t, err := s.parseDate(*input.Until)
 		if err != nil {
 			return nil, fmt.Errorf("invalid 'until' date: %w", err)
 		}
 		appInput.Until = &t
 	}
+	if input.GroupBy != nil {
+		appInput.GroupBy = *input.GroupBy
+	}
 
 	out, err := s.getMeetingStats.Execute(ctx, appInput)
 	if err != nil {
 		return nil, err
 	}
 
-	return &MeetingStatsResult{
-		GeneratedAt:          out.GeneratedAt.Format(time.RFC3339),
+	return s.toMeetingStatsResult(out), nil
+}
+
+func (s *Server) toMeetingStatsResult(out *meetingapp.GetMeetingStatsOutput) *MeetingStatsResult {
+	result := &MeetingStatsResult{
+		GeneratedAt:          s.formatTime(out.GeneratedAt),
 		TotalMeetings:        out.TotalMeetings,
 		DateRange:            out.DateRange,
 		MeetingFrequency:     out.MeetingFrequency,
@@ -577,30 +1912,74 @@ func (s *Server) HandleMeetingStats(ctx context.Context, input MeetingStatsToolI
 		DayOfWeekHeatmap:     out.DayOfWeekHeatmap,
 		SpeakerTalkTime:      out.SpeakerTalkTime,
 		SummaryCoverage:      out.SummaryCoverage,
-	}, nil
+		PeakInsights:         out.PeakInsights,
+	}
+	if out.ScanLimited {
+		result.ScanLimited = true
+		result.Note = fmt.Sprintf("statistics cover only %d meetings, the scan limit; narrow since and until for complete results", out.ScanLimit)
+	}
+	if out.ByWorkspace != nil {
+		result.ByWorkspace = make(map[string]*MeetingStatsResult, len(out.ByWorkspace))
+		for id, ws := range out.ByWorkspace {
+			result.ByWorkspace[id] = s.toMeetingStatsResult(ws)
+		}
+	}
+	return result
 }
 
-func (s *Server) HandleListWorkspaces(ctx context.Context, _ ListWorkspacesToolInput) ([]WorkspaceResult, error) {
-	out, err := s.listWorkspaces.Execute(ctx, workspaceapp.ListWorkspacesInput{})
+func (s *Server) HandleListWorkspaces(ctx context.Context, input ListWorkspacesToolInput) (*ListWorkspacesResult, error) {
+	appInput := workspaceapp.ListWorkspacesInput{}
+	if input.Limit != nil {
+		appInput.Limit = *input.Limit
+	}
+	if input.Offset != nil {
+		appInput.Offset = *input.Offset
+	}
+
+	out, err := s.listWorkspaces.Execute(ctx, appInput)
 	if err != nil {
 		return nil, err
 	}
 
-	results := make([]WorkspaceResult, len(out.Workspaces))
+	result := &ListWorkspacesResult{
+		Workspaces: make([]WorkspaceResult, len(out.Workspaces)),
+		Total:      out.Total,
+	}
 	for i, ws := range out.Workspaces {
-		results[i] = toWorkspaceResult(ws)
+		result.Workspaces[i] = toWorkspaceResult(ws)
 	}
-	return results, nil
+	return result, nil
 }
 
 // --- Result to JSON helper ---
 
+// HandleToolJSON dispatches a tool call by name with raw JSON input.
+// Each invocation runs under a correlation ID, reused from ctx when a caller
+// already set one. Errors are *ToolError values with a stable code, and
+// carry the ID so users can quote it in bug reports.
 func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.RawMessage) (json.RawMessage, error) {
+	id := tracing.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = tracing.NewCorrelationID()
+		ctx = tracing.WithCorrelationID(ctx, id)
+	}
+	result, err := s.handleToolJSON(ctx, tool, rawInput)
+	if err != nil {
+		return nil, newToolError(err, id)
+	}
+	return result, nil
+}
+
+func (s *Server) handleToolJSON(ctx context.Context, tool string, rawInput json.RawMessage) (json.RawMessage, error) {
+	if !s.toolEnabled(tool) {
+		return nil, fmt.Errorf("%s: %w", tool, ErrToolDisabled)
+	}
+
 	switch tool {
 	case "list_meetings":
 		var input ListMeetingsToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleListMeetings(ctx, input)
 		if err != nil {
@@ -608,10 +1987,32 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "recent_meetings":
+		var input RecentMeetingsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleRecentMeetings(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "count_meetings":
+		var input CountMeetingsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleCountMeetings(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "get_meeting":
 		var input GetMeetingToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleGetMeeting(ctx, input)
 		if err != nil {
@@ -622,7 +2023,7 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 	case "get_transcript":
 		var input GetTranscriptToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleGetTranscript(ctx, input)
 		if err != nil {
@@ -633,7 +2034,7 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 	case "search_transcripts":
 		var input SearchTranscriptsToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleSearchTranscripts(ctx, input)
 		if err != nil {
@@ -641,10 +2042,21 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "search_meetings":
+		var input SearchMeetingsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleSearchMeetings(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "get_action_items":
 		var input GetActionItemsToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleGetActionItems(ctx, input)
 		if err != nil {
@@ -652,10 +2064,65 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "get_action_item":
+		var input GetActionItemToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleGetActionItem(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "overdue_action_items":
+		var input OverdueActionItemsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleOverdueActionItems(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "action_item_digest":
+		var input ActionItemDigestToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleActionItemDigest(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "find_conflicts":
+		var input FindConflictsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleFindConflicts(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "meeting_timeline":
+		var input MeetingTimelineToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleMeetingTimeline(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "meeting_stats":
 		var input MeetingStatsToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleMeetingStats(ctx, input)
 		if err != nil {
@@ -663,10 +2130,32 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "extract_keywords":
+		var input ExtractKeywordsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleExtractKeywords(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "compare_meetings":
+		var input CompareMeetingsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleCompareMeetings(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "list_workspaces":
 		var input ListWorkspacesToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleListWorkspaces(ctx, input)
 		if err != nil {
@@ -677,7 +2166,7 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 	case "add_note":
 		var input AddNoteToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleAddNote(ctx, input)
 		if err != nil {
@@ -688,7 +2177,7 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 	case "list_notes":
 		var input ListNotesToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleListNotes(ctx, input)
 		if err != nil {
@@ -696,10 +2185,21 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "search_notes":
+		var input SearchNotesToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleSearchNotes(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "delete_note":
 		var input DeleteNoteToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleDeleteNote(ctx, input)
 		if err != nil {
@@ -707,10 +2207,21 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "delete_meeting_notes":
+		var input DeleteMeetingNotesToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleDeleteMeetingNotes(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "complete_action_item":
 		var input CompleteActionItemToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleCompleteActionItem(ctx, input)
 		if err != nil {
@@ -718,10 +2229,21 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "complete_action_items":
+		var input CompleteActionItemsToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleCompleteActionItems(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "update_action_item":
 		var input UpdateActionItemToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleUpdateActionItem(ctx, input)
 		if err != nil {
@@ -729,10 +2251,32 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		}
 		return json.Marshal(result)
 
+	case "tag_meeting":
+		var input TagMeetingToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleTagMeeting(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
+	case "untag_meeting":
+		var input TagMeetingToolInput
+		if err := json.Unmarshal(rawInput, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		result, err := s.HandleUntagMeeting(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "export_embeddings":
 		var input ExportEmbeddingsToolInput
 		if err := json.Unmarshal(rawInput, &input); err != nil {
-			return nil, fmt.Errorf("invalid input: %w", err)
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 		}
 		result, err := s.HandleExportEmbeddings(ctx, input)
 		if err != nil {
@@ -741,33 +2285,64 @@ func (s *Server) HandleToolJSON(ctx context.Context, tool string, rawInput json.
 		return json.Marshal(result)
 
 	default:
-		return nil, fmt.Errorf("unknown tool: %s", tool)
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTool, tool)
 	}
 }
 
 // --- Mappers (interface layer → output DTOs) ---
 
-func toMeetingResult(m *domain.Meeting) MeetingResult {
-	participants := make([]ParticipantResult, len(m.Participants()))
-	for i, p := range m.Participants() {
-		participants[i] = ParticipantResult{
-			Name:  p.Name(),
-			Email: p.Email(),
-			Role:  string(p.Role()),
-		}
+// parseDate parses a since/until argument; date-only values are midnight
+// in the display zone.
+func (s *Server) parseDate(value string) (time.Time, error) {
+	return datefilter.Parse(value, s.location)
+}
+
+// formatTime renders t as RFC3339 in the configured display zone.
+func (s *Server) formatTime(t time.Time) string {
+	loc := s.location
+	if loc == nil {
+		loc = time.UTC
 	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+func (s *Server) toMeetingResult(m *domain.Meeting) MeetingResult {
 	return MeetingResult{
 		ID:           string(m.ID()),
 		Title:        m.Title(),
-		Datetime:     m.Datetime().Format(time.RFC3339),
+		Datetime:     s.formatTime(m.Datetime()),
 		Source:       string(m.Source()),
-		Participants: participants,
+		Participants: toParticipantResults(m.Participants()),
+	}
+}
+
+func toParticipantResults(participants []domain.Participant) []ParticipantResult {
+	results := make([]ParticipantResult, len(participants))
+	for i, p := range participants {
+		results[i] = ParticipantResult{
+			Name:  p.Name(),
+			Email: p.Email(),
+			Role:  string(p.Role()),
+		}
+	}
+	return results
+}
+
+func (s *Server) toComparisonSide(snap meetingapp.MeetingSnapshot) MeetingComparisonSide {
+	return MeetingComparisonSide{
+		ID:              string(snap.Meeting.ID()),
+		Title:           snap.Meeting.Title(),
+		Datetime:        s.formatTime(snap.Meeting.Datetime()),
+		ActionItems:     snap.ActionItems,
+		OpenActionItems: snap.OpenActionItems,
+		HasSummary:      snap.HasSummary,
+		DurationSeconds: snap.Duration.Seconds(),
 	}
 }
 
-func toMeetingDetailResult(m *domain.Meeting) MeetingDetailResult {
+func (s *Server) toMeetingDetailResult(m *domain.Meeting) MeetingDetailResult {
 	result := MeetingDetailResult{
-		MeetingResult: toMeetingResult(m),
+		MeetingResult: s.toMeetingResult(m),
 	}
 
 	if m.Summary() != nil {
@@ -780,29 +2355,54 @@ func toMeetingDetailResult(m *domain.Meeting) MeetingDetailResult {
 	items := m.ActionItems()
 	result.ActionItems = make([]ActionItemResult, len(items))
 	for i, item := range items {
-		result.ActionItems[i] = toActionItemResult(item)
+		result.ActionItems[i] = s.toActionItemResult(item)
 	}
 
 	return result
 }
 
-func toTranscriptResult(t *domain.Transcript) TranscriptResult {
+func (s *Server) toTranscriptResult(t *domain.Transcript) TranscriptResult {
 	utterances := make([]UtteranceResult, len(t.Utterances()))
 	for i, u := range t.Utterances() {
-		utterances[i] = UtteranceResult{
-			Speaker:    u.Speaker(),
-			Text:       u.Text(),
-			Timestamp:  u.Timestamp().Format(time.RFC3339),
-			Confidence: u.Confidence(),
-		}
+		utterances[i] = s.toUtteranceResult(u)
 	}
 	return TranscriptResult{
-		MeetingID:  string(t.MeetingID()),
-		Utterances: utterances,
+		MeetingID:       string(t.MeetingID()),
+		Utterances:      utterances,
+		TotalUtterances: len(utterances),
+	}
+}
+
+func (s *Server) toSpeakerSegmentResults(segments []domain.SpeakerSegment) []SpeakerSegmentResult {
+	results := make([]SpeakerSegmentResult, len(segments))
+	for i, seg := range segments {
+		results[i] = SpeakerSegmentResult{
+			Speaker:    seg.Speaker(),
+			Text:       seg.Text(),
+			Utterances: seg.Utterances(),
+		}
+		if !seg.Start().IsZero() {
+			results[i].Start = s.formatTime(seg.Start())
+			results[i].End = s.formatTime(seg.End())
+		}
+	}
+	return results
+}
+
+func (s *Server) toUtteranceResult(u domain.Utterance) UtteranceResult {
+	r := UtteranceResult{
+		Speaker:            u.Speaker(),
+		Text:               u.Text(),
+		Confidence:         u.Confidence(),
+		TimestampEstimated: u.TimestampEstimated(),
+	}
+	if !u.Timestamp().IsZero() {
+		r.Timestamp = s.formatTime(u.Timestamp())
 	}
+	return r
 }
 
-func toActionItemResult(item *domain.ActionItem) ActionItemResult {
+func (s *Server) toActionItemResult(item *domain.ActionItem) ActionItemResult {
 	r := ActionItemResult{
 		ID:        string(item.ID()),
 		Owner:     item.Owner(),
@@ -810,8 +2410,12 @@ func toActionItemResult(item *domain.ActionItem) ActionItemResult {
 		Completed: item.IsCompleted(),
 	}
 	if item.DueDate() != nil {
-		s := item.DueDate().Format(time.RFC3339)
-		r.DueDate = &s
+		d := s.formatTime(*item.DueDate())
+		r.DueDate = &d
+	}
+	if item.ModifiedAt() != nil {
+		m := s.formatTime(*item.ModifiedAt())
+		r.ModifiedAt = &m
 	}
 	return r
 }
@@ -830,58 +2434,133 @@ type ExportEmbeddingsToolInput struct {
 	MeetingIDs []string `json:"meeting_ids"`
 	Strategy   string   `json:"strategy,omitempty"`
 	MaxTokens  int      `json:"max_tokens,omitempty"`
+	// Overlap repeats this many utterances between consecutive
+	// time_window and token_limit chunks.
+	Overlap int `json:"overlap,omitempty"`
+	// RedactEmails masks participant emails in the chunk content.
+	RedactEmails bool `json:"redact_emails,omitempty"`
 }
 
 type ExportEmbeddingsResult struct {
-	Content    string `json:"content"`
-	ChunkCount int    `json:"chunk_count"`
-	Format     string `json:"format"`
+	Content    string              `json:"content"`
+	ChunkCount int                 `json:"chunk_count"`
+	Format     string              `json:"format"`
+	Errors     []ExportErrorResult `json:"errors,omitempty"`
+	// LimitClamped reports that more meetings were requested than the
+	// maximum; SkippedMeetingIDs were left out.
+	LimitClamped      bool     `json:"limit_clamped,omitempty"`
+	SkippedMeetingIDs []string `json:"skipped_meeting_ids,omitempty"`
+}
+
+// ExportErrorResult names a meeting left out of an export and why.
+type ExportErrorResult struct {
+	MeetingID string `json:"meeting_id"`
+	Error     string `json:"error"`
 }
 
 // --- Write Tool Input Types (Phase 3) ---
 
 type AddNoteToolInput struct {
-	MeetingID string `json:"meeting_id"`
-	Author    string `json:"author"`
-	Content   string `json:"content"`
+	MeetingID string   `json:"meeting_id"`
+	Author    string   `json:"author"`
+	Content   string   `json:"content"`
+	Tags      []string `json:"tags,omitempty"`
+	// IdempotencyKey makes retries safe: a repeated key returns the note
+	// it first created.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 type ListNotesToolInput struct {
-	MeetingID string `json:"meeting_id"`
+	MeetingID string   `json:"meeting_id"`
+	Tags      []string `json:"tags,omitempty"`
+}
+
+type SearchNotesToolInput struct {
+	Query string `json:"query"`
+	Limit *int   `json:"limit,omitempty"`
 }
 
 type DeleteNoteToolInput struct {
 	NoteID string `json:"note_id"`
 }
 
+type DeleteMeetingNotesToolInput struct {
+	MeetingID string `json:"meeting_id"`
+}
+
 type CompleteActionItemToolInput struct {
 	MeetingID    string `json:"meeting_id"`
 	ActionItemID string `json:"action_item_id"`
 }
 
+type CompleteActionItemsToolInput struct {
+	MeetingID     string   `json:"meeting_id"`
+	ActionItemIDs []string `json:"action_item_ids"`
+}
+
 type UpdateActionItemToolInput struct {
 	MeetingID    string `json:"meeting_id"`
 	ActionItemID string `json:"action_item_id"`
 	Text         string `json:"text"`
 }
 
+// TagMeetingToolInput is the input of tag_meeting and untag_meeting.
+type TagMeetingToolInput struct {
+	MeetingID string `json:"meeting_id"`
+	Tag       string `json:"tag"`
+}
+
 // --- Write Tool Output Types ---
 
+// CompleteActionItemsResult lists the items completed by
+// complete_action_items and the error message for each ID that failed.
+type CompleteActionItemsResult struct {
+	Items  []ActionItemResult `json:"items"`
+	Errors map[string]string  `json:"errors,omitempty"`
+}
+
 type NoteResult struct {
-	ID        string `json:"id"`
+	ID        string   `json:"id"`
+	MeetingID string   `json:"meeting_id"`
+	Author    string   `json:"author"`
+	Content   string   `json:"content"`
+	Tags      []string `json:"tags"`
+	CreatedAt string   `json:"created_at"`
+}
+
+type DeleteMeetingNotesResult struct {
 	MeetingID string `json:"meeting_id"`
-	Author    string `json:"author"`
-	Content   string `json:"content"`
-	CreatedAt string `json:"created_at"`
+	Deleted   int    `json:"deleted"`
+}
+
+// MeetingTagsResult is a meeting's tags after tag_meeting or untag_meeting.
+type MeetingTagsResult struct {
+	MeetingID string   `json:"meeting_id"`
+	Tags      []string `json:"tags"`
+}
+
+type EventResult struct {
+	EventName  string `json:"event_name"`
+	MeetingID  string `json:"meeting_id"`
+	OccurredAt string `json:"occurred_at"`
+}
+
+func (s *Server) toEventResult(e events.RecordedEvent) EventResult {
+	return EventResult{
+		EventName:  e.EventName,
+		MeetingID:  e.MeetingID,
+		OccurredAt: s.formatTime(e.OccurredAt),
+	}
 }
 
-func toNoteResult(n *annotation.AgentNote) NoteResult {
+func (s *Server) toNoteResult(n *annotation.AgentNote) NoteResult {
 	return NoteResult{
 		ID:        string(n.ID()),
 		MeetingID: n.MeetingID(),
 		Author:    n.Author(),
 		Content:   n.Content(),
-		CreatedAt: n.CreatedAt().Format(time.RFC3339),
+		Tags:      n.Tags(),
+		CreatedAt: s.formatTime(n.CreatedAt()),
 	}
 }
 
@@ -889,32 +2568,55 @@ func toNoteResult(n *annotation.AgentNote) NoteResult {
 
 func (s *Server) HandleAddNote(ctx context.Context, input AddNoteToolInput) (*NoteResult, error) {
 	out, err := s.addNote.Execute(ctx, annotationapp.AddNoteInput{
-		MeetingID: input.MeetingID,
-		Author:    input.Author,
-		Content:   input.Content,
+		MeetingID:      input.MeetingID,
+		Author:         input.Author,
+		Content:        input.Content,
+		Tags:           input.Tags,
+		IdempotencyKey: input.IdempotencyKey,
 	})
 	if err != nil {
 		return nil, err
 	}
-	result := toNoteResult(out.Note)
+	result := s.toNoteResult(out.Note)
 	return &result, nil
 }
 
 func (s *Server) HandleListNotes(ctx context.Context, input ListNotesToolInput) ([]NoteResult, error) {
 	out, err := s.listNotes.Execute(ctx, annotationapp.ListNotesInput{
 		MeetingID: input.MeetingID,
+		Tags:      input.Tags,
 	})
 	if err != nil {
 		return nil, err
 	}
 	results := make([]NoteResult, len(out.Notes))
 	for i, n := range out.Notes {
-		results[i] = toNoteResult(n)
+		results[i] = s.toNoteResult(n)
+	}
+	return results, nil
+}
+
+func (s *Server) HandleSearchNotes(ctx context.Context, input SearchNotesToolInput) ([]NoteResult, error) {
+	searchInput := annotationapp.SearchNotesInput{Query: input.Query}
+	if input.Limit != nil {
+		searchInput.Limit = *input.Limit
+	}
+	out, err := s.searchNotes.Execute(ctx, searchInput)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]NoteResult, len(out.Notes))
+	for i, n := range out.Notes {
+		results[i] = s.toNoteResult(n)
 	}
 	return results, nil
 }
 
+// auditActor attributes audited writes made through MCP tools.
+const auditActor = "mcp"
+
 func (s *Server) HandleDeleteNote(ctx context.Context, input DeleteNoteToolInput) (*struct{}, error) {
+	ctx = audit.WithActor(ctx, auditActor)
 	_, err := s.deleteNote.Execute(ctx, annotationapp.DeleteNoteInput{
 		NoteID: input.NoteID,
 	})
@@ -924,7 +2626,18 @@ func (s *Server) HandleDeleteNote(ctx context.Context, input DeleteNoteToolInput
 	return &struct{}{}, nil
 }
 
+func (s *Server) HandleDeleteMeetingNotes(ctx context.Context, input DeleteMeetingNotesToolInput) (*DeleteMeetingNotesResult, error) {
+	out, err := s.deleteMeetingNotes.Execute(ctx, annotationapp.DeleteNotesByMeetingInput{
+		MeetingID: input.MeetingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteMeetingNotesResult{MeetingID: input.MeetingID, Deleted: out.Deleted}, nil
+}
+
 func (s *Server) HandleCompleteActionItem(ctx context.Context, input CompleteActionItemToolInput) (*ActionItemResult, error) {
+	ctx = audit.WithActor(ctx, auditActor)
 	out, err := s.completeActionItem.Execute(ctx, meetingapp.CompleteActionItemInput{
 		MeetingID:    domain.MeetingID(input.MeetingID),
 		ActionItemID: domain.ActionItemID(input.ActionItemID),
@@ -932,11 +2645,40 @@ func (s *Server) HandleCompleteActionItem(ctx context.Context, input CompleteAct
 	if err != nil {
 		return nil, err
 	}
-	result := toActionItemResult(out.Item)
+	result := s.toActionItemResult(out.Item)
 	return &result, nil
 }
 
+func (s *Server) HandleCompleteActionItems(ctx context.Context, input CompleteActionItemsToolInput) (*CompleteActionItemsResult, error) {
+	ctx = audit.WithActor(ctx, auditActor)
+	ids := make([]domain.ActionItemID, len(input.ActionItemIDs))
+	for i, id := range input.ActionItemIDs {
+		ids[i] = domain.ActionItemID(id)
+	}
+
+	out, err := s.completeActionItems.Execute(ctx, meetingapp.CompleteActionItemsInput{
+		MeetingID:     domain.MeetingID(input.MeetingID),
+		ActionItemIDs: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CompleteActionItemsResult{Items: make([]ActionItemResult, len(out.Items))}
+	for i, item := range out.Items {
+		result.Items[i] = s.toActionItemResult(item)
+	}
+	if len(out.Errors) > 0 {
+		result.Errors = make(map[string]string, len(out.Errors))
+		for id, err := range out.Errors {
+			result.Errors[string(id)] = err.Error()
+		}
+	}
+	return result, nil
+}
+
 func (s *Server) HandleUpdateActionItem(ctx context.Context, input UpdateActionItemToolInput) (*ActionItemResult, error) {
+	ctx = audit.WithActor(ctx, auditActor)
 	out, err := s.updateActionItem.Execute(ctx, meetingapp.UpdateActionItemInput{
 		MeetingID:    domain.MeetingID(input.MeetingID),
 		ActionItemID: domain.ActionItemID(input.ActionItemID),
@@ -945,13 +2687,40 @@ func (s *Server) HandleUpdateActionItem(ctx context.Context, input UpdateActionI
 	if err != nil {
 		return nil, err
 	}
-	result := toActionItemResult(out.Item)
+	result := s.toActionItemResult(out.Item)
 	return &result, nil
 }
 
+func (s *Server) HandleTagMeeting(ctx context.Context, input TagMeetingToolInput) (*MeetingTagsResult, error) {
+	out, err := s.tagMeeting.Execute(ctx, meetingapp.TagMeetingInput{
+		ID:  domain.MeetingID(input.MeetingID),
+		Tag: input.Tag,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MeetingTagsResult{MeetingID: input.MeetingID, Tags: out.Tags}, nil
+}
+
+func (s *Server) HandleUntagMeeting(ctx context.Context, input TagMeetingToolInput) (*MeetingTagsResult, error) {
+	out, err := s.untagMeeting.Execute(ctx, meetingapp.TagMeetingInput{
+		ID:  domain.MeetingID(input.MeetingID),
+		Tag: input.Tag,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MeetingTagsResult{MeetingID: input.MeetingID, Tags: out.Tags}, nil
+}
+
 func (s *Server) HandleExportEmbeddings(ctx context.Context, input ExportEmbeddingsToolInput) (*ExportEmbeddingsResult, error) {
-	meetingIDs := make([]domain.MeetingID, len(input.MeetingIDs))
-	for i, id := range input.MeetingIDs {
+	ids := input.MeetingIDs
+	var skipped []string
+	if len(ids) > s.limits.Max {
+		ids, skipped = ids[:s.limits.Max], ids[s.limits.Max:]
+	}
+	meetingIDs := make([]domain.MeetingID, len(ids))
+	for i, id := range ids {
 		meetingIDs[i] = domain.MeetingID(id)
 	}
 
@@ -959,15 +2728,27 @@ func (s *Server) HandleExportEmbeddings(ctx context.Context, input ExportEmbeddi
 		MeetingIDs: meetingIDs,
 		Strategy:   input.Strategy,
 		MaxTokens:  input.MaxTokens,
+		Overlap:    input.Overlap,
 		Format:     "jsonl",
+
+		RedactEmails: input.RedactEmails || s.redactEmails,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ExportEmbeddingsResult{
-		Content:    out.Content,
-		ChunkCount: out.ChunkCount,
-		Format:     "jsonl",
-	}, nil
+	result := &ExportEmbeddingsResult{
+		Content:           out.Content,
+		ChunkCount:        out.ChunkCount,
+		Format:            "jsonl",
+		LimitClamped:      len(skipped) > 0,
+		SkippedMeetingIDs: skipped,
+	}
+	for _, e := range out.Errors {
+		result.Errors = append(result.Errors, ExportErrorResult{
+			MeetingID: string(e.MeetingID),
+			Error:     e.Err.Error(),
+		})
+	}
+	return result, nil
 }
@@ -3,12 +3,15 @@ package mcp_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
 	domain "github.com/felixgeelhaar/acai/internal/domain/meeting"
 	"github.com/felixgeelhaar/acai/internal/domain/workspace"
 	mcpiface "github.com/felixgeelhaar/acai/internal/interfaces/mcp"
@@ -38,8 +41,42 @@ func TestServer_HandleSearchTranscripts(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(results) != 1 {
-		t.Errorf("got %d results", len(results))
+	if len(results.Meetings) != 1 {
+		t.Errorf("got %d results", len(results.Meetings))
+	}
+}
+
+func TestServer_HandleSearchTranscripts_IncludeSnippets(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Good morning", now, 0.9),
+		domain.NewUtterance("Bob", "Let's review the budget", now.Add(time.Minute), 0.9),
+		domain.NewUtterance("Alice", "Sounds good", now.Add(2*time.Minute), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	srv := newTestServer(repo)
+
+	results, err := srv.HandleSearchTranscripts(context.Background(), mcpiface.SearchTranscriptsToolInput{
+		Query:           "budget",
+		IncludeSnippets: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results.Meetings) != 1 || len(results.Meetings[0].Snippets) != 1 {
+		t.Fatalf("got %+v, want one meeting with one snippet", results)
+	}
+	snip := results.Meetings[0].Snippets[0]
+	if len(snip.Utterances) != 3 || snip.Utterances[snip.MatchIndex].Speaker != "Bob" {
+		t.Errorf("got snippet %+v, want Bob's utterance with one line of context each side", snip)
+	}
+
+	results, _ = srv.HandleSearchTranscripts(context.Background(), mcpiface.SearchTranscriptsToolInput{Query: "budget"})
+	if len(results.Meetings[0].Snippets) != 0 {
+		t.Error("snippets should be omitted unless include_snippets is set")
 	}
 }
 
@@ -54,7 +91,7 @@ func TestServer_HandleListMeetings_WithFilters(t *testing.T) {
 	offset := 0
 	source := "zoom"
 
-	results, err := srv.HandleListMeetings(context.Background(), mcpiface.ListMeetingsToolInput{
+	result, err := srv.HandleListMeetings(context.Background(), mcpiface.ListMeetingsToolInput{
 		Since:  &since,
 		Until:  &until,
 		Limit:  &limit,
@@ -64,8 +101,8 @@ func TestServer_HandleListMeetings_WithFilters(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(results) != 1 {
-		t.Errorf("got %d results", len(results))
+	if len(result.Meetings) != 1 {
+		t.Errorf("got %d results", len(result.Meetings))
 	}
 }
 
@@ -167,10 +204,13 @@ func TestServer_HandleToolJSON_SearchTranscripts(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	var results []mcpiface.MeetingResult
-	if err := json.Unmarshal(raw, &results); err != nil {
+	var result mcpiface.SearchTranscriptsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
 		t.Fatalf("unmarshal error: %v", err)
 	}
+	if len(result.Meetings) != 1 {
+		t.Errorf("got %d meetings, want 1", len(result.Meetings))
+	}
 }
 
 func TestServer_HandleToolJSON_GetActionItems(t *testing.T) {
@@ -229,8 +269,8 @@ func TestServer_HandleSearchTranscripts_WithSince(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(results) != 1 {
-		t.Errorf("got %d results", len(results))
+	if len(results.Meetings) != 1 {
+		t.Errorf("got %d results", len(results.Meetings))
 	}
 }
 
@@ -278,15 +318,37 @@ func TestServer_HandleListWorkspaces(t *testing.T) {
 	wsRepo := &mockWorkspaceRepo{workspaces: []*workspace.Workspace{ws1, ws2}}
 	srv := newTestServerWithWorkspaces(repo, wsRepo)
 
-	results, err := srv.HandleListWorkspaces(context.Background(), mcpiface.ListWorkspacesToolInput{})
+	result, err := srv.HandleListWorkspaces(context.Background(), mcpiface.ListWorkspacesToolInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Workspaces) != 2 || result.Total != 2 {
+		t.Errorf("expected 2 of 2 workspaces, got %d of %d", len(result.Workspaces), result.Total)
+	}
+	if result.Workspaces[0].ID != "ws-1" {
+		t.Errorf("expected ws-1, got %s", result.Workspaces[0].ID)
+	}
+}
+
+func TestServer_HandleListWorkspaces_Paginated(t *testing.T) {
+	repo := newMockRepo()
+	var all []*workspace.Workspace
+	for _, id := range []string{"ws-1", "ws-2", "ws-3", "ws-4", "ws-5"} {
+		ws, _ := workspace.New(workspace.WorkspaceID(id), "Team "+id, id)
+		all = append(all, ws)
+	}
+	srv := newTestServerWithWorkspaces(repo, &mockWorkspaceRepo{workspaces: all})
+
+	limit, offset := 2, 2
+	result, err := srv.HandleListWorkspaces(context.Background(), mcpiface.ListWorkspacesToolInput{Limit: &limit, Offset: &offset})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(results) != 2 {
-		t.Errorf("expected 2 workspaces, got %d", len(results))
+	if result.Total != 5 {
+		t.Errorf("expected total 5, got %d", result.Total)
 	}
-	if results[0].ID != "ws-1" {
-		t.Errorf("expected ws-1, got %s", results[0].ID)
+	if len(result.Workspaces) != 2 || result.Workspaces[0].ID != "ws-3" || result.Workspaces[1].ID != "ws-4" {
+		t.Errorf("expected ws-3 and ws-4, got %+v", result.Workspaces)
 	}
 }
 
@@ -295,12 +357,12 @@ func TestServer_HandleListWorkspaces_Empty(t *testing.T) {
 	wsRepo := &mockWorkspaceRepo{workspaces: []*workspace.Workspace{}}
 	srv := newTestServerWithWorkspaces(repo, wsRepo)
 
-	results, err := srv.HandleListWorkspaces(context.Background(), mcpiface.ListWorkspacesToolInput{})
+	result, err := srv.HandleListWorkspaces(context.Background(), mcpiface.ListWorkspacesToolInput{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(results) != 0 {
-		t.Errorf("expected 0, got %d", len(results))
+	if len(result.Workspaces) != 0 || result.Total != 0 {
+		t.Errorf("expected 0, got %d", len(result.Workspaces))
 	}
 }
 
@@ -315,12 +377,12 @@ func TestServer_HandleToolJSON_ListWorkspaces(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	var results []mcpiface.WorkspaceResult
-	if err := json.Unmarshal(raw, &results); err != nil {
+	var result mcpiface.ListWorkspacesResult
+	if err := json.Unmarshal(raw, &result); err != nil {
 		t.Fatalf("unmarshal error: %v", err)
 	}
-	if len(results) != 1 {
-		t.Errorf("expected 1 workspace, got %d", len(results))
+	if len(result.Workspaces) != 1 || result.Total != 1 {
+		t.Errorf("expected 1 workspace, got %d", len(result.Workspaces))
 	}
 }
 
@@ -350,6 +412,126 @@ func TestServer_ServeHTTP_StartsAndStops(t *testing.T) {
 	}
 }
 
+func TestServer_HTTPServer_Limits(t *testing.T) {
+	repo := newMockRepo()
+	httpSrv := newTestServer(repo).HTTPServer(":0", http.NewServeMux())
+	if httpSrv.ReadHeaderTimeout == 0 || httpSrv.ReadTimeout == 0 || httpSrv.WriteTimeout == 0 ||
+		httpSrv.IdleTimeout == 0 || httpSrv.MaxHeaderBytes == 0 {
+		t.Errorf("expected default limits, got %+v", httpSrv)
+	}
+
+	opts, _, _ := testDeps(repo)
+	opts.HTTP = mcpiface.HTTPLimits{ReadHeaderTimeout: 2 * time.Second}
+	httpSrv = mcpiface.NewServer("acai", "test", opts).HTTPServer(":0", http.NewServeMux())
+	if httpSrv.ReadHeaderTimeout != 2*time.Second {
+		t.Errorf("got read header timeout %v, want 2s", httpSrv.ReadHeaderTimeout)
+	}
+	if httpSrv.IdleTimeout != mcpiface.DefaultHTTPLimits.IdleTimeout {
+		t.Errorf("got idle timeout %v, want default", httpSrv.IdleTimeout)
+	}
+}
+
+func TestServer_HTTPHandler_StreamsCountedSeparately(t *testing.T) {
+	opts, _, _ := testDeps(newMockRepo())
+	opts.HTTP = mcpiface.HTTPLimits{MaxInFlight: 1, MaxStreams: 1}
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	handler := srv.HTTPHandler(func(mux *http.ServeMux) {
+		mux.Handle("/stream", mcpiface.StreamingHandler(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			close(entered)
+			<-release
+		})))
+		mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if resp, err := http.Get(ts.URL + "/stream"); err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-entered
+
+	resp, err := http.Get(ts.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d with a stream open, want 200", resp.StatusCode)
+	}
+
+	resp, err = http.Get(ts.URL + "/stream")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status %d over the stream limit, want 503", resp.StatusCode)
+	}
+
+	close(release)
+	<-done
+}
+
+func TestServer_HTTPHandler_MaxInFlight(t *testing.T) {
+	opts, _, _ := testDeps(newMockRepo())
+	opts.HTTP = mcpiface.HTTPLimits{MaxInFlight: 1}
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	handler := srv.HTTPHandler(func(mux *http.ServeMux) {
+		mux.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+			close(entered)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		})
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if resp, err := http.Get(ts.URL + "/slow"); err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-entered
+
+	resp, err := http.Get(ts.URL + "/slow")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status %d over the limit, want 503", resp.StatusCode)
+	}
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("expected a Retry-After header")
+	}
+
+	resp, err = http.Get(ts.URL + "/health")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got /health status %d, want 200 regardless of the limit", resp.StatusCode)
+	}
+
+	close(release)
+	<-done
+}
+
 func TestServer_ServeHTTP_HealthEndpoint(t *testing.T) {
 	repo := newMockRepo()
 	srv := newTestServer(repo)
@@ -489,6 +671,111 @@ func TestServer_HandleListNotes(t *testing.T) {
 	}
 }
 
+func TestServer_HandleSearchNotes(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Meeting"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Other"))
+
+	srv := newTestServer(repo)
+	for _, in := range []mcpiface.AddNoteToolInput{
+		{MeetingID: "m-1", Author: "claude", Content: "budget approved"},
+		{MeetingID: "m-2", Author: "claude", Content: "hiring plan"},
+	} {
+		if _, err := srv.HandleAddNote(context.Background(), in); err != nil {
+			t.Fatalf("add note: %v", err)
+		}
+	}
+
+	raw, err := srv.HandleToolJSON(context.Background(), "search_notes", []byte(`{"query":"budget","limit":5}`))
+	if err != nil {
+		t.Fatalf("search notes: %v", err)
+	}
+	var results []mcpiface.NoteResult
+	if err := json.Unmarshal(raw, &results); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(results) != 1 || results[0].MeetingID != "m-1" {
+		t.Errorf("expected one note from m-1, got %+v", results)
+	}
+}
+
+func TestServer_HandleSearchNotes_EmptyQuery(t *testing.T) {
+	srv := newTestServer(newMockRepo())
+	_, err := srv.HandleSearchNotes(context.Background(), mcpiface.SearchNotesToolInput{})
+	if !errors.Is(err, annotationapp.ErrEmptyQuery) {
+		t.Errorf("expected ErrEmptyQuery, got %v", err)
+	}
+}
+
+func TestServer_HandleDeleteMeetingNotes(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Meeting"))
+
+	srv := newTestServer(repo)
+	for _, content := range []string{"first", "second"} {
+		if _, err := srv.HandleAddNote(context.Background(), mcpiface.AddNoteToolInput{
+			MeetingID: "m-1", Author: "claude", Content: content,
+		}); err != nil {
+			t.Fatalf("add note: %v", err)
+		}
+	}
+
+	raw, err := srv.HandleToolJSON(context.Background(), "delete_meeting_notes", []byte(`{"meeting_id":"m-1"}`))
+	if err != nil {
+		t.Fatalf("delete meeting notes: %v", err)
+	}
+	var result mcpiface.DeleteMeetingNotesResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if result.Deleted != 2 {
+		t.Errorf("got %d deleted, want 2", result.Deleted)
+	}
+
+	notes, _ := srv.HandleListNotes(context.Background(), mcpiface.ListNotesToolInput{MeetingID: "m-1"})
+	if len(notes) != 0 {
+		t.Errorf("got %d notes left, want 0", len(notes))
+	}
+}
+
+func TestServer_HandleListNotes_FilterByTags(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Meeting"))
+
+	srv := newTestServer(repo)
+
+	added, err := srv.HandleAddNote(context.Background(), mcpiface.AddNoteToolInput{
+		MeetingID: "m-1",
+		Author:    "claude",
+		Content:   "ship date agreed",
+		Tags:      []string{"Decision", "follow-up"},
+	})
+	if err != nil {
+		t.Fatalf("add note: %v", err)
+	}
+	if len(added.Tags) != 2 || added.Tags[0] != "decision" {
+		t.Errorf("got tags %v", added.Tags)
+	}
+	if _, err := srv.HandleAddNote(context.Background(), mcpiface.AddNoteToolInput{
+		MeetingID: "m-1",
+		Author:    "claude",
+		Content:   "untagged",
+	}); err != nil {
+		t.Fatalf("add note: %v", err)
+	}
+
+	results, err := srv.HandleListNotes(context.Background(), mcpiface.ListNotesToolInput{
+		MeetingID: "m-1",
+		Tags:      []string{"decision", "follow-up"},
+	})
+	if err != nil {
+		t.Fatalf("list notes: %v", err)
+	}
+	if len(results) != 1 || results[0].ID != added.ID {
+		t.Errorf("got %d notes, want only the tagged note", len(results))
+	}
+}
+
 func TestServer_HandleListNotes_Empty(t *testing.T) {
 	repo := newMockRepo()
 	srv := newTestServer(repo)
@@ -549,6 +836,11 @@ func TestServer_HandleCompleteActionItem(t *testing.T) {
 	if !result.Completed {
 		t.Error("action item should be completed")
 	}
+	if result.ModifiedAt == nil {
+		t.Error("completed action item should report modified_at")
+	} else if _, err := time.Parse(time.RFC3339, *result.ModifiedAt); err != nil {
+		t.Errorf("modified_at %q is not RFC3339: %v", *result.ModifiedAt, err)
+	}
 }
 
 func TestServer_HandleUpdateActionItem(t *testing.T) {
@@ -641,6 +933,49 @@ func TestServer_HandleExportEmbeddings(t *testing.T) {
 	}
 }
 
+func TestServer_HandleExportEmbeddings_ClampsMeetings(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Hello world", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	opts, _, _ := testDeps(repo)
+	opts.Limits = mcpiface.ListLimits{Max: 1}
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	result, err := srv.HandleExportEmbeddings(context.Background(), mcpiface.ExportEmbeddingsToolInput{
+		MeetingIDs: []string{"m-1", "m-2", "m-3"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.LimitClamped || len(result.SkippedMeetingIDs) != 2 || result.SkippedMeetingIDs[0] != "m-2" {
+		t.Errorf("got clamped %v, skipped %v; want true, [m-2 m-3]", result.LimitClamped, result.SkippedMeetingIDs)
+	}
+	if len(result.Errors) != 0 {
+		t.Errorf("skipped meetings should not be fetched, got errors %+v", result.Errors)
+	}
+}
+
+func TestServer_HandleExportEmbeddings_ReportsFailedMeetings(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleExportEmbeddings(context.Background(), mcpiface.ExportEmbeddingsToolInput{
+		MeetingIDs: []string{"m-1", "missing"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].MeetingID != "missing" {
+		t.Errorf("Errors = %+v, want only missing", result.Errors)
+	}
+}
+
 func TestServer_HandleToolJSON_ExportEmbeddings(t *testing.T) {
 	repo := newMockRepo()
 	repo.addMeeting(mustMeeting(t, "m-1", "Meeting"))
@@ -687,3 +1022,25 @@ func TestServer_HandleGetMeeting_WithParticipants(t *testing.T) {
 		t.Errorf("got role %q", result.Participants[0].Role)
 	}
 }
+
+func TestServer_HandleCompleteActionItems(t *testing.T) {
+	repo := newMockRepo()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleCompleteActionItems(context.Background(), mcpiface.CompleteActionItemsToolInput{
+		MeetingID:     "m-1",
+		ActionItemIDs: []string{"ai-1", "nope"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Items) != 1 || !result.Items[0].Completed {
+		t.Errorf("got items %+v, want ai-1 completed", result.Items)
+	}
+	if _, ok := result.Errors["nope"]; !ok || len(result.Errors) != 1 {
+		t.Errorf("got errors %v, want only nope", result.Errors)
+	}
+}
@@ -3,9 +3,15 @@ package mcp_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	mcpfw "github.com/felixgeelhaar/mcp-go"
+
 	annotationapp "github.com/felixgeelhaar/acai/internal/application/annotation"
 	embeddingapp "github.com/felixgeelhaar/acai/internal/application/embedding"
 	meetingapp "github.com/felixgeelhaar/acai/internal/application/meeting"
@@ -23,12 +29,433 @@ func TestServer_HandleListMeetings(t *testing.T) {
 
 	srv := newTestServer(repo)
 
-	results, err := srv.HandleListMeetings(context.Background(), mcpiface.ListMeetingsToolInput{})
+	result, err := srv.HandleListMeetings(context.Background(), mcpiface.ListMeetingsToolInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Meetings) != 2 || result.Partial {
+		t.Errorf("got %d results (partial=%v), want 2", len(result.Meetings), result.Partial)
+	}
+}
+
+func TestServer_HandleListMeetings_SortByTitle(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
+
+	srv := newTestServer(repo)
+
+	sortBy := "title"
+	result, err := srv.HandleListMeetings(context.Background(), mcpiface.ListMeetingsToolInput{SortBy: &sortBy})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Meetings) != 2 || result.Meetings[0].Title != "Retrospective" {
+		t.Errorf("got %+v, want Retrospective first", result.Meetings)
+	}
+}
+
+// partialListRepo reports every list as cut short by its deadline.
+type partialListRepo struct {
+	*mockRepo
+}
+
+func (r partialListRepo) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	meetings, _ := r.mockRepo.List(ctx, filter)
+	return meetings, fmt.Errorf("%w: %w", domain.ErrPartialResults, context.DeadlineExceeded)
+}
+
+// limitRecordingRepo records the limit of the last list and search.
+type limitRecordingRepo struct {
+	*mockRepo
+	limit int
+}
+
+func (r *limitRecordingRepo) List(ctx context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	r.limit = filter.Limit
+	return r.mockRepo.List(ctx, filter)
+}
+
+func (r *limitRecordingRepo) SearchTranscripts(ctx context.Context, query string, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	r.limit = filter.Limit
+	return r.mockRepo.SearchTranscripts(ctx, query, filter)
+}
+
+func TestServer_ListLimits_Clamped(t *testing.T) {
+	repo := &limitRecordingRepo{mockRepo: newMockRepo()}
+	opts, _, _ := testDeps(repo.mockRepo)
+	opts.ListMeetings = meetingapp.NewListMeetings(repo)
+	opts.SearchTranscripts = meetingapp.NewSearchTranscripts(repo)
+	opts.Limits = mcpiface.ListLimits{Default: 10, Max: 50}
+	srv := mcpiface.NewServer("acai", "test", opts)
+	ctx := context.Background()
+
+	result, err := srv.HandleListMeetings(ctx, mcpiface.ListMeetingsToolInput{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if repo.limit != 10 || result.LimitClamped {
+		t.Errorf("no limit: got limit %d, clamped %v; want 10, false", repo.limit, result.LimitClamped)
+	}
+
+	within := 30
+	result, _ = srv.HandleListMeetings(ctx, mcpiface.ListMeetingsToolInput{Limit: &within})
+	if repo.limit != 30 || result.LimitClamped {
+		t.Errorf("limit 30: got limit %d, clamped %v; want 30, false", repo.limit, result.LimitClamped)
+	}
+
+	huge := 100000
+	result, _ = srv.HandleListMeetings(ctx, mcpiface.ListMeetingsToolInput{Limit: &huge})
+	if repo.limit != 50 || !result.LimitClamped || result.Limit != 50 {
+		t.Errorf("limit %d: got limit %d, clamped %v, reported %d; want 50, true, 50", huge, repo.limit, result.LimitClamped, result.Limit)
+	}
+
+	search, err := srv.HandleSearchTranscripts(ctx, mcpiface.SearchTranscriptsToolInput{Query: "x", Limit: &huge})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.limit != 50 || !search.LimitClamped || search.Limit != 50 {
+		t.Errorf("search_transcripts: got limit %d, clamped %v, reported %d; want 50, true, 50", repo.limit, search.LimitClamped, search.Limit)
+	}
+
+	search, _ = srv.HandleSearchTranscripts(ctx, mcpiface.SearchTranscriptsToolInput{Query: "x", Limit: &within})
+	if search.LimitClamped {
+		t.Error("search_transcripts: a limit within the maximum should not be marked clamped")
+	}
+}
+
+func TestServer_HandleListMeetings_Partial(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	opts, _, _ := testDeps(repo)
+	opts.ListMeetings = meetingapp.NewListMeetings(partialListRepo{repo})
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "list_meetings", json.RawMessage(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.ListMeetingsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if !result.Partial || len(result.Meetings) != 1 {
+		t.Errorf("got partial=%v with %d meetings, want partial with 1", result.Partial, len(result.Meetings))
+	}
+}
+
+func TestServer_HandleSearchMeetings(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
+
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "search_meetings", json.RawMessage(`{"query":"sprint"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var results []mcpiface.SearchMeetingResult
+	if err := json.Unmarshal(raw, &results); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	// The mock matches every meeting in both searches; results are deduped.
 	if len(results) != 2 {
-		t.Errorf("got %d results, want 2", len(results))
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for _, r := range results {
+		if r.MatchReason != "both" {
+			t.Errorf("meeting %s: got match_reason %q, want both", r.ID, r.MatchReason)
+		}
+	}
+}
+
+func TestServer_HandleSearchMeetings_EmptyQuery(t *testing.T) {
+	srv := newTestServer(newMockRepo())
+	if _, err := srv.HandleSearchMeetings(context.Background(), mcpiface.SearchMeetingsToolInput{}); !errors.Is(err, meetingapp.ErrEmptyQuery) {
+		t.Errorf("got error %v, want %v", err, meetingapp.ErrEmptyQuery)
+	}
+}
+
+func TestServer_HandleToolJSON_RecentMeetings(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Standup"))
+	srv := newTestServer(repo)
+
+	before := time.Now().UTC()
+	raw, err := srv.HandleToolJSON(context.Background(), "recent_meetings", json.RawMessage(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.ListMeetingsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(result.Meetings) != 1 {
+		t.Errorf("got %d meetings, want 1", len(result.Meetings))
+	}
+	since := repo.listFilter.Since
+	if since == nil || since.After(before.Add(-7*24*time.Hour)) || since.Before(before.Add(-7*24*time.Hour-time.Minute)) {
+		t.Errorf("default since: got %v, want about 7 days ago", since)
+	}
+	if repo.listFilter.Limit != 20 {
+		t.Errorf("default limit: got %d, want 20", repo.listFilter.Limit)
+	}
+
+	if _, err := srv.HandleToolJSON(context.Background(), "recent_meetings", json.RawMessage(`{"days":1,"limit":5}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if since := repo.listFilter.Since; since == nil || time.Since(*since) > 25*time.Hour {
+		t.Errorf("days=1: got since %v", since)
+	}
+	if repo.listFilter.Limit != 5 {
+		t.Errorf("limit: got %d, want 5", repo.listFilter.Limit)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "recent_meetings", json.RawMessage(`{"days":0}`))
+	if !errors.Is(err, mcpiface.ErrInvalidInput) {
+		t.Errorf("got %v, want %v", err, mcpiface.ErrInvalidInput)
+	}
+}
+
+func TestServer_HandleToolJSON_GetActionItem(t *testing.T) {
+	repo := newMockRepo()
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "get_action_item", json.RawMessage(`{"meeting_id":"m-1","action_item_id":"ai-1"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.ActionItemResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if result.ID != "ai-1" || result.Owner != "Alice" || result.Text != "Send notes" {
+		t.Errorf("got %+v", result)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "get_action_item", json.RawMessage(`{"meeting_id":"m-1","action_item_id":"ai-9"}`))
+	if !errors.Is(err, domain.ErrActionItemNotFound) {
+		t.Errorf("got error %v, want %v", err, domain.ErrActionItemNotFound)
+	}
+}
+
+func TestServer_HandleToolJSON_ListMeetingsDateFormats(t *testing.T) {
+	repo := newMockRepo()
+	srv := newTestServer(repo)
+
+	tests := []struct {
+		args string
+		want time.Time
+	}{
+		{`{"since":"2026-01-01T09:30:00+01:00"}`, time.Date(2026, 1, 1, 8, 30, 0, 0, time.UTC)},
+		{`{"since":"2026-01-01"}`, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if _, err := srv.HandleToolJSON(context.Background(), "list_meetings", json.RawMessage(tt.args)); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.args, err)
+		}
+		if since := repo.listFilter.Since; since == nil || !since.Equal(tt.want) {
+			t.Errorf("%s: got since %v, want %s", tt.args, since, tt.want)
+		}
+	}
+
+	// Date-only values are midnight in the display zone.
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+	srv.SetLocation(tokyo)
+	if _, err := srv.HandleToolJSON(context.Background(), "list_meetings", json.RawMessage(`{"until":"2026-01-01"}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if until := repo.listFilter.Until; until == nil || !until.Equal(time.Date(2025, 12, 31, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("got until %v, want midnight in Tokyo", until)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "list_meetings", json.RawMessage(`{"since":"01/02/2026"}`))
+	if mcpiface.ErrorCodeOf(err) != mcpiface.CodeInvalidInput {
+		t.Errorf("got %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestServer_HandleToolJSON_OverdueActionItems(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Planning"))
+	due := time.Now().UTC().Add(-3*24*time.Hour - time.Hour)
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", &due)
+	repo.addActionItems("m-1", []*domain.ActionItem{item})
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "overdue_action_items", json.RawMessage(`{"owner":"alice"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.OverdueActionItemsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(result.Meetings) != 1 || result.Meetings[0].MeetingID != "m-1" || len(result.Meetings[0].Items) != 1 {
+		t.Fatalf("got %+v, want one meeting with one item", result)
+	}
+	if got := result.Meetings[0].Items[0]; got.ID != "ai-1" || got.DaysOverdue != 3 {
+		t.Errorf("got item %+v, want ai-1 overdue 3 days", got)
+	}
+
+	raw, err = srv.HandleToolJSON(context.Background(), "overdue_action_items", json.RawMessage(`{"owner":"bob"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(raw) != `{"meetings":[]}` {
+		t.Errorf("got %s, want an empty list", raw)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "overdue_action_items", json.RawMessage(`{"since":"last week"}`))
+	if mcpiface.ErrorCodeOf(err) != mcpiface.CodeInvalidInput {
+		t.Errorf("got %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestServer_HandleToolJSON_ActionItemDigest(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Retro"))
+	soon := time.Now().UTC().Add(48 * time.Hour)
+	late := time.Now().UTC().Add(-24 * time.Hour)
+	a1, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Send notes", &soon)
+	a2, _ := domain.NewActionItem("ai-2", "m-2", "Alice", "Fix the build", &late)
+	b1, _ := domain.NewActionItem("ai-3", "m-2", "Bob", "Book room", nil)
+	b1.Complete()
+	repo.addActionItems("m-1", []*domain.ActionItem{a1})
+	repo.addActionItems("m-2", []*domain.ActionItem{a2, b1})
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "action_item_digest", json.RawMessage(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.ActionItemDigestResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(result.Owners) != 2 {
+		t.Fatalf("got %+v, want Alice and Bob", result.Owners)
+	}
+	alice, bob := result.Owners[0], result.Owners[1]
+	if alice.Owner != "Alice" || alice.Open != 2 || alice.Overdue != 1 || alice.Completed != 0 {
+		t.Errorf("got Alice %+v, want 2 open, 1 overdue", alice)
+	}
+	if len(alice.Upcoming) != 1 || alice.Upcoming[0].ID != "ai-1" || alice.Upcoming[0].MeetingID != "m-1" || alice.Upcoming[0].MeetingTitle != "Planning" {
+		t.Errorf("got Alice upcoming %+v, want ai-1 from Planning", alice.Upcoming)
+	}
+	if bob.Owner != "Bob" || bob.Open != 0 || bob.Completed != 1 {
+		t.Errorf("got Bob %+v, want 1 completed", bob)
+	}
+
+	raw, err = srv.HandleToolJSON(context.Background(), "action_item_digest", json.RawMessage(`{"owner":"alice","upcoming_days":1}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result = mcpiface.ActionItemDigestResult{}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(result.Owners) != 1 || len(result.Owners[0].Upcoming) != 0 {
+		t.Errorf("got %+v, want only Alice with nothing due within a day", result.Owners)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "action_item_digest", json.RawMessage(`{"until":"tomorrow"}`))
+	if mcpiface.ErrorCodeOf(err) != mcpiface.CodeInvalidInput {
+		t.Errorf("got %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestServer_HandleToolJSON_FindConflicts(t *testing.T) {
+	repo := newMockRepo()
+	base := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	for i, id := range []string{"m-1", "m-2", "m-3"} {
+		m, _ := domain.New(domain.MeetingID(id), "Meeting "+id, base.Add(time.Duration(i)*20*time.Minute), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "find_conflicts", json.RawMessage(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.FindConflictsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(result.Conflicts) != 2 {
+		t.Fatalf("got %+v, want two overlapping pairs", result)
+	}
+	if got := result.Conflicts[0]; got.MeetingA.MeetingID != "m-1" || got.MeetingB.MeetingID != "m-2" || got.OverlapSeconds != 600 {
+		t.Errorf("got %+v, want m-1/m-2 overlapping 600s", got)
+	}
+
+	raw, err = srv.HandleToolJSON(context.Background(), "find_conflicts", json.RawMessage(`{"assumed_duration_minutes":15}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(raw) != `{"conflicts":[]}` {
+		t.Errorf("got %s, want an empty list", raw)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "find_conflicts", json.RawMessage(`{"until":"tomorrow"}`))
+	if mcpiface.ErrorCodeOf(err) != mcpiface.CodeInvalidInput {
+		t.Errorf("got %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestServer_HandleToolJSON_MeetingTimeline(t *testing.T) {
+	repo := newMockRepo()
+	for i, day := range []int{3, 5, 17} {
+		id := domain.MeetingID(fmt.Sprintf("m-%d", i))
+		m, _ := domain.New(id, "Meeting", time.Date(2025, 6, day, 10, 0, 0, 0, time.UTC), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "meeting_timeline", json.RawMessage(`{"granularity":"week"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"periods":[{"period":"2025-06-02","count":2},{"period":"2025-06-09","count":0},{"period":"2025-06-16","count":1}]}`; string(raw) != want {
+		t.Errorf("got %s, want %s", raw, want)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "meeting_timeline", json.RawMessage(`{"granularity":"quarter"}`))
+	if mcpiface.ErrorCodeOf(err) != mcpiface.CodeInvalidInput {
+		t.Errorf("got %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestServer_HandleToolJSON_MeetingTimeline_ScanLimited(t *testing.T) {
+	repo := newMockRepo()
+	for i, day := range []int{3, 5} {
+		m, _ := domain.New(domain.MeetingID(fmt.Sprintf("m-%d", i)), "Meeting", time.Date(2025, 6, day, 10, 0, 0, 0, time.UTC), domain.SourceZoom, nil)
+		m.ClearDomainEvents()
+		repo.addMeeting(m)
+	}
+	opts, _, _ := testDeps(repo)
+	opts.MeetingTimeline.SetMaxMeetings(1)
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "meeting_timeline", json.RawMessage(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.MeetingTimelineResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !result.ScanLimited || result.Note == "" {
+		t.Errorf("got %+v, want scan_limited with a note", result)
 	}
 }
 
@@ -56,6 +483,79 @@ func TestServer_HandleGetMeeting(t *testing.T) {
 	}
 }
 
+func TestServer_HandleGetMeeting_IncludeTranscript(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "No Transcript Yet"))
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Hello everyone", time.Now().UTC(), 0.95),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleGetMeeting(context.Background(), mcpiface.GetMeetingToolInput{ID: "m-1", IncludeTranscript: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Transcript == nil || len(result.Transcript.Utterances) != 1 {
+		t.Fatalf("expected inline transcript, got %+v", result.Transcript)
+	}
+
+	result, err = srv.HandleGetMeeting(context.Background(), mcpiface.GetMeetingToolInput{ID: "m-2", IncludeTranscript: true})
+	if err != nil {
+		t.Fatalf("missing transcript should not error: %v", err)
+	}
+	if result.Transcript != nil {
+		t.Error("expected transcript to be omitted when not ready")
+	}
+
+	result, err = srv.HandleGetMeeting(context.Background(), mcpiface.GetMeetingToolInput{ID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Transcript != nil {
+		t.Error("transcript should be omitted by default")
+	}
+}
+
+func TestServer_HandleToolJSON_GetMeetingFields(t *testing.T) {
+	repo := newMockRepo()
+	m := mustMeeting(t, "m-1", "Sprint Planning")
+	m.AttachSummary(domain.NewSummary("m-1", "Summary here", domain.SummaryAuto))
+	repo.addMeeting(m)
+
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "get_meeting", json.RawMessage(`{"id":"m-1","fields":["title","summary","bogus"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var masked map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &masked); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(masked) != 3 || masked["title"] == nil || masked["summary"] == nil {
+		t.Errorf("got keys %v, want title, summary and warnings", masked)
+	}
+	var warnings []string
+	if err := json.Unmarshal(masked["warnings"], &warnings); err != nil || len(warnings) != 1 || !strings.Contains(warnings[0], "bogus") {
+		t.Errorf("got warnings %v, want one naming bogus", warnings)
+	}
+
+	raw, err = srv.HandleToolJSON(context.Background(), "get_meeting", json.RawMessage(`{"id":"m-1"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var full map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &full); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if full["id"] == nil || full["datetime"] == nil || full["warnings"] != nil {
+		t.Errorf("empty fields should return the full object, got %v", full)
+	}
+}
+
 func TestServer_HandleGetMeeting_NotFound(t *testing.T) {
 	repo := newMockRepo()
 	srv := newTestServer(repo)
@@ -87,6 +587,29 @@ func TestServer_HandleGetTranscript(t *testing.T) {
 	}
 }
 
+func TestServer_HandleGetTranscript_TimestampFlags(t *testing.T) {
+	repo := newMockRepo()
+	ts := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Hello", ts, 0.95).WithEstimatedTimestamp(ts),
+		domain.NewUtterance("Bob", "Hi", time.Time{}, 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleGetTranscript(context.Background(), mcpiface.GetTranscriptToolInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Utterances[0].TimestampEstimated {
+		t.Error("expected first utterance to be flagged as estimated")
+	}
+	if got := result.Utterances[1].Timestamp; got != "" {
+		t.Errorf("got timestamp %q for a missing one, want empty", got)
+	}
+}
+
 func TestServer_HandleGetActionItems(t *testing.T) {
 	repo := newMockRepo()
 	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
@@ -106,6 +629,27 @@ func TestServer_HandleGetActionItems(t *testing.T) {
 	}
 }
 
+func TestServer_HandleGetActionItems_OwnerFilter(t *testing.T) {
+	repo := newMockRepo()
+	alice, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Write report", nil)
+	bob, _ := domain.NewActionItem("ai-2", "m-1", "Bob", "Book venue", nil)
+	repo.addActionItems("m-1", []*domain.ActionItem{alice, bob})
+
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "get_action_items", json.RawMessage(`{"meeting_id":"m-1","owner":"bo","completed":"open"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var results []mcpiface.ActionItemResult
+	if err := json.Unmarshal(raw, &results); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(results) != 1 || results[0].Owner != "Bob" {
+		t.Errorf("got %+v, want only Bob's item", results)
+	}
+}
+
 func TestServer_HandleToolJSON_ListMeetings(t *testing.T) {
 	repo := newMockRepo()
 	repo.addMeeting(mustMeeting(t, "m-1", "Test"))
@@ -117,12 +661,33 @@ func TestServer_HandleToolJSON_ListMeetings(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	var results []mcpiface.MeetingResult
-	if err := json.Unmarshal(raw, &results); err != nil {
+	var result mcpiface.ListMeetingsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
 		t.Fatalf("unmarshal error: %v", err)
 	}
-	if len(results) != 1 {
-		t.Errorf("got %d results", len(results))
+	if len(result.Meetings) != 1 {
+		t.Errorf("got %d results", len(result.Meetings))
+	}
+	if strings.Contains(string(raw), "partial") {
+		t.Errorf("complete list should omit partial, got %s", raw)
+	}
+}
+
+func TestServer_HandleToolJSON_CountMeetings(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "count_meetings", json.RawMessage(`{"since":"2026-01-01"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(raw) != `{"count":2}` {
+		t.Errorf("got %s, want a count of 2", raw)
+	}
+	if repo.listFilter.Since == nil || repo.listFilter.Limit != 0 {
+		t.Errorf("got filter %+v, want since set and no limit", repo.listFilter)
 	}
 }
 
@@ -136,22 +701,69 @@ func TestServer_HandleToolJSON_UnknownTool(t *testing.T) {
 	}
 }
 
-func TestServer_HandleMeetingStats(t *testing.T) {
+func TestServer_HandleMeetingStats(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
+
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleMeetingStats(context.Background(), mcpiface.MeetingStatsToolInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.TotalMeetings != 2 {
+		t.Errorf("got %d meetings, want 2", result.TotalMeetings)
+	}
+	if result.GeneratedAt == "" {
+		t.Error("expected GeneratedAt to be set")
+	}
+}
+
+func TestServer_HandleMeetingStats_GroupByWorkspace(t *testing.T) {
+	repo := newMockRepo()
+	m1 := mustMeeting(t, "m-1", "Sprint Planning")
+	m1.SetWorkspaceID("ws-a")
+	repo.addMeeting(m1)
+	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
+
+	srv := newTestServer(repo)
+
+	groupBy := "workspace"
+	result, err := srv.HandleMeetingStats(context.Background(), mcpiface.MeetingStatsToolInput{GroupBy: &groupBy})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.TotalMeetings != 2 {
+		t.Errorf("got %d meetings, want 2", result.TotalMeetings)
+	}
+	if ws := result.ByWorkspace["ws-a"]; ws == nil || ws.TotalMeetings != 1 {
+		t.Errorf("got ws-a stats %+v, want 1 meeting", ws)
+	}
+	if ws := result.ByWorkspace["unassigned"]; ws == nil || ws.TotalMeetings != 1 {
+		t.Errorf("got unassigned stats %+v, want 1 meeting", ws)
+	}
+}
+
+func TestServer_HandleMeetingStats_ScanLimited(t *testing.T) {
 	repo := newMockRepo()
 	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
 	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
-
-	srv := newTestServer(repo)
+	opts, _, _ := testDeps(repo)
+	stats := meetingapp.NewGetMeetingStats(repo)
+	stats.SetMaxMeetings(1)
+	opts.GetMeetingStats = stats
+	srv := mcpiface.NewServer("acai", "test", opts)
 
 	result, err := srv.HandleMeetingStats(context.Background(), mcpiface.MeetingStatsToolInput{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if result.TotalMeetings != 2 {
-		t.Errorf("got %d meetings, want 2", result.TotalMeetings)
+	if !result.ScanLimited || result.TotalMeetings != 1 {
+		t.Errorf("got scan_limited=%v total=%d, want true, 1", result.ScanLimited, result.TotalMeetings)
 	}
-	if result.GeneratedAt == "" {
-		t.Error("expected GeneratedAt to be set")
+	if !strings.Contains(result.Note, "narrow since and until") {
+		t.Errorf("got note %q", result.Note)
 	}
 }
 
@@ -207,6 +819,8 @@ type mockRepo struct {
 	meetings    map[domain.MeetingID]*domain.Meeting
 	transcripts map[domain.MeetingID]*domain.Transcript
 	actionItems map[domain.MeetingID][]*domain.ActionItem
+	// listFilter records the filter of the last List call.
+	listFilter domain.ListFilter
 }
 
 func newMockRepo() *mockRepo {
@@ -225,7 +839,8 @@ func (m *mockRepo) FindByID(_ context.Context, id domain.MeetingID) (*domain.Mee
 	return mtg, nil
 }
 
-func (m *mockRepo) List(_ context.Context, _ domain.ListFilter) ([]*domain.Meeting, error) {
+func (m *mockRepo) List(_ context.Context, filter domain.ListFilter) ([]*domain.Meeting, error) {
+	m.listFilter = filter
 	result := make([]*domain.Meeting, 0, len(m.meetings))
 	for _, mtg := range m.meetings {
 		result = append(result, mtg)
@@ -253,8 +868,8 @@ func (m *mockRepo) GetActionItems(_ context.Context, id domain.MeetingID) ([]*do
 	return m.actionItems[id], nil
 }
 
-func (m *mockRepo) Sync(_ context.Context, _ *time.Time) ([]domain.DomainEvent, error) {
-	return nil, nil
+func (m *mockRepo) Sync(_ context.Context, _ *time.Time) (*domain.SyncResult, error) {
+	return &domain.SyncResult{}, nil
 }
 
 func (m *mockRepo) addMeeting(mtg *domain.Meeting) {
@@ -318,6 +933,15 @@ func (m *mockNoteRepo) FindByID(_ context.Context, id annotatn.NoteID) (*annotat
 	return note, nil
 }
 
+func (m *mockNoteRepo) FindByIdempotencyKey(_ context.Context, key string) (*annotatn.AgentNote, error) {
+	for _, note := range m.notes {
+		if note.IdempotencyKey() == key {
+			return note, nil
+		}
+	}
+	return nil, annotatn.ErrNoteNotFound
+}
+
 func (m *mockNoteRepo) ListByMeeting(_ context.Context, meetingID string) ([]*annotatn.AgentNote, error) {
 	var result []*annotatn.AgentNote
 	for _, note := range m.notes {
@@ -331,6 +955,14 @@ func (m *mockNoteRepo) ListByMeeting(_ context.Context, meetingID string) ([]*an
 	return result, nil
 }
 
+func (m *mockNoteRepo) ListAll(_ context.Context) ([]*annotatn.AgentNote, error) {
+	result := make([]*annotatn.AgentNote, 0, len(m.notes))
+	for _, note := range m.notes {
+		result = append(result, note)
+	}
+	return result, nil
+}
+
 func (m *mockNoteRepo) Delete(_ context.Context, id annotatn.NoteID) error {
 	if _, ok := m.notes[id]; !ok {
 		return annotatn.ErrNoteNotFound
@@ -339,6 +971,30 @@ func (m *mockNoteRepo) Delete(_ context.Context, id annotatn.NoteID) error {
 	return nil
 }
 
+func (m *mockNoteRepo) DeleteByMeeting(_ context.Context, meetingID string) (int, error) {
+	deleted := 0
+	for id, note := range m.notes {
+		if note.MeetingID() == meetingID {
+			delete(m.notes, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (m *mockNoteRepo) Search(_ context.Context, query string, limit int) ([]*annotatn.AgentNote, error) {
+	result := []*annotatn.AgentNote{}
+	for _, note := range m.notes {
+		if strings.Contains(note.Content(), query) {
+			result = append(result, note)
+		}
+	}
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
 // mockWriteRepo implements domain.WriteRepository for tests.
 type mockWriteRepo struct {
 	items map[domain.ActionItemID]*domain.ActionItem
@@ -371,27 +1027,75 @@ func (m *mockDispatcher) Dispatch(_ context.Context, events []domain.DomainEvent
 	return nil
 }
 
+// mockTagRepo is an in-memory domain.TagRepository.
+type mockTagRepo struct {
+	tags map[domain.MeetingID][]string
+}
+
+func newMockTagRepo() *mockTagRepo {
+	return &mockTagRepo{tags: make(map[domain.MeetingID][]string)}
+}
+
+func (m *mockTagRepo) AddTag(_ context.Context, id domain.MeetingID, tag string) error {
+	if !slices.Contains(m.tags[id], tag) {
+		m.tags[id] = append(m.tags[id], tag)
+		slices.Sort(m.tags[id])
+	}
+	return nil
+}
+
+func (m *mockTagRepo) RemoveTag(_ context.Context, id domain.MeetingID, tag string) error {
+	m.tags[id] = slices.DeleteFunc(m.tags[id], func(t string) bool { return t == tag })
+	return nil
+}
+
+func (m *mockTagRepo) TagsOf(_ context.Context, id domain.MeetingID) ([]string, error) {
+	return append([]string{}, m.tags[id]...), nil
+}
+
+func (m *mockTagRepo) ListTags(_ context.Context) (map[domain.MeetingID][]string, error) {
+	return m.tags, nil
+}
+
 func testDeps(repo *mockRepo) (mcpiface.ServerOptions, *mockNoteRepo, *mockWriteRepo) {
 	wsRepo := &mockWorkspaceRepo{}
 	noteRepo := newMockNoteRepo()
 	writeRepo := newMockWriteRepo()
 	dispatcher := &mockDispatcher{}
+	tagRepo := newMockTagRepo()
+	listMeetings := meetingapp.NewListMeetings(repo)
+	listMeetings.SetTags(tagRepo)
+	getMeeting := meetingapp.NewGetMeeting(repo)
+	getMeeting.SetTags(tagRepo)
 
 	return mcpiface.ServerOptions{
-		ListMeetings:       meetingapp.NewListMeetings(repo),
-		GetMeeting:         meetingapp.NewGetMeeting(repo),
-		GetTranscript:      meetingapp.NewGetTranscript(repo),
-		SearchTranscripts:  meetingapp.NewSearchTranscripts(repo),
-		GetActionItems:     meetingapp.NewGetActionItems(repo),
-		GetMeetingStats:    meetingapp.NewGetMeetingStats(repo),
-		ListWorkspaces:     workspaceapp.NewListWorkspaces(wsRepo),
-		GetWorkspace:       workspaceapp.NewGetWorkspace(wsRepo),
-		AddNote:            annotationapp.NewAddNote(noteRepo, repo, dispatcher),
-		ListNotes:          annotationapp.NewListNotes(noteRepo),
-		DeleteNote:         annotationapp.NewDeleteNote(noteRepo, dispatcher),
-		CompleteActionItem: meetingapp.NewCompleteActionItem(repo, writeRepo, dispatcher),
-		UpdateActionItem:   meetingapp.NewUpdateActionItem(repo, writeRepo, dispatcher),
-		ExportEmbeddings:   embeddingapp.NewExportEmbeddings(repo, noteRepo),
+		ListMeetings:        listMeetings,
+		GetMeeting:          getMeeting,
+		GetTranscript:       meetingapp.NewGetTranscript(repo),
+		SearchTranscripts:   meetingapp.NewSearchTranscripts(repo),
+		SearchMeetings:      meetingapp.NewSearchMeetings(repo),
+		GetActionItems:      meetingapp.NewGetActionItems(repo),
+		GetMeetingStats:     meetingapp.NewGetMeetingStats(repo),
+		ExtractKeywords:     meetingapp.NewExtractKeywords(repo),
+		CompareMeetings:     meetingapp.NewCompareMeetings(meetingapp.NewGetMeeting(repo)),
+		OverdueActionItems:  meetingapp.NewOverdueActionItems(repo, meetingapp.NewGetActionItems(repo)),
+		ActionItemDigest:    meetingapp.NewActionItemDigest(repo, meetingapp.NewGetActionItems(repo)),
+		FindConflicts:       meetingapp.NewFindConflicts(repo),
+		MeetingTimeline:     meetingapp.NewMeetingTimeline(repo),
+		GetActionItem:       meetingapp.NewGetActionItem(repo),
+		ListWorkspaces:      workspaceapp.NewListWorkspaces(wsRepo),
+		GetWorkspace:        workspaceapp.NewGetWorkspace(wsRepo),
+		AddNote:             annotationapp.NewAddNote(noteRepo, repo, dispatcher),
+		ListNotes:           annotationapp.NewListNotes(noteRepo),
+		SearchNotes:         annotationapp.NewSearchNotes(noteRepo),
+		DeleteNote:          annotationapp.NewDeleteNote(noteRepo, dispatcher),
+		DeleteMeetingNotes:  annotationapp.NewDeleteNotesByMeeting(noteRepo, dispatcher),
+		CompleteActionItem:  meetingapp.NewCompleteActionItem(repo, writeRepo, dispatcher),
+		CompleteActionItems: meetingapp.NewCompleteActionItems(repo, meetingapp.NewCompleteActionItem(repo, writeRepo, dispatcher)),
+		UpdateActionItem:    meetingapp.NewUpdateActionItem(repo, writeRepo, dispatcher),
+		TagMeeting:          meetingapp.NewTagMeeting(tagRepo),
+		UntagMeeting:        meetingapp.NewUntagMeeting(tagRepo),
+		ExportEmbeddings:    embeddingapp.NewExportEmbeddings(repo, noteRepo),
 	}, noteRepo, writeRepo
 }
 
@@ -406,3 +1110,392 @@ func newTestServerWithWorkspaces(repo *mockRepo, wsRepo *mockWorkspaceRepo) *mcp
 	opts.GetWorkspace = workspaceapp.NewGetWorkspace(wsRepo)
 	return mcpiface.NewServer("acai", "test", opts)
 }
+
+func TestServer_DisabledTools_NotRegistered(t *testing.T) {
+	opts, _, _ := testDeps(newMockRepo())
+	opts.DisabledTools = []string{"add_note", "delete_note", "not_a_tool"}
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	registered := make(map[string]bool)
+	for _, tool := range srv.Inner().Tools() {
+		registered[tool.Name] = true
+	}
+
+	if registered["add_note"] {
+		t.Error("add_note should not be registered when disabled")
+	}
+	if registered["delete_note"] {
+		t.Error("delete_note should not be registered when disabled")
+	}
+	if !registered["list_notes"] {
+		t.Error("list_notes should still be registered")
+	}
+}
+
+func TestServer_ToolOverrides(t *testing.T) {
+	opts, _, _ := testDeps(newMockRepo())
+	opts.ToolOverrides = map[string]mcpiface.ToolOverride{
+		"get_meeting":   {Description: "Fetch a customer call"},
+		"meeting_stats": {DisableUIResource: true},
+		"not_a_tool":    {Description: "ignored"},
+	}
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	descriptions := make(map[string]string)
+	metas := make(map[string]map[string]any)
+	for _, tool := range srv.Inner().Tools() {
+		descriptions[tool.Name] = tool.Description
+		metas[tool.Name] = tool.Meta
+	}
+
+	if got := descriptions["get_meeting"]; got != "Fetch a customer call" {
+		t.Errorf("get_meeting description: got %q", got)
+	}
+	if got := descriptions["get_transcript"]; !strings.HasPrefix(got, "Get the transcript for a meeting") {
+		t.Errorf("get_transcript should keep its default description, got %q", got)
+	}
+	if meta := metas["meeting_stats"]; meta != nil {
+		t.Errorf("meeting_stats should not advertise a UI resource, got meta %v", meta)
+	}
+	if _, ok := descriptions["not_a_tool"]; ok {
+		t.Error("unknown override must not register a tool")
+	}
+}
+
+func TestServer_HandleToolJSON_DisabledTool(t *testing.T) {
+	opts, _, _ := testDeps(newMockRepo())
+	opts.DisabledTools = []string{"list_meetings"}
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	_, err := srv.HandleToolJSON(context.Background(), "list_meetings", json.RawMessage(`{}`))
+	if !errors.Is(err, mcpiface.ErrToolDisabled) {
+		t.Errorf("got error %v, want %v", err, mcpiface.ErrToolDisabled)
+	}
+}
+
+func TestServer_HandleGetTranscript_Truncated(t *testing.T) {
+	repo := newMockRepo()
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "one", now, 0.9),
+		domain.NewUtterance("Bob", "two", now.Add(time.Second), 0.9),
+		domain.NewUtterance("Alice", "three", now.Add(2*time.Second), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	opts, _, _ := testDeps(repo)
+	getTranscript := meetingapp.NewGetTranscript(repo)
+	getTranscript.SetMaxUtterances(2)
+	opts.GetTranscript = getTranscript
+	srv := mcpiface.NewServer("acai", "test", opts)
+
+	result, err := srv.HandleGetTranscript(context.Background(), mcpiface.GetTranscriptToolInput{MeetingID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Utterances) != 2 || !result.Truncated || !result.HasMore {
+		t.Errorf("got %d utterances, truncated=%v has_more=%v", len(result.Utterances), result.Truncated, result.HasMore)
+	}
+	if !strings.Contains(result.Note, "1 omitted") {
+		t.Errorf("got note %q", result.Note)
+	}
+}
+
+func TestServer_HandleGetTranscript_MergeSpeakerTurns(t *testing.T) {
+	repo := newMockRepo()
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "one", now, 0.9),
+		domain.NewUtterance("Alice", "two", now.Add(time.Second), 0.9),
+		domain.NewUtterance("Bob", "three", now.Add(2*time.Second), 0.9),
+		domain.NewUtterance("Alice", "four", now.Add(3*time.Second), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleGetTranscript(context.Background(), mcpiface.GetTranscriptToolInput{
+		MeetingID: "m-1", MergeSpeakerTurns: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Utterances) != 0 || len(result.Segments) != 3 {
+		t.Fatalf("got %d utterances and %d segments, want 0 and 3", len(result.Utterances), len(result.Segments))
+	}
+	if seg := result.Segments[0]; seg.Speaker != "Alice" || seg.Text != "one two" || seg.Utterances != 2 || seg.Start == seg.End {
+		t.Errorf("got first segment %+v", seg)
+	}
+	if result.TotalUtterances != 4 {
+		t.Errorf("got total %d, want 4", result.TotalUtterances)
+	}
+}
+
+func TestServer_HandleGetTranscript_ConfidenceHistogramWithoutScores(t *testing.T) {
+	repo := newMockRepo()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "one", time.Now().UTC(), 0),
+	})
+	repo.addTranscript("m-1", &transcript)
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleGetTranscript(context.Background(), mcpiface.GetTranscriptToolInput{
+		MeetingID: "m-1", ConfidenceHistogram: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ConfidenceHistogram == nil || result.ConfidenceHistogram.HasScores {
+		t.Fatalf("got histogram %+v, want one without scores", result.ConfidenceHistogram)
+	}
+	if !strings.Contains(result.Note, "no confidence scores") {
+		t.Errorf("got note %q", result.Note)
+	}
+}
+
+func TestServer_HandleGetTranscript_Paginated(t *testing.T) {
+	repo := newMockRepo()
+	now := time.Now().UTC()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "one", now, 0.9),
+		domain.NewUtterance("Bob", "two", now.Add(time.Second), 0.9),
+		domain.NewUtterance("Alice", "three", now.Add(2*time.Second), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	srv := newTestServer(repo)
+
+	offset, limit := 1, 1
+	result, err := srv.HandleGetTranscript(context.Background(), mcpiface.GetTranscriptToolInput{
+		MeetingID: "m-1", Offset: &offset, Limit: &limit,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Utterances) != 1 || result.Utterances[0].Text != "two" {
+		t.Errorf("got utterances %+v, want only \"two\"", result.Utterances)
+	}
+	if result.TotalUtterances != 3 || !result.HasMore {
+		t.Errorf("got total %d has_more %v, want 3 true", result.TotalUtterances, result.HasMore)
+	}
+}
+
+func TestServer_HandleExtractKeywords(t *testing.T) {
+	repo := newMockRepo()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "Pricing pricing and the roadmap", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	srv := newTestServer(repo)
+
+	limit := 1
+	result, err := srv.HandleExtractKeywords(context.Background(), mcpiface.ExtractKeywordsToolInput{MeetingID: "m-1", Limit: &limit})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Keywords) != 1 || result.Keywords[0].Term != "pricing" || result.Keywords[0].Count != 2 {
+		t.Errorf("got %+v, want [{pricing 2}]", result.Keywords)
+	}
+}
+
+func TestServer_HandleExtractKeywords_Language(t *testing.T) {
+	repo := newMockRepo()
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "und das Budget", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+	srv := newTestServer(repo)
+
+	result, err := srv.HandleExtractKeywords(context.Background(), mcpiface.ExtractKeywordsToolInput{MeetingID: "m-1", Language: "de"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Keywords) != 1 || result.Keywords[0].Term != "budget" || result.Warning != "" {
+		t.Errorf("got %+v, want only budget", result)
+	}
+
+	result, err = srv.HandleExtractKeywords(context.Background(), mcpiface.ExtractKeywordsToolInput{MeetingID: "m-1", Language: "xx"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Keywords) != 3 || result.Warning == "" {
+		t.Errorf("got %+v, want all terms unfiltered with a warning", result)
+	}
+}
+
+func TestServer_HandleTagMeeting(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+	repo.addMeeting(mustMeeting(t, "m-2", "Retrospective"))
+	srv := newTestServer(repo)
+	ctx := context.Background()
+
+	raw, err := srv.HandleToolJSON(ctx, "tag_meeting", json.RawMessage(`{"meeting_id":"m-1","tag":"Planning"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var tagged mcpiface.MeetingTagsResult
+	if err := json.Unmarshal(raw, &tagged); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(tagged.Tags) != 1 || tagged.Tags[0] != "planning" {
+		t.Errorf("got tags %v, want [planning]", tagged.Tags)
+	}
+
+	list, err := srv.HandleListMeetings(ctx, mcpiface.ListMeetingsToolInput{Tags: []string{"planning"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Meetings) != 1 || list.Meetings[0].ID != "m-1" || len(list.Meetings[0].Tags) != 1 {
+		t.Errorf("got %+v, want only m-1 with its tag", list.Meetings)
+	}
+
+	detail, err := srv.HandleGetMeeting(ctx, mcpiface.GetMeetingToolInput{ID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(detail.Tags) != 1 || detail.Tags[0] != "planning" {
+		t.Errorf("get_meeting tags: got %v", detail.Tags)
+	}
+
+	raw, err = srv.HandleToolJSON(ctx, "untag_meeting", json.RawMessage(`{"meeting_id":"m-1","tag":"planning"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(raw), `"tags":[]`) {
+		t.Errorf("got %s, want an empty tag list", raw)
+	}
+
+	_, err = srv.HandleToolJSON(ctx, "tag_meeting", json.RawMessage(`{"meeting_id":"m-1","tag":" "}`))
+	if mcpiface.ErrorCodeOf(err) != mcpiface.CodeInvalidInput {
+		t.Errorf("blank tag: got %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestServer_HandleCompareMeetings(t *testing.T) {
+	repo := newMockRepo()
+	now := time.Now().UTC()
+	a, _ := domain.New("m-1", "Standup", now.Add(-7*24*time.Hour), domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+		domain.NewParticipant("Bob", "bob@example.com", domain.RoleAttendee),
+	})
+	repo.addMeeting(a)
+	b, _ := domain.New("m-2", "Standup", now, domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+	})
+	item, _ := domain.NewActionItem("ai-1", "m-2", "Alice", "Ship it", nil)
+	b.AddActionItem(item)
+	repo.addMeeting(b)
+	transcript := domain.NewTranscript("m-2", []domain.Utterance{
+		domain.NewUtterance("Alice", "Morning", now, 0.9),
+		domain.NewUtterance("Alice", "Done", now.Add(10*time.Minute), 0.9),
+	})
+	repo.addTranscript("m-2", &transcript)
+	srv := newTestServer(repo)
+
+	raw, err := srv.HandleToolJSON(context.Background(), "compare_meetings", json.RawMessage(`{"id_a":"m-1","id_b":"m-2"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result mcpiface.MeetingComparisonResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(result.ParticipantsRemoved) != 1 || result.ParticipantsRemoved[0].Name != "Bob" || len(result.ParticipantsAdded) != 0 {
+		t.Errorf("got added %+v, removed %+v", result.ParticipantsAdded, result.ParticipantsRemoved)
+	}
+	if result.ActionItemDelta != 1 || result.B.DurationSeconds != 600 || result.DurationDeltaSeconds != 600 {
+		t.Errorf("got action item delta %d, duration %v, delta %v", result.ActionItemDelta, result.B.DurationSeconds, result.DurationDeltaSeconds)
+	}
+
+	_, err = srv.HandleToolJSON(context.Background(), "compare_meetings", json.RawMessage(`{"id_a":"m-1","id_b":"m-404"}`))
+	var toolErr *mcpiface.ToolError
+	if !errors.As(err, &toolErr) || toolErr.Code != mcpiface.CodeNotFound || !strings.Contains(toolErr.Message, "m-404") {
+		t.Errorf("got %v, want NOT_FOUND naming m-404", err)
+	}
+}
+
+func TestServer_SetLocation(t *testing.T) {
+	repo := newMockRepo()
+	at := time.Date(2025, 6, 1, 14, 30, 0, 0, time.UTC)
+	mtg, _ := domain.New("m-1", "Sync", at, domain.SourceZoom, nil)
+	repo.addMeeting(mtg)
+	srv := newTestServer(repo)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("zone database unavailable: %v", err)
+	}
+	srv.SetLocation(berlin)
+
+	result, err := srv.HandleGetMeeting(context.Background(), mcpiface.GetMeetingToolInput{ID: "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Datetime != "2025-06-01T16:30:00+02:00" {
+		t.Errorf("got datetime %q, want Berlin time with offset", result.Datetime)
+	}
+}
+
+func TestServer_SummarizeMeetingPrompt(t *testing.T) {
+	repo := newMockRepo()
+	mtg, _ := domain.New("m-1", "Sprint Planning", time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC), domain.SourceZoom, []domain.Participant{
+		domain.NewParticipant("Alice", "alice@example.com", domain.RoleHost),
+	})
+	item, _ := domain.NewActionItem("ai-1", "m-1", "Alice", "Draft the roadmap", nil)
+	mtg.AddActionItem(item)
+	repo.addMeeting(mtg)
+	transcript := domain.NewTranscript("m-1", []domain.Utterance{
+		domain.NewUtterance("Alice", "We ship on Friday", time.Now().UTC(), 0.9),
+	})
+	repo.addTranscript("m-1", &transcript)
+
+	srv := newTestServer(repo)
+	prompt, ok := srv.Inner().GetPrompt("summarize_meeting")
+	if !ok {
+		t.Fatal("summarize_meeting prompt not registered")
+	}
+
+	result, err := prompt.Get(context.Background(), map[string]string{"meeting_id": "m-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Messages) != 2 {
+		t.Fatalf("got %d messages, want instructions and content", len(result.Messages))
+	}
+	content, ok := result.Messages[1].Content.(mcpfw.TextContent)
+	if !ok {
+		t.Fatalf("got content %T, want text", result.Messages[1].Content)
+	}
+	for _, want := range []string{"# Sprint Planning", "Alice <alice@example.com>", "[ ] Draft the roadmap", "Alice: We ship on Friday"} {
+		if !strings.Contains(content.Text, want) {
+			t.Errorf("prompt missing %q:\n%s", want, content.Text)
+		}
+	}
+
+	if _, err := prompt.Get(context.Background(), map[string]string{}); err == nil {
+		t.Error("expected error without meeting_id")
+	}
+	_, err = srv.HandleSummarizeMeetingPrompt(context.Background(), "m-404")
+	if !errors.Is(err, domain.ErrMeetingNotFound) {
+		t.Errorf("got %v, want %v", err, domain.ErrMeetingNotFound)
+	}
+}
+
+func TestServer_SummarizeMeetingPrompt_NotRegisteredWithoutUseCases(t *testing.T) {
+	srv := mcpiface.NewServer("acai", "test", mcpiface.ServerOptions{})
+	if _, ok := srv.Inner().GetPrompt("summarize_meeting"); ok {
+		t.Error("summarize_meeting should not be registered without get-meeting and get-transcript")
+	}
+}
+
+func TestServer_SummarizeMeetingPrompt_WithoutTranscript(t *testing.T) {
+	repo := newMockRepo()
+	repo.addMeeting(mustMeeting(t, "m-1", "Sprint Planning"))
+
+	result, err := newTestServer(repo).HandleSummarizeMeetingPrompt(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content := result.Messages[1].Content.(mcpfw.TextContent)
+	if !strings.Contains(content.Text, "No transcript is available") {
+		t.Errorf("expected a missing-transcript note, got:\n%s", content.Text)
+	}
+}
@@ -0,0 +1,70 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	mcpfw "github.com/felixgeelhaar/mcp-go"
+	"github.com/felixgeelhaar/mcp-go/protocol"
+)
+
+// SetToolHandler routes tools/call requests through h on every transport
+// served with ServeOptions, typically the policy and metrics middlewares
+// wrapping s. Without it the registered tool handlers run directly.
+func (s *Server) SetToolHandler(h ToolHandler) {
+	s.toolHandler = h
+}
+
+// ToolCallMiddleware answers tools/call requests for registered tools with
+// the handler set by SetToolHandler. Other requests, and calls to unknown
+// tools, go to next. Errors carry the *ToolError as their data, so clients
+// see its code, correlation ID and, for POLICY_DENIED, the rule.
+func (s *Server) ToolCallMiddleware() mcpfw.Middleware {
+	return func(next mcpfw.MiddlewareHandlerFunc) mcpfw.MiddlewareHandlerFunc {
+		return func(ctx context.Context, req *protocol.Request) (*protocol.Response, error) {
+			if req.Method != protocol.MethodToolsCall || s.toolHandler == nil {
+				return next(ctx, req)
+			}
+
+			var params struct {
+				Name      string          `json:"name"`
+				Arguments json.RawMessage `json:"arguments"`
+			}
+			if err := json.Unmarshal(req.Params, &params); err != nil {
+				return next(ctx, req)
+			}
+			tool, ok := s.inner.GetTool(params.Name)
+			if !ok {
+				return next(ctx, req)
+			}
+			if len(params.Arguments) == 0 {
+				params.Arguments = json.RawMessage(`{}`)
+			}
+
+			result, err := s.toolHandler.HandleToolJSON(ctx, params.Name, params.Arguments)
+			if err != nil {
+				return nil, toProtocolError(err)
+			}
+
+			response := map[string]any{
+				"content": []map[string]any{
+					{"type": "text", "text": string(result)},
+				},
+			}
+			if tool.Meta() != nil {
+				response["_meta"] = tool.Meta()
+			}
+			return protocol.NewResponse(req.ID, response), nil
+		}
+	}
+}
+
+func toProtocolError(err error) *protocol.Error {
+	pErr := protocol.NewInternalError(err.Error())
+	var toolErr *ToolError
+	if errors.As(err, &toolErr) {
+		pErr.Data = toolErr
+	}
+	return pErr
+}